@@ -0,0 +1,223 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package authMetrics records the outcome of reverse-authentication
+// handshakes so the reason a host cannot authenticate can be queried instead
+// of found in the logs.
+package authMetrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/netTime"
+)
+
+// Errors wrapped by the errors returned from Validate, which Classify maps to
+// a Result.
+var (
+	ErrUnknownHost      = errors.New("no host set up with the sender's ID")
+	ErrMalformedToken   = errors.New("malformed token")
+	ErrSignatureInvalid = errors.New("invalid token signature")
+	ErrTokenRejected    = errors.New("token rejected")
+)
+
+// Result is the outcome of a single step of the auth handshake.
+type Result uint8
+
+const (
+	// TokenIssued means a token was generated for a RequestToken call
+	TokenIssued Result = iota
+	// TokenValidated means a signed token was accepted
+	TokenValidated
+	// UnknownHost means the sender has no host set up on this side
+	UnknownHost
+	// MalformedToken means the token or its wrapper could not be unmarshalled
+	MalformedToken
+	// SignatureInvalid means the token signature did not verify against the
+	// sender's certificate
+	SignatureInvalid
+	// TokenRejected means the token was unknown or had expired. This is
+	// usually caused by clock skew between the hosts or a stale handshake.
+	TokenRejected
+	// Failed is any other failure
+	Failed
+	NumResults
+)
+
+// String returns a human-readable name for the Result.
+func (r Result) String() string {
+	switch r {
+	case TokenIssued:
+		return "TOKEN_ISSUED"
+	case TokenValidated:
+		return "TOKEN_VALIDATED"
+	case UnknownHost:
+		return "UNKNOWN_HOST"
+	case MalformedToken:
+		return "MALFORMED_TOKEN"
+	case SignatureInvalid:
+		return "SIGNATURE_INVALID"
+	case TokenRejected:
+		return "TOKEN_REJECTED"
+	case Failed:
+		return "FAILED"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// Record is the most recent handshake result seen for a host.
+type Record struct {
+	Result    Result
+	Error     string
+	Timestamp time.Time
+}
+
+// Tracker stores the last handshake Record for each host and counts every
+// Result. It is safe for concurrent use.
+type Tracker struct {
+	records  map[id.ID]Record
+	counters [NumResults]uint64
+	mux      sync.RWMutex
+}
+
+// NewTracker creates an empty Tracker.
+func NewTracker() *Tracker {
+	return &Tracker{
+		records: make(map[id.ID]Record),
+	}
+}
+
+// RecordIssued counts a token issued in response to a RequestToken. The
+// requester is not authenticated at this point so no host record is kept.
+func (t *Tracker) RecordIssued(err error) {
+	r := TokenIssued
+	if err != nil {
+		r = Failed
+	}
+
+	t.mux.Lock()
+	t.counters[r]++
+	t.mux.Unlock()
+}
+
+// HostGetter returns the host with the ID, e.g. connect.ProtoComms.GetHost.
+type HostGetter func(hid *id.ID) (*connect.Host, bool)
+
+// ValidateFunc validates a signed reverse-authentication token, e.g.
+// connect.ProtoComms.ValidateToken.
+type ValidateFunc func(msg *messages.AuthenticatedMessage) error
+
+// Validate checks that the token message is well formed and sent by a host
+// known to getHost, validates it and records the result. A Record is only
+// kept for known hosts, so unauthenticated callers cannot grow the Tracker
+// by cycling IDs; every result is counted.
+//
+// Errors from validate are wrapped in ErrTokenRejected unless they already
+// wrap one of the errors of this package. connect.ProtoComms.ValidateToken
+// does not report which check failed, so a bad signature is only reported as
+// SignatureInvalid by validators returning ErrSignatureInvalid.
+func (t *Tracker) Validate(msg *messages.AuthenticatedMessage,
+	getHost HostGetter, validate ValidateFunc) error {
+	sender, err := id.Unmarshal(msg.ID)
+	if err != nil {
+		return t.record(nil, errors.WithMessagef(ErrMalformedToken,
+			"Unable to unmarshal sender ID: %+v", err))
+	}
+	if err = ptypes.UnmarshalAny(msg.Message, &messages.AssignToken{}); err != nil {
+		return t.record(nil, errors.WithMessagef(ErrMalformedToken,
+			"Unable to unmarshal token from %s: %+v", sender, err))
+	}
+	if _, exists := getHost(sender); !exists {
+		return t.record(nil, errors.WithMessagef(ErrUnknownHost, "%s",
+			sender))
+	}
+
+	if err = validate(msg); err != nil && Classify(err) == Failed {
+		err = errors.WithMessagef(ErrTokenRejected, "%+v", err)
+	}
+	return t.record(sender, err)
+}
+
+// record counts the result of a validation, stores it as the last Record of
+// the host if it is not nil and returns the error.
+func (t *Tracker) record(hid *id.ID, err error) error {
+	rec := Record{
+		Result:    Classify(err),
+		Timestamp: netTime.Now(),
+	}
+	if err != nil {
+		rec.Error = err.Error()
+	}
+
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	t.counters[rec.Result]++
+	if hid != nil {
+		t.records[*hid] = rec
+	}
+	return err
+}
+
+// Get returns the last handshake Record for the host.
+func (t *Tracker) Get(hostID *id.ID) (Record, bool) {
+	t.mux.RLock()
+	defer t.mux.RUnlock()
+
+	rec, exists := t.records[*hostID]
+	return rec, exists
+}
+
+// GetCount returns the number of times the Result has been recorded.
+func (t *Tracker) GetCount(r Result) uint64 {
+	if r >= NumResults {
+		return 0
+	}
+
+	t.mux.RLock()
+	defer t.mux.RUnlock()
+	return t.counters[r]
+}
+
+// GetCounts returns a copy of all counters keyed by Result.
+func (t *Tracker) GetCounts() map[Result]uint64 {
+	t.mux.RLock()
+	defer t.mux.RUnlock()
+
+	counts := make(map[Result]uint64, NumResults)
+	for r, c := range t.counters {
+		counts[Result(r)] = c
+	}
+	return counts
+}
+
+// Classify maps an error wrapping one of the errors of this package, as
+// returned by Validate, to a Result. A nil error is TokenValidated and any
+// other error is Failed.
+func Classify(err error) Result {
+	switch {
+	case err == nil:
+		return TokenValidated
+	case errors.Is(err, ErrUnknownHost):
+		return UnknownHost
+	case errors.Is(err, ErrSignatureInvalid):
+		return SignatureInvalid
+	case errors.Is(err, ErrMalformedToken):
+		return MalformedToken
+	case errors.Is(err, ErrTokenRejected):
+		return TokenRejected
+	default:
+		return Failed
+	}
+}
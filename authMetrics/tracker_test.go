@@ -0,0 +1,131 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package authMetrics
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// Tests that errors wrapping the errors of the package are mapped to the
+// expected Result.
+func TestClassify(t *testing.T) {
+	tests := map[Result]error{
+		TokenValidated:   nil,
+		UnknownHost:      errors.WithMessage(ErrUnknownHost, "gateway"),
+		SignatureInvalid: errors.WithStack(ErrSignatureInvalid),
+		MalformedToken:   errors.WithMessage(ErrMalformedToken, "bad"),
+		TokenRejected:    errors.WithMessage(ErrTokenRejected, "[1 2 3]"),
+		Failed:           errors.New("Invalid token signature: bad"),
+	}
+
+	for expected, err := range tests {
+		if r := Classify(err); r != expected {
+			t.Errorf("Unexpected result for %v.\nexpected: %s\nreceived: %s",
+				err, expected, r)
+		}
+	}
+}
+
+// newTokenMessage returns a token message sent by the host.
+func newTokenMessage(hid *id.ID, t *testing.T) *messages.AuthenticatedMessage {
+	token, err := ptypes.MarshalAny(&messages.AssignToken{Token: []byte{1}})
+	if err != nil {
+		t.Fatalf("Failed to marshal token: %+v", err)
+	}
+	return &messages.AuthenticatedMessage{ID: hid.Marshal(), Message: token}
+}
+
+// Tests that validations are stored for known hosts and counted.
+func TestTracker_Validate(t *testing.T) {
+	tr := NewTracker()
+	hostID := id.NewIdFromString("gateway", id.Gateway, t)
+	getHost := func(hid *id.ID) (*connect.Host, bool) {
+		return nil, hid.Cmp(hostID)
+	}
+	msg := newTokenMessage(hostID, t)
+
+	err := tr.Validate(msg, getHost, func(*messages.AuthenticatedMessage) error {
+		return errors.WithMessage(ErrSignatureInvalid, "bad")
+	})
+	if !errors.Is(err, ErrSignatureInvalid) {
+		t.Errorf("Validate() changed the error: %+v", err)
+	}
+	rec, exists := tr.Get(hostID)
+	if !exists {
+		t.Fatalf("No record stored for %s.", hostID)
+	}
+	if rec.Result != SignatureInvalid || rec.Error == "" {
+		t.Errorf("Unexpected record: %+v", rec)
+	}
+
+	err = tr.Validate(msg, getHost, func(*messages.AuthenticatedMessage) error {
+		return errors.New("Failed to validate token")
+	})
+	if rec, _ = tr.Get(hostID); rec.Result != TokenRejected {
+		t.Errorf("Unclassified validation error not rejected: %+v", rec)
+	}
+
+	err = tr.Validate(msg, getHost, func(*messages.AuthenticatedMessage) error {
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Validate() failed: %+v", err)
+	}
+	if rec, _ = tr.Get(hostID); rec.Result != TokenValidated {
+		t.Errorf("Record not replaced by the latest result: %+v", rec)
+	}
+
+	tr.RecordIssued(nil)
+	counts := tr.GetCounts()
+	if counts[SignatureInvalid] != 1 || counts[TokenRejected] != 1 ||
+		counts[TokenValidated] != 1 || counts[TokenIssued] != 1 {
+		t.Errorf("Unexpected counts: %v", counts)
+	}
+}
+
+// Tests that results for unknown senders and malformed tokens are counted
+// without storing a record or calling the validator.
+func TestTracker_Validate_Unknown(t *testing.T) {
+	tr := NewTracker()
+	getHost := func(*id.ID) (*connect.Host, bool) { return nil, false }
+	validate := func(*messages.AuthenticatedMessage) error {
+		t.Errorf("Validator called for an unknown sender.")
+		return nil
+	}
+
+	for i := 0; i < 10; i++ {
+		hid := id.NewIdFromUInt(uint64(i), id.Gateway, t)
+		err := tr.Validate(newTokenMessage(hid, t), getHost, validate)
+		if !errors.Is(err, ErrUnknownHost) {
+			t.Errorf("Unexpected error for unknown sender: %+v", err)
+		}
+		if _, exists := tr.Get(hid); exists {
+			t.Errorf("Record stored for unknown sender %s.", hid)
+		}
+	}
+
+	err := tr.Validate(&messages.AuthenticatedMessage{ID: []byte{1}},
+		getHost, validate)
+	if !errors.Is(err, ErrMalformedToken) {
+		t.Errorf("Unexpected error for malformed sender: %+v", err)
+	}
+
+	if len(tr.records) != 0 {
+		t.Errorf("Records stored for unknown senders: %v", tr.records)
+	}
+	counts := tr.GetCounts()
+	if counts[UnknownHost] != 10 || counts[MalformedToken] != 1 {
+		t.Errorf("Unexpected counts: %v", counts)
+	}
+}
@@ -16,6 +16,7 @@ import (
 	pb "gitlab.com/elixxir/comms/mixmessages"
 	"gitlab.com/elixxir/comms/node"
 	"gitlab.com/elixxir/comms/testkeys"
+	"gitlab.com/elixxir/comms/testutils"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/primitives/id"
 )
@@ -81,3 +82,9 @@ func TestBadCerts(t *testing.T) {
 	_ = StartAuthorizerServer(testId, RegAddress, NewImplementation(),
 		[]byte("bad cert"), []byte("bad key"))
 }
+
+// Tests that every Handler method has an endpoint and every endpoint a
+// Handler method.
+func TestHandler_Endpoints(t *testing.T) {
+	testutils.CheckEndpoints(t, (*Handler)(nil), &pb.Authorizer_ServiceDesc)
+}
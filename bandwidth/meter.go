@@ -0,0 +1,324 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package bandwidth accounts for the bytes a comms object sends to and
+// receives from each host over a rolling window, and enforces byte quotas on
+// them, for operators on metered links. Bytes are counted by client
+// interceptors, which find the host of each call from the connection the
+// instrumentation hooks bound to it; the hooks check the quota of the host
+// before each send and stream.
+package bandwidth
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"gitlab.com/elixxir/comms/interceptors"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultWindow is the default length of the rolling window usage is
+// accounted over.
+const DefaultWindow = 24 * time.Hour
+
+// slots is the number of slots the window is divided into. Usage leaves the
+// window one slot at a time.
+const slots = 60
+
+// Usage is the number of bytes sent to and received from a host.
+type Usage struct {
+	Sent     uint64
+	Received uint64
+}
+
+// Total returns the number of bytes sent and received.
+func (u Usage) Total() uint64 {
+	return u.Sent + u.Received
+}
+
+// QuotaFunc is called before a send to a host whose usage within the window
+// has reached its quota. It may block, e.g. until the operator raises the
+// quota, and returns an error to reject the send or nil to allow it. It is
+// called on the sending goroutine.
+type QuotaFunc func(hid *id.ID, usage Usage, quota uint64) error
+
+// slot holds the usage of a host during one slot of the window.
+type slot struct {
+	start time.Time
+	usage Usage
+}
+
+// Meter accounts for the usage of each host and enforces their quotas.
+type Meter struct {
+	window time.Duration
+	usage  map[id.ID]*[slots]slot
+	// Quotas of each host, and of hosts without their own. Zero is no quota.
+	quotas       map[id.ID]uint64
+	defaultQuota uint64
+	onExceeded   QuotaFunc
+	// Host of each connection bound by the instrumentation hooks
+	conns map[*grpc.ClientConn]*id.ID
+	// Chains the interceptors are installed on
+	installed map[*interceptors.Chain]bool
+	now       func() time.Time
+	mux       sync.Mutex
+}
+
+// NewMeter returns a Meter without quotas accounting over the window. If the
+// window is zero, DefaultWindow is used.
+func NewMeter(window time.Duration) *Meter {
+	if window <= 0 {
+		window = DefaultWindow
+	}
+	return &Meter{
+		window:    window,
+		usage:     make(map[id.ID]*[slots]slot),
+		quotas:    make(map[id.ID]uint64),
+		conns:     make(map[*grpc.ClientConn]*id.ID),
+		installed: make(map[*interceptors.Chain]bool),
+		now:       time.Now,
+	}
+}
+
+// SetDefaultQuota sets the number of bytes which may be sent to and received
+// from each host without a quota of its own within the window. Zero removes
+// the quota.
+func (m *Meter) SetDefaultQuota(bytes uint64) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	m.defaultQuota = bytes
+}
+
+// SetQuota sets the quota of the host, overriding the default. Zero removes
+// the quota of the host, which returns to the default.
+func (m *Meter) SetQuota(hid *id.ID, bytes uint64) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	if bytes == 0 {
+		delete(m.quotas, *hid)
+		return
+	}
+	m.quotas[*hid] = bytes
+}
+
+// OnQuotaExceeded sets the function called before a send to a host which has
+// reached its quota. Without one, such sends are rejected.
+func (m *Meter) OnQuotaExceeded(f QuotaFunc) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	m.onExceeded = f
+}
+
+// Usage returns the usage of the host within the window.
+func (m *Meter) Usage(hid *id.ID) Usage {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	return m.usageUnsafe(hid)
+}
+
+// usageUnsafe returns the usage of the host within the window. Must be
+// called with the lock held.
+func (m *Meter) usageUnsafe(hid *id.ID) Usage {
+	var total Usage
+	ring, exists := m.usage[*hid]
+	if !exists {
+		return total
+	}
+	since := m.now().Add(-m.window)
+	for _, s := range ring {
+		if s.start.After(since) {
+			total.Sent += s.usage.Sent
+			total.Received += s.usage.Received
+		}
+	}
+	return total
+}
+
+// Record adds the bytes sent to and received from the host to its usage.
+func (m *Meter) Record(hid *id.ID, sent, received int) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	ring, exists := m.usage[*hid]
+	if !exists {
+		ring = &[slots]slot{}
+		m.usage[*hid] = ring
+	}
+
+	slotLength := int64(m.window / slots)
+	n := m.now().UnixNano() / slotLength
+	start := time.Unix(0, n*slotLength)
+	s := &ring[n%slots]
+	if !s.start.Equal(start) {
+		*s = slot{start: start}
+	}
+	s.usage.Sent += uint64(sent)
+	s.usage.Received += uint64(received)
+}
+
+// Reset clears the usage of the host.
+func (m *Meter) Reset(hid *id.ID) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	delete(m.usage, *hid)
+}
+
+// Allow returns nil if a send to the host may be made. Once the host has
+// reached its quota, the QuotaFunc decides, or a RESOURCE_EXHAUSTED error is
+// returned if there is none. A nil Meter allows every send.
+func (m *Meter) Allow(hid *id.ID) error {
+	if m == nil {
+		return nil
+	}
+
+	m.mux.Lock()
+	quota, exists := m.quotas[*hid]
+	if !exists {
+		quota = m.defaultQuota
+	}
+	usage := m.usageUnsafe(hid)
+	onExceeded := m.onExceeded
+	m.mux.Unlock()
+
+	if quota == 0 || usage.Total() < quota {
+		return nil
+	}
+	if onExceeded != nil {
+		return onExceeded(hid, usage, quota)
+	}
+	return status.Errorf(codes.ResourceExhausted, "Bandwidth quota of %d "+
+		"bytes to %s within %s exceeded", quota, hid, m.window)
+}
+
+// Bind records the connection as being to the host, so that the calls made
+// over it are accounted to the host. A nil Meter or connection is ignored.
+func (m *Meter) Bind(conn *grpc.ClientConn, hid *id.ID) {
+	if m == nil || conn == nil {
+		return
+	}
+
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	if _, exists := m.conns[conn]; exists {
+		return
+	}
+	// Forget connections which have been closed
+	for c := range m.conns {
+		if c.GetState() == connectivity.Shutdown {
+			delete(m.conns, c)
+		}
+	}
+	m.conns[conn] = hid.DeepCopy()
+}
+
+// host returns the host the connection is bound to.
+func (m *Meter) host(conn *grpc.ClientConn) (*id.ID, bool) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	hid, exists := m.conns[conn]
+	return hid, exists
+}
+
+// Install adds the interceptors of the Meter to the chain. Installing on the
+// same chain again does nothing. A nil Meter is not installed.
+func (m *Meter) Install(chain *interceptors.Chain) {
+	if m == nil || chain == nil {
+		return
+	}
+	m.mux.Lock()
+	installed := m.installed[chain]
+	m.installed[chain] = true
+	m.mux.Unlock()
+	if installed {
+		return
+	}
+
+	chain.AddUnaryClientInterceptor(m.UnaryClientInterceptor())
+	chain.AddStreamClientInterceptor(m.StreamClientInterceptor())
+}
+
+// size returns the marshalled size of the message, or zero if it is neither
+// a protobuf message nor already marshalled.
+func size(msg interface{}) int {
+	switch m := msg.(type) {
+	case proto.Message:
+		return proto.Size(m)
+	case pb.RawMessage:
+		return len(m)
+	case *pb.RawMessage:
+		return len(*m)
+	}
+	return 0
+}
+
+// UnaryClientInterceptor returns an interceptor accounting for the request
+// and response of calls over bound connections.
+func (m *Meter) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{},
+		cc *grpc.ClientConn, invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption) error {
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if hid, exists := m.host(cc); exists {
+			received := 0
+			if err == nil {
+				received = size(reply)
+			}
+			m.Record(hid, size(req), received)
+		}
+		return err
+	}
+}
+
+// StreamClientInterceptor returns an interceptor accounting for the messages
+// of streams over bound connections.
+func (m *Meter) StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc,
+		cc *grpc.ClientConn, method string, streamer grpc.Streamer,
+		opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			return stream, err
+		}
+		hid, exists := m.host(cc)
+		if !exists {
+			return stream, nil
+		}
+		return &clientStream{ClientStream: stream, meter: m, host: hid}, nil
+	}
+}
+
+// clientStream accounts for the messages of a stream.
+type clientStream struct {
+	grpc.ClientStream
+	meter *Meter
+	host  *id.ID
+}
+
+// SendMsg sends the message, accounting for it once sent.
+func (s *clientStream) SendMsg(msg interface{}) error {
+	err := s.ClientStream.SendMsg(msg)
+	if err == nil {
+		s.meter.Record(s.host, size(msg), 0)
+	}
+	return err
+}
+
+// RecvMsg receives a message, accounting for it once received.
+func (s *clientStream) RecvMsg(msg interface{}) error {
+	err := s.ClientStream.RecvMsg(msg)
+	if err == nil {
+		s.meter.Record(s.host, 0, size(msg))
+	}
+	return err
+}
@@ -0,0 +1,181 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package bandwidth
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/elixxir/comms/interceptors"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Tests that usage leaves the window as it rolls on.
+func TestMeter_Usage(t *testing.T) {
+	m := NewMeter(time.Hour)
+	now := time.Unix(1000000, 0)
+	m.now = func() time.Time { return now }
+	hid := id.NewIdFromString("gateway", id.Gateway, t)
+
+	m.Record(hid, 10, 20)
+	now = now.Add(30 * time.Minute)
+	m.Record(hid, 1, 2)
+	if u := m.Usage(hid); u != (Usage{Sent: 11, Received: 22}) {
+		t.Errorf("Unexpected usage: %+v", u)
+	}
+
+	now = now.Add(45 * time.Minute)
+	if u := m.Usage(hid); u != (Usage{Sent: 1, Received: 2}) {
+		t.Errorf("Usage did not leave the window: %+v", u)
+	}
+
+	m.Reset(hid)
+	if u := m.Usage(hid); u.Total() != 0 {
+		t.Errorf("Usage not reset: %+v", u)
+	}
+}
+
+// Tests that sends are rejected once the quota is reached, unless the quota
+// function allows them.
+func TestMeter_Allow(t *testing.T) {
+	m := NewMeter(time.Hour)
+	hid := id.NewIdFromString("gateway", id.Gateway, t)
+	other := id.NewIdFromString("other", id.Gateway, t)
+
+	m.SetDefaultQuota(100)
+	m.SetQuota(other, 1000)
+	m.Record(hid, 60, 40)
+	m.Record(other, 60, 40)
+
+	err := m.Allow(hid)
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Expected RESOURCE_EXHAUSTED, got: %+v", err)
+	}
+	if err = m.Allow(other); err != nil {
+		t.Errorf("Host under its own quota rejected: %+v", err)
+	}
+
+	var reported Usage
+	m.OnQuotaExceeded(func(_ *id.ID, usage Usage, quota uint64) error {
+		reported = usage
+		return nil
+	})
+	if err = m.Allow(hid); err != nil {
+		t.Errorf("Quota function did not allow the send: %+v", err)
+	}
+	if reported.Total() != 100 {
+		t.Errorf("Quota function given usage %+v", reported)
+	}
+
+	m.OnQuotaExceeded(func(*id.ID, Usage, uint64) error {
+		return errors.New("rejected")
+	})
+	if err = m.Allow(hid); err == nil {
+		t.Error("Quota function did not reject the send")
+	}
+
+	var nilMeter *Meter
+	if err = nilMeter.Allow(hid); err != nil {
+		t.Errorf("Nil meter rejected a send: %+v", err)
+	}
+}
+
+// Tests that the interceptors account for the calls and streams made over a
+// bound connection only.
+func TestMeter_Interceptors(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer()
+	hs := health.NewServer()
+	hs.SetServingStatus("comms", grpc_health_v1.HealthCheckResponse_SERVING)
+	grpc_health_v1.RegisterHealthServer(server, hs)
+	go func() { _ = server.Serve(lis) }()
+	defer server.Stop()
+
+	conn, err := grpc.Dial(lis.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	m := NewMeter(time.Hour)
+	chain := interceptors.New()
+	m.Install(chain)
+	m.Install(chain)
+	client := grpc_health_v1.NewHealthClient(chain.ClientConn(conn))
+	hid := id.NewIdFromString("gateway", id.Gateway, t)
+
+	req := &grpc_health_v1.HealthCheckRequest{Service: "comms"}
+	if _, err = client.Check(context.Background(), req); err != nil {
+		t.Fatal(err)
+	}
+	if u := m.Usage(hid); u.Total() != 0 {
+		t.Errorf("Call over an unbound connection accounted: %+v", u)
+	}
+
+	m.Bind(conn, hid)
+	resp, err := client.Check(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Usage{
+		Sent:     uint64(proto.Size(req)),
+		Received: uint64(proto.Size(resp)),
+	}
+	if u := m.Usage(hid); u != expected {
+		t.Errorf("Call accounted as %+v, expected %+v", u, expected)
+	}
+
+	// Already marshalled requests are accounted by their length
+	raw, err := proto.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp = &grpc_health_v1.HealthCheckResponse{}
+	err = chain.ClientConn(conn).Invoke(context.Background(),
+		"/grpc.health.v1.Health/Check", pb.RawMessage(raw), resp,
+		grpc.ForceCodec(pb.RawCodec))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected.Sent += uint64(len(raw))
+	expected.Received += uint64(proto.Size(resp))
+	if u := m.Usage(hid); u != expected {
+		t.Errorf("Raw call accounted as %+v, expected %+v", u, expected)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := client.Watch(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	update, err := stream.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected.Sent += uint64(proto.Size(req))
+	expected.Received += uint64(proto.Size(update))
+	if u := m.Usage(hid); u != expected {
+		t.Errorf("Stream accounted as %+v, expected %+v", u, expected)
+	}
+}
@@ -0,0 +1,139 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package buildInfo serves a description of what a comms server is running,
+// so that remote processes can be identified when debugging the network.
+package buildInfo
+
+import (
+	"context"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/messages"
+	"google.golang.org/grpc"
+)
+
+// Info describes the version and build of a comms server.
+type Info struct {
+	// Version of the server application, as set by the application
+	Version string
+	// Module version and VCS revision of the comms library
+	CommsVersion string
+	GitCommit    string
+	GoVersion    string
+	// Time of the VCS revision the binary was built from
+	BuildTime time.Time
+	// Optional protocol features supported by the server
+	Features  []string
+	StartTime time.Time
+}
+
+// Server serves Info on the BuildInfo service.
+type Server struct {
+	info Info
+	mux  sync.RWMutex
+	pb.UnimplementedBuildInfoServer
+}
+
+// commsModule is the path of this module in the build info.
+const commsModule = "gitlab.com/elixxir/comms"
+
+// NewServer returns a Server describing the running binary, with its start
+// time set to now.
+func NewServer() *Server {
+	info := Info{StartTime: time.Now()}
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		info.GoVersion = bi.GoVersion
+		if bi.Main.Path == commsModule {
+			info.CommsVersion = bi.Main.Version
+		}
+		for _, dep := range bi.Deps {
+			if dep.Path == commsModule {
+				info.CommsVersion = dep.Version
+			}
+		}
+		for _, setting := range bi.Settings {
+			switch setting.Key {
+			case "vcs.revision":
+				info.GitCommit = setting.Value
+			case "vcs.time":
+				info.BuildTime, _ = time.Parse(time.RFC3339, setting.Value)
+			}
+		}
+	}
+	return &Server{info: info}
+}
+
+// Register registers the server on the gRPC server.
+func (s *Server) Register(grpcServer *grpc.Server) {
+	pb.RegisterBuildInfoServer(grpcServer, s)
+}
+
+// SetVersion sets the version of the server application.
+func (s *Server) SetVersion(version string) {
+	s.mux.Lock()
+	s.info.Version = version
+	s.mux.Unlock()
+}
+
+// SetFeatures sets the optional protocol features supported by the server.
+func (s *Server) SetFeatures(features ...string) {
+	s.mux.Lock()
+	s.info.Features = append([]string(nil), features...)
+	s.mux.Unlock()
+}
+
+// Get returns a copy of the served Info.
+func (s *Server) Get() Info {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	info := s.info
+	info.Features = append([]string(nil), s.info.Features...)
+	return info
+}
+
+// GetBuildInfo returns the served Info.
+func (s *Server) GetBuildInfo(context.Context, *messages.Ping) (
+	*pb.ServerBuildInfo, error) {
+	return s.Get().Message(), nil
+}
+
+// Message returns the Info as sent in a GetBuildInfo response.
+func (i Info) Message() *pb.ServerBuildInfo {
+	var buildTime int64
+	if !i.BuildTime.IsZero() {
+		buildTime = i.BuildTime.UnixNano()
+	}
+	return &pb.ServerBuildInfo{
+		Version:      i.Version,
+		CommsVersion: i.CommsVersion,
+		GitCommit:    i.GitCommit,
+		GoVersion:    i.GoVersion,
+		Features:     i.Features,
+		StartTime:    i.StartTime.UnixNano(),
+		BuildTime:    buildTime,
+	}
+}
+
+// FromMessage returns the Info in a GetBuildInfo response.
+func FromMessage(msg *pb.ServerBuildInfo) *Info {
+	info := &Info{
+		Version:      msg.GetVersion(),
+		CommsVersion: msg.GetCommsVersion(),
+		GitCommit:    msg.GetGitCommit(),
+		GoVersion:    msg.GetGoVersion(),
+		Features:     msg.GetFeatures(),
+		StartTime:    time.Unix(0, msg.GetStartTime()),
+	}
+	if msg.GetBuildTime() != 0 {
+		info.BuildTime = time.Unix(0, msg.GetBuildTime())
+	}
+	return info
+}
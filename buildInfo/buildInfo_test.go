@@ -0,0 +1,67 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package buildInfo
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/messages"
+)
+
+// Happy path: the served info is returned by FromMessage.
+func TestServer_GetBuildInfo(t *testing.T) {
+	s := NewServer()
+	s.SetVersion("1.2.3")
+	features := []string{"a", "b"}
+	s.SetFeatures(features...)
+
+	// Features are copied
+	features[0] = "c"
+
+	msg, err := s.GetBuildInfo(context.Background(), &messages.Ping{})
+	if err != nil {
+		t.Fatalf("GetBuildInfo() returned an error: %+v", err)
+	}
+	info := FromMessage(msg)
+
+	expected := s.Get()
+	if info.Version != "1.2.3" || info.GoVersion != expected.GoVersion ||
+		!reflect.DeepEqual(info.Features, []string{"a", "b"}) ||
+		!info.StartTime.Equal(expected.StartTime) {
+		t.Errorf("Unexpected build info.\nexpected: %+v\nreceived: %+v",
+			expected, *info)
+	}
+}
+
+// Tests that a caller decoding the response as a ClientVersion reads the
+// version of the server.
+func TestServer_GetBuildInfo_ClientVersion(t *testing.T) {
+	s := NewServer()
+	s.SetVersion("1.2.3")
+	s.SetFeatures("a")
+
+	msg, err := s.GetBuildInfo(context.Background(), &messages.Ping{})
+	if err != nil {
+		t.Fatalf("GetBuildInfo() returned an error: %+v", err)
+	}
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		t.Fatalf("Failed to marshal build info: %+v", err)
+	}
+	version := &pb.ClientVersion{}
+	if err = proto.Unmarshal(data, version); err != nil {
+		t.Fatalf("Failed to unmarshal as a ClientVersion: %+v", err)
+	}
+	if version.Version != "1.2.3" {
+		t.Errorf("Unexpected version %q.", version.Version)
+	}
+}
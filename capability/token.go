@@ -0,0 +1,116 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package capability contains short-lived authorization tokens issued by
+// permissioning, granting one host permission to call one method on another
+// (e.g. "this gateway may call UploadUnmixedBatch on node X"). These add
+// authorization on top of the identification provided by the auth handshake.
+package capability
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"hash"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/comms/signature"
+	"gitlab.com/xx_network/crypto/signature/rsa"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/netTime"
+)
+
+// UploadUnmixedBatch is the method name a gateway's token must carry to
+// upload a batch to a node which enforces capabilities.
+const UploadUnmixedBatch = "UploadUnmixedBatch"
+
+// Token grants Holder permission to call Method on Target until Expiry. It is
+// signed by permissioning and conforms to signature.GenericRsaSignable.
+type Token struct {
+	Holder    *id.ID
+	Target    *id.ID
+	Method    string
+	Expiry    int64 // Unix nanoseconds
+	Signature *messages.RSASignature
+}
+
+// NewRequest creates an unsigned Token used to ask permissioning for
+// permission to call method on target. The holder and expiry are set by
+// permissioning.
+func NewRequest(target *id.ID, method string) *Token {
+	return &Token{
+		Target: target,
+		Method: method,
+	}
+}
+
+// Sign sets the expiry to ttl from now and signs the token with the
+// permissioning key.
+func (t *Token) Sign(ttl time.Duration, key *rsa.PrivateKey) error {
+	t.Expiry = netTime.Now().Add(ttl).UnixNano()
+	t.Signature = nil
+	return signature.SignRsa(t, key)
+}
+
+// GetExpiry returns the time the token expires.
+func (t *Token) GetExpiry() time.Time {
+	return time.Unix(0, t.Expiry)
+}
+
+// IsExpired returns true if the token has expired at the given time.
+func (t *Token) IsExpired(now time.Time) bool {
+	return !now.Before(t.GetExpiry())
+}
+
+// GetSig returns the RSA signature.
+// IF none exists, it creates it, adds it to the object, then returns it.
+func (t *Token) GetSig() *messages.RSASignature {
+	if t.Signature != nil {
+		return t.Signature
+	}
+
+	t.Signature = new(messages.RSASignature)
+
+	return t.Signature
+}
+
+// Digest hashes the contents of the token in a repeatable manner
+// using the provided cryptographic hash. It includes the nonce in the hash
+func (t *Token) Digest(nonce []byte, h hash.Hash) []byte {
+	h.Reset()
+
+	if t.Holder != nil {
+		h.Write(t.Holder.Bytes())
+	}
+	if t.Target != nil {
+		h.Write(t.Target.Bytes())
+	}
+	h.Write([]byte(t.Method))
+
+	expiry := make([]byte, 8)
+	binary.BigEndian.PutUint64(expiry, uint64(t.Expiry))
+	h.Write(expiry)
+	h.Write(nonce)
+
+	return h.Sum(nil)
+}
+
+// Marshal serialises the token for transmission.
+func (t *Token) Marshal() ([]byte, error) {
+	return json.Marshal(t)
+}
+
+// Unmarshal deserializes a token created by Marshal.
+func Unmarshal(data []byte) (*Token, error) {
+	t := &Token{}
+	if err := json.Unmarshal(data, t); err != nil {
+		return nil, errors.Errorf("Failed to unmarshal capability "+
+			"token: %+v", err)
+	}
+	return t, nil
+}
@@ -0,0 +1,150 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package capability
+
+import (
+	"context"
+	"encoding/base64"
+	"sync"
+
+	"github.com/pkg/errors"
+	"gitlab.com/xx_network/comms/signature"
+	"gitlab.com/xx_network/crypto/signature/rsa"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/netTime"
+	"google.golang.org/grpc/metadata"
+)
+
+// Header is the gRPC metadata key the marshalled token is sent under.
+const Header = "capability"
+
+// AppendToOutgoingContext adds the marshalled token to the outgoing context.
+func AppendToOutgoingContext(ctx context.Context,
+	token []byte) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, Header,
+		base64.StdEncoding.EncodeToString(token))
+}
+
+// FromIncomingContext extracts the token from the incoming context.
+func FromIncomingContext(ctx context.Context) (*Token, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, errors.New("unable to retrieve meta data / header")
+	}
+
+	values := md.Get(Header)
+	if len(values) == 0 {
+		return nil, errors.New("no capability token sent")
+	}
+
+	data, err := base64.StdEncoding.DecodeString(values[0])
+	if err != nil {
+		return nil, errors.Errorf("could not decode capability token: %+v",
+			err)
+	}
+	return Unmarshal(data)
+}
+
+// Verifier checks that callers hold a valid token for the endpoint they are
+// calling on this host.
+type Verifier struct {
+	self    *id.ID
+	permKey *rsa.PublicKey
+}
+
+// NewVerifier creates a Verifier for the host with the given ID, trusting
+// tokens signed by the permissioning key.
+func NewVerifier(self *id.ID, permissioningKey *rsa.PublicKey) *Verifier {
+	return &Verifier{
+		self:    self,
+		permKey: permissioningKey,
+	}
+}
+
+// Verify checks that the token is signed by permissioning, has not expired
+// and grants sender permission to call method on this host.
+func (v *Verifier) Verify(token *Token, sender *id.ID, method string) error {
+	if token.Holder == nil || !token.Holder.Cmp(sender) {
+		return errors.Errorf("capability token was not issued to %s", sender)
+	}
+	if token.Target == nil || !token.Target.Cmp(v.self) {
+		return errors.Errorf("capability token is not for %s", v.self)
+	}
+	if token.Method != method {
+		return errors.Errorf("capability token does not permit %s", method)
+	}
+	if token.IsExpired(netTime.Now()) {
+		return errors.Errorf("capability token expired at %s",
+			token.GetExpiry())
+	}
+	if err := signature.VerifyRsa(token, v.permKey); err != nil {
+		return errors.Errorf("invalid capability token signature: %+v", err)
+	}
+	return nil
+}
+
+// VerifyContext extracts the token from the incoming context and verifies it.
+func (v *Verifier) VerifyContext(ctx context.Context, sender *id.ID,
+	method string) error {
+	token, err := FromIncomingContext(ctx)
+	if err != nil {
+		return err
+	}
+	return v.Verify(token, sender, method)
+}
+
+// Store holds the tokens a host has been issued, keyed by target and method.
+type Store struct {
+	tokens map[storeKey][]byte
+	expiry map[storeKey]int64
+	mux    sync.RWMutex
+}
+
+type storeKey struct {
+	target id.ID
+	method string
+}
+
+// NewStore creates an empty Store.
+func NewStore() *Store {
+	return &Store{
+		tokens: make(map[storeKey][]byte),
+		expiry: make(map[storeKey]int64),
+	}
+}
+
+// Add stores the token, replacing any previous one for the same target and
+// method.
+func (s *Store) Add(token *Token) error {
+	data, err := token.Marshal()
+	if err != nil {
+		return err
+	}
+
+	k := storeKey{target: *token.Target, method: token.Method}
+	s.mux.Lock()
+	s.tokens[k] = data
+	s.expiry[k] = token.Expiry
+	s.mux.Unlock()
+	return nil
+}
+
+// Get returns the marshalled token for calling method on target, if one is
+// held and has not expired.
+func (s *Store) Get(target *id.ID, method string) ([]byte, bool) {
+	k := storeKey{target: *target, method: method}
+
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+
+	data, exists := s.tokens[k]
+	if !exists || netTime.Now().UnixNano() >= s.expiry[k] {
+		return nil, false
+	}
+	return data, true
+}
@@ -0,0 +1,158 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package capability
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"gitlab.com/elixxir/comms/testutils"
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc/metadata"
+)
+
+// newSignedToken returns a token for holder to call UploadUnmixedBatch on
+// target, signed with the testing key.
+func newSignedToken(holder, target *id.ID, ttl time.Duration,
+	t *testing.T) *Token {
+	key, err := testutils.LoadPrivateKeyTesting(t)
+	if err != nil {
+		t.Fatalf("Failed to load private key: %+v", err)
+	}
+
+	token := NewRequest(target, UploadUnmixedBatch)
+	token.Holder = holder
+	if err = token.Sign(ttl, key); err != nil {
+		t.Fatalf("Failed to sign token: %+v", err)
+	}
+	return token
+}
+
+// Happy path.
+func TestVerifier_Verify(t *testing.T) {
+	holder := id.NewIdFromString("gateway", id.Gateway, t)
+	target := id.NewIdFromString("node", id.Node, t)
+	pubKey, err := testutils.LoadPublicKeyTesting(t)
+	if err != nil {
+		t.Fatalf("Failed to load public key: %+v", err)
+	}
+	v := NewVerifier(target, pubKey)
+
+	token := newSignedToken(holder, target, time.Minute, t)
+
+	// Send the token over the wire
+	data, err := token.Marshal()
+	if err != nil {
+		t.Fatalf("Failed to marshal token: %+v", err)
+	}
+	received, err := Unmarshal(data)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal token: %+v", err)
+	}
+
+	if err = v.Verify(received, holder, UploadUnmixedBatch); err != nil {
+		t.Errorf("Verify() returned an error: %+v", err)
+	}
+}
+
+// Tests that Verify rejects tokens which do not grant the call.
+func TestVerifier_Verify_Rejected(t *testing.T) {
+	holder := id.NewIdFromString("gateway", id.Gateway, t)
+	target := id.NewIdFromString("node", id.Node, t)
+	other := id.NewIdFromString("other", id.Node, t)
+	pubKey, err := testutils.LoadPublicKeyTesting(t)
+	if err != nil {
+		t.Fatalf("Failed to load public key: %+v", err)
+	}
+	v := NewVerifier(target, pubKey)
+
+	token := newSignedToken(holder, target, time.Minute, t)
+	if err = v.Verify(token, other, UploadUnmixedBatch); err == nil {
+		t.Errorf("Verify() accepted a token held by another sender.")
+	}
+	if err = v.Verify(token, holder, "PostPhase"); err == nil {
+		t.Errorf("Verify() accepted a token for another method.")
+	}
+
+	otherTarget := newSignedToken(holder, other, time.Minute, t)
+	if err = v.Verify(otherTarget, holder, UploadUnmixedBatch); err == nil {
+		t.Errorf("Verify() accepted a token for another target.")
+	}
+
+	expired := newSignedToken(holder, target, -time.Second, t)
+	if err = v.Verify(expired, holder, UploadUnmixedBatch); err == nil {
+		t.Errorf("Verify() accepted an expired token.")
+	}
+
+	tampered := newSignedToken(holder, target, time.Minute, t)
+	tampered.Expiry += int64(time.Hour)
+	if err = v.Verify(tampered, holder, UploadUnmixedBatch); err == nil {
+		t.Errorf("Verify() accepted a token with a modified expiry.")
+	}
+}
+
+// Tests that a token added to an outgoing context can be verified from the
+// incoming context.
+func TestVerifier_VerifyContext(t *testing.T) {
+	holder := id.NewIdFromString("gateway", id.Gateway, t)
+	target := id.NewIdFromString("node", id.Node, t)
+	pubKey, err := testutils.LoadPublicKeyTesting(t)
+	if err != nil {
+		t.Fatalf("Failed to load public key: %+v", err)
+	}
+	v := NewVerifier(target, pubKey)
+
+	if err = v.VerifyContext(context.Background(), holder,
+		UploadUnmixedBatch); err == nil {
+		t.Errorf("VerifyContext() accepted a context without a token.")
+	}
+
+	data, err := newSignedToken(holder, target, time.Minute, t).Marshal()
+	if err != nil {
+		t.Fatalf("Failed to marshal token: %+v", err)
+	}
+	outgoing := AppendToOutgoingContext(context.Background(), data)
+	md, _ := metadata.FromOutgoingContext(outgoing)
+	incoming := metadata.NewIncomingContext(context.Background(), md)
+
+	if err = v.VerifyContext(incoming, holder, UploadUnmixedBatch); err != nil {
+		t.Errorf("VerifyContext() returned an error: %+v", err)
+	}
+}
+
+// Tests that Store returns only unexpired tokens for the requested target and
+// method.
+func TestStore(t *testing.T) {
+	holder := id.NewIdFromString("gateway", id.Gateway, t)
+	target := id.NewIdFromString("node", id.Node, t)
+	expiredTarget := id.NewIdFromString("expired", id.Node, t)
+	s := NewStore()
+
+	token := newSignedToken(holder, target, time.Minute, t)
+	if err := s.Add(token); err != nil {
+		t.Fatalf("Add() returned an error: %+v", err)
+	}
+	if err := s.Add(newSignedToken(holder, expiredTarget, -time.Second,
+		t)); err != nil {
+		t.Fatalf("Add() returned an error: %+v", err)
+	}
+
+	expected, _ := token.Marshal()
+	data, exists := s.Get(target, UploadUnmixedBatch)
+	if !exists || !bytes.Equal(expected, data) {
+		t.Errorf("Get() did not return the stored token.")
+	}
+	if _, exists = s.Get(target, "PostPhase"); exists {
+		t.Errorf("Get() returned a token for another method.")
+	}
+	if _, exists = s.Get(expiredTarget, UploadUnmixedBatch); exists {
+		t.Errorf("Get() returned an expired token.")
+	}
+}
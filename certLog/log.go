@@ -0,0 +1,163 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package certLog records the TLS certificates of the hosts in the network,
+// in the manner of a certificate transparency log. Every certificate first
+// seen for a host, and every change to the certificate of a known host, is
+// submitted to a pluggable Sink. Changes are also reported to an alert
+// callback, giving operators early warning of a host being impersonated or
+// issued an unexpected certificate.
+package certLog
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// Entry describes a certificate seen for a host.
+type Entry struct {
+	Host    *id.ID
+	Address string
+	// PEM encoded certificate and the hex SHA-256 fingerprint of it
+	Certificate string
+	Fingerprint string
+	// Fingerprint of the certificate the host had before; empty if the host
+	// was not known
+	Previous string
+	Time     time.Time
+}
+
+// Changed returns true if the entry replaces a known certificate.
+func (e Entry) Changed() bool {
+	return e.Previous != ""
+}
+
+// Sink stores entries, e.g. by writing them to a file or submitting them to
+// an external log.
+type Sink interface {
+	Record(e Entry) error
+}
+
+// AlertFunc is called with every entry changing the certificate of a known
+// host. It is called synchronously and so must not block.
+type AlertFunc func(e Entry)
+
+// Log submits the certificates of hosts to a sink.
+type Log struct {
+	sink  Sink
+	alert AlertFunc
+	// Fingerprint of the last certificate seen for each host
+	known map[id.ID]string
+	now   func() time.Time
+	mux   sync.Mutex
+}
+
+// New returns a Log submitting to the sink, which may be nil if only alerts
+// are wanted.
+func New(sink Sink) *Log {
+	return &Log{
+		sink:  sink,
+		known: make(map[id.ID]string),
+		now:   time.Now,
+	}
+}
+
+// SetAlert sets the callback called when the certificate of a known host
+// changes. Passing nil disables it.
+func (l *Log) SetAlert(alert AlertFunc) {
+	l.mux.Lock()
+	l.alert = alert
+	l.mux.Unlock()
+}
+
+// Observe records the certificate of the host if it has not been seen for
+// the host before, returning the entry submitted, or nil if the certificate
+// is already known. A nil Log records nothing.
+func (l *Log) Observe(hid *id.ID, address, cert string) (*Entry, error) {
+	if l == nil {
+		return nil, nil
+	}
+	sum := sha256.Sum256([]byte(cert))
+	fingerprint := hex.EncodeToString(sum[:])
+
+	l.mux.Lock()
+	previous := l.known[*hid]
+	if previous == fingerprint {
+		l.mux.Unlock()
+		return nil, nil
+	}
+	l.known[*hid] = fingerprint
+	alert := l.alert
+	l.mux.Unlock()
+
+	e := Entry{
+		Host:        hid.DeepCopy(),
+		Address:     address,
+		Certificate: cert,
+		Fingerprint: fingerprint,
+		Previous:    previous,
+		Time:        l.now(),
+	}
+	if e.Changed() {
+		jww.WARN.Printf("Certificate of host %s at %s changed from %s to %s",
+			hid, address, previous, fingerprint)
+		if alert != nil {
+			alert(e)
+		}
+	}
+
+	if l.sink != nil {
+		if err := l.sink.Record(e); err != nil {
+			return &e, errors.WithMessagef(err, "Failed to record "+
+				"certificate of host %s", hid)
+		}
+	}
+	return &e, nil
+}
+
+// Forget removes the host, so that its next certificate is recorded as new
+// rather than as a change.
+func (l *Log) Forget(hid *id.ID) {
+	if l == nil {
+		return
+	}
+	l.mux.Lock()
+	delete(l.known, *hid)
+	l.mux.Unlock()
+}
+
+// WriterSink writes each entry to a writer as a line of JSON.
+type WriterSink struct {
+	w   io.Writer
+	mux sync.Mutex
+}
+
+// NewWriterSink returns a sink writing to the writer.
+func NewWriterSink(w io.Writer) *WriterSink {
+	return &WriterSink{w: w}
+}
+
+// Record writes the entry as a line of JSON.
+func (s *WriterSink) Record(e Entry) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return errors.Errorf("Failed to marshal entry: %+v", err)
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	_, err = s.w.Write(append(data, '\n'))
+	return err
+}
@@ -0,0 +1,78 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package certLog
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// Tests that new and changed certificates are recorded, repeated ones are
+// not, and only changes raise alerts.
+func TestLog_Observe(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(NewWriterSink(&buf))
+	var alerts []Entry
+	l.SetAlert(func(e Entry) { alerts = append(alerts, e) })
+	hid := id.NewIdFromString("gateway", id.Gateway, t)
+
+	first, err := l.Observe(hid, "1.2.3.4:11420", "cert1")
+	if err != nil || first == nil || first.Changed() {
+		t.Fatalf("Unexpected entry for a new host: %+v, %+v", first, err)
+	}
+	if e, _ := l.Observe(hid, "1.2.3.4:11420", "cert1"); e != nil {
+		t.Errorf("Known certificate recorded again: %+v", e)
+	}
+	if len(alerts) != 0 {
+		t.Errorf("Alert raised for a new host: %+v", alerts)
+	}
+
+	second, err := l.Observe(hid, "1.2.3.4:11420", "cert2")
+	if err != nil || second == nil {
+		t.Fatalf("Changed certificate not recorded: %+v", err)
+	}
+	if second.Previous != first.Fingerprint {
+		t.Errorf("Previous fingerprint %s, expected %s", second.Previous,
+			first.Fingerprint)
+	}
+	if len(alerts) != 1 || alerts[0].Fingerprint != second.Fingerprint {
+		t.Errorf("Change not alerted: %+v", alerts)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Sink received %d entries, expected 2", len(lines))
+	}
+	var written Entry
+	if err = json.Unmarshal([]byte(lines[1]), &written); err != nil {
+		t.Fatalf("Failed to unmarshal entry: %+v", err)
+	}
+	if !written.Host.Cmp(hid) || written.Certificate != "cert2" {
+		t.Errorf("Unexpected entry written: %+v", written)
+	}
+
+	// Forgotten hosts are new again
+	l.Forget(hid)
+	if e, _ := l.Observe(hid, "1.2.3.4:11420", "cert3"); e == nil ||
+		e.Changed() {
+		t.Errorf("Forgotten host not recorded as new: %+v", e)
+	}
+}
+
+// Tests that a nil Log records nothing.
+func TestLog_Nil(t *testing.T) {
+	var l *Log
+	e, err := l.Observe(id.NewIdFromString("gateway", id.Gateway, t), "", "")
+	if e != nil || err != nil {
+		t.Errorf("Nil log recorded an entry: %+v, %+v", e, err)
+	}
+}
@@ -0,0 +1,347 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package channelBinding binds reverse-authentication tokens to the TLS
+// session they were issued over, using a hash of the TLS exporter keying
+// material (RFC 5705, RFC 8446 section 7.5). A token stolen from one session
+// cannot then be replayed over another. Peers whose connection cannot export
+// keying material (TLS 1.2 without extended master secret, grpc-web) are
+// negotiated down to unbound tokens unless binding is required.
+//
+// A sender may use its token over several channels, e.g. the connections of
+// a hostPool.Pool. Each extra channel joins the sender's binding by requesting
+// a token over it and returning that token over a channel already bound to
+// the sender, with the sender's current token; see VerifyToken.
+package channelBinding
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/netTime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	// ExporterLabel is the label passed to the TLS keying material exporter
+	ExporterLabel = "EXPORTER-elixxir-comms-auth-token"
+
+	// exporterLength is the number of bytes of keying material exported
+	exporterLength = 32
+
+	// Header is the response header reporting whether an issued token was
+	// bound, so the peer can tell which mode was negotiated
+	Header = "channel-binding"
+
+	// Header values
+	Bound   = "bound"
+	Unbound = "unbound"
+
+	// pendingTTL is how long an issued token's binding is kept waiting for
+	// the peer to complete the handshake
+	pendingTTL = 5 * time.Minute
+
+	// MaxChannels is the number of channels a sender's token may be bound
+	// to at once. Once reached, the oldest joined channel is dropped.
+	MaxChannels = 32
+)
+
+// ErrUnsupported is returned when the connection cannot export keying
+// material.
+var ErrUnsupported = errors.New("connection does not support " +
+	"channel binding")
+
+// FromContext returns the channel binding of the connection a request was
+// received over: a hash of the TLS exporter keying material. ErrUnsupported
+// is returned if the connection is not TLS or cannot export keying material.
+func FromContext(ctx context.Context) ([]byte, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, errors.Wrap(ErrUnsupported, "no peer in context")
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return nil, errors.Wrapf(ErrUnsupported, "peer auth info is %T",
+			p.AuthInfo)
+	}
+
+	ekm, err := tlsInfo.State.ExportKeyingMaterial(ExporterLabel, nil,
+		exporterLength)
+	if err != nil {
+		return nil, errors.Wrap(ErrUnsupported, err.Error())
+	}
+
+	h := sha256.Sum256(ekm)
+	return h[:], nil
+}
+
+// Binder tracks the TLS session each reverse-authentication token was issued
+// over and checks that the token is only used over that session.
+type Binder struct {
+	// If set, peers whose connections do not support channel binding are
+	// refused tokens rather than being issued unbound ones
+	Required bool
+
+	// pending holds bindings of issued tokens awaiting AuthenticateToken
+	pending map[string]pendingBinding
+	// hosts holds the bindings of authenticated hosts
+	hosts map[id.ID]*boundSender
+
+	// exporter returns the binding of a request's connection
+	exporter func(ctx context.Context) ([]byte, error)
+	mux      sync.Mutex
+}
+
+type pendingBinding struct {
+	binding []byte
+	issued  time.Time
+}
+
+// boundSender is the token a sender authenticated with and the channels it
+// may be used over, the first of which is the one it was issued over.
+type boundSender struct {
+	token    []byte
+	bindings [][]byte
+}
+
+// has returns true if the binding is one of the sender's channels.
+func (bs *boundSender) has(binding []byte) bool {
+	for _, b := range bs.bindings {
+		if bytes.Equal(b, binding) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewBinder creates an empty Binder.
+func NewBinder(required bool) *Binder {
+	return &Binder{
+		Required: required,
+		pending:  make(map[string]pendingBinding),
+		hosts:    make(map[id.ID]*boundSender),
+		exporter: FromContext,
+	}
+}
+
+// NewBinderTesting creates a Binder which binds tokens to the address of the
+// peer's connection rather than its TLS session, so that binding can be
+// exercised by tests run without TLS. FOR TESTING PURPOSES ONLY.
+func NewBinderTesting(required bool, i interface{}) *Binder {
+	switch i.(type) {
+	case *testing.T, *testing.M, *testing.B:
+		break
+	default:
+		jww.FATAL.Panicf("NewBinderTesting is restricted to testing only. "+
+			"Got %T", i)
+	}
+
+	b := NewBinder(required)
+	b.exporter = func(ctx context.Context) ([]byte, error) {
+		p, ok := peer.FromContext(ctx)
+		if !ok || p.Addr == nil {
+			return nil, errors.Wrap(ErrUnsupported, "no peer in context")
+		}
+		h := sha256.Sum256([]byte(p.Addr.String()))
+		return h[:], nil
+	}
+	return b
+}
+
+// Bind records the binding of the connection a token is being issued over.
+// Call it from the RequestToken endpoint. The negotiated mode is reported to
+// the peer in the Header response header. It does nothing if b is nil.
+func (b *Binder) Bind(ctx context.Context, token []byte) error {
+	if b == nil {
+		return nil
+	}
+
+	binding, err := b.exporter(ctx)
+	if err != nil {
+		if !errors.Is(err, ErrUnsupported) || b.Required {
+			return errors.Errorf("Unable to bind token to channel: %+v",
+				err)
+		}
+		jww.DEBUG.Printf("Issuing unbound token: %+v", err)
+		setHeader(ctx, Unbound)
+		return nil
+	}
+
+	now := netTime.Now()
+	b.mux.Lock()
+	for t, p := range b.pending {
+		if now.Sub(p.issued) > pendingTTL {
+			delete(b.pending, t)
+		}
+	}
+	b.pending[string(token)] = pendingBinding{binding: binding, issued: now}
+	b.mux.Unlock()
+
+	setHeader(ctx, Bound)
+	return nil
+}
+
+// ValidateFunc validates a signed reverse-authentication token, e.g.
+// connect.ProtoComms.ValidateToken.
+type ValidateFunc func(msg *messages.AuthenticatedMessage) error
+
+// VerifyToken checks that the token signed in an AuthenticateToken message was
+// issued over the connection it is being returned on and then validates it.
+// Only once the token is valid is the binding attached to the sender, so
+// later messages can be checked with Check. Call it from the
+// AuthenticateToken endpoint in place of validate. If b is nil, the token is
+// only validated.
+//
+// A token issued over another channel is accepted if it is returned over a
+// channel already bound to the sender along with the sender's current token.
+// That channel then joins the sender's binding without the token being
+// validated, so the sender's current token stays valid over every channel.
+func (b *Binder) VerifyToken(ctx context.Context,
+	msg *messages.AuthenticatedMessage, validate ValidateFunc) error {
+	if b == nil {
+		return validate(msg)
+	}
+
+	sender, err := id.Unmarshal(msg.ID)
+	if err != nil {
+		return err
+	}
+
+	tokenMsg := &messages.AssignToken{}
+	err = ptypes.UnmarshalAny(msg.Message, tokenMsg)
+	if err != nil {
+		return errors.Errorf("Unable to unmarshal token: %+v", err)
+	}
+
+	b.mux.Lock()
+	p, bound := b.pending[string(tokenMsg.Token)]
+	b.mux.Unlock()
+
+	var binding []byte
+	if bound {
+		binding, err = b.exporter(ctx)
+		if err != nil {
+			return errors.Errorf("Token from %s was not issued over "+
+				"this channel", sender)
+		}
+		if !bytes.Equal(binding, p.binding) {
+			return b.join(sender, msg.Token, binding, tokenMsg.Token)
+		}
+	} else if b.Required {
+		// The token was issued unbound, or was not issued by this host, in
+		// which case validation would reject it anyway
+		return errors.Errorf("Token from %s is not bound to a channel",
+			sender)
+	}
+
+	if err = validate(msg); err != nil {
+		return err
+	}
+
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	if bound {
+		delete(b.pending, string(tokenMsg.Token))
+		b.hosts[*sender] = &boundSender{
+			token:    tokenMsg.Token,
+			bindings: [][]byte{binding},
+		}
+	} else {
+		delete(b.hosts, *sender)
+	}
+	return nil
+}
+
+// join adds the channel the token was issued over to the sender's binding.
+// current is the token the sender authenticated the request with, which must
+// be the sender's token, and binding is the channel the request was received
+// over, which must already be bound to the sender.
+func (b *Binder) join(sender *id.ID, current, binding, token []byte) error {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	bs, exists := b.hosts[*sender]
+	if !exists || !bytes.Equal(bs.token, current) || !bs.has(binding) {
+		return errors.Errorf("Token from %s was not issued over this "+
+			"channel", sender)
+	}
+
+	p, exists := b.pending[string(token)]
+	if !exists {
+		return errors.Errorf("Token from %s was already used", sender)
+	}
+	delete(b.pending, string(token))
+
+	if !bs.has(p.binding) {
+		if len(bs.bindings) == MaxChannels {
+			bs.bindings = append(bs.bindings[:1], bs.bindings[2:]...)
+		}
+		bs.bindings = append(bs.bindings, p.binding)
+	}
+	return nil
+}
+
+// Verify checks that a message from an authenticated sender was received over
+// one of the channels its token is bound to. Senders with unbound tokens
+// pass, as do all senders if b is nil.
+func (b *Binder) Verify(ctx context.Context, sender *id.ID) error {
+	if b == nil || sender == nil {
+		return nil
+	}
+
+	b.mux.Lock()
+	bs, exists := b.hosts[*sender]
+	b.mux.Unlock()
+	if !exists {
+		return nil
+	}
+
+	binding, err := b.exporter(ctx)
+	if err == nil {
+		b.mux.Lock()
+		exists = bs.has(binding)
+		b.mux.Unlock()
+	}
+	if err != nil || !exists {
+		return errors.Errorf("Token from %s is bound to another channel",
+			sender)
+	}
+	return nil
+}
+
+// Check marks auth as unauthenticated if the message was not received over
+// the channel the sender's token is bound to. It does nothing if b is nil.
+func (b *Binder) Check(ctx context.Context, auth *connect.Auth) {
+	if b == nil || !auth.IsAuthenticated {
+		return
+	}
+
+	if err := b.Verify(ctx, auth.Sender.GetId()); err != nil {
+		auth.IsAuthenticated = false
+		auth.Reason = err.Error()
+	}
+}
+
+// setHeader reports the negotiated mode to the peer. It only succeeds inside
+// a unary gRPC handler and is otherwise ignored.
+func setHeader(ctx context.Context, mode string) {
+	_ = grpc.SetHeader(ctx, metadata.Pairs(Header, mode))
+}
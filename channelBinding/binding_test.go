@@ -0,0 +1,311 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package channelBinding
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"net"
+	"os"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+	"gitlab.com/elixxir/comms/testkeys"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+func TestMain(m *testing.M) {
+	connect.TestingOnlyDisableTLS = true
+	os.Exit(m.Run())
+}
+
+// tlsPair performs a TLS handshake over an in-memory connection and returns
+// the connection state seen by each side.
+func tlsPair(maxVersion uint16, t *testing.T) (client, server tls.ConnectionState) {
+	cert, err := tls.X509KeyPair(
+		testkeys.LoadFromPath(testkeys.GetNodeCertPath()),
+		testkeys.LoadFromPath(testkeys.GetNodeKeyPath()))
+	if err != nil {
+		t.Fatalf("Failed to load key pair: %+v", err)
+	}
+
+	c, s := net.Pipe()
+	defer c.Close()
+	defer s.Close()
+	clientConn := tls.Client(c, &tls.Config{
+		InsecureSkipVerify: true,
+		MaxVersion:         maxVersion,
+	})
+	serverConn := tls.Server(s, &tls.Config{
+		Certificates: []tls.Certificate{cert},
+	})
+
+	errChan := make(chan error, 1)
+	go func() { errChan <- serverConn.Handshake() }()
+	if err = clientConn.Handshake(); err != nil {
+		t.Fatalf("Client handshake failed: %+v", err)
+	}
+	if err = <-errChan; err != nil {
+		t.Fatalf("Server handshake failed: %+v", err)
+	}
+
+	return clientConn.ConnectionState(), serverConn.ConnectionState()
+}
+
+// contextWithState returns a context with a peer on the given TLS session.
+func contextWithState(state tls.ConnectionState) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{
+		Addr:     &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1},
+		AuthInfo: credentials.TLSInfo{State: state},
+	})
+}
+
+// Tests that FromContext returns the same binding on both ends of a session
+// and a different one for another session.
+func TestFromContext(t *testing.T) {
+	client, server := tlsPair(tls.VersionTLS13, t)
+
+	clientBinding, err := FromContext(contextWithState(client))
+	if err != nil {
+		t.Fatalf("FromContext() returned an error: %+v", err)
+	}
+	serverBinding, err := FromContext(contextWithState(server))
+	if err != nil {
+		t.Fatalf("FromContext() returned an error: %+v", err)
+	}
+	if !bytes.Equal(clientBinding, serverBinding) {
+		t.Errorf("Bindings differ between ends of the same session.")
+	}
+
+	_, otherServer := tlsPair(tls.VersionTLS13, t)
+	otherBinding, err := FromContext(contextWithState(otherServer))
+	if err != nil {
+		t.Fatalf("FromContext() returned an error: %+v", err)
+	}
+	if bytes.Equal(serverBinding, otherBinding) {
+		t.Errorf("Bindings of different sessions are equal.")
+	}
+}
+
+// Tests that FromContext returns ErrUnsupported for connections without TLS.
+func TestFromContext_Unsupported(t *testing.T) {
+	if _, err := FromContext(context.Background()); !errors.Is(err,
+		ErrUnsupported) {
+		t.Errorf("Expected ErrUnsupported without a peer, got %+v", err)
+	}
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{})
+	if _, err := FromContext(ctx); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("Expected ErrUnsupported without TLS, got %+v", err)
+	}
+}
+
+// bindingKey is the context key used by testExporter.
+type bindingKey struct{}
+
+// testExporter returns the binding stored in the context by withBinding.
+func testExporter(ctx context.Context) ([]byte, error) {
+	binding, ok := ctx.Value(bindingKey{}).([]byte)
+	if !ok {
+		return nil, ErrUnsupported
+	}
+	return binding, nil
+}
+
+func withBinding(binding string) context.Context {
+	return context.WithValue(context.Background(), bindingKey{},
+		[]byte(binding))
+}
+
+// newTokenMessage returns an AuthenticateToken message from sender.
+func newTokenMessage(sender *id.ID, token []byte,
+	t *testing.T) *messages.AuthenticatedMessage {
+	anyMsg, err := ptypes.MarshalAny(&messages.AssignToken{Token: token})
+	if err != nil {
+		t.Fatalf("Failed to marshal token: %+v", err)
+	}
+	return &messages.AuthenticatedMessage{ID: sender.Marshal(), Message: anyMsg}
+}
+
+func validateOk(*messages.AuthenticatedMessage) error { return nil }
+
+// Tests that a token bound to one channel can only be returned and used over
+// that channel.
+func TestBinder_VerifyToken(t *testing.T) {
+	b := NewBinder(false)
+	b.exporter = testExporter
+	sender := id.NewIdFromString("sender", id.Gateway, t)
+	token := []byte("token")
+
+	if err := b.Bind(withBinding("session1"), token); err != nil {
+		t.Fatalf("Bind() returned an error: %+v", err)
+	}
+
+	msg := newTokenMessage(sender, token, t)
+	if err := b.VerifyToken(withBinding("session2"), msg,
+		validateOk); err == nil {
+		t.Errorf("VerifyToken() accepted a token over another channel.")
+	}
+	if err := b.VerifyToken(withBinding("session1"), msg,
+		validateOk); err != nil {
+		t.Fatalf("VerifyToken() returned an error: %+v", err)
+	}
+
+	if err := b.Verify(withBinding("session1"), sender); err != nil {
+		t.Errorf("Verify() returned an error: %+v", err)
+	}
+	if err := b.Verify(withBinding("session2"), sender); err == nil {
+		t.Errorf("Verify() accepted a message over another channel.")
+	}
+
+	host, err := connect.NewHost(sender, "0.0.0.0:1", nil,
+		connect.GetDefaultHostParams())
+	if err != nil {
+		t.Fatalf("Failed to create host: %+v", err)
+	}
+	auth := &connect.Auth{IsAuthenticated: true, Sender: host}
+	b.Check(withBinding("session2"), auth)
+	if auth.IsAuthenticated {
+		t.Errorf("Check() did not revoke authentication.")
+	}
+}
+
+// Tests that a channel joins the sender's binding when the token issued over
+// it is returned over a bound channel with the sender's current token, and
+// that the join is refused otherwise.
+func TestBinder_VerifyToken_Join(t *testing.T) {
+	b := NewBinder(false)
+	b.exporter = testExporter
+	sender := id.NewIdFromString("sender", id.Gateway, t)
+	token, joinToken := []byte("token"), []byte("join token")
+
+	if err := b.Bind(withBinding("session1"), token); err != nil {
+		t.Fatalf("Bind() returned an error: %+v", err)
+	}
+	if err := b.VerifyToken(withBinding("session1"),
+		newTokenMessage(sender, token, t), validateOk); err != nil {
+		t.Fatalf("VerifyToken() returned an error: %+v", err)
+	}
+	if err := b.Bind(withBinding("session2"), joinToken); err != nil {
+		t.Fatalf("Bind() returned an error: %+v", err)
+	}
+
+	// The join must be sent with the current token over a bound channel
+	invalid := func(*messages.AuthenticatedMessage) error {
+		return errors.New("token must not be validated")
+	}
+	join := newTokenMessage(sender, joinToken, t)
+	join.Token = []byte("stale token")
+	if err := b.VerifyToken(withBinding("session1"), join,
+		invalid); err == nil {
+		t.Errorf("VerifyToken() joined a channel with a stale token.")
+	}
+	join.Token = token
+	if err := b.VerifyToken(withBinding("session3"), join,
+		invalid); err == nil {
+		t.Errorf("VerifyToken() joined a channel over an unbound channel.")
+	}
+	if err := b.VerifyToken(withBinding("session1"), join,
+		invalid); err != nil {
+		t.Fatalf("VerifyToken() refused to join a channel: %+v", err)
+	}
+
+	for _, session := range []string{"session1", "session2"} {
+		if err := b.Verify(withBinding(session), sender); err != nil {
+			t.Errorf("Verify() refused a message over %s: %+v", session, err)
+		}
+	}
+	if err := b.Verify(withBinding("session3"), sender); err == nil {
+		t.Errorf("Verify() accepted a message over an unjoined channel.")
+	}
+	if err := b.VerifyToken(withBinding("session1"), join,
+		invalid); err == nil {
+		t.Errorf("VerifyToken() accepted a join token twice.")
+	}
+}
+
+// Tests that the binding is not attached to the sender if validation fails.
+func TestBinder_VerifyToken_InvalidToken(t *testing.T) {
+	b := NewBinder(false)
+	b.exporter = testExporter
+	sender := id.NewIdFromString("sender", id.Gateway, t)
+	token := []byte("token")
+
+	if err := b.Bind(withBinding("session1"), token); err != nil {
+		t.Fatalf("Bind() returned an error: %+v", err)
+	}
+
+	invalid := func(*messages.AuthenticatedMessage) error {
+		return errors.New("invalid signature")
+	}
+	if err := b.VerifyToken(withBinding("session1"),
+		newTokenMessage(sender, token, t), invalid); err == nil {
+		t.Errorf("VerifyToken() did not return the validation error.")
+	}
+	if _, exists := b.hosts[*sender]; exists {
+		t.Errorf("Binding was attached to sender with an invalid token.")
+	}
+}
+
+// Tests that peers without channel binding support are issued unbound tokens
+// unless binding is required.
+func TestBinder_Unsupported(t *testing.T) {
+	sender := id.NewIdFromString("sender", id.Gateway, t)
+	token := []byte("token")
+
+	b := NewBinder(false)
+	b.exporter = testExporter
+	if err := b.Bind(context.Background(), token); err != nil {
+		t.Fatalf("Bind() returned an error: %+v", err)
+	}
+	if err := b.VerifyToken(context.Background(),
+		newTokenMessage(sender, token, t), validateOk); err != nil {
+		t.Errorf("VerifyToken() returned an error: %+v", err)
+	}
+	if err := b.Verify(context.Background(), sender); err != nil {
+		t.Errorf("Verify() returned an error: %+v", err)
+	}
+
+	required := NewBinder(true)
+	required.exporter = testExporter
+	if err := required.Bind(context.Background(), token); err == nil {
+		t.Errorf("Bind() issued an unbound token when binding is required.")
+	}
+	if err := required.VerifyToken(context.Background(),
+		newTokenMessage(sender, token, t), validateOk); err == nil {
+		t.Errorf("VerifyToken() accepted an unbound token when binding " +
+			"is required.")
+	}
+}
+
+// Tests that a nil Binder only validates tokens.
+func TestBinder_Nil(t *testing.T) {
+	var b *Binder
+	sender := id.NewIdFromString("sender", id.Gateway, t)
+	if err := b.Bind(context.Background(), nil); err != nil {
+		t.Errorf("Bind() returned an error: %+v", err)
+	}
+	if err := b.VerifyToken(context.Background(),
+		newTokenMessage(sender, nil, t), validateOk); err != nil {
+		t.Errorf("VerifyToken() returned an error: %+v", err)
+	}
+	auth := &connect.Auth{IsAuthenticated: true}
+	b.Check(context.Background(), auth)
+	if !auth.IsAuthenticated {
+		t.Errorf("Check() revoked authentication.")
+	}
+	if err := b.Verify(context.Background(), sender); err != nil {
+		t.Errorf("Verify() returned an error: %+v", err)
+	}
+}
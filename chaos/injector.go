@@ -0,0 +1,153 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package chaos injects faults into the calls made and received by comms, so
+// that tests can exercise retry, failover and resumption logic. Faults are
+// drawn from a seeded source, so a test injecting the same faults into the
+// same calls sees the same failures on every run. An Injector without rules
+// leaves every call unchanged.
+package chaos
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Fault describes the faults injected into a call.
+type Fault struct {
+	// Fraction of calls failed, in [0, 1]
+	DropRate float64
+	// Code failed calls return. If OK, Unavailable is returned.
+	Code codes.Code
+	// Delay added before each call
+	Latency time.Duration
+	// Fraction of messages sent or received on a stream after which the
+	// stream is reset, in [0, 1]. Only applies to streams received by
+	// servers.
+	StreamResetRate float64
+}
+
+// Rule applies a fault to the calls it matches.
+type Rule struct {
+	// Name of the method matched. Sends are named by their Send function,
+	// e.g. "SendPoll", and calls received by servers by their gRPC method,
+	// e.g. "Poll". If empty, every method is matched.
+	Method string
+	// Host matched. Only sends have a host, so rules with a host never
+	// match calls received by servers. If nil, every host is matched.
+	Host *id.ID
+	Fault
+}
+
+// matches returns true if the rule applies to the call.
+func (r Rule) matches(method string, host *id.ID) bool {
+	if r.Method != "" && r.Method != method {
+		return false
+	}
+	if r.Host != nil && (host == nil || !r.Host.Cmp(host)) {
+		return false
+	}
+	return true
+}
+
+// Injector injects the faults of its rules into calls.
+type Injector struct {
+	rules []Rule
+	rng   *rand.Rand
+	// Replaced in tests to avoid waiting
+	sleep func(time.Duration)
+	mux   sync.Mutex
+}
+
+// NewInjector returns an Injector without rules whose faults are drawn from
+// the seed.
+func NewInjector(seed int64) *Injector {
+	return &Injector{
+		rng:   rand.New(rand.NewSource(seed)),
+		sleep: time.Sleep,
+	}
+}
+
+// Seed restarts the source faults are drawn from at the seed.
+func (i *Injector) Seed(seed int64) {
+	i.mux.Lock()
+	defer i.mux.Unlock()
+	i.rng.Seed(seed)
+}
+
+// Add adds a rule. Every rule matching a call applies to it.
+func (i *Injector) Add(rule Rule) {
+	i.mux.Lock()
+	defer i.mux.Unlock()
+	i.rules = append(i.rules, rule)
+}
+
+// Clear removes every rule.
+func (i *Injector) Clear() {
+	i.mux.Lock()
+	defer i.mux.Unlock()
+	i.rules = nil
+}
+
+// Inject applies the rules matching the call, waiting out their latency, and
+// returns an error if the call is dropped. A nil Injector injects nothing.
+func (i *Injector) Inject(method string, host *id.ID) error {
+	if i == nil {
+		return nil
+	}
+
+	latency, err := i.draw(method, host)
+	if latency > 0 {
+		i.sleep(latency)
+	}
+	return err
+}
+
+// draw returns the latency of the rules matching the call and the error of
+// the first of them to drop it.
+func (i *Injector) draw(method string, host *id.ID) (time.Duration, error) {
+	i.mux.Lock()
+	defer i.mux.Unlock()
+
+	var latency time.Duration
+	var err error
+	for _, rule := range i.rules {
+		if !rule.matches(method, host) {
+			continue
+		}
+		latency += rule.Latency
+		if err == nil && rule.DropRate > 0 && i.rng.Float64() < rule.DropRate {
+			code := rule.Code
+			if code == codes.OK {
+				code = codes.Unavailable
+			}
+			err = status.Errorf(code, "%s dropped by fault injection", method)
+		}
+	}
+	return latency, err
+}
+
+// reset returns an error if a rule matching the method resets its stream
+// after a message.
+func (i *Injector) reset(method string) error {
+	i.mux.Lock()
+	defer i.mux.Unlock()
+
+	for _, rule := range i.rules {
+		if rule.matches(method, nil) && rule.StreamResetRate > 0 &&
+			i.rng.Float64() < rule.StreamResetRate {
+			return status.Errorf(codes.Aborted,
+				"%s stream reset by fault injection", method)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package chaos
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// dropped returns which of n calls to the method are dropped.
+func dropped(i *Injector, method string, host *id.ID, n int) []bool {
+	results := make([]bool, n)
+	for j := range results {
+		results[j] = i.Inject(method, host) != nil
+	}
+	return results
+}
+
+// Tests that the same seed drops the same calls.
+func TestInjector_Inject_Deterministic(t *testing.T) {
+	rule := Rule{Method: "SendPoll", Fault: Fault{DropRate: 0.5}}
+
+	a := NewInjector(42)
+	a.Add(rule)
+	b := NewInjector(42)
+	b.Add(rule)
+
+	first := dropped(a, "SendPoll", nil, 100)
+	second := dropped(b, "SendPoll", nil, 100)
+	count := 0
+	for j := range first {
+		if first[j] != second[j] {
+			t.Fatalf("Call %d dropped differently with the same seed.", j)
+		}
+		if first[j] {
+			count++
+		}
+	}
+	if count == 0 || count == 100 {
+		t.Errorf("Dropped %d of 100 calls at a rate of 0.5.", count)
+	}
+
+	// Reseeding repeats the drops
+	a.Seed(42)
+	again := dropped(a, "SendPoll", nil, 100)
+	for j := range first {
+		if first[j] != again[j] {
+			t.Fatalf("Call %d dropped differently after reseeding.", j)
+		}
+	}
+}
+
+// Tests that only matching calls are affected and that dropped calls return
+// the code of the rule.
+func TestInjector_Inject_Matching(t *testing.T) {
+	hostID := id.NewIdFromString("gateway", id.Gateway, t)
+	otherID := id.NewIdFromString("other", id.Gateway, t)
+
+	i := NewInjector(0)
+	var slept time.Duration
+	i.sleep = func(d time.Duration) { slept += d }
+	i.Add(Rule{
+		Method: "SendPutMessage",
+		Host:   hostID,
+		Fault: Fault{
+			DropRate: 1,
+			Code:     codes.DeadlineExceeded,
+			Latency:  time.Second,
+		},
+	})
+
+	err := i.Inject("SendPutMessage", hostID)
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("Unexpected error for a matching call: %v", err)
+	}
+	if slept != time.Second {
+		t.Errorf("Unexpected latency %s.", slept)
+	}
+
+	for _, call := range []struct {
+		method string
+		host   *id.ID
+	}{{"SendPoll", hostID}, {"SendPutMessage", otherID},
+		{"SendPutMessage", nil}} {
+		if err = i.Inject(call.method, call.host); err != nil {
+			t.Errorf("Call to %s on %s dropped: %v", call.method, call.host,
+				err)
+		}
+	}
+
+	i.Clear()
+	if err = i.Inject("SendPutMessage", hostID); err != nil {
+		t.Errorf("Call dropped after rules were cleared: %v", err)
+	}
+
+	var nilInjector *Injector
+	if err = nilInjector.Inject("SendPutMessage", hostID); err != nil {
+		t.Errorf("Nil injector dropped a call: %v", err)
+	}
+}
+
+// Tests that wrapped unary handlers are dropped without running.
+func TestInjector_Wrap_Unary(t *testing.T) {
+	called := false
+	desc := &grpc.ServiceDesc{
+		ServiceName: "test.Service",
+		Methods: []grpc.MethodDesc{{
+			MethodName: "Poll",
+			Handler: func(interface{}, context.Context, func(interface{}) error,
+				grpc.UnaryServerInterceptor) (interface{}, error) {
+				called = true
+				return nil, nil
+			},
+		}},
+	}
+
+	i := NewInjector(0)
+	i.Add(Rule{Method: "Poll", Fault: Fault{DropRate: 1}})
+	wrapped := i.Wrap(desc)
+
+	_, err := wrapped.Methods[0].Handler(nil, context.Background(), nil, nil)
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if called {
+		t.Errorf("Dropped handler was run.")
+	}
+}
+
+// mockServerStream is a grpc.ServerStream which receives messages forever.
+type mockServerStream struct {
+	grpc.ServerStream
+}
+
+func (mockServerStream) RecvMsg(interface{}) error { return nil }
+
+// Tests that wrapped stream handlers have their streams reset.
+func TestInjector_Wrap_StreamReset(t *testing.T) {
+	received := 0
+	desc := &grpc.ServiceDesc{
+		ServiceName: "test.Service",
+		Streams: []grpc.StreamDesc{{
+			StreamName: "Upload",
+			Handler: func(srv interface{}, stream grpc.ServerStream) error {
+				for {
+					if err := stream.RecvMsg(nil); err != nil {
+						return err
+					}
+					received++
+				}
+			},
+			ClientStreams: true,
+		}},
+	}
+
+	i := NewInjector(0)
+	i.Add(Rule{Method: "Upload", Fault: Fault{StreamResetRate: 0.1}})
+	wrapped := i.Wrap(desc)
+
+	err := wrapped.Streams[0].Handler(nil, mockServerStream{})
+	if status.Code(err) != codes.Aborted {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if received > 1000 {
+		t.Errorf("Stream received %d messages before being reset.", received)
+	}
+}
@@ -0,0 +1,86 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package chaos
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// Wrap returns a copy of the service description whose handlers have the
+// faults of the rules injected before they run. Streams are also reset
+// after messages as the rules decide. A nil Injector returns the description
+// unchanged.
+func (i *Injector) Wrap(desc *grpc.ServiceDesc) *grpc.ServiceDesc {
+	if i == nil {
+		return desc
+	}
+	wrapped := *desc
+
+	wrapped.Methods = make([]grpc.MethodDesc, len(desc.Methods))
+	for j, md := range desc.Methods {
+		method := md.MethodName
+		handler := md.Handler
+		wrapped.Methods[j] = grpc.MethodDesc{
+			MethodName: md.MethodName,
+			Handler: func(srv interface{}, ctx context.Context,
+				dec func(interface{}) error,
+				interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				if err := i.Inject(method, nil); err != nil {
+					return nil, err
+				}
+				return handler(srv, ctx, dec, interceptor)
+			},
+		}
+	}
+
+	wrapped.Streams = make([]grpc.StreamDesc, len(desc.Streams))
+	for j, sd := range desc.Streams {
+		method := sd.StreamName
+		handler := sd.Handler
+		wrapped.Streams[j] = sd
+		wrapped.Streams[j].Handler = func(srv interface{},
+			stream grpc.ServerStream) error {
+			if err := i.Inject(method, nil); err != nil {
+				return err
+			}
+			return handler(srv, &resettingStream{
+				ServerStream: stream,
+				injector:     i,
+				method:       method,
+			})
+		}
+	}
+
+	return &wrapped
+}
+
+// resettingStream resets the stream after messages as the rules of the
+// injector decide.
+type resettingStream struct {
+	grpc.ServerStream
+	injector *Injector
+	method   string
+}
+
+// SendMsg sends a message unless the stream is reset.
+func (rs *resettingStream) SendMsg(m interface{}) error {
+	if err := rs.injector.reset(rs.method); err != nil {
+		return err
+	}
+	return rs.ServerStream.SendMsg(m)
+}
+
+// RecvMsg receives a message, then resets the stream if the rules decide.
+func (rs *resettingStream) RecvMsg(m interface{}) error {
+	if err := rs.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return rs.injector.reset(rs.method)
+}
@@ -0,0 +1,256 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package circuitBreaker stops sending to hosts which keep failing. After a
+// number of consecutive failed sends the breaker of a host trips, and sends
+// to it fail fast for a cooldown period. The breaker then half-opens and
+// lets a single send through: if it succeeds the breaker closes, otherwise
+// it trips again. A connect.Host takes no breaker, so the breakers are
+// applied to the sends of a comms object by its instrumentation hooks.
+package circuitBreaker
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DefaultFailures is the number of consecutive failures which trip a
+	// breaker, used when none is given.
+	DefaultFailures = 5
+	// DefaultCooldown is how long a tripped breaker fails sends before
+	// half-opening, used when none is given.
+	DefaultCooldown = 30 * time.Second
+)
+
+// State is the state of the breaker of a host.
+type State uint8
+
+const (
+	// Closed breakers let every send through.
+	Closed State = iota
+	// Open breakers have tripped and fail every send.
+	Open
+	// HalfOpen breakers let a single send through to test the host.
+	HalfOpen
+)
+
+// String returns the name of the state.
+func (s State) String() string {
+	switch s {
+	case Closed:
+		return "closed"
+	case Open:
+		return "open"
+	case HalfOpen:
+		return "half-open"
+	default:
+		return "State(" + strconv.Itoa(int(s)) + ")"
+	}
+}
+
+// breaker is the breaker of a host.
+type breaker struct {
+	state    State
+	failures uint32
+	opened   time.Time
+	// Whether the send testing a half-open breaker is in progress
+	testing bool
+}
+
+// Breakers holds the breakers of the hosts sent to.
+type Breakers struct {
+	failures uint32
+	cooldown time.Duration
+	hosts    map[id.ID]*breaker
+	now      func() time.Time
+	mux      sync.Mutex
+}
+
+// NewBreakers returns Breakers which trip after the number of consecutive
+// failures and fail sends for the cooldown. Zero values are replaced by
+// DefaultFailures and DefaultCooldown.
+func NewBreakers(failures uint32, cooldown time.Duration) *Breakers {
+	if failures == 0 {
+		failures = DefaultFailures
+	}
+	if cooldown <= 0 {
+		cooldown = DefaultCooldown
+	}
+	return &Breakers{
+		failures: failures,
+		cooldown: cooldown,
+		hosts:    make(map[id.ID]*breaker),
+		now:      time.Now,
+	}
+}
+
+// Allow returns an UNAVAILABLE error if the breaker of the host is open, or
+// half-open with its test send in progress. Otherwise, the send is allowed
+// and its result must be passed to Record. A nil Breakers allows every send.
+func (b *Breakers) Allow(hid *id.ID) error {
+	if b == nil {
+		return nil
+	}
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	br, exists := b.hosts[*hid]
+	if !exists {
+		return nil
+	}
+	b.update(br)
+
+	switch br.state {
+	case Open:
+		return status.Errorf(codes.Unavailable, "Sends to %s are failing "+
+			"fast for %s after %d consecutive failures", hid,
+			br.opened.Add(b.cooldown).Sub(b.now()), br.failures)
+	case HalfOpen:
+		if br.testing {
+			return status.Errorf(codes.Unavailable, "Sends to %s are "+
+				"failing fast until a test send succeeds", hid)
+		}
+		br.testing = true
+	}
+	return nil
+}
+
+// Record records the result of a send allowed by Allow. Only failures to
+// reach the host count, as found by Code: sends failing as Unavailable,
+// DeadlineExceeded or ResourceExhausted. Any other error was returned by the
+// host, so it counts as a success. Sends cancelled by the caller are not
+// counted either way.
+func (b *Breakers) Record(hid *id.ID, err error) {
+	if b == nil {
+		return
+	}
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	code := Code(err)
+	br, exists := b.hosts[*hid]
+	switch code {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+	case codes.Canceled:
+		if exists {
+			br.testing = false
+		}
+		return
+	default:
+		delete(b.hosts, *hid)
+		return
+	}
+
+	if !exists {
+		br = &breaker{}
+		b.hosts[*hid] = br
+	}
+	br.failures++
+	br.testing = false
+	if br.state == HalfOpen || br.failures >= b.failures {
+		br.state = Open
+		br.opened = b.now()
+	}
+}
+
+// Code returns the gRPC status code of the error of a send. The status is
+// looked for through the chain of wrapped errors; context errors are given
+// their gRPC codes. Senders flatten the status of a failed call into the text
+// of a new error, so failing that the code is read from text in the form
+// status errors print, "rpc error: code = <code> desc = ...". Returns
+// codes.Unknown for other errors and codes.OK for nil.
+func Code(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	var se interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &se) {
+		return se.GRPCStatus().Code()
+	}
+	if errors.Is(err, context.Canceled) {
+		return codes.Canceled
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return codes.DeadlineExceeded
+	}
+
+	msg := err.Error()
+	i := strings.Index(msg, statusPrefix)
+	if i < 0 {
+		return codes.Unknown
+	}
+	name := msg[i+len(statusPrefix):]
+	if end := strings.Index(name, " desc = "); end >= 0 {
+		name = name[:end]
+	}
+	if code, exists := codeNames[name]; exists {
+		return code
+	}
+	return codes.Unknown
+}
+
+// statusPrefix precedes the code in the text of a status error.
+const statusPrefix = "rpc error: code = "
+
+// codeNames maps the names status errors print to their codes.
+var codeNames = func() map[string]codes.Code {
+	names := make(map[string]codes.Code)
+	for c := codes.OK; c <= codes.Unauthenticated; c++ {
+		names[c.String()] = c
+	}
+	return names
+}()
+
+// State returns the state of the breaker of the host.
+func (b *Breakers) State(hid *id.ID) State {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	br, exists := b.hosts[*hid]
+	if !exists {
+		return Closed
+	}
+	b.update(br)
+	return br.state
+}
+
+// Tripped returns the hosts whose breakers are open or half-open.
+func (b *Breakers) Tripped() []*id.ID {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	var tripped []*id.ID
+	for hid, br := range b.hosts {
+		if br.state != Closed {
+			hid := hid
+			tripped = append(tripped, &hid)
+		}
+	}
+	return tripped
+}
+
+// Reset closes the breaker of the host and forgets its failures.
+func (b *Breakers) Reset(hid *id.ID) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	delete(b.hosts, *hid)
+}
+
+// update half-opens the breaker if its cooldown has passed.
+func (b *Breakers) update(br *breaker) {
+	if br.state == Open && !b.now().Before(br.opened.Add(b.cooldown)) {
+		br.state = HalfOpen
+		br.testing = false
+	}
+}
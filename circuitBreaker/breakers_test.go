@@ -0,0 +1,186 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package circuitBreaker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Tests that a breaker trips after consecutive failures, fails fast for the
+// cooldown, half-opens for a single test send and closes once it succeeds.
+func TestBreakers_Allow(t *testing.T) {
+	now := time.Unix(0, 0)
+	b := NewBreakers(3, time.Minute)
+	b.now = func() time.Time { return now }
+	gw := id.NewIdFromString("gateway", id.Gateway, t)
+	failed := status.Error(codes.Unavailable, "failed")
+
+	// A success between failures resets the count
+	b.Record(gw, failed)
+	b.Record(gw, failed)
+	b.Record(gw, nil)
+	for i := 0; i < 3; i++ {
+		if err := b.Allow(gw); err != nil {
+			t.Fatalf("Send %d before tripping rejected: %+v", i, err)
+		}
+		b.Record(gw, failed)
+	}
+
+	if state := b.State(gw); state != Open {
+		t.Fatalf("Breaker %s after 3 failures.", state)
+	}
+	if err := b.Allow(gw); status.Code(err) != codes.Unavailable {
+		t.Fatalf("Send to tripped host returned %v", err)
+	}
+
+	now = now.Add(time.Minute)
+	if state := b.State(gw); state != HalfOpen {
+		t.Fatalf("Breaker %s after the cooldown.", state)
+	}
+	if err := b.Allow(gw); err != nil {
+		t.Fatalf("Test send rejected: %+v", err)
+	}
+	if err := b.Allow(gw); err == nil {
+		t.Errorf("Send during the test send allowed.")
+	}
+
+	b.Record(gw, nil)
+	if state := b.State(gw); state != Closed {
+		t.Errorf("Breaker %s after the test send succeeded.", state)
+	}
+	if err := b.Allow(gw); err != nil {
+		t.Errorf("Send after closing rejected: %+v", err)
+	}
+}
+
+// Tests that a failed test send trips the breaker again.
+func TestBreakers_Record_HalfOpenFailure(t *testing.T) {
+	now := time.Unix(0, 0)
+	b := NewBreakers(1, time.Minute)
+	b.now = func() time.Time { return now }
+	gw := id.NewIdFromString("gateway", id.Gateway, t)
+
+	b.Record(gw, status.Error(codes.DeadlineExceeded, "failed"))
+	now = now.Add(time.Minute)
+	if err := b.Allow(gw); err != nil {
+		t.Fatalf("Test send rejected: %+v", err)
+	}
+	b.Record(gw, status.Error(codes.DeadlineExceeded, "failed"))
+
+	if state := b.State(gw); state != Open {
+		t.Errorf("Breaker %s after the test send failed.", state)
+	}
+	if err := b.Allow(gw); err == nil {
+		t.Errorf("Send after the test send failed allowed.")
+	}
+}
+
+// Tests that cancelled sends are not counted as failures.
+func TestBreakers_Record_Cancelled(t *testing.T) {
+	b := NewBreakers(1, time.Minute)
+	gw := id.NewIdFromString("gateway", id.Gateway, t)
+
+	b.Record(gw, context.Canceled)
+	b.Record(gw, status.Error(codes.Canceled, "cancelled"))
+	if state := b.State(gw); state != Closed {
+		t.Errorf("Breaker %s after cancelled sends.", state)
+	}
+}
+
+// Tests that Reset closes a tripped breaker and that Tripped lists the hosts
+// whose breakers are not closed.
+func TestBreakers_Reset(t *testing.T) {
+	b := NewBreakers(1, time.Minute)
+	gw := id.NewIdFromString("gateway", id.Gateway, t)
+	node := id.NewIdFromString("node", id.Node, t)
+
+	b.Record(gw, status.Error(codes.DeadlineExceeded, "failed"))
+	b.Record(node, nil)
+	if tripped := b.Tripped(); len(tripped) != 1 || !tripped[0].Cmp(gw) {
+		t.Errorf("Unexpected tripped hosts: %v", tripped)
+	}
+
+	b.Reset(gw)
+	if state := b.State(gw); state != Closed {
+		t.Errorf("Breaker %s after reset.", state)
+	}
+	if err := b.Allow(gw); err != nil {
+		t.Errorf("Send after reset rejected: %+v", err)
+	}
+	if tripped := b.Tripped(); len(tripped) != 0 {
+		t.Errorf("Hosts tripped after reset: %v", tripped)
+	}
+}
+
+// Tests that a nil Breakers allows every send.
+func TestBreakers_Nil(t *testing.T) {
+	var b *Breakers
+	gw := id.NewIdFromString("gateway", id.Gateway, t)
+	b.Record(gw, status.Error(codes.DeadlineExceeded, "failed"))
+	if err := b.Allow(gw); err != nil {
+		t.Errorf("Nil Breakers rejected a send: %+v", err)
+	}
+}
+
+// Tests that only errors failing to reach the host are counted, including
+// statuses flattened into text or wrapped, and that other errors close the
+// breaker as a success does.
+func TestBreakers_Record_Codes(t *testing.T) {
+	b := NewBreakers(1, time.Minute)
+	gw := id.NewIdFromString("gateway", id.Gateway, t)
+
+	for _, err := range []error{
+		errors.New("round not found"),
+		status.Error(codes.InvalidArgument, "bad request"),
+		errors.New(status.Error(codes.NotFound, "missing").Error()),
+	} {
+		b.Record(gw, err)
+		if state := b.State(gw); state != Closed {
+			t.Errorf("Breaker %s after error %q.", state, err)
+		}
+	}
+
+	flattened := errors.New(
+		status.Error(codes.Unavailable, "connection refused").Error())
+	b.Record(gw, flattened)
+	if state := b.State(gw); state != Open {
+		t.Errorf("Breaker %s after error %q.", state, flattened)
+	}
+	b.Reset(gw)
+
+	b.Record(gw, fmt.Errorf("wrapped: %w", context.DeadlineExceeded))
+	if state := b.State(gw); state != Open {
+		t.Errorf("Breaker %s after an exceeded deadline.", state)
+	}
+}
+
+// Tests that Code finds the code of status, context and flattened errors.
+func TestCode(t *testing.T) {
+	tests := map[error]codes.Code{
+		nil:                              codes.OK,
+		errors.New("failed"):             codes.Unknown,
+		context.Canceled:                 codes.Canceled,
+		status.Error(codes.Aborted, "x"): codes.Aborted,
+		errors.New("Failed to send: " + status.Error(
+			codes.ResourceExhausted, "quota").Error()): codes.ResourceExhausted,
+		errors.New("rpc error: code = Bogus desc = x"): codes.Unknown,
+	}
+	for err, expected := range tests {
+		if code := Code(err); code != expected {
+			t.Errorf("Code of %v is %s, expected %s.", err, code, expected)
+		}
+	}
+}
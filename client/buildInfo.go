@@ -0,0 +1,61 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the request for the build info of any comms server
+
+package client
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/elixxir/comms/buildInfo"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/messages"
+)
+
+// GetBuildInfo requests the version and build of the comms server at the
+// host, which may be any type of server.
+func (c *Comms) GetBuildInfo(host *connect.Host) (*buildInfo.Info, error) {
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+
+		// Send the message
+		var resultMsg = &pb.ServerBuildInfo{}
+		var err error
+		if conn.IsWeb() {
+			wc := conn.GetWebConn()
+			err = wc.Invoke(ctx, "/mixmessages.BuildInfo/GetBuildInfo",
+				&messages.Ping{}, resultMsg)
+		} else {
+			resultMsg, err = pb.NewBuildInfoClient(c.Interceptors.ClientConn(conn.GetGrpcConn())).
+				GetBuildInfo(ctx, &messages.Ping{})
+		}
+		if err != nil {
+			return nil, err
+		}
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	// Execute the Send function
+	jww.TRACE.Printf("Requesting build info from %s", host.GetId())
+	resultMsg, err := c.Send(host, f)
+	if err != nil {
+		return nil, err
+	}
+
+	// Marshall the result
+	result := &pb.ServerBuildInfo{}
+	if err = ptypes.UnmarshalAny(resultMsg, result); err != nil {
+		return nil, err
+	}
+	return buildInfo.FromMessage(result), nil
+}
@@ -0,0 +1,90 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package client
+
+import (
+	"reflect"
+	"testing"
+
+	"gitlab.com/elixxir/comms/gateway"
+	"gitlab.com/elixxir/comms/node"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/gossip"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// Tests that GetBuildInfo returns the info set on the server.
+func TestComms_GetBuildInfo(t *testing.T) {
+	gatewayAddress := getNextAddress()
+	testID := id.NewIdFromString("test", id.Gateway, t)
+	gw := gateway.StartGateway(testID, gatewayAddress,
+		gateway.NewImplementation(), nil, nil, gossip.DefaultManagerFlags())
+	defer gw.Shutdown()
+	gw.BuildInfo.SetVersion("1.2.3")
+	gw.BuildInfo.SetFeatures("paging", "retention")
+	var c Comms
+
+	for _, connectionType := range []connect.ConnectionType{connect.Grpc, connect.Web} {
+		manager := connect.NewManagerTesting(t)
+
+		params := connect.GetDefaultHostParams()
+		params.ConnectionType = connectionType
+		params.AuthEnabled = false
+		host, err := manager.AddHost(testID, gatewayAddress, nil, params)
+		if err != nil {
+			t.Errorf("Unable to call NewHost: %+v", err)
+		}
+
+		info, err := c.GetBuildInfo(host)
+		if err != nil {
+			t.Errorf("GetBuildInfo: Error received: %+v", err)
+			continue
+		}
+
+		expected := gw.BuildInfo.Get()
+		if info.Version != "1.2.3" ||
+			!reflect.DeepEqual(info.Features, expected.Features) ||
+			!info.StartTime.Equal(expected.StartTime) {
+			t.Errorf("Unexpected build info.\nexpected: %+v\nreceived: %+v",
+				expected, *info)
+		}
+	}
+}
+
+// Tests that a node started with web support can be reached over grpc-web.
+func TestComms_AddWebHost(t *testing.T) {
+	nodeAddress := getNextAddress()
+	testID := id.NewIdFromString("test", id.Node, t)
+	n := node.StartNodeWithWeb(testID, nodeAddress, 0,
+		node.NewImplementation(), nil, nil)
+	defer n.Shutdown()
+	n.BuildInfo.SetVersion("1.2.3")
+
+	c, err := NewClientComms(id.NewIdFromString("client", id.User, t),
+		nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to create client comms: %+v", err)
+	}
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := c.AddWebHost(testID, nodeAddress, nil, params)
+	if err != nil {
+		t.Fatalf("Unable to add web host: %+v", err)
+	}
+	if !host.IsWeb() {
+		t.Error("Host added with AddWebHost does not use grpc-web")
+	}
+
+	info, err := c.GetBuildInfo(host)
+	if err != nil {
+		t.Fatalf("GetBuildInfo: Error received: %+v", err)
+	}
+	if info.Version != "1.2.3" {
+		t.Errorf("Unexpected version %q", info.Version)
+	}
+}
@@ -11,6 +11,12 @@ package client
 
 import (
 	"github.com/pkg/errors"
+	"gitlab.com/elixxir/comms/hostProxy"
+	"gitlab.com/elixxir/comms/instrumentation"
+	"gitlab.com/elixxir/comms/interceptors"
+	"gitlab.com/elixxir/comms/messageSize"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/network/dataStructures"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/primitives/id"
 )
@@ -18,6 +24,29 @@ import (
 // Client object used to implement endpoints and top-level comms functionality
 type Comms struct {
 	*connect.ProtoComms
+
+	// Optional clock offset estimator fed by gateway polls
+	clockOffsets *dataStructures.ClockOffsets
+
+	// Deduplicates concurrent identical idempotent requests
+	flights flightGroup
+	// Callbacks reporting each send
+	sendHooks instrumentation.Hooks
+	// Message retention advertised by gateways
+	retention retentionTracker
+	// Last responses of conditional polls
+	etags etagCache
+	// Adjustments made to hosts added with AddHighLatencyHost
+	HighLatency HighLatencyParams
+	// Proxy hosts added with AddHost are routed through, if set
+	proxy *hostProxy.Proxy
+	// Interceptors run on the calls sent by this client
+	Interceptors *interceptors.Chain
+	// Limits on the size of the messages sent and received by this client
+	MessageSize *messageSize.Limiter
+	// If set, marks the scheme of the slots sent by SendPutMessage and
+	// SendPutManyMessages which are not already marked
+	SlotScheme pb.SlotScheme
 }
 
 // Returns a Comms object with given attributes
@@ -26,5 +55,18 @@ func NewClientComms(id *id.ID, pubKeyPem, privKeyPem, salt []byte) (*Comms, erro
 	if err != nil {
 		return nil, errors.Errorf("Unable to create Client comms: %+v", err)
 	}
-	return &Comms{pc}, nil
+	c := &Comms{
+		ProtoComms:   pc,
+		HighLatency:  DefaultHighLatencyParams(),
+		Interceptors: interceptors.New(),
+		MessageSize:  messageSize.NewLimiter(messageSize.Limits{}),
+	}
+	c.MessageSize.Install(c.Interceptors)
+	return c, nil
+}
+
+// SetClockOffsets sets the estimator which is passed a round-trip sample from
+// every successful SendPoll. Passing nil disables sampling.
+func (c *Comms) SetClockOffsets(co *dataStructures.ClockOffsets) {
+	c.clockOffsets = co
 }
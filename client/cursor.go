@@ -0,0 +1,96 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains paged retrieval of messages from gateways
+
+package client
+
+import (
+	"github.com/golang/protobuf/proto"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+)
+
+// RequestMessagesPage requests the page of a round's messages after the
+// cursor, which is empty for the first page. It returns the cursor for the
+// next page, which is empty once the last page has been received.
+func (c *Comms) RequestMessagesPage(host *connect.Host,
+	message *pb.GetMessages, cursor string) (*pb.GetMessagesResponse,
+	string, error) {
+	message = proto.Clone(message).(*pb.GetMessages)
+	message.Paged = true
+	message.Cursor = cursor
+
+	response, err := c.RequestMessages(host, message)
+	if err != nil {
+		return nil, "", err
+	}
+	return response, response.GetNextCursor(), nil
+}
+
+// MessageIterator retrieves a round's messages from a gateway a page at a
+// time. Its Cursor may be saved to resume retrieval later, for example after
+// reconnecting.
+type MessageIterator struct {
+	c       *Comms
+	host    *connect.Host
+	message *pb.GetMessages
+	cursor  string
+	done    bool
+}
+
+// IterateMessages returns an iterator over the messages requested by the
+// message, starting from the first.
+func (c *Comms) IterateMessages(host *connect.Host,
+	message *pb.GetMessages) *MessageIterator {
+	return c.ResumeMessages(host, message, "")
+}
+
+// ResumeMessages returns an iterator over the messages requested by the
+// message, starting after the cursor returned by an earlier iterator.
+func (c *Comms) ResumeMessages(host *connect.Host, message *pb.GetMessages,
+	cursor string) *MessageIterator {
+	return &MessageIterator{
+		c:       c,
+		host:    host,
+		message: message,
+		cursor:  cursor,
+	}
+}
+
+// Next returns the next page of messages. It returns false once all messages
+// have been returned. After an error, Next may be called again to retry the
+// same page.
+func (mi *MessageIterator) Next() (*pb.GetMessagesResponse, bool, error) {
+	if mi.done {
+		return nil, false, nil
+	}
+
+	response, next, err := mi.c.RequestMessagesPage(mi.host, mi.message,
+		mi.cursor)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if next == "" {
+		mi.done = true
+		// The gateway sends no cursor after the last page, so one is made
+		// from the last message for Cursor to return
+		if n := len(response.Messages); n > 0 {
+			mi.cursor = pb.NewMessageCursor(mi.message.GetRoundID(),
+				response.Messages, n).Marshal()
+		}
+	} else {
+		mi.cursor = next
+	}
+	return response, true, nil
+}
+
+// Cursor returns the position after the last page returned by Next.
+func (mi *MessageIterator) Cursor() string {
+	return mi.cursor
+}
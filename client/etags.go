@@ -0,0 +1,72 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the responses held for conditional polls
+
+package client
+
+import (
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// etagEntry is the last response of a poll and its entity tag.
+type etagEntry struct {
+	etag     string
+	response proto.Message
+}
+
+// etagCache holds the last tagged response of each poll to each host, so
+// that servers may answer a poll whose response is unchanged with
+// mixmessages.NotModifiedHeader rather than the response. The zero value is
+// ready to use.
+type etagCache struct {
+	entries map[string]etagEntry
+	mux     sync.Mutex
+}
+
+// get returns the tag of the last response of the poll to the host and a
+// copy of the response, or an empty tag if there is none.
+func (ec *etagCache) get(rpc string, hid *id.ID) (string, proto.Message) {
+	ec.mux.Lock()
+	defer ec.mux.Unlock()
+	entry, exists := ec.entries[rpc+hid.String()]
+	if !exists {
+		return "", nil
+	}
+	return entry.etag, proto.Clone(entry.response)
+}
+
+// set stores a copy of the response of the poll to the host with its tag. An
+// empty tag, sent by servers not supporting conditional polls, removes the
+// response.
+func (ec *etagCache) set(rpc string, hid *id.ID, etag string,
+	response proto.Message) {
+	ec.mux.Lock()
+	defer ec.mux.Unlock()
+	if ec.entries == nil {
+		ec.entries = make(map[string]etagEntry)
+	}
+	if etag == "" {
+		delete(ec.entries, rpc+hid.String())
+		return
+	}
+	ec.entries[rpc+hid.String()] = etagEntry{
+		etag:     etag,
+		response: proto.Clone(response),
+	}
+}
+
+// firstValue returns the first value of the header, or an empty string.
+func firstValue(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
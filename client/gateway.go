@@ -10,13 +10,16 @@
 package client
 
 import (
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/pkg/errors"
 	jww "github.com/spf13/jwalterweatherman"
 	pb "gitlab.com/elixxir/comms/mixmessages"
 	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/crypto/signature/rsa"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"io"
 	"strconv"
 	"time"
@@ -25,6 +28,10 @@ import (
 // SendPutMessage Client -> Gateway Send Function
 func (c *Comms) SendPutMessage(host *connect.Host, message *pb.GatewaySlot,
 	timeout time.Duration) (*pb.GatewaySlotResponse, error) {
+	if c.markSlotScheme(message.GetSlotScheme()) {
+		message = proto.Clone(message).(*pb.GatewaySlot)
+		message.SlotScheme = c.SlotScheme.String()
+	}
 
 	// Create the Send Function
 	f := func(conn connect.Connection) (*any.Any, error) {
@@ -40,13 +47,14 @@ func (c *Comms) SendPutMessage(host *connect.Host, message *pb.GatewaySlot,
 			err = wc.Invoke(
 				ctx, "/mixmessages.Gateway/PutMessage", message, resultMsg)
 		} else {
-			resultMsg, err = pb.NewGatewayClient(conn.GetGrpcConn()).
+			resultMsg, err = pb.NewGatewayClient(c.Interceptors.ClientConn(conn.GetGrpcConn())).
 				PutMessage(ctx, message)
 		}
 
 		if err != nil {
 			return nil, err
 		}
+		c.retention.record(host, resultMsg.GetRetention())
 		return ptypes.MarshalAny(resultMsg)
 	}
 
@@ -66,6 +74,11 @@ func (c *Comms) SendPutMessage(host *connect.Host, message *pb.GatewaySlot,
 func (c *Comms) SendPutManyMessages(host *connect.Host,
 	messages *pb.GatewaySlots, timeout time.Duration) (
 	*pb.GatewaySlotResponse, error) {
+	if c.markSlotScheme(messages.GetSlotScheme()) {
+		messages = proto.Clone(messages).(*pb.GatewaySlots)
+		messages.SlotScheme = c.SlotScheme.String()
+	}
+
 	// Create the Send Function
 	f := func(conn connect.Connection) (*any.Any, error) {
 		// Set up the context
@@ -80,12 +93,13 @@ func (c *Comms) SendPutManyMessages(host *connect.Host,
 			err = wc.Invoke(ctx, "/mixmessages.Gateway/PutManyMessages",
 				messages, resultMsg)
 		} else {
-			resultMsg, err = pb.NewGatewayClient(conn.GetGrpcConn()).
+			resultMsg, err = pb.NewGatewayClient(c.Interceptors.ClientConn(conn.GetGrpcConn())).
 				PutManyMessages(ctx, messages)
 		}
 		if err != nil {
 			return nil, err
 		}
+		c.retention.record(host, resultMsg.GetRetention())
 		return ptypes.MarshalAny(resultMsg)
 	}
 
@@ -104,6 +118,16 @@ func (c *Comms) SendPutManyMessages(host *connect.Host,
 // SendRequestClientKeyMessage Client -> Gateway Send Function
 func (c *Comms) SendRequestClientKeyMessage(host *connect.Host,
 	message *pb.SignedClientKeyRequest) (*pb.SignedKeyResponse, error) {
+	result, _, err := c.SendRequestClientKeyMessageWithReceipt(host, message)
+	return result, err
+}
+
+// SendRequestClientKeyMessageWithReceipt Client -> Gateway Send Function
+// which also returns the node's registration receipt for the response. The
+// receipt is nil if none was sent, e.g. by nodes which do not issue receipts.
+func (c *Comms) SendRequestClientKeyMessageWithReceipt(host *connect.Host,
+	message *pb.SignedClientKeyRequest) (*pb.SignedKeyResponse,
+	*pb.RegistrationReceipt, error) {
 
 	// Create the Send Function
 	f := func(conn connect.Connection) (*any.Any, error) {
@@ -119,7 +143,7 @@ func (c *Comms) SendRequestClientKeyMessage(host *connect.Host,
 			err = wc.Invoke(ctx, "/mixmessages.Gateway/RequestClientKey",
 				message, resultMsg)
 		} else {
-			resultMsg, err = pb.NewGatewayClient(conn.GetGrpcConn()).
+			resultMsg, err = pb.NewGatewayClient(c.Interceptors.ClientConn(conn.GetGrpcConn())).
 				RequestClientKey(ctx, message)
 		}
 
@@ -134,17 +158,31 @@ func (c *Comms) SendRequestClientKeyMessage(host *connect.Host,
 	jww.TRACE.Printf("Sending Request Client Key message: %+v", message)
 	resultMsg, err := c.Send(host, f)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Marshall the result
 	result := &pb.SignedKeyResponse{}
-	return result, ptypes.UnmarshalAny(resultMsg, result)
+	err = ptypes.UnmarshalAny(resultMsg, result)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, result.GetReceipt(), nil
 }
 
 // Client -> Gateway Send Function
 func (c *Comms) BatchNodeRegistration(host *connect.Host,
 	message *pb.SignedClientBatchKeyRequest) (*pb.SignedBatchKeyResponse, error) {
+	result, _, err := c.BatchNodeRegistrationWithReceipts(host, message)
+	return result, err
+}
+
+// BatchNodeRegistrationWithReceipts Client -> Gateway Send Function which
+// also returns the registration receipts for the responses. Each receipt is
+// at the same index as the response it is for and is nil if none was sent.
+func (c *Comms) BatchNodeRegistrationWithReceipts(host *connect.Host,
+	message *pb.SignedClientBatchKeyRequest) (*pb.SignedBatchKeyResponse,
+	[]*pb.RegistrationReceipt, error) {
 
 	// Create the Send Function
 	f := func(conn connect.Connection) (*any.Any, error) {
@@ -160,7 +198,7 @@ func (c *Comms) BatchNodeRegistration(host *connect.Host,
 			err = wc.Invoke(ctx, "/mixmessages.Gateway/BatchNodeRegistration",
 				message, resultMsg)
 		} else {
-			resultMsg, err = pb.NewGatewayClient(conn.GetGrpcConn()).
+			resultMsg, err = pb.NewGatewayClient(c.Interceptors.ClientConn(conn.GetGrpcConn())).
 				BatchNodeRegistration(ctx, message)
 		}
 
@@ -175,12 +213,35 @@ func (c *Comms) BatchNodeRegistration(host *connect.Host,
 	jww.TRACE.Printf("Sending Request Client Key message: %+v", message)
 	resultMsg, err := c.Send(host, f)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Marshall the result
 	result := &pb.SignedBatchKeyResponse{}
-	return result, ptypes.UnmarshalAny(resultMsg, result)
+	err = ptypes.UnmarshalAny(resultMsg, result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	receipts := make([]*pb.RegistrationReceipt, len(result.SignedKeys))
+	for i, response := range result.SignedKeys {
+		receipts[i] = response.GetReceipt()
+	}
+	return result, receipts, nil
+}
+
+// VerifyRegistrationReceipt checks that the receipt was signed by the node
+// with the given public key over the response, proving that the node
+// completed registration at the receipt's timestamp.
+func VerifyRegistrationReceipt(receipt *pb.RegistrationReceipt,
+	response *pb.SignedKeyResponse, nodePubKey *rsa.PublicKey) error {
+	if receipt == nil {
+		return errors.New("No registration receipt was received")
+	}
+	if err := receipt.Verify(response, nodePubKey); err != nil {
+		return errors.Errorf("Invalid registration receipt: %+v", err)
+	}
+	return nil
 }
 
 // SendPoll Client -> Gateway Send Function
@@ -193,6 +254,13 @@ func (c *Comms) SendPoll(host *connect.Host,
 	ctx, cancel := connect.StreamingContextWithTimeout(10 * time.Second)
 	defer cancel()
 
+	// Ask the gateway to only send timestamps if the content of its response
+	// matches the last one
+	etag, cached := c.etags.get("Poll", host.GetId())
+	if etag != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, pb.IfNoneMatchHeader, etag)
+	}
+
 	var startTime time.Time
 
 	// Create the Stream Function
@@ -223,7 +291,7 @@ func (c *Comms) SendPoll(host *connect.Host,
 			// clock skew will be done with this timestamp and including
 			// the skew adjustment will break the calculation
 			startTime = time.Now()
-			clientStream, err := pb.NewGatewayClient(conn.GetGrpcConn()).
+			clientStream, err := pb.NewGatewayClient(c.Interceptors.ClientConn(conn.GetGrpcConn())).
 				Poll(ctx, message)
 			roundTripTime = time.Now().Sub(startTime)
 			if err != nil {
@@ -292,11 +360,45 @@ func (c *Comms) SendPoll(host *connect.Host,
 
 	// Assemble the result
 	result := &pb.GatewayPollResponse{}
-	return result, startTime, roundTripTime, pb.AssembleChunksIntoResponse(chunks, result)
+	err = pb.AssembleChunksIntoResponse(chunks, result)
+	if err == nil && pb.IsNotModified(md) {
+		// Only the timestamps were sent; the rest matches the last response
+		if cached == nil {
+			return nil, time.Time{}, 0, errors.Errorf("Gateway %s sent an "+
+				"unmodified poll response without one being held",
+				host.GetId())
+		}
+		full := cached.(*pb.GatewayPollResponse)
+		full.ReceivedTs, full.GatewayDelay = result.ReceivedTs,
+			result.GatewayDelay
+		result = full
+	} else if err == nil {
+		c.etags.set("Poll", host.GetId(), firstValue(md.Get(pb.ETagHeader)),
+			result)
+	}
+	if err == nil && c.clockOffsets != nil && result.ReceivedTs != 0 {
+		c.clockOffsets.AddSample(host.GetId(), startTime, roundTripTime,
+			time.Unix(0, result.ReceivedTs), time.Duration(result.GatewayDelay))
+	}
+	return result, startTime, roundTripTime, err
 }
 
-// RequestHistoricalRounds Client -> Gateway Send Function
+// RequestHistoricalRounds Client -> Gateway Send Function. Concurrent
+// identical requests to the same host share one request.
 func (c *Comms) RequestHistoricalRounds(host *connect.Host,
+	message *pb.HistoricalRounds) (*pb.HistoricalRoundsResponse, error) {
+	result, err := c.flights.do("RequestHistoricalRounds", host, message,
+		func() (proto.Message, error) {
+			return c.sendRequestHistoricalRounds(host, message)
+		})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*pb.HistoricalRoundsResponse), nil
+}
+
+// sendRequestHistoricalRounds sends the request for RequestHistoricalRounds.
+func (c *Comms) sendRequestHistoricalRounds(host *connect.Host,
 	message *pb.HistoricalRounds) (*pb.HistoricalRoundsResponse, error) {
 	// Create the Send Function
 	f := func(conn connect.Connection) (*any.Any, error) {
@@ -312,7 +414,7 @@ func (c *Comms) RequestHistoricalRounds(host *connect.Host,
 			err = wc.Invoke(ctx, "/mixmessages.Gateway/RequestHistoricalRounds",
 				message, resultMsg)
 		} else {
-			resultMsg, err = pb.NewGatewayClient(conn.GetGrpcConn()).
+			resultMsg, err = pb.NewGatewayClient(c.Interceptors.ClientConn(conn.GetGrpcConn())).
 				RequestHistoricalRounds(ctx, message)
 		}
 		if err != nil {
@@ -350,12 +452,13 @@ func (c *Comms) RequestMessages(host *connect.Host,
 			err = wc.Invoke(
 				ctx, "/mixmessages.Gateway/RequestMessages", message, resultMsg)
 		} else {
-			resultMsg, err = pb.NewGatewayClient(conn.GetGrpcConn()).
+			resultMsg, err = pb.NewGatewayClient(c.Interceptors.ClientConn(conn.GetGrpcConn())).
 				RequestMessages(ctx, message)
 		}
 		if err != nil {
 			return nil, err
 		}
+		c.retention.record(host, resultMsg.GetRetention())
 		return ptypes.MarshalAny(resultMsg)
 	}
 
@@ -388,12 +491,13 @@ func (c *Comms) RequestBatchMessages(host *connect.Host,
 			err = wc.Invoke(
 				ctx, "/mixmessages.Gateway/RequestBatchMessages", message, resultMsg)
 		} else {
-			resultMsg, err = pb.NewGatewayClient(conn.GetGrpcConn()).
+			resultMsg, err = pb.NewGatewayClient(c.Interceptors.ClientConn(conn.GetGrpcConn())).
 				RequestBatchMessages(ctx, message)
 		}
 		if err != nil {
 			return nil, err
 		}
+		c.retention.record(host, resultMsg.GetRetention())
 		return ptypes.MarshalAny(resultMsg)
 	}
 
@@ -425,7 +529,7 @@ func (c *Comms) GetGatewayTLSCertificate(host *connect.Host,
 			err = wc.Invoke(
 				ctx, "/mixmessages.Gateway/RequestTlsCert", message, resultMsg)
 		} else {
-			resultMsg, err = pb.NewGatewayClient(conn.GetGrpcConn()).
+			resultMsg, err = pb.NewGatewayClient(c.Interceptors.ClientConn(conn.GetGrpcConn())).
 				RequestTlsCert(ctx, message)
 		}
 		if err != nil {
@@ -452,3 +556,11 @@ func wrapError(err error, s string, i ...interface{}) error {
 	}
 	return errors.Wrapf(err, s, i...)
 }
+
+// markSlotScheme returns true if a message with the slot scheme marker is to
+// be marked with the slot scheme of the client, which is when the client has
+// one and the message is not already marked. The caller's message is copied
+// rather than marked in place.
+func (c *Comms) markSlotScheme(marker string) bool {
+	return marker == "" && !c.SlotScheme.IsZero()
+}
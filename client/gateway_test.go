@@ -53,6 +53,53 @@ func TestSendPutMessage(t *testing.T) {
 	}
 }
 
+// Tests that the message retention advertised by the gateway on put is
+// recorded for the host.
+func TestSendPutMessage_MessageRetention(t *testing.T) {
+	gatewayAddress := getNextAddress()
+	testID := id.NewIdFromString("test", id.Gateway, t)
+	impl := gateway.NewImplementation()
+	impl.Functions.PutMessage = func(*pb.GatewaySlot, string) (
+		*pb.GatewaySlotResponse, error) {
+		return &pb.GatewaySlotResponse{Accepted: true}, nil
+	}
+	gw := gateway.StartGateway(testID, gatewayAddress, impl, nil, nil,
+		gossip.DefaultManagerFlags())
+	defer gw.Shutdown()
+	gw.MessageRetention = 72 * time.Hour
+	var c Comms
+
+	manager := connect.NewManagerTesting(t)
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(testID, gatewayAddress, nil, params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+
+	if _, exists := c.GetMessageRetention(testID); exists {
+		t.Errorf("Retention exists before any put.")
+	}
+
+	before := time.Now()
+	_, err = c.SendPutMessage(host, &pb.GatewaySlot{}, 10*time.Second)
+	if err != nil {
+		t.Fatalf("PutMessage: Error received: %s", err)
+	}
+
+	retention, exists := c.GetMessageRetention(testID)
+	if !exists {
+		t.Fatalf("Retention was not recorded.")
+	}
+	if retention.TTL != gw.MessageRetention {
+		t.Errorf("Unexpected TTL.\nexpected: %s\nreceived: %s",
+			gw.MessageRetention, retention.TTL)
+	}
+	if retention.Expiry.Before(before.Add(gw.MessageRetention)) {
+		t.Errorf("Expiry %s is before the put was made.", retention.Expiry)
+	}
+}
+
 // Test the restart function of gateway comms
 func TestRestart(t *testing.T) {
 	gatewayAddress := getNextAddress()
@@ -270,3 +317,87 @@ func (m mockGatewayImpl) RequestBatchMessages(message *pb.GetMessagesBatch) (*pb
 func (m mockGatewayImpl) RequestTlsCert(msg *pb.RequestGatewayCert) (*pb.GatewayCertificate, error) {
 	return &pb.GatewayCertificate{}, nil
 }
+
+func (m mockGatewayImpl) NotifyAddressUpdate(update *pb.NDF, auth *connect.Auth) error {
+	return nil
+}
+
+func (m mockGatewayImpl) MirrorMessages(msgs *pb.RoundMessages, manifest *pb.MirrorManifest, auth *connect.Auth) error {
+	return nil
+}
+
+func (m mockGatewayImpl) StreamRoundUpdates(msg *pb.GatewayPoll, interest [][]byte, stream pb.Gateway_StreamRoundUpdatesServer) error {
+	return nil
+}
+
+func (m mockGatewayImpl) RelayMessage(destination *id.ID, payload []byte) error {
+	return nil
+}
+
+func (m mockGatewayImpl) UpdateHostAddress(update *pb.AddressUpdate, auth *connect.Auth) error {
+	return nil
+}
+
+func (m mockGatewayImpl) RequestInclusionProof(msg *pb.GatewaySlot) (uint32, bool, error) {
+	return 0, false, nil
+}
+
+// Tests that MessageIterator retrieves every message of a round in pages.
+func TestComms_IterateMessages(t *testing.T) {
+	gatewayAddress := getNextAddress()
+	testID := id.NewIdFromString("test", id.Gateway, t)
+	slots := make([]*pb.Slot, 5)
+	for i := range slots {
+		slots[i] = &pb.Slot{Index: uint32(i)}
+	}
+	impl := gateway.NewImplementation()
+	impl.Functions.RequestMessages = func(*pb.GetMessages) (
+		*pb.GetMessagesResponse, error) {
+		return &pb.GetMessagesResponse{Messages: slots, HasRound: true}, nil
+	}
+	gw := gateway.StartGateway(testID, gatewayAddress, impl, nil, nil,
+		gossip.DefaultManagerFlags())
+	defer gw.Shutdown()
+	gw.MessagePageSize = 2
+	var c Comms
+
+	manager := connect.NewManagerTesting(t)
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(testID, gatewayAddress, nil, params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+
+	it := c.IterateMessages(host, &pb.GetMessages{RoundID: 7})
+	var received []*pb.Slot
+	pages := 0
+	for {
+		page, more, err := it.Next()
+		if err != nil {
+			t.Fatalf("Failed to get page %d: %+v", pages, err)
+		}
+		if !more {
+			break
+		}
+		pages++
+		received = append(received, page.Messages...)
+	}
+
+	if pages != 3 || len(received) != len(slots) {
+		t.Fatalf("Received %d messages in %d pages", len(received), pages)
+	}
+	for i, slot := range received {
+		if slot.Index != uint32(i) {
+			t.Errorf("Message %d has index %d", i, slot.Index)
+		}
+	}
+
+	// Resuming after the last page, as if reconnecting, returns nothing new
+	it = c.ResumeMessages(host, &pb.GetMessages{RoundID: 7}, it.Cursor())
+	page, more, err := it.Next()
+	if err != nil || !more || len(page.Messages) != 0 {
+		t.Errorf("Unexpected page after the last: %v %t %+v",
+			page, more, err)
+	}
+}
@@ -0,0 +1,86 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the Client -> Gateway request for proof that a message was
+// included in a round
+
+package client
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+)
+
+// SendRequestInclusionProof Client -> Gateway request for proof that a
+// message the gateway accepted with SendPutMessage was included in its
+// round. The message must be the one which was sent. The proof is verified
+// against the key of the gateway before it is returned. If the message has
+// not been included (yet), nil is returned without an error.
+func (c *Comms) SendRequestInclusionProof(host *connect.Host,
+	message *pb.GatewaySlot) (*pb.InclusionProof, error) {
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+
+		// Send the message
+		var resultMsg = &pb.GatewaySlotResponse{}
+		var err error
+		if conn.IsWeb() {
+			wc := conn.GetWebConn()
+			err = wc.Invoke(ctx, "/mixmessages.Gateway/RequestInclusionProof",
+				message, resultMsg)
+		} else {
+			resultMsg, err = pb.NewGatewayClient(c.Interceptors.ClientConn(conn.GetGrpcConn())).
+				RequestInclusionProof(ctx, message)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	// Execute the Send function
+	jww.TRACE.Printf("Sending Request Inclusion Proof message for round %d",
+		message.GetRoundID())
+	resultMsg, err := c.Send(host, f)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &pb.GatewaySlotResponse{}
+	if err = ptypes.UnmarshalAny(resultMsg, result); err != nil {
+		return nil, err
+	}
+	if !result.GetAccepted() {
+		return nil, nil
+	}
+
+	proof := result.GetProof()
+	if proof == nil {
+		return nil, errors.Errorf("Gateway %s accepted the request for an "+
+			"inclusion proof without sending one", host.GetId())
+	}
+	gwID, err := proof.GetGatewayID()
+	if err != nil {
+		return nil, errors.Errorf("Invalid inclusion proof gateway: %+v", err)
+	}
+	if !gwID.Cmp(host.GetId()) {
+		return nil, errors.Errorf("Inclusion proof from %s was sent by %s",
+			gwID, host.GetId())
+	}
+	if err = proof.Verify(message, host.GetPubKey()); err != nil {
+		return nil, errors.Errorf("Invalid inclusion proof from %s: %+v",
+			host.GetId(), err)
+	}
+	return proof, nil
+}
@@ -0,0 +1,70 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package client
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"gitlab.com/elixxir/comms/gateway"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/testkeys"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/gossip"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// Tests that a proof signed by the gateway is returned once the message is
+// included, and nil before.
+func TestComms_SendRequestInclusionProof(t *testing.T) {
+	gatewayAddress := getNextAddress()
+	testID := id.NewIdFromString("test", id.Gateway, t)
+
+	var included uint32
+	impl := gateway.NewImplementation()
+	impl.Functions.RequestInclusionProof = func(msg *pb.GatewaySlot) (uint32,
+		bool, error) {
+		return 5, atomic.LoadUint32(&included) == 1, nil
+	}
+	gw := gateway.StartGateway(testID, gatewayAddress, impl,
+		testkeys.GetGatewayCert(), testkeys.GetGatewayKey(),
+		gossip.DefaultManagerFlags())
+	defer gw.Shutdown()
+
+	c, err := NewClientComms(id.NewIdFromString("client", id.User, t), nil,
+		nil, nil)
+	if err != nil {
+		t.Fatalf("Could not start client: %v", err)
+	}
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := c.Manager.AddHost(testID, gatewayAddress,
+		testkeys.GetGatewayCert(), params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+
+	msg := &pb.GatewaySlot{
+		Message: &pb.Slot{PayloadA: []byte("payload")},
+		RoundID: 7,
+		Target:  testID.Marshal(),
+	}
+	proof, err := c.SendRequestInclusionProof(host, msg)
+	if err != nil || proof != nil {
+		t.Fatalf("Unexpected proof before inclusion: %v, %+v", proof, err)
+	}
+
+	atomic.StoreUint32(&included, 1)
+	proof, err = c.SendRequestInclusionProof(host, msg)
+	if err != nil {
+		t.Fatalf("Failed to request inclusion proof: %+v", err)
+	}
+	if proof.RoundID != 7 || proof.SlotIndex != 5 {
+		t.Errorf("Unexpected proof: %+v", proof)
+	}
+}
@@ -12,11 +12,13 @@ package client
 import (
 	"fmt"
 	"github.com/pkg/errors"
+	"gitlab.com/elixxir/comms/capability"
 	pb "gitlab.com/elixxir/comms/mixmessages"
 	"gitlab.com/elixxir/comms/testutils"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/primitives/ndf"
 	"sync"
+	"time"
 )
 
 // ------------------------- Testing globals -------------------------------------
@@ -76,6 +78,14 @@ func (s *MockRegistration) CheckRegistration(msg *pb.RegisteredNodeCheck) (*pb.R
 	return nil, nil
 }
 
+func (s *MockRegistration) IssueCapability(*capability.Token, *connect.Auth) (time.Duration, error) {
+	return time.Minute, nil
+}
+
+func (s *MockRegistration) ReportRoundMetrics(*pb.RoundMetricsReport, *connect.Auth) error {
+	return nil
+}
+
 // ------------------------- Mock Error Registration Server Handler ---------------------------
 
 type MockRegistrationError struct {
@@ -112,3 +122,11 @@ func (s *MockRegistrationError) RegisterUser(confirmation *pb.ClientRegistration
 func (s *MockRegistrationError) CheckRegistration(msg *pb.RegisteredNodeCheck) (*pb.RegisteredNodeConfirmation, error) {
 	return nil, nil
 }
+
+func (s *MockRegistrationError) IssueCapability(*capability.Token, *connect.Auth) (time.Duration, error) {
+	return time.Minute, nil
+}
+
+func (s *MockRegistrationError) ReportRoundMetrics(*pb.RoundMetricsReport, *connect.Auth) error {
+	return nil
+}
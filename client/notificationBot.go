@@ -29,8 +29,16 @@ func (c *Comms) RegisterForNotifications(host *connect.Host,
 		defer cancel()
 
 		// Send the message
-		resultMsg, err := pb.NewNotificationBotClient(conn.GetGrpcConn()).
-			RegisterForNotifications(ctx, message)
+		var resultMsg = &messages.Ack{}
+		var err error
+		if conn.IsWeb() {
+			wc := conn.GetWebConn()
+			err = wc.Invoke(ctx,
+				"/mixmessages.NotificationBot/RegisterForNotifications", message, resultMsg)
+		} else {
+			resultMsg, err = pb.NewNotificationBotClient(c.Interceptors.ClientConn(conn.GetGrpcConn())).
+				RegisterForNotifications(ctx, message)
+		}
 		if err != nil {
 			return nil, errors.New(err.Error())
 		}
@@ -59,8 +67,16 @@ func (c *Comms) UnregisterForNotifications(host *connect.Host, message *pb.Notif
 		defer cancel()
 
 		// Send the message
-		resultMsg, err := pb.NewNotificationBotClient(conn.GetGrpcConn()).
-			UnregisterForNotifications(ctx, message)
+		var resultMsg = &messages.Ack{}
+		var err error
+		if conn.IsWeb() {
+			wc := conn.GetWebConn()
+			err = wc.Invoke(ctx,
+				"/mixmessages.NotificationBot/UnregisterForNotifications", message, resultMsg)
+		} else {
+			resultMsg, err = pb.NewNotificationBotClient(c.Interceptors.ClientConn(conn.GetGrpcConn())).
+				UnregisterForNotifications(ctx, message)
+		}
 		if err != nil {
 			return nil, errors.New(err.Error())
 		}
@@ -29,7 +29,7 @@ func (c *Comms) RegisterTrackedID(host *connect.Host, message *pb.RegisterTracke
 			err = wc.Invoke(ctx, "/mixmessages.NotificationBot/RegisterTrackedID",
 				message, resultMsg)
 		} else {
-			resultMsg, err = pb.NewNotificationBotClient(conn.GetGrpcConn()).
+			resultMsg, err = pb.NewNotificationBotClient(c.Interceptors.ClientConn(conn.GetGrpcConn())).
 				RegisterTrackedID(ctx, message)
 		}
 		if err != nil {
@@ -67,7 +67,7 @@ func (c *Comms) UnregisterTrackedID(host *connect.Host, message *pb.UnregisterTr
 			err = wc.Invoke(ctx, "/mixmessages.NotificationBot/UnregisterTrackedID",
 				message, resultMsg)
 		} else {
-			resultMsg, err = pb.NewNotificationBotClient(conn.GetGrpcConn()).
+			resultMsg, err = pb.NewNotificationBotClient(c.Interceptors.ClientConn(conn.GetGrpcConn())).
 				UnregisterTrackedID(ctx, message)
 		}
 		if err != nil {
@@ -106,7 +106,7 @@ func (c *Comms) RegisterToken(host *connect.Host, message *pb.RegisterTokenReque
 			err = wc.Invoke(ctx, "/mixmessages.NotificationBot/RegisterToken",
 				message, resultMsg)
 		} else {
-			resultMsg, err = pb.NewNotificationBotClient(conn.GetGrpcConn()).
+			resultMsg, err = pb.NewNotificationBotClient(c.Interceptors.ClientConn(conn.GetGrpcConn())).
 				RegisterToken(ctx, message)
 		}
 		if err != nil {
@@ -144,7 +144,7 @@ func (c *Comms) UnregisterToken(host *connect.Host, message *pb.UnregisterTokenR
 			err = wc.Invoke(ctx, "/mixmessages.NotificationBot/UnregisterToken",
 				message, resultMsg)
 		} else {
-			resultMsg, err = pb.NewNotificationBotClient(conn.GetGrpcConn()).
+			resultMsg, err = pb.NewNotificationBotClient(c.Interceptors.ClientConn(conn.GetGrpcConn())).
 				UnregisterToken(ctx, message)
 		}
 		if err != nil {
@@ -10,10 +10,15 @@
 package client
 
 import (
+	"bytes"
 	"crypto/sha256"
+	"encoding/base64"
+	"io"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/pkg/errors"
@@ -22,6 +27,10 @@ import (
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/primitives/id"
 	"gitlab.com/xx_network/primitives/ndf"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 // Client -> Registration Send Function
@@ -42,7 +51,7 @@ func (c *Comms) SendRegistrationMessage(host *connect.Host,
 			err = wc.Invoke(ctx, "/mixmessages.ClientRegistrar/RegisterUser",
 				message, resultMsg)
 		} else {
-			resultMsg, err = pb.NewClientRegistrarClient(conn.GetGrpcConn()).
+			resultMsg, err = pb.NewClientRegistrarClient(c.Interceptors.ClientConn(conn.GetGrpcConn())).
 				RegisterUser(ctx, message)
 		}
 		if err != nil {
@@ -66,21 +75,53 @@ func (c *Comms) SendRegistrationMessage(host *connect.Host,
 // RequestNdf is used to get an NDF from permissioning. It is only used by UDB
 // when starting or by client in testing. Other than those two uses, this
 // function should never be used as clients.
+//
+// Concurrent identical requests to the same host share one request.
 func (c *Comms) RequestNdf(host *connect.Host,
 	message *pb.NDFHash) (*pb.NDF, error) {
+	result, err := c.flights.do("RequestNdf", host, message,
+		func() (proto.Message, error) {
+			return c.sendRequestNdf(host, message)
+		})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*pb.NDF), nil
+}
+
+// sendRequestNdf sends the PollNdf request for RequestNdf.
+func (c *Comms) sendRequestNdf(host *connect.Host,
+	message *pb.NDFHash) (*pb.NDF, error) {
+
+	etag, cached := c.etags.get("PollNdf", host.GetId())
 
 	// Create the Send Function
 	f := func(conn connect.Connection) (*any.Any, error) {
 		// Set up the context
 		ctx, cancel := host.GetMessagingContext()
 		defer cancel()
+		if etag != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, pb.IfNoneMatchHeader,
+				etag)
+		}
 
 		// Send the message
-		resultMsg, err := pb.NewRegistrationClient(conn.GetGrpcConn()).
-			PollNdf(ctx, message)
+		var header metadata.MD
+		resultMsg, err := pb.NewRegistrationClient(c.Interceptors.ClientConn(conn.GetGrpcConn())).
+			PollNdf(ctx, message, grpc.Header(&header))
 		if err != nil {
 			return nil, err
 		}
+
+		// Permissioning responds without the NDF if it matches the one held
+		if pb.IsNotModified(header) && cached != nil {
+			return ptypes.MarshalAny(cached)
+		} else if pb.IsNotModified(header) {
+			return nil, errors.Errorf("Permissioning sent an unmodified " +
+				"NDF without one being held")
+		}
+		c.etags.set("PollNdf", host.GetId(),
+			firstValue(header.Get(pb.ETagHeader)), resultMsg)
 		return ptypes.MarshalAny(resultMsg)
 	}
 
@@ -96,6 +137,111 @@ func (c *Comms) RequestNdf(host *connect.Host,
 
 }
 
+// RequestNdfStream gets an NDF from permissioning as a stream of chunks, so
+// the NDF is not limited by the maximum message size. The reassembled NDF is
+// checked against the hash sent in the stream trailer. Concurrent identical
+// requests to the same host share one stream.
+func (c *Comms) RequestNdfStream(host *connect.Host,
+	message *pb.NDFHash) (*pb.NDF, error) {
+	result, err := c.flights.do("RequestNdfStream", host, message,
+		func() (proto.Message, error) {
+			return c.sendRequestNdfStream(host, message)
+		})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*pb.NDF), nil
+}
+
+// sendRequestNdfStream receives the PollNdfStream stream for
+// RequestNdfStream.
+func (c *Comms) sendRequestNdfStream(host *connect.Host,
+	message *pb.NDFHash) (*pb.NDF, error) {
+	ctx, cancel := connect.StreamingContextWithTimeout(30 * time.Second)
+	defer cancel()
+
+	// Create the Stream Function
+	f := func(conn connect.Connection) (interface{}, error) {
+		return pb.NewRegistrationClient(c.Interceptors.ClientConn(conn.GetGrpcConn())).
+			PollNdfStream(ctx, message)
+	}
+
+	// Execute the Stream function
+	jww.TRACE.Printf("Sending Request Ndf Stream message: %+v", message)
+	resultClient, err := c.Stream(host, f)
+	if err != nil {
+		return nil, err
+	}
+	stream := resultClient.(pb.Registration_PollNdfStreamClient)
+
+	// Get the total number of chunks from the header
+	md, err := stream.Header()
+	if err != nil {
+		closeErr := stream.RecvMsg(nil)
+		return nil, wrapError(closeErr, "Could not receive streaming "+
+			"header from %s: %s", host.GetId(), err)
+	}
+	chunkHeader := md.Get(pb.ChunkHeader)
+	if len(chunkHeader) == 0 {
+		closeErr := stream.RecvMsg(nil)
+		return nil, wrapError(closeErr, pb.NoStreamingHeaderErr, host.GetId())
+	}
+	totalChunks, err := strconv.Atoi(chunkHeader[0])
+	if err != nil {
+		closeErr := stream.RecvMsg(nil)
+		return nil, wrapError(closeErr, "Invalid header received: %v", err)
+	}
+
+	// Receive the chunks
+	chunks := make([]*pb.StreamChunk, 0, totalChunks)
+	chunk, err := stream.Recv()
+	for ; err == nil && len(chunks) <= totalChunks; chunk, err = stream.Recv() {
+		chunks = append(chunks, chunk)
+	}
+	if err != io.EOF {
+		return nil, errors.Errorf("Failed to complete streaming NDF, "+
+			"received %d of %d chunks: %+v", len(chunks), totalChunks, err)
+	}
+	if len(chunks) != totalChunks {
+		return nil, errors.Errorf("Received %d NDF chunks, expected %d",
+			len(chunks), totalChunks)
+	}
+
+	// Check the reassembled data against the hash in the trailer
+	hashTrailer := stream.Trailer().Get(pb.ChunkHashTrailer)
+	if len(hashTrailer) == 0 {
+		return nil, errors.Errorf("NDF stream from %s has no hash trailer",
+			host.GetId())
+	}
+	expectedHash, err := base64.StdEncoding.DecodeString(hashTrailer[0])
+	if err != nil {
+		return nil, errors.Errorf("Invalid NDF hash trailer: %+v", err)
+	}
+	if !bytes.Equal(expectedHash, pb.HashChunks(chunks)) {
+		return nil, errors.Errorf("Streamed NDF from %s does not match "+
+			"its hash", host.GetId())
+	}
+
+	result := &pb.NDF{}
+	if len(chunks) == 0 {
+		return result, nil
+	}
+	return result, pb.AssembleChunksIntoResponse(chunks, result)
+}
+
+// requestNdf gets the NDF by streaming, falling back to the unary request
+// for permissioning servers which do not support streaming.
+func (c *Comms) requestNdf(host *connect.Host,
+	message *pb.NDFHash) (*pb.NDF, error) {
+	response, err := c.RequestNdfStream(host, message)
+	if err != nil && status.Code(errors.Cause(err)) == codes.Unimplemented {
+		jww.DEBUG.Printf("NDF streaming not supported by %s, falling "+
+			"back to unary request", host.GetId())
+		return c.RequestNdf(host, message)
+	}
+	return response, err
+}
+
 // RetrieveNdf, attempts to connect to the permissioning server to retrieve the latest ndf for the notifications bot
 func (c *Comms) RetrieveNdf(currentDef *ndf.NetworkDefinition) (*ndf.NetworkDefinition, error) {
 	// Hash the notifications bot ndf for comparison with registration's ndf
@@ -120,7 +266,7 @@ func (c *Comms) RetrieveNdf(currentDef *ndf.NetworkDefinition) (*ndf.NetworkDefi
 	}
 
 	// Send the hash to registration
-	response, err := c.RequestNdf(regHost, msg)
+	response, err := c.requestNdf(regHost, msg)
 
 	// Keep going until we get a grpc error or we get an ndf
 	for err != nil {
@@ -134,7 +280,7 @@ func (c *Comms) RetrieveNdf(currentDef *ndf.NetworkDefinition) (*ndf.NetworkDefi
 		// If the error is that the permissioning server is not ready, ask again
 		jww.WARN.Println("Failed to get an ndf, possibly not ready yet. Retying now...")
 		time.Sleep(250 * time.Millisecond)
-		response, err = c.RequestNdf(regHost, msg)
+		response, err = c.requestNdf(regHost, msg)
 
 	}
 
@@ -51,6 +51,40 @@ func TestSendRegistrationMessage(t *testing.T) {
 	}
 }
 
+// Tests that RequestNdfStream reassembles an NDF larger than one chunk.
+func TestComms_RequestNdfStream(t *testing.T) {
+	GatewayAddress := getNextAddress()
+	testId := id.NewIdFromString("test", id.Generic, t)
+	clientId := id.NewIdFromString("client", id.Generic, t)
+
+	rg := registration.StartRegistrationServer(testId, GatewayAddress, &MockRegistration{}, nil, nil, nil)
+	defer rg.Shutdown()
+	c, err := NewClientComms(clientId, nil, nil, nil)
+	if err != nil {
+		t.Errorf("Can't create client comms: %+v", err)
+	}
+	manager := connect.NewManagerTesting(t)
+
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(testId, GatewayAddress, nil, params)
+	if err != nil {
+		t.Errorf("Unable to call NewHost: %+v", err)
+	}
+
+	if len(testutils.ExampleJSON) <= pb.ChunkSize {
+		t.Fatalf("Test NDF fits in a single chunk.")
+	}
+
+	received, err := c.RequestNdfStream(host, &pb.NDFHash{})
+	if err != nil {
+		t.Fatalf("RequestNdfStream: Error received: %+v", err)
+	}
+	if string(received.Ndf) != testutils.ExampleJSON {
+		t.Errorf("Reassembled NDF does not match.")
+	}
+}
+
 // Smoke test RequestNdf
 func TestSendGetUpdatedNDF(t *testing.T) {
 	GatewayAddress := getNextAddress()
@@ -0,0 +1,65 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the Client -> Gateway relay of sealed payloads
+
+package client
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// SendRelayMessage Client -> Gateway request to relay an opaque payload to
+// the destination. The payload should be sealed, e.g. onion-encrypted for
+// each entity on its path, as the gateway forwards it unread and without
+// knowing who sent it.
+func (c *Comms) SendRelayMessage(host *connect.Host, destination *id.ID,
+	payload []byte) (*messages.Ack, error) {
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+
+		// Send the message
+		msg := &pb.RelayedMessage{
+			Destination: destination.Marshal(),
+			Payload:     payload,
+		}
+		var resultMsg = &messages.Ack{}
+		var err error
+		if conn.IsWeb() {
+			wc := conn.GetWebConn()
+			err = wc.Invoke(ctx, "/mixmessages.Gateway/RelayMessage", msg,
+				resultMsg)
+		} else {
+			resultMsg, err = pb.NewGatewayClient(c.Interceptors.ClientConn(conn.GetGrpcConn())).
+				RelayMessage(ctx, msg)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	// Execute the Send function
+	jww.TRACE.Printf("Relaying %d bytes to %s", len(payload), destination)
+	resultMsg, err := c.Send(host, f)
+	if err != nil {
+		return nil, err
+	}
+
+	// Marshall the result
+	result := &messages.Ack{}
+	return result, ptypes.UnmarshalAny(resultMsg, result)
+}
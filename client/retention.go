@@ -0,0 +1,63 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains tracking of the message retention policies of gateways
+
+package client
+
+import (
+	"sync"
+
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// retentionTracker holds the last message retention advertised by each
+// gateway. The zero value is ready to use.
+type retentionTracker struct {
+	retentions map[id.ID]pb.MessageRetention
+	mux        sync.RWMutex
+}
+
+// record stores the retention in the response from the host, if any.
+func (rt *retentionTracker) record(host *connect.Host,
+	policy *pb.MessageRetentionPolicy) {
+	retention, ok, err := pb.MessageRetentionFromMessage(policy)
+	if err != nil {
+		jww.WARN.Printf("Ignoring message retention from %s: %+v",
+			host.GetId(), err)
+		return
+	} else if !ok {
+		return
+	}
+
+	rt.mux.Lock()
+	defer rt.mux.Unlock()
+	if rt.retentions == nil {
+		rt.retentions = make(map[id.ID]pb.MessageRetention)
+	}
+	rt.retentions[*host.GetId()] = retention
+}
+
+// get returns the last retention advertised by the gateway.
+func (rt *retentionTracker) get(gatewayID *id.ID) (pb.MessageRetention, bool) {
+	rt.mux.RLock()
+	defer rt.mux.RUnlock()
+	retention, exists := rt.retentions[*gatewayID]
+	return retention, exists
+}
+
+// GetMessageRetention returns the message retention last advertised by the
+// gateway in response to SendPutMessage, SendPutManyMessages, RequestMessages
+// or RequestBatchMessages. The expiry is that of the last messages put. It
+// returns false if the gateway has not advertised a retention.
+func (c *Comms) GetMessageRetention(gatewayID *id.ID) (pb.MessageRetention,
+	bool) {
+	return c.retention.get(gatewayID)
+}
@@ -0,0 +1,186 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains sessions sending a message on an upcoming round
+
+package client
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/network/dataStructures"
+	"gitlab.com/elixxir/comms/retryPolicy"
+	"gitlab.com/elixxir/primitives/excludedRounds"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// RoundSessionParams configures a RoundSession.
+type RoundSessionParams struct {
+	// Time within which the message must be sent, including waiting for a
+	// round and every retry
+	Timeout time.Duration
+	// Passed to WaitingRounds.GetUpcomingRealtime; each attempt waits
+	// for rounds this much older than the last
+	MinRoundAge time.Duration
+	// Number of rounds tried and the wait between them
+	Policy retryPolicy.Policy
+}
+
+// GetDefaultRoundSessionParams returns the default parameters of a
+// RoundSession.
+func GetDefaultRoundSessionParams() RoundSessionParams {
+	return RoundSessionParams{
+		Timeout:     30 * time.Second,
+		MinRoundAge: 0,
+		Policy: retryPolicy.Constant{
+			MaxAttempts: 5,
+			Delay:       100 * time.Millisecond,
+		},
+	}
+}
+
+// SlotBuilder returns the message sent on the round through its gateway.
+// It is called for every round tried, since the message commonly depends on
+// the nodes of the round. RoundID and Target are set by the session.
+type SlotBuilder func(round *pb.RoundInfo, gateway *connect.Host) (
+	*pb.GatewaySlot, error)
+
+// RoundSession sends a message on an upcoming round. It picks the round from
+// WaitingRounds, resolves the gateway of the first node of the round and
+// sends to it, excluding the round and retrying on another if the send
+// fails, until the message is accepted or the deadline passes.
+type RoundSession struct {
+	comms    *Comms
+	rounds   *dataStructures.WaitingRounds
+	exclude  excludedRounds.ExcludedRounds
+	params   RoundSessionParams
+	deadline time.Time
+
+	// Round and gateway of the last attempt
+	round   *pb.RoundInfo
+	gateway *connect.Host
+
+	sleep func(time.Duration)
+}
+
+// NewRoundSession returns a session sending on the rounds. Rounds which
+// fail are added to exclude, which may be shared between sessions; if nil,
+// the session excludes rounds on its own. The deadline of the session starts
+// now.
+func (c *Comms) NewRoundSession(rounds *dataStructures.WaitingRounds,
+	exclude excludedRounds.ExcludedRounds,
+	params RoundSessionParams) *RoundSession {
+	if exclude == nil {
+		exclude = excludedRounds.NewSet()
+	}
+	return &RoundSession{
+		comms:    c,
+		rounds:   rounds,
+		exclude:  exclude,
+		params:   params,
+		deadline: time.Now().Add(params.Timeout),
+		sleep:    time.Sleep,
+	}
+}
+
+// Deadline returns the time by which the session must send.
+func (s *RoundSession) Deadline() time.Time {
+	return s.deadline
+}
+
+// Round returns the round of the last attempt, or nil if none was made.
+func (s *RoundSession) Round() *pb.RoundInfo {
+	return s.round
+}
+
+// Gateway returns the gateway of the last attempt, or nil if none was made.
+func (s *RoundSession) Gateway() *connect.Host {
+	return s.gateway
+}
+
+// Send sends the message built by build on the next upcoming round, moving
+// to another round each time a send fails or is not accepted. Errors
+// returned by build are returned without retrying.
+func (s *RoundSession) Send(build SlotBuilder) (*pb.GatewaySlotResponse,
+	error) {
+	var lastErr error
+	attempts := s.params.Policy.Attempts()
+	for attempt := uint32(0); attempt < attempts; attempt++ {
+		remaining := time.Until(s.deadline)
+		if remaining <= 0 {
+			break
+		}
+
+		round, _, err := s.rounds.GetUpcomingRealtime(remaining, s.exclude,
+			int(attempt), s.params.MinRoundAge)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "Failed to get a round "+
+				"after %d attempts (last error: %v)", attempt, lastErr)
+		}
+		s.round, s.gateway = round, nil
+
+		gateway, err := s.getGateway(round)
+		if err == nil {
+			s.gateway = gateway
+			var msg *pb.GatewaySlot
+			if msg, err = build(round, gateway); err != nil {
+				return nil, err
+			}
+			msg.RoundID = round.ID
+			msg.Target = gateway.GetId().Marshal()
+
+			var resp *pb.GatewaySlotResponse
+			resp, err = s.comms.SendPutMessage(gateway, msg, remaining)
+			if err == nil && resp.Accepted {
+				return resp, nil
+			} else if err == nil {
+				err = errors.Errorf("Gateway %s did not accept the message",
+					gateway.GetId())
+			}
+		}
+		lastErr = err
+
+		jww.DEBUG.Printf("Send on round %d failed (attempt %d of %d): %+v",
+			round.ID, attempt+1, attempts, lastErr)
+		s.exclude.Insert(id.Round(round.ID))
+		if attempt+1 < attempts {
+			delay := s.params.Policy.Backoff(attempt)
+			if left := time.Until(s.deadline); delay > left {
+				delay = left
+			}
+			s.sleep(delay)
+		}
+	}
+
+	return nil, errors.Errorf("Failed to send on a round before running "+
+		"out of attempts or time: %v", lastErr)
+}
+
+// getGateway returns the host of the gateway of the first node of the round.
+func (s *RoundSession) getGateway(round *pb.RoundInfo) (*connect.Host,
+	error) {
+	if len(round.Topology) == 0 {
+		return nil, errors.Errorf("Round %d has no nodes", round.ID)
+	}
+	gwID, err := id.Unmarshal(round.Topology[0])
+	if err != nil {
+		return nil, errors.WithMessagef(err, "Invalid first node of round "+
+			"%d", round.ID)
+	}
+	gwID.SetType(id.Gateway)
+
+	host, exists := s.comms.GetHost(gwID)
+	if !exists {
+		return nil, errors.Errorf("No host for gateway %s of round %d",
+			gwID, round.ID)
+	}
+	return host, nil
+}
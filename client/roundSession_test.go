@@ -0,0 +1,116 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/network/dataStructures"
+	"gitlab.com/elixxir/comms/retryPolicy"
+	"gitlab.com/elixxir/primitives/current"
+	"gitlab.com/elixxir/primitives/excludedRounds"
+	"gitlab.com/elixxir/primitives/states"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// newTestWaitingRounds returns WaitingRounds holding n queued rounds, each
+// with a node without a gateway host.
+func newTestWaitingRounds(n int, t *testing.T) *dataStructures.WaitingRounds {
+	wr := dataStructures.NewWaitingRounds()
+	rounds := make([]*dataStructures.Round, n)
+	for i := range rounds {
+		ri := &pb.RoundInfo{
+			ID:         uint64(i + 1),
+			State:      uint32(states.QUEUED),
+			Timestamps: make([]uint64, current.NUM_STATES),
+			Topology: [][]byte{
+				id.NewIdFromUInt(uint64(i), id.Node, t).Marshal()},
+		}
+		ri.Timestamps[states.QUEUED] =
+			uint64(time.Now().Add(time.Minute).UnixNano())
+		rounds[i] = dataStructures.NewVerifiedRound(ri, nil)
+	}
+	wr.Insert(rounds, nil)
+	return wr
+}
+
+// Tests that a failed send excludes the round and moves to the next one until
+// the attempts run out.
+func TestRoundSession_Send_Retries(t *testing.T) {
+	c, err := NewClientComms(id.NewIdFromString("client", id.User, t), nil,
+		nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to create client comms: %+v", err)
+	}
+
+	params := GetDefaultRoundSessionParams()
+	params.Policy = retryPolicy.Constant{MaxAttempts: 2}
+	exclude := excludedRounds.NewSet()
+	s := c.NewRoundSession(newTestWaitingRounds(3, t), exclude, params)
+	s.sleep = func(time.Duration) {}
+
+	built := 0
+	_, err = s.Send(func(*pb.RoundInfo, *connect.Host) (*pb.GatewaySlot,
+		error) {
+		built++
+		return &pb.GatewaySlot{}, nil
+	})
+	if err == nil {
+		t.Fatalf("Sent without gateway hosts.")
+	}
+	if built != 0 {
+		t.Errorf("Built %d messages without a gateway.", built)
+	}
+	if exclude.Len() != 2 {
+		t.Errorf("Expected 2 rounds excluded, got %d.", exclude.Len())
+	}
+	if s.Round() == nil || s.Gateway() != nil {
+		t.Errorf("Unexpected last attempt: round %v, gateway %v.", s.Round(),
+			s.Gateway())
+	}
+}
+
+// Tests that an error building the message is returned without retrying.
+func TestRoundSession_Send_BuildError(t *testing.T) {
+	c, err := NewClientComms(id.NewIdFromString("client", id.User, t), nil,
+		nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to create client comms: %+v", err)
+	}
+	rounds := newTestWaitingRounds(2, t)
+
+	// Add the gateway of the first round
+	gwID := id.NewIdFromUInt(0, id.Node, t)
+	gwID.SetType(id.Gateway)
+	if _, err = c.AddHost(gwID, "0.0.0.0:5970", nil,
+		connect.GetDefaultHostParams()); err != nil {
+		t.Fatalf("Failed to add host: %+v", err)
+	}
+
+	s := c.NewRoundSession(rounds, nil, GetDefaultRoundSessionParams())
+	expected := errors.New("build error")
+	built := 0
+	_, err = s.Send(func(*pb.RoundInfo, *connect.Host) (*pb.GatewaySlot,
+		error) {
+		built++
+		return nil, expected
+	})
+	if err != expected {
+		t.Errorf("Expected %v, got %v.", expected, err)
+	}
+	if built != 1 {
+		t.Errorf("Expected a single message built, got %d.", built)
+	}
+	if s.Gateway() == nil || !s.Gateway().GetId().Cmp(gwID) {
+		t.Errorf("Gateway %s not resolved.", gwID)
+	}
+}
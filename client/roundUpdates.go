@@ -0,0 +1,183 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains subscriptions to round updates pushed by gateways
+
+package client
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// StreamRoundUpdates Client -> Gateway subscription to round updates. The
+// gateway pushes updates for the ephemeral IDs in the interest, and for the
+// reception ID of the message, over the returned stream until the context is
+// canceled. An empty interest subscribes to every update. Only gRPC
+// connections are supported.
+func (c *Comms) StreamRoundUpdates(ctx context.Context, host *connect.Host,
+	message *pb.GatewayPoll, interest [][]byte) (
+	pb.Gateway_StreamRoundUpdatesClient, error) {
+	f := func(conn connect.Connection) (interface{}, error) {
+		if conn.IsWeb() {
+			return nil, errors.New("Round update streams are not " +
+				"supported over web connections")
+		}
+		for _, ephID := range interest {
+			ctx = metadata.AppendToOutgoingContext(ctx,
+				pb.RoundUpdateInterestHeader, string(ephID))
+		}
+		return pb.NewGatewayClient(c.Interceptors.ClientConn(conn.GetGrpcConn())).
+			StreamRoundUpdates(ctx, message)
+	}
+
+	jww.TRACE.Printf("Subscribing to round updates: %+v", message)
+	resultClient, err := c.Stream(host, f)
+	if err != nil {
+		return nil, err
+	}
+	return resultClient.(pb.Gateway_StreamRoundUpdatesClient), nil
+}
+
+// RoundUpdateSubscription receives round updates from a gateway as they are
+// pushed, falling back to polling while the stream is down. Gateways which
+// do not support streaming are only polled.
+type RoundUpdateSubscription struct {
+	comms    *Comms
+	host     *connect.Host
+	request  *pb.GatewayPoll
+	interest [][]byte
+	onUpdate func(round *pb.RoundInfo)
+	// Called on the interval while the stream is down
+	poll         func() error
+	pollInterval time.Duration
+
+	streaming bool
+	cancel    context.CancelFunc
+	done      chan struct{}
+	mux       sync.Mutex
+}
+
+// SubscribeRoundUpdates subscribes to round updates from the gateway, calling
+// onUpdate with each. If the stream cannot be opened or ends, poll is called
+// every pollInterval until it is reopened; poll should send the regular
+// SendPoll and process its updates. The stream resumes after the last round
+// received, so updates are not repeated. The subscription runs until Close
+// is called.
+func (c *Comms) SubscribeRoundUpdates(host *connect.Host,
+	message *pb.GatewayPoll, interest [][]byte,
+	onUpdate func(round *pb.RoundInfo), poll func() error,
+	pollInterval time.Duration) *RoundUpdateSubscription {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &RoundUpdateSubscription{
+		comms:        c,
+		host:         host,
+		request:      proto.Clone(message).(*pb.GatewayPoll),
+		interest:     interest,
+		onUpdate:     onUpdate,
+		poll:         poll,
+		pollInterval: pollInterval,
+		cancel:       cancel,
+		done:         make(chan struct{}),
+	}
+	go s.run(ctx)
+	return s
+}
+
+// Streaming returns true if updates are currently being pushed by the
+// gateway, and false if the subscription has fallen back to polling.
+func (s *RoundUpdateSubscription) Streaming() bool {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return s.streaming
+}
+
+// Close ends the subscription and waits for it to stop. No update is passed
+// to onUpdate once Close returns.
+func (s *RoundUpdateSubscription) Close() {
+	s.cancel()
+	<-s.done
+}
+
+// run alternates between streaming and polling until the context is canceled.
+func (s *RoundUpdateSubscription) run(ctx context.Context) {
+	defer close(s.done)
+
+	streamSupported := true
+	for {
+		if streamSupported {
+			err := s.receive(ctx)
+			if ctx.Err() != nil {
+				return
+			}
+			if status.Code(err) == codes.Unimplemented {
+				jww.INFO.Printf("Gateway %s does not stream round updates, "+
+					"polling instead", s.host.GetId())
+				streamSupported = false
+			} else {
+				jww.WARN.Printf("Round update stream from %s ended, polling "+
+					"until it is reopened: %+v", s.host.GetId(), err)
+			}
+		}
+
+		if err := s.poll(); err != nil {
+			jww.WARN.Printf("Failed to poll %s for round updates: %+v",
+				s.host.GetId(), err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(s.pollInterval):
+		}
+	}
+}
+
+// receive opens the stream and passes updates to the callback until the
+// stream ends, returning the error which ended it.
+func (s *RoundUpdateSubscription) receive(ctx context.Context) error {
+	stream, err := s.comms.StreamRoundUpdates(ctx, s.host, s.request,
+		s.interest)
+	if err != nil {
+		return err
+	}
+
+	s.setStreaming(true)
+	defer s.setStreaming(false)
+	for {
+		round, err := stream.Recv()
+		if err == io.EOF {
+			return errors.New("Gateway closed the stream")
+		} else if err != nil {
+			return err
+		}
+
+		// Resume after this round if the stream is reopened
+		if round.GetID() > s.request.LastRound {
+			s.request.LastRound = round.GetID()
+		}
+		s.onUpdate(round)
+	}
+}
+
+// setStreaming records whether updates are being streamed.
+func (s *RoundUpdateSubscription) setStreaming(streaming bool) {
+	s.mux.Lock()
+	s.streaming = streaming
+	s.mux.Unlock()
+}
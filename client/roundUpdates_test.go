@@ -0,0 +1,115 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package client
+
+import (
+	"testing"
+	"time"
+
+	"gitlab.com/elixxir/comms/gateway"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/gossip"
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// startRoundUpdateGateway starts a gateway serving round update streams with
+// the function and returns a host for it.
+func startRoundUpdateGateway(serve func(msg *pb.GatewayPoll,
+	interest [][]byte, stream pb.Gateway_StreamRoundUpdatesServer) error,
+	t *testing.T) (*gateway.Comms, *connect.Host) {
+	gatewayAddress := getNextAddress()
+	testID := id.NewIdFromString("test", id.Gateway, t)
+	impl := gateway.NewImplementation()
+	impl.Functions.StreamRoundUpdates = serve
+	gw := gateway.StartGateway(testID, gatewayAddress, impl, nil, nil,
+		gossip.DefaultManagerFlags())
+
+	manager := connect.NewManagerTesting(t)
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(testID, gatewayAddress, nil, params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+	return gw, host
+}
+
+// Tests that updates published by the gateway are pushed only to the
+// subscribers interested in them.
+func TestComms_SubscribeRoundUpdates(t *testing.T) {
+	hub := gateway.NewRoundUpdateHub()
+	gw, host := startRoundUpdateGateway(func(msg *pb.GatewayPoll,
+		interest [][]byte, stream pb.Gateway_StreamRoundUpdatesServer) error {
+		return hub.Serve(interest, stream)
+	}, t)
+	defer gw.Shutdown()
+	var c Comms
+
+	updates := make(chan *pb.RoundInfo, 10)
+	sub := c.SubscribeRoundUpdates(host, &pb.GatewayPoll{}, [][]byte{{1}},
+		func(round *pb.RoundInfo) { updates <- round },
+		func() error { return nil }, time.Hour)
+	defer sub.Close()
+
+	for hub.Subscribers() == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if !sub.Streaming() {
+		t.Errorf("Subscription is not streaming.")
+	}
+
+	hub.Publish(&pb.RoundInfo{ID: 1}, [][]byte{{2}})
+	hub.Publish(&pb.RoundInfo{ID: 2}, [][]byte{{1}, {2}})
+	hub.Publish(&pb.RoundInfo{ID: 3}, nil)
+
+	for _, expected := range []uint64{2, 3} {
+		select {
+		case round := <-updates:
+			if round.GetID() != expected {
+				t.Errorf("Received round %d, expected %d.", round.GetID(),
+					expected)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Timed out waiting for round %d.", expected)
+		}
+	}
+}
+
+// Tests that a subscription to a gateway which does not stream round
+// updates falls back to polling.
+func TestComms_SubscribeRoundUpdates_Fallback(t *testing.T) {
+	gw, host := startRoundUpdateGateway(func(*pb.GatewayPoll, [][]byte,
+		pb.Gateway_StreamRoundUpdatesServer) error {
+		return status.Error(codes.Unimplemented, "not supported")
+	}, t)
+	defer gw.Shutdown()
+	var c Comms
+
+	polled := make(chan struct{}, 10)
+	sub := c.SubscribeRoundUpdates(host, &pb.GatewayPoll{}, nil,
+		func(*pb.RoundInfo) { t.Errorf("Update received without a stream.") },
+		func() error {
+			polled <- struct{}{}
+			return nil
+		}, time.Millisecond)
+
+	for i := 0; i < 3; i++ {
+		select {
+		case <-polled:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Timed out waiting for poll %d.", i)
+		}
+	}
+	if sub.Streaming() {
+		t.Errorf("Subscription reports streaming after falling back.")
+	}
+	sub.Close()
+}
@@ -0,0 +1,88 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package client
+
+import (
+	"testing"
+
+	"gitlab.com/elixxir/comms/gateway"
+	"gitlab.com/elixxir/comms/instrumentation"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/gossip"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// Tests that sends are reported to the callbacks with the name of the Send
+// function, including sends made through helpers.
+func TestComms_SetSendCallbacks(t *testing.T) {
+	gatewayAddress := getNextAddress()
+	testID := id.NewIdFromString("test", id.Gateway, t)
+	gw := gateway.StartGateway(testID, gatewayAddress,
+		mockGatewayImpl{}, nil, nil, gossip.DefaultManagerFlags())
+	defer gw.Shutdown()
+
+	var c Comms
+	var started []instrumentation.SendInfo
+	var completed []instrumentation.SendResult
+	c.SetSendCallbacks(instrumentation.Callbacks{
+		OnSendStart: func(info instrumentation.SendInfo) {
+			started = append(started, info)
+		},
+		OnSendComplete: func(result instrumentation.SendResult) {
+			completed = append(completed, result)
+		},
+	})
+
+	manager := connect.NewManagerTesting(t)
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(testID, gatewayAddress, nil, params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+
+	_, _, _, err = c.SendPoll(host, &pb.GatewayPoll{Partial: &pb.NDFHash{}})
+	if err != nil {
+		t.Fatalf("SendPoll: Error received: %+v", err)
+	}
+	_, err = c.RequestHistoricalRounds(host, &pb.HistoricalRounds{})
+	if err != nil {
+		t.Fatalf("RequestHistoricalRounds: Error received: %+v", err)
+	}
+
+	expected := []string{"SendPoll", "RequestHistoricalRounds"}
+	if len(started) != len(expected) || len(completed) != len(expected) {
+		t.Fatalf("Expected %d sends to be reported, got %d started and "+
+			"%d completed", len(expected), len(started), len(completed))
+	}
+	for i, rpc := range expected {
+		if started[i].RPC != rpc || completed[i].RPC != rpc {
+			t.Errorf("Send %d reported as %q/%q, expected %q", i,
+				started[i].RPC, completed[i].RPC, rpc)
+		}
+		if !started[i].Host.Cmp(testID) {
+			t.Errorf("Send %d reported host %s, expected %s", i,
+				started[i].Host, testID)
+		}
+		if completed[i].Err != nil || completed[i].Duration <= 0 {
+			t.Errorf("Send %d reported unexpected result: %+v", i,
+				completed[i])
+		}
+	}
+	if completed[0].Bytes == 0 {
+		t.Errorf("Size of the SendPoll response was not reported.")
+	}
+
+	// Disabling the callbacks stops reporting
+	c.SetSendCallbacks(instrumentation.Callbacks{})
+	_, _, _, _ = c.SendPoll(host, &pb.GatewayPoll{Partial: &pb.NDFHash{}})
+	if len(started) != len(expected) {
+		t.Errorf("Send was reported after callbacks were removed.")
+	}
+}
@@ -0,0 +1,93 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains deduplication of identical concurrent requests
+
+package client
+
+import (
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"gitlab.com/xx_network/comms/connect"
+)
+
+// flightGroup deduplicates concurrent idempotent requests. While a request is
+// in flight, identical requests to the same host wait for it and share its
+// result rather than sending their own. The zero value is ready to use.
+type flightGroup struct {
+	calls map[string]*flightCall
+	mux   sync.Mutex
+}
+
+// flightCall is a request in flight.
+type flightCall struct {
+	wg     sync.WaitGroup
+	result proto.Message
+	err    error
+	// Number of callers waiting on this request
+	dups int
+}
+
+// flightKey returns the key identifying a request of the given method with
+// the given message to the host.
+func flightKey(method string, host *connect.Host,
+	message proto.Message) (string, error) {
+	data, err := proto.Marshal(message)
+	if err != nil {
+		return "", errors.Errorf("Failed to marshal %s request: %+v",
+			method, err)
+	}
+	return method + "/" + host.GetId().String() + "/" + string(data), nil
+}
+
+// do sends the request by calling send, unless an identical request is in
+// flight, in which case it waits for that request. Callers sharing a result
+// each receive their own copy, so the result may be modified freely.
+func (fg *flightGroup) do(method string, host *connect.Host,
+	message proto.Message,
+	send func() (proto.Message, error)) (proto.Message, error) {
+	key, err := flightKey(method, host, message)
+	if err != nil {
+		return nil, err
+	}
+
+	fg.mux.Lock()
+	if fg.calls == nil {
+		fg.calls = make(map[string]*flightCall)
+	}
+	if call, exists := fg.calls[key]; exists {
+		call.dups++
+		fg.mux.Unlock()
+		call.wg.Wait()
+		if call.err != nil {
+			return nil, call.err
+		}
+		return proto.Clone(call.result), nil
+	}
+
+	call := &flightCall{}
+	call.wg.Add(1)
+	fg.calls[key] = call
+	fg.mux.Unlock()
+
+	call.result, call.err = send()
+
+	fg.mux.Lock()
+	delete(fg.calls, key)
+	shared := call.dups > 0
+	fg.mux.Unlock()
+	call.wg.Done()
+
+	// The waiters copy the result, so it must not be handed out to be
+	// modified while they do
+	if shared && call.err == nil {
+		return proto.Clone(call.result), nil
+	}
+	return call.result, call.err
+}
@@ -0,0 +1,114 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package client
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// Tests that concurrent identical requests share one send and each receive
+// their own copy of the result.
+func TestFlightGroup_do(t *testing.T) {
+	host, err := connect.NewHost(id.NewIdFromString("gateway", id.Gateway, t),
+		"0.0.0.0:1", nil, connect.GetDefaultHostParams())
+	if err != nil {
+		t.Fatalf("Failed to create host: %+v", err)
+	}
+
+	var fg flightGroup
+	var sends int32
+	release := make(chan struct{})
+	send := func() (proto.Message, error) {
+		atomic.AddInt32(&sends, 1)
+		<-release
+		return &pb.NDF{Ndf: []byte("ndf")}, nil
+	}
+
+	const callers = 10
+	results := make([]proto.Message, callers)
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = fg.do("RequestNdf", host, &pb.NDFHash{}, send)
+		}(i)
+	}
+
+	// Give all callers time to join the request before it completes
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if sends != 1 {
+		t.Errorf("Expected one send, got %d", sends)
+	}
+	for i, result := range results {
+		if string(result.(*pb.NDF).Ndf) != "ndf" {
+			t.Errorf("Caller %d received unexpected result %v", i, result)
+		}
+		for j := 0; j < i; j++ {
+			if result == results[j] {
+				t.Errorf("Callers %d and %d share a result", i, j)
+			}
+		}
+	}
+}
+
+// Tests that requests with different messages or methods are not merged and
+// that errors are returned to every caller.
+func TestFlightGroup_do_Distinct(t *testing.T) {
+	host, err := connect.NewHost(id.NewIdFromString("gateway", id.Gateway, t),
+		"0.0.0.0:1", nil, connect.GetDefaultHostParams())
+	if err != nil {
+		t.Fatalf("Failed to create host: %+v", err)
+	}
+
+	var fg flightGroup
+	started := make(chan struct{})
+	release := make(chan struct{})
+	blocked := func() (proto.Message, error) {
+		close(started)
+		<-release
+		return nil, errors.New("failed")
+	}
+	var sends int32
+	other := func() (proto.Message, error) {
+		atomic.AddInt32(&sends, 1)
+		return &pb.NDF{}, nil
+	}
+
+	errChan := make(chan error)
+	go func() {
+		_, err := fg.do("RequestNdf", host, &pb.NDFHash{Hash: []byte("a")},
+			blocked)
+		errChan <- err
+	}()
+	<-started
+
+	_, _ = fg.do("RequestNdf", host, &pb.NDFHash{Hash: []byte("b")}, other)
+	_, _ = fg.do("RequestNdfStream", host, &pb.NDFHash{Hash: []byte("a")},
+		other)
+	if sends != 2 {
+		t.Errorf("Distinct requests were merged: %d sends", sends)
+	}
+
+	close(release)
+	if err = <-errChan; err == nil {
+		t.Errorf("Error was not returned.")
+	}
+}
@@ -0,0 +1,108 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains host parameters for high-latency transports such as Tor and web
+// browsers, and routing of hosts through proxies
+
+package client
+
+import (
+	"time"
+
+	"gitlab.com/elixxir/comms/hostProxy"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc/keepalive"
+)
+
+// HighLatencyParams adjust the connection to a host reached over a
+// high-latency transport, such as Tor or another anonymity network, so
+// registration and polling are not cut off by deadlines tuned for direct
+// connections.
+type HighLatencyParams struct {
+	// Number of attempts made to establish the connection. Each attempt
+	// waits longer than the previous one, up to 15 seconds.
+	MaxRetries uint32
+	// Deadline of each send, which includes the authentication handshake
+	SendTimeout time.Duration
+	// Deadline of pings checking the host is online
+	PingTimeout time.Duration
+	// Interval between keepalive pings while a stream is open. Keepalive
+	// pings are not sent on idle connections.
+	KeepaliveTime time.Duration
+	// Time waited for a keepalive ping to be answered before the connection
+	// is closed
+	KeepaliveTimeout time.Duration
+}
+
+// DefaultHighLatencyParams returns parameters suitable for connecting over
+// Tor.
+func DefaultHighLatencyParams() HighLatencyParams {
+	return HighLatencyParams{
+		MaxRetries:       200,
+		SendTimeout:      5 * time.Minute,
+		PingTimeout:      60 * time.Second,
+		KeepaliveTime:    2 * time.Minute,
+		KeepaliveTimeout: 3 * time.Minute,
+	}
+}
+
+// Apply returns a copy of the host parameters adjusted for a high-latency
+// transport. The connection is established lazily and kept for reuse rather
+// than being reopened after a cool off, since each new connection over an
+// anonymity network costs a full circuit setup.
+func (p HighLatencyParams) Apply(params connect.HostParams) connect.HostParams {
+	params.MaxRetries = p.MaxRetries
+	params.SendTimeout = p.SendTimeout
+	params.PingTimeout = p.PingTimeout
+	params.KaClientOpts = keepalive.ClientParameters{
+		Time:                p.KeepaliveTime,
+		Timeout:             p.KeepaliveTimeout,
+		PermitWithoutStream: false,
+	}
+	params.EnableCoolOff = false
+	params.DisableLazyConnection = false
+	params.DisableAutoConnect = false
+	return params
+}
+
+// AddHighLatencyHost adds a host reached over a high-latency transport. The
+// given parameters are adjusted by the HighLatency parameters of the Comms.
+// Hosts added with AddHost are unaffected.
+func (c *Comms) AddHighLatencyHost(hid *id.ID, address string, cert []byte,
+	params connect.HostParams) (*connect.Host, error) {
+	return c.AddHost(hid, address, cert, c.HighLatency.Apply(params))
+}
+
+// SetProxy routes every host added with AddHost afterwards through the proxy.
+// Passing nil connects to hosts added afterwards directly. It must not be
+// called concurrently with AddHost. The hosts of the NDF are created by the
+// hostCache.Reconciler of a network.Instance rather than these comms; the
+// proxy must also be passed to its SetRouter, as returned by
+// Instance.GetHosts, so that they are routed and stay routed when they move.
+func (c *Comms) SetProxy(p *hostProxy.Proxy) {
+	c.proxy = p
+}
+
+// AddHost overrides connect.Manager.AddHost to route the host through the
+// proxy set with SetProxy, if any.
+func (c *Comms) AddHost(hid *id.ID, address string, cert []byte,
+	params connect.HostParams) (*connect.Host, error) {
+	if c.proxy == nil {
+		return c.ProtoComms.AddHost(hid, address, cert, params)
+	}
+	return c.proxy.AddHost(c.ProtoComms.Manager, hid, address, cert, params)
+}
+
+// AddWebHost adds a host reached over grpc-web rather than gRPC, as is
+// needed when running in a browser under WASM. The remote must serve
+// grpc-web, as gateways and nodes started with StartNodeWithWeb do.
+func (c *Comms) AddWebHost(hid *id.ID, address string, cert []byte,
+	params connect.HostParams) (*connect.Host, error) {
+	params.ConnectionType = connect.Web
+	return c.AddHost(hid, address, cert, params)
+}
@@ -0,0 +1,72 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package client
+
+import (
+	"testing"
+
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// Tests that HighLatencyParams.Apply lengthens deadlines, stops keepalive
+// pings on idle connections and leaves unrelated parameters alone.
+func TestHighLatencyParams_Apply(t *testing.T) {
+	hl := DefaultHighLatencyParams()
+	base := connect.GetDefaultHostParams()
+	base.EnableCoolOff = true
+	base.AuthEnabled = false
+
+	params := hl.Apply(base)
+
+	if params.SendTimeout != hl.SendTimeout {
+		t.Errorf("Unexpected send timeout.\nexpected: %s\nreceived: %s",
+			hl.SendTimeout, params.SendTimeout)
+	}
+	if params.SendTimeout <= base.SendTimeout {
+		t.Errorf("Send timeout %s not longer than default %s.",
+			params.SendTimeout, base.SendTimeout)
+	}
+	if params.PingTimeout != hl.PingTimeout {
+		t.Errorf("Unexpected ping timeout.\nexpected: %s\nreceived: %s",
+			hl.PingTimeout, params.PingTimeout)
+	}
+	if params.KaClientOpts.PermitWithoutStream {
+		t.Error("Keepalive pings permitted on idle connections.")
+	}
+	if params.KaClientOpts.Time != hl.KeepaliveTime {
+		t.Errorf("Unexpected keepalive time.\nexpected: %s\nreceived: %s",
+			hl.KeepaliveTime, params.KaClientOpts.Time)
+	}
+	if params.EnableCoolOff {
+		t.Error("Cool off not disabled.")
+	}
+	if params.AuthEnabled != base.AuthEnabled {
+		t.Error("Unrelated parameter changed.")
+	}
+}
+
+// Tests that AddHighLatencyHost adds the host to the Comms.
+func TestComms_AddHighLatencyHost(t *testing.T) {
+	testID := id.NewIdFromString("test", id.Generic, t)
+	c := &Comms{
+		ProtoComms:  &connect.ProtoComms{Manager: connect.NewManagerTesting(t)},
+		HighLatency: DefaultHighLatencyParams(),
+	}
+
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := c.AddHighLatencyHost(testID, "0.0.0.0:5000", nil, params)
+	if err != nil {
+		t.Fatalf("Failed to add host: %+v", err)
+	}
+
+	if got, exists := c.GetHost(testID); !exists || got != host {
+		t.Errorf("Host not added to Comms.")
+	}
+}
@@ -33,7 +33,7 @@ func (c *Comms) SendRegisterUser(host *connect.Host, message *pb.UDBUserRegistra
 			err = wc.Invoke(
 				ctx, "/mixmessages.UDB/RegisterUser", message, resultMsg)
 		} else {
-			resultMsg, err = pb.NewUDBClient(conn.GetGrpcConn()).
+			resultMsg, err = pb.NewUDBClient(c.Interceptors.ClientConn(conn.GetGrpcConn())).
 				RegisterUser(ctx, message)
 		}
 		if err != nil {
@@ -68,7 +68,7 @@ func (c *Comms) SendRegisterFact(host *connect.Host, message *pb.FactRegisterReq
 			err = wc.Invoke(
 				ctx, "/mixmessages.UDB/RegisterFact", message, resultMsg)
 		} else {
-			resultMsg, err = pb.NewUDBClient(conn.GetGrpcConn()).
+			resultMsg, err = pb.NewUDBClient(c.Interceptors.ClientConn(conn.GetGrpcConn())).
 				RegisterFact(ctx, message)
 		}
 		if err != nil {
@@ -105,7 +105,7 @@ func (c *Comms) SendConfirmFact(host *connect.Host, message *pb.FactConfirmReque
 			err = wc.Invoke(
 				ctx, "/mixmessages.UDB/ConfirmFact", message, resultMsg)
 		} else {
-			resultMsg, err = pb.NewUDBClient(conn.GetGrpcConn()).
+			resultMsg, err = pb.NewUDBClient(c.Interceptors.ClientConn(conn.GetGrpcConn())).
 				ConfirmFact(ctx, message)
 		}
 		if err != nil {
@@ -140,7 +140,7 @@ func (c *Comms) SendRemoveFact(host *connect.Host, message *pb.FactRemovalReques
 			err = wc.Invoke(
 				ctx, "/mixmessages.UDB/RemoveFact", message, resultMsg)
 		} else {
-			resultMsg, err = pb.NewUDBClient(conn.GetGrpcConn()).
+			resultMsg, err = pb.NewUDBClient(c.Interceptors.ClientConn(conn.GetGrpcConn())).
 				RemoveFact(ctx, message)
 		}
 		if err != nil {
@@ -175,7 +175,7 @@ func (c *Comms) SendRemoveUser(host *connect.Host, message *pb.FactRemovalReques
 			err = wc.Invoke(
 				ctx, "/mixmessages.UDB/RemoveUser", message, resultMsg)
 		} else {
-			resultMsg, err = pb.NewUDBClient(conn.GetGrpcConn()).
+			resultMsg, err = pb.NewUDBClient(c.Interceptors.ClientConn(conn.GetGrpcConn())).
 				RemoveUser(ctx, message)
 		}
 		if err != nil {
@@ -210,7 +210,7 @@ func (c *Comms) SendChannelLeaseRequest(host *connect.Host, message *pb.ChannelL
 			err = wc.Invoke(
 				ctx, "/mixmessages.UDB/RequestChannelLease", message, resultMsg)
 		} else {
-			resultMsg, err = pb.NewUDBClient(conn.GetGrpcConn()).
+			resultMsg, err = pb.NewUDBClient(c.Interceptors.ClientConn(conn.GetGrpcConn())).
 				RequestChannelLease(ctx, message)
 		}
 		if err != nil {
@@ -243,7 +243,15 @@ func (c *Comms) SendUsernameValidation(host *connect.Host,
 		defer cancel()
 
 		// Send the message
-		resultMsg, err := pb.NewUDBClient(conn.GetGrpcConn()).ValidateUsername(ctx, message)
+		var resultMsg = &pb.UsernameValidation{}
+		var err error
+		if conn.IsWeb() {
+			wc := conn.GetWebConn()
+			err = wc.Invoke(
+				ctx, "/mixmessages.UDB/ValidateUsername", message, resultMsg)
+		} else {
+			resultMsg, err = pb.NewUDBClient(c.Interceptors.ClientConn(conn.GetGrpcConn())).ValidateUsername(ctx, message)
+		}
 		if err != nil {
 			err = errors.New(err.Error())
 			return nil, errors.New(err.Error())
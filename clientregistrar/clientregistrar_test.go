@@ -16,6 +16,7 @@ import (
 	"gitlab.com/elixxir/comms/client"
 	pb "gitlab.com/elixxir/comms/mixmessages"
 	"gitlab.com/elixxir/comms/testkeys"
+	"gitlab.com/elixxir/comms/testutils"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/primitives/id"
 )
@@ -72,3 +73,9 @@ func TestTLS(t *testing.T) {
 		t.Errorf("RegistrationMessage: Error received: %s", err)
 	}
 }
+
+// Tests that every Handler method has an endpoint and every endpoint a
+// Handler method.
+func TestHandler_Endpoints(t *testing.T) {
+	testutils.CheckEndpoints(t, (*Handler)(nil), &pb.ClientRegistrar_ServiceDesc)
+}
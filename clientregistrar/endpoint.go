@@ -20,7 +20,7 @@ import (
 // Handles validation of reverse-authentication tokens
 func (r *Comms) AuthenticateToken(ctx context.Context,
 	msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
-	err := r.ValidateToken(msg)
+	err := r.AuthMetrics.Validate(msg, r.ProtoComms.GetHost, r.ValidateToken)
 	if err != nil {
 		jww.ERROR.Printf("Unable to authenticate token: %+v", err)
 	}
@@ -30,6 +30,7 @@ func (r *Comms) AuthenticateToken(ctx context.Context,
 // Handles reception of reverse-authentication token requests
 func (r *Comms) RequestToken(context.Context, *messages.Ping) (*messages.AssignToken, error) {
 	token, err := r.GenerateToken()
+	r.AuthMetrics.RecordIssued(err)
 	return &messages.AssignToken{
 		Token: token,
 	}, err
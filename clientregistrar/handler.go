@@ -13,6 +13,13 @@ import (
 	"runtime/debug"
 
 	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/elixxir/comms/authMetrics"
+	"gitlab.com/elixxir/comms/buildInfo"
+	// Registers the compressors the server accepts
+	_ "gitlab.com/elixxir/comms/compression"
+	"gitlab.com/elixxir/comms/endpointSwitch"
+	"gitlab.com/elixxir/comms/health"
+	"gitlab.com/elixxir/comms/interceptors"
 	pb "gitlab.com/elixxir/comms/mixmessages"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/comms/messages"
@@ -24,10 +31,26 @@ import (
 type Comms struct {
 	*connect.ProtoComms
 	handler Handler
-	*pb.UnimplementedClientRegistrarServer
-	*messages.UnimplementedGenericServer
+	// Results of reverse-authentication handshakes with this server
+	AuthMetrics *authMetrics.Tracker
+	// Describes what this server is running to remote callers
+	BuildInfo *buildInfo.Server
+	// Liveness and readiness probes of this server
+	Health *health.Server
+	// Interceptors run on the calls received and sent by this server
+	Interceptors *interceptors.Chain
+	// Endpoints disabled at runtime
+	Switches *endpointSwitch.Switches
+	pb.UnimplementedClientRegistrarServer
+	messages.UnimplementedGenericServer
 }
 
+// Comms is checked at compile time to serve its gRPC services
+var (
+	_ pb.ClientRegistrarServer = (*Comms)(nil)
+	_ messages.GenericServer   = (*Comms)(nil)
+)
+
 // Starts a new server on the address:port specified by localServer
 // and a callback interface for server operations
 // with given path to public and private key for TLS connection
@@ -41,11 +64,20 @@ func StartClientRegistrarServer(id *id.ID, localServer string, handler Handler,
 	}
 
 	clientRegistrarServer := Comms{
-		ProtoComms: pc,
-		handler:    handler,
+		ProtoComms:  pc,
+		handler:     handler,
+		AuthMetrics: authMetrics.NewTracker(),
 	}
-	pb.RegisterClientRegistrarServer(clientRegistrarServer.GetServer(), &clientRegistrarServer)
+	clientRegistrarServer.Switches = endpointSwitch.NewSwitches()
+	clientRegistrarServer.Interceptors = interceptors.New()
+	clientRegistrarServer.Switches.Register(clientRegistrarServer.GetServer(),
+		clientRegistrarServer.Interceptors.Wrap(&pb.ClientRegistrar_ServiceDesc), &clientRegistrarServer)
+	clientRegistrarServer.Switches.RegisterAdmin(clientRegistrarServer.GetServer(), clientRegistrarServer.AuthenticatedReceiver)
 	messages.RegisterGenericServer(clientRegistrarServer.GetServer(), &clientRegistrarServer)
+	clientRegistrarServer.BuildInfo = buildInfo.NewServer()
+	clientRegistrarServer.BuildInfo.Register(clientRegistrarServer.GetServer())
+	clientRegistrarServer.Health = health.NewServer(handler)
+	clientRegistrarServer.Health.Register(clientRegistrarServer.GetServer())
 
 	pc.ServeWithWeb()
 	return &clientRegistrarServer
@@ -0,0 +1,226 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package compression compresses the calls and streams sent to chosen hosts
+// with gzip or zstd. Batches and slots sent between nodes compress well,
+// which matters on bandwidth constrained links between datacenters.
+//
+// connect.Host and the connections it dials take no call options, so
+// compression is chosen per host by a Hosts, whose client interceptors are
+// added to the interceptors.Chain of a comms object. Importing this package
+// registers both compressors with gRPC, so that servers accept them; every
+// server in this repository imports it where the server is started.
+package compression
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"math"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/status"
+)
+
+// Names of the supported compressors.
+const (
+	None = ""
+	Gzip = gzip.Name
+	Zstd = "zstd"
+)
+
+// zstdDecoder decompresses every message compressed with zstd. DecodeAll may
+// be called concurrently, so the decoder and its goroutines are shared rather
+// than created and closed for each message. Messages are limited to the size
+// servers accept.
+var zstdDecoder *zstd.Decoder
+
+func init() {
+	var err error
+	zstdDecoder, err = zstd.NewReader(nil,
+		zstd.WithDecoderMaxMemory(math.MaxInt32))
+	if err != nil {
+		jww.FATAL.Panicf("Failed to create zstd decoder: %+v", err)
+	}
+	encoding.RegisterCompressor(zstdCompressor{})
+}
+
+// hostCompression is the compressor chosen for a host and the address it was
+// chosen at.
+type hostCompression struct {
+	address string
+	name    string
+}
+
+// Hosts holds the compressor used for each host. Servers built before a
+// compressor was registered reject calls compressed with it, so a host
+// rejecting its compressor is recorded as not supporting it and sent
+// uncompressed calls from then on.
+type Hosts struct {
+	hosts map[id.ID]hostCompression
+
+	// Compressor of each address, as the client interceptors only learn the
+	// address of the connection, and the addresses rejecting it
+	targets     map[string]string
+	unsupported map[string]bool
+
+	mux sync.RWMutex
+}
+
+// NewHosts returns a Hosts compressing nothing.
+func NewHosts() *Hosts {
+	return &Hosts{
+		hosts:       make(map[id.ID]hostCompression),
+		targets:     make(map[string]string),
+		unsupported: make(map[string]bool),
+	}
+}
+
+// Set sets the compressor used for the calls and streams sent to the host at
+// its current address, and clears any earlier rejection of compression by it.
+// Setting None disables compression of the host. Set must be called again if
+// the address of the host changes.
+func (h *Hosts) Set(host *connect.Host, name string) error {
+	if name != None && encoding.GetCompressor(name) == nil {
+		return errors.Errorf("Unknown compressor %q", name)
+	}
+
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	if old, exists := h.hosts[*host.GetId()]; exists {
+		delete(h.targets, old.address)
+		delete(h.unsupported, old.address)
+	}
+	if name == None {
+		delete(h.hosts, *host.GetId())
+		return nil
+	}
+	address := host.GetAddress()
+	h.hosts[*host.GetId()] = hostCompression{address: address, name: name}
+	h.targets[address] = name
+	delete(h.unsupported, address)
+	return nil
+}
+
+// Get returns the compressor used for the host with the ID.
+func (h *Hosts) Get(hid *id.ID) string {
+	h.mux.RLock()
+	defer h.mux.RUnlock()
+	hc, exists := h.hosts[*hid]
+	if !exists || h.unsupported[hc.address] {
+		return None
+	}
+	return hc.name
+}
+
+// forTarget returns the compressor of the host dialed at the address, unless
+// the host rejected it.
+func (h *Hosts) forTarget(cc *grpc.ClientConn) string {
+	if cc == nil {
+		return None
+	}
+	target := cc.Target()
+
+	h.mux.RLock()
+	defer h.mux.RUnlock()
+	if h.unsupported[target] {
+		return None
+	}
+	return h.targets[target]
+}
+
+// rejected records that the host dialed at the address does not support its
+// compressor if the error is the one gRPC servers return for calls compressed
+// with a compressor they do not have. Returns true if it was.
+func (h *Hosts) rejected(cc *grpc.ClientConn, err error) bool {
+	if status.Code(err) != codes.Unimplemented ||
+		!strings.Contains(status.Convert(err).Message(), "grpc-encoding") {
+		return false
+	}
+
+	h.mux.Lock()
+	h.unsupported[cc.Target()] = true
+	h.mux.Unlock()
+	jww.WARN.Printf("Host at %s does not support compression, sending "+
+		"uncompressed: %+v", cc.Target(), err)
+	return true
+}
+
+// UnaryClientInterceptor returns an interceptor compressing the unary calls
+// made to the hosts. A call rejected for its compression is sent again
+// uncompressed; the server rejects it before running its handler.
+func (h *Hosts) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{},
+		cc *grpc.ClientConn, invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption) error {
+		name := h.forTarget(cc)
+		if name == None {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		compressed := append(opts[:len(opts):len(opts)],
+			grpc.UseCompressor(name))
+		err := invoker(ctx, method, req, reply, cc, compressed...)
+		if err != nil && h.rejected(cc, err) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		return err
+	}
+}
+
+// StreamClientInterceptor returns an interceptor compressing the streams
+// opened to the hosts. Streams learn of a rejection only once messages are
+// sent, so they are not retried, but a host rejecting compression on a unary
+// call is sent uncompressed streams.
+func (h *Hosts) StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc,
+		cc *grpc.ClientConn, method string, streamer grpc.Streamer,
+		opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if name := h.forTarget(cc); name != None {
+			opts = append(opts, grpc.UseCompressor(name))
+		}
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
+
+// zstdCompressor implements encoding.Compressor with zstd.
+type zstdCompressor struct{}
+
+// Compress returns a writer compressing to w.
+func (zstdCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	return zstd.NewWriter(w, zstd.WithEncoderConcurrency(1))
+}
+
+// Decompress reads the whole compressed message from r and returns a reader
+// of it decompressed.
+func (zstdCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	compressed, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	decompressed, err := zstdDecoder.DecodeAll(compressed, nil)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(decompressed), nil
+}
+
+// Name returns the name of the compressor.
+func (zstdCompressor) Name() string {
+	return Zstd
+}
@@ -0,0 +1,190 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package compression
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/status"
+)
+
+func TestMain(m *testing.M) {
+	connect.TestingOnlyDisableTLS = true
+	os.Exit(m.Run())
+}
+
+// Tests that data compressed with zstd decompresses to the original.
+func TestZstdCompressor(t *testing.T) {
+	c := encoding.GetCompressor(Zstd)
+	if c == nil {
+		t.Fatalf("zstd compressor not registered.")
+	}
+	data := bytes.Repeat([]byte("slot payload "), 1000)
+
+	var buf bytes.Buffer
+	w, err := c.Compress(&buf)
+	if err != nil {
+		t.Fatalf("Failed to create writer: %+v", err)
+	}
+	if _, err = w.Write(data); err != nil {
+		t.Fatalf("Failed to compress: %+v", err)
+	}
+	if err = w.Close(); err != nil {
+		t.Fatalf("Failed to close writer: %+v", err)
+	}
+	if buf.Len() >= len(data) {
+		t.Errorf("Compressed %d bytes to %d.", len(data), buf.Len())
+	}
+
+	r, err := c.Decompress(&buf)
+	if err != nil {
+		t.Fatalf("Failed to create reader: %+v", err)
+	}
+	decompressed, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatalf("Failed to decompress: %+v", err)
+	}
+	if !bytes.Equal(decompressed, data) {
+		t.Errorf("Decompressed data does not match.")
+	}
+}
+
+// Tests that the compressor of a host is set, replaced and removed.
+func TestHosts_Set(t *testing.T) {
+	h := NewHosts()
+	hid := id.NewIdFromString("node", id.Node, t)
+	host, err := connect.NewHost(hid, "0.0.0.0:5970", nil,
+		connect.GetDefaultHostParams())
+	if err != nil {
+		t.Fatalf("Failed to create host: %+v", err)
+	}
+
+	if err = h.Set(host, "unknown"); err == nil {
+		t.Errorf("Set an unknown compressor.")
+	}
+	for _, name := range []string{Gzip, Zstd, None} {
+		if err = h.Set(host, name); err != nil {
+			t.Errorf("Failed to set %q: %+v", name, err)
+		}
+		if got := h.Get(hid); got != name {
+			t.Errorf("Expected %q, got %q.", name, got)
+		}
+	}
+	if h.forTarget(nil) != None {
+		t.Errorf("Compressed a call without a connection.")
+	}
+}
+
+// Tests that a host rejecting its compressor is sent the call again
+// uncompressed and no longer compressed, until its compressor is set again.
+func TestHosts_UnaryClientInterceptor_Rejected(t *testing.T) {
+	h := NewHosts()
+	hid := id.NewIdFromString("node", id.Node, t)
+	host, err := connect.NewHost(hid, "0.0.0.0:5971", nil,
+		connect.GetDefaultHostParams())
+	if err != nil {
+		t.Fatalf("Failed to create host: %+v", err)
+	}
+	if err = h.Set(host, Zstd); err != nil {
+		t.Fatalf("Failed to set compressor: %+v", err)
+	}
+
+	cc, err := grpc.Dial(host.GetAddress(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("Failed to dial: %+v", err)
+	}
+	defer cc.Close()
+	if got := h.forTarget(cc); got != Zstd {
+		t.Fatalf("Expected %q for the host, got %q.", Zstd, got)
+	}
+
+	var calls, compressed int
+	invoker := func(ctx context.Context, method string, req,
+		reply interface{}, cc *grpc.ClientConn,
+		opts ...grpc.CallOption) error {
+		calls++
+		for _, opt := range opts {
+			if _, ok := opt.(grpc.CompressorCallOption); ok {
+				compressed++
+				return status.Errorf(codes.Unimplemented, "grpc: "+
+					"Decompressor is not installed for grpc-encoding %q", Zstd)
+			}
+		}
+		return nil
+	}
+
+	interceptor := h.UnaryClientInterceptor()
+	err = interceptor(context.Background(), "/test", nil, nil, cc, invoker)
+	if err != nil {
+		t.Errorf("Call failed: %+v", err)
+	}
+	if calls != 2 || compressed != 1 {
+		t.Errorf("Expected a compressed call and a retry, got %d calls "+
+			"with %d compressed.", calls, compressed)
+	}
+	if got := h.Get(hid); got != None {
+		t.Errorf("Host still compressed with %q.", got)
+	}
+
+	err = interceptor(context.Background(), "/test", nil, nil, cc, invoker)
+	if err != nil || calls != 3 || compressed != 1 {
+		t.Errorf("Compressed a call to a host rejecting compression.")
+	}
+
+	if err = h.Set(host, Zstd); err != nil {
+		t.Fatalf("Failed to set compressor: %+v", err)
+	}
+	if got := h.forTarget(cc); got != Zstd {
+		t.Errorf("Setting the compressor did not clear the rejection.")
+	}
+}
+
+// Tests that errors other than a rejected compressor are returned without
+// retrying or disabling compression.
+func TestHosts_UnaryClientInterceptor_Error(t *testing.T) {
+	h := NewHosts()
+	hid := id.NewIdFromString("node", id.Node, t)
+	host, err := connect.NewHost(hid, "0.0.0.0:5972", nil,
+		connect.GetDefaultHostParams())
+	if err != nil {
+		t.Fatalf("Failed to create host: %+v", err)
+	}
+	if err = h.Set(host, Gzip); err != nil {
+		t.Fatalf("Failed to set compressor: %+v", err)
+	}
+	cc, err := grpc.Dial(host.GetAddress(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("Failed to dial: %+v", err)
+	}
+	defer cc.Close()
+
+	calls := 0
+	invoker := func(ctx context.Context, method string, req,
+		reply interface{}, cc *grpc.ClientConn,
+		opts ...grpc.CallOption) error {
+		calls++
+		return status.Error(codes.Unimplemented, "unknown method")
+	}
+	err = h.UnaryClientInterceptor()(
+		context.Background(), "/test", nil, nil, cc, invoker)
+	if status.Code(err) != codes.Unimplemented || calls != 1 {
+		t.Errorf("Unexpected result after %d calls: %+v", calls, err)
+	}
+	if got := h.Get(hid); got != Gzip {
+		t.Errorf("Expected %q, got %q.", Gzip, got)
+	}
+}
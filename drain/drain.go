@@ -0,0 +1,50 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package drain stops a gRPC server gracefully within a deadline.
+// ProtoComms.Shutdown either waits for in-flight RPCs without bound or, when
+// serving over the web, closes the connections after a fixed timeout, which
+// drops streams such as StreamPostPhase mid-transfer. Stop refuses new RPCs
+// at once and gives in-flight handlers, including active streams, until the
+// timeout to complete before closing their connections.
+package drain
+
+import (
+	"time"
+
+	jww "github.com/spf13/jwalterweatherman"
+	"google.golang.org/grpc"
+)
+
+// Stop stops the server from accepting new connections and RPCs and waits up
+// to the timeout for in-flight RPCs to complete. Once the timeout elapses, the
+// remaining RPCs are cancelled and their connections closed. Returns true if
+// every RPC completed in time. A nil server is stopped trivially.
+func Stop(server *grpc.Server, timeout time.Duration) bool {
+	if server == nil {
+		return true
+	}
+
+	done := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(done)
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-done:
+		return true
+	case <-timer.C:
+		jww.WARN.Printf("In-flight RPCs did not complete within %s, "+
+			"closing their connections", timeout)
+		server.Stop()
+		<-done
+		return false
+	}
+}
@@ -0,0 +1,121 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package drain
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// blockingHealth is a health server whose Watch stream blocks until release
+// is closed or the stream is cancelled.
+type blockingHealth struct {
+	*health.Server
+	started chan struct{}
+	release chan struct{}
+}
+
+func (b *blockingHealth) Watch(_ *grpc_health_v1.HealthCheckRequest,
+	stream grpc_health_v1.Health_WatchServer) error {
+	close(b.started)
+	select {
+	case <-b.release:
+		return nil
+	case <-stream.Context().Done():
+		return stream.Context().Err()
+	}
+}
+
+// serve starts a server with a blocking stream and opens the stream.
+func serve(t *testing.T) (*grpc.Server, *blockingHealth, chan error) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer()
+	b := &blockingHealth{
+		Server:  health.NewServer(),
+		started: make(chan struct{}),
+		release: make(chan struct{}),
+	}
+	grpc_health_v1.RegisterHealthServer(server, b)
+	go func() { _ = server.Serve(lis) }()
+
+	conn, err := grpc.Dial(lis.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	errs := make(chan error, 1)
+	go func() {
+		stream, err := grpc_health_v1.NewHealthClient(conn).Watch(
+			context.Background(), &grpc_health_v1.HealthCheckRequest{})
+		if err == nil {
+			_, err = stream.Recv()
+		}
+		errs <- err
+	}()
+
+	select {
+	case <-b.started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Stream was not started")
+	}
+	return server, b, errs
+}
+
+// Tests that Stop waits for an in-flight stream that completes in time.
+func TestStop(t *testing.T) {
+	server, b, errs := serve(t)
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		close(b.release)
+	}()
+
+	if !Stop(server, 5*time.Second) {
+		t.Error("Stop timed out waiting for a completing stream")
+	}
+	if err := <-errs; err == nil {
+		t.Error("Expected the stream to end without a response")
+	}
+}
+
+// Tests that Stop cancels an in-flight stream once the timeout elapses.
+func TestStop_Timeout(t *testing.T) {
+	server, _, errs := serve(t)
+
+	start := time.Now()
+	if Stop(server, 50*time.Millisecond) {
+		t.Error("Stop did not time out on a blocked stream")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Stop took %s", elapsed)
+	}
+	select {
+	case <-errs:
+	case <-time.After(5 * time.Second):
+		t.Error("Stream was not cancelled")
+	}
+}
+
+// Tests that Stop accepts a nil server.
+func TestStop_Nil(t *testing.T) {
+	if !Stop(nil, time.Millisecond) {
+		t.Error("Stop failed on a nil server")
+	}
+}
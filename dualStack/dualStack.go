@@ -0,0 +1,139 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package dualStack serves comms on several TCP addresses, such as an IPv4
+// and an IPv6 address, for operators whose hosts are IPv6 only or which need
+// to listen on both families explicitly. connect.StartCommServer listens on
+// a single address, so the start functions accept a comma separated list of
+// addresses, pass the first to StartCommServer and serve the others on the
+// same gRPC server with Serve.
+package dualStack
+
+import (
+	"net"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	"google.golang.org/grpc"
+)
+
+// Separator separates the addresses in a list of listening addresses.
+const Separator = ","
+
+// Split splits a comma separated list of listening addresses into the first
+// address, which is passed to connect.StartCommServer, and the others, which
+// are served with Serve. Surrounding spaces and empty entries are ignored.
+func Split(addresses string) (string, []string) {
+	var list []string
+	for _, address := range strings.Split(addresses, Separator) {
+		if address = strings.TrimSpace(address); address != "" {
+			list = append(list, address)
+		}
+	}
+	if len(list) == 0 {
+		return "", nil
+	}
+	return list[0], list[1:]
+}
+
+// Network returns the network listened on for the address: "tcp4" for IPv4
+// literals, "tcp6" for IPv6 literals and "tcp" for host names and addresses
+// without a host.
+func Network(address string) string {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return "tcp"
+	}
+	ip := net.ParseIP(host)
+	switch {
+	case ip == nil:
+		return "tcp"
+	case ip.To4() != nil:
+		return "tcp4"
+	default:
+		return "tcp6"
+	}
+}
+
+// Listeners are the additional addresses served by a gRPC server.
+type Listeners struct {
+	listeners []net.Listener
+	once      sync.Once
+}
+
+// Serve listens on each address and serves the gRPC server on them until the
+// listeners or the server are closed. If an address cannot be listened on,
+// those already opened are closed and an error is returned.
+//
+// An unspecified IP address, e.g. "[::]:11420", which is already in use is
+// skipped: on most systems a listener on the unspecified address of one
+// family accepts connections of both, so it is already served.
+func Serve(server *grpc.Server, addresses []string) (*Listeners, error) {
+	l := &Listeners{}
+	for _, address := range addresses {
+		lis, err := net.Listen(Network(address), address)
+		if err != nil {
+			if isUnspecified(address) &&
+				errors.Is(err, syscall.EADDRINUSE) {
+				jww.INFO.Printf("Not listening on %s, its port is already "+
+					"in use by a dual-stack listener: %+v", address, err)
+				continue
+			}
+			_ = l.Close()
+			return nil, errors.Errorf("Failed to listen on %s: %+v",
+				address, err)
+		}
+		l.listeners = append(l.listeners, lis)
+
+		go func(lis net.Listener) {
+			// This blocks for the lifetime of the listener
+			if err := server.Serve(lis); err != nil &&
+				err != grpc.ErrServerStopped {
+				jww.WARN.Printf("Stopped serving on %s: %+v", lis.Addr(), err)
+			}
+			jww.INFO.Printf("Shutting down listener %s", lis.Addr())
+		}(lis)
+	}
+	return l, nil
+}
+
+// Addrs returns the addresses listened on.
+func (l *Listeners) Addrs() []net.Addr {
+	addrs := make([]net.Addr, len(l.listeners))
+	for i, lis := range l.listeners {
+		addrs[i] = lis.Addr()
+	}
+	return addrs
+}
+
+// Close stops listening on every address. Connections already made are
+// closed with the server.
+func (l *Listeners) Close() error {
+	var err error
+	l.once.Do(func() {
+		for _, lis := range l.listeners {
+			if closeErr := lis.Close(); closeErr != nil && err == nil {
+				err = closeErr
+			}
+		}
+	})
+	return err
+}
+
+// isUnspecified returns true if the host of the address is the unspecified
+// IPv4 or IPv6 address.
+func isUnspecified(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return false
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsUnspecified()
+}
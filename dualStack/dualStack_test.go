@@ -0,0 +1,99 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package dualStack
+
+import (
+	"net"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc"
+)
+
+// Tests that Split separates the first address from the others.
+func TestSplit(t *testing.T) {
+	first, others := Split(" 0.0.0.0:11420, [::1]:11420,,[2001:db8::1]:11420 ")
+	if first != "0.0.0.0:11420" {
+		t.Errorf("Unexpected first address: %q", first)
+	}
+	expected := []string{"[::1]:11420", "[2001:db8::1]:11420"}
+	if !reflect.DeepEqual(others, expected) {
+		t.Errorf("Unexpected other addresses.\nexpected: %v\nreceived: %v",
+			expected, others)
+	}
+
+	if first, others = Split("localhost:11420"); first != "localhost:11420" ||
+		len(others) != 0 {
+		t.Errorf("Unexpected split of a single address: %q %v", first,
+			others)
+	}
+}
+
+// Tests that Network picks the network of the family of the address.
+func TestNetwork(t *testing.T) {
+	tests := map[string]string{
+		"1.2.3.4:11420":       "tcp4",
+		"[::]:11420":          "tcp6",
+		"[2001:db8::1]:11420": "tcp6",
+		"localhost:11420":     "tcp",
+		":11420":              "tcp",
+		"invalid":             "tcp",
+	}
+	for address, expected := range tests {
+		if network := Network(address); network != expected {
+			t.Errorf("Expected %s for %s, got %s.", expected, address,
+				network)
+		}
+	}
+}
+
+// Tests that Serve listens on every address and Close stops listening.
+func TestServe(t *testing.T) {
+	server := grpc.NewServer()
+	defer server.Stop()
+
+	l, err := Serve(server, []string{"127.0.0.1:0", "127.0.0.1:0"})
+	if err != nil {
+		t.Fatalf("Failed to serve: %+v", err)
+	}
+	addrs := l.Addrs()
+	if len(addrs) != 2 {
+		t.Fatalf("Expected 2 listeners, got %v.", addrs)
+	}
+
+	conn, err := net.Dial("tcp", addrs[1].String())
+	if err != nil {
+		t.Fatalf("Failed to dial %s: %+v", addrs[1], err)
+	}
+	_ = conn.Close()
+
+	if err = l.Close(); err != nil {
+		t.Errorf("Failed to close: %+v", err)
+	}
+	if conn, err = net.Dial("tcp", addrs[0].String()); err == nil {
+		_ = conn.Close()
+		t.Errorf("Dialed %s after closing.", addrs[0])
+	}
+}
+
+// Tests that Serve closes the listeners it opened when an address fails.
+func TestServe_Error(t *testing.T) {
+	server := grpc.NewServer()
+	defer server.Stop()
+
+	taken, err := net.Listen("tcp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %+v", err)
+	}
+	defer taken.Close()
+
+	if _, err = Serve(server, []string{"127.0.0.1:0",
+		taken.Addr().String()}); err == nil {
+		t.Errorf("Served on an address in use.")
+	}
+}
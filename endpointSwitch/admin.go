@@ -0,0 +1,159 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the Admin service used to enable and disable endpoints remotely
+
+package endpointSwitch
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/messages"
+	"google.golang.org/grpc"
+)
+
+// Command enables or disables an endpoint.
+type Command struct {
+	// Full method name of the endpoint
+	Endpoint string
+	Enabled  bool
+	// Returned to callers of the endpoint while it is disabled
+	Reason string
+}
+
+// message returns the command in the form it is sent in.
+func (c Command) message() *pb.SetEndpointEnabled {
+	return &pb.SetEndpointEnabled{
+		Endpoint: c.Endpoint,
+		Enabled:  c.Enabled,
+		Reason:   c.Reason,
+	}
+}
+
+// commandFromMessage parses a command sent by SendCommand.
+func commandFromMessage(msg *pb.SetEndpointEnabled) (Command, error) {
+	if msg.GetEndpoint() == "" {
+		return Command{}, errors.New("Command does not name an endpoint")
+	}
+	return Command{
+		Endpoint: msg.GetEndpoint(),
+		Enabled:  msg.GetEnabled(),
+		Reason:   msg.GetReason(),
+	}, nil
+}
+
+// ReceiverFunc authenticates a message, as connect.ProtoComms
+// AuthenticatedReceiver does.
+type ReceiverFunc func(msg *messages.AuthenticatedMessage,
+	ctx context.Context) (*connect.Auth, error)
+
+// adminServer serves the Admin service for a server's switches.
+type adminServer struct {
+	switches *Switches
+	receiver ReceiverFunc
+	pb.UnimplementedAdminServer
+}
+
+// RegisterAdmin registers the Admin service on the gRPC server, using the
+// receiver to authenticate commands. Only the switches' admins may send
+// commands.
+func (s *Switches) RegisterAdmin(grpcServer *grpc.Server,
+	receiver ReceiverFunc) {
+	pb.RegisterAdminServer(grpcServer,
+		&adminServer{switches: s, receiver: receiver})
+}
+
+// SetEndpointEnabled applies a command from an admin.
+func (as *adminServer) SetEndpointEnabled(ctx context.Context,
+	msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
+	// Verify the message authentication
+	authState, err := as.receiver(msg, ctx)
+	if err != nil {
+		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
+	}
+
+	// Return an error if the sender is not an admin
+	if !authState.IsAuthenticated ||
+		!as.switches.isAdmin(authState.Sender.GetId()) {
+		return &messages.Ack{}, connect.AuthError(authState.Sender.GetId())
+	}
+
+	// Unmarshall the any message to the message type needed
+	cmdMsg := &pb.SetEndpointEnabled{}
+	if err = ptypes.UnmarshalAny(msg.Message, cmdMsg); err != nil {
+		return nil, err
+	}
+	command, err := commandFromMessage(cmdMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	if command.Enabled {
+		as.switches.Enable(command.Endpoint)
+	} else {
+		as.switches.Disable(command.Endpoint, command.Reason)
+	}
+	jww.INFO.Printf("Endpoint %s set enabled %t by %s: %s", command.Endpoint,
+		command.Enabled, authState.Sender.GetId(), command.Reason)
+
+	return &messages.Ack{}, nil
+}
+
+// Sender sends authenticated messages. It is implemented by the Comms of
+// every package.
+type Sender interface {
+	Send(host *connect.Host, f func(conn connect.Connection) (*any.Any,
+		error)) (*any.Any, error)
+	PackAuthenticatedMessage(msg proto.Message, host *connect.Host,
+		enableSignature bool) (*messages.AuthenticatedMessage, error)
+}
+
+// SendCommand sends the command to the server at the host. The sender must be
+// one of the server's admins.
+func SendCommand(sender Sender, host *connect.Host,
+	command Command) (*messages.Ack, error) {
+	cmdMsg := command.message()
+
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+
+		// Pack the message as an authenticated message
+		authMsg, err := sender.PackAuthenticatedMessage(cmdMsg, host, false)
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+
+		// Send the message
+		resultMsg, err := pb.NewAdminClient(conn.GetGrpcConn()).
+			SetEndpointEnabled(ctx, authMsg)
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	// Execute the Send function
+	jww.TRACE.Printf("Sending endpoint command: %+v", command)
+	resultMsg, err := sender.Send(host, f)
+	if err != nil {
+		return nil, err
+	}
+
+	// Marshall the result
+	result := &messages.Ack{}
+	return result, ptypes.UnmarshalAny(resultMsg, result)
+}
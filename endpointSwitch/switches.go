@@ -0,0 +1,187 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package endpointSwitch allows endpoints of a comms server to be disabled at
+// runtime, for example during maintenance. Calls to a disabled endpoint fail
+// with an UNAVAILABLE error giving the reason it was disabled.
+package endpointSwitch
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Switches holds the disabled endpoints of a server and the IDs allowed to
+// change them through the Admin service.
+type Switches struct {
+	// Disabled endpoints, keyed by full method name, with their reasons
+	disabled map[string]string
+	admins   map[id.ID]struct{}
+	mux      sync.RWMutex
+}
+
+// NewSwitches returns Switches with every endpoint enabled which accept
+// commands from the given admins.
+func NewSwitches(admins ...*id.ID) *Switches {
+	s := &Switches{
+		disabled: make(map[string]string),
+		admins:   make(map[id.ID]struct{}),
+	}
+	for _, admin := range admins {
+		s.admins[*admin] = struct{}{}
+	}
+	return s
+}
+
+// MethodName returns the full method name of an endpoint, e.g.
+// "/mixmessages.ClientRegistrar/RegisterUser".
+func MethodName(service, method string) string {
+	return "/" + service + "/" + method
+}
+
+// Disable disables the endpoint with the full method name.
+func (s *Switches) Disable(method, reason string) {
+	s.mux.Lock()
+	s.disabled[method] = reason
+	s.mux.Unlock()
+}
+
+// Enable enables the endpoint with the full method name.
+func (s *Switches) Enable(method string) {
+	s.mux.Lock()
+	delete(s.disabled, method)
+	s.mux.Unlock()
+}
+
+// Disabled returns the disabled endpoints and the reasons they were disabled.
+func (s *Switches) Disabled() map[string]string {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	disabled := make(map[string]string, len(s.disabled))
+	for method, reason := range s.disabled {
+		disabled[method] = reason
+	}
+	return disabled
+}
+
+// AddAdmin allows the ID to enable and disable endpoints.
+func (s *Switches) AddAdmin(admin *id.ID) {
+	s.mux.Lock()
+	s.admins[*admin] = struct{}{}
+	s.mux.Unlock()
+}
+
+// isAdmin returns true if the ID may enable and disable endpoints.
+func (s *Switches) isAdmin(admin *id.ID) bool {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	_, exists := s.admins[*admin]
+	return exists
+}
+
+// Check returns a DisabledError if the endpoint with the full method name is
+// disabled.
+func (s *Switches) Check(method string) error {
+	if s == nil {
+		return nil
+	}
+	s.mux.RLock()
+	reason, disabled := s.disabled[method]
+	s.mux.RUnlock()
+	if disabled {
+		return &DisabledError{Endpoint: method, Reason: reason}
+	}
+	return nil
+}
+
+// Register registers the service on the gRPC server with each of its
+// endpoints checked against the switches before being called.
+func (s *Switches) Register(grpcServer *grpc.Server, desc *grpc.ServiceDesc,
+	srv interface{}) {
+	grpcServer.RegisterService(s.Wrap(desc), srv)
+}
+
+// Wrap returns a copy of the service description whose handlers fail with a
+// DisabledError while their endpoint is disabled.
+func (s *Switches) Wrap(desc *grpc.ServiceDesc) *grpc.ServiceDesc {
+	wrapped := *desc
+
+	wrapped.Methods = make([]grpc.MethodDesc, len(desc.Methods))
+	for i, md := range desc.Methods {
+		method := MethodName(desc.ServiceName, md.MethodName)
+		handler := md.Handler
+		wrapped.Methods[i] = grpc.MethodDesc{
+			MethodName: md.MethodName,
+			Handler: func(srv interface{}, ctx context.Context,
+				dec func(interface{}) error,
+				interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				if err := s.Check(method); err != nil {
+					return nil, err
+				}
+				return handler(srv, ctx, dec, interceptor)
+			},
+		}
+	}
+
+	wrapped.Streams = make([]grpc.StreamDesc, len(desc.Streams))
+	for i, sd := range desc.Streams {
+		method := MethodName(desc.ServiceName, sd.StreamName)
+		handler := sd.Handler
+		wrapped.Streams[i] = sd
+		wrapped.Streams[i].Handler = func(srv interface{},
+			stream grpc.ServerStream) error {
+			if err := s.Check(method); err != nil {
+				return err
+			}
+			return handler(srv, stream)
+		}
+	}
+
+	return &wrapped
+}
+
+// DisabledError is returned by calls to a disabled endpoint.
+type DisabledError struct {
+	Endpoint string
+	Reason   string
+}
+
+func (e *DisabledError) Error() string {
+	return fmt.Sprintf("endpoint %s is disabled: %s", e.Endpoint, e.Reason)
+}
+
+// GRPCStatus returns the UNAVAILABLE status the error is sent to callers as.
+func (e *DisabledError) GRPCStatus() *status.Status {
+	return status.New(codes.Unavailable, e.Error())
+}
+
+// disabledPattern matches the error message of a DisabledError
+var disabledPattern = regexp.MustCompile(`endpoint (/\S+) is disabled: (.*)`)
+
+// AsDisabled returns the DisabledError a remote call failed with. The error
+// is found in the message of err, so it may have been wrapped or rebuilt as
+// it was returned from the send function.
+func AsDisabled(err error) (*DisabledError, bool) {
+	if err == nil {
+		return nil, false
+	}
+	if de, ok := err.(*DisabledError); ok {
+		return de, true
+	}
+	match := disabledPattern.FindStringSubmatch(err.Error())
+	if match == nil {
+		return nil, false
+	}
+	return &DisabledError{Endpoint: match[1], Reason: match[2]}, true
+}
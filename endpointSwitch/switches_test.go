@@ -0,0 +1,164 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package endpointSwitch
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMain(m *testing.M) {
+	connect.TestingOnlyDisableTLS = true
+	os.Exit(m.Run())
+}
+
+// Tests that wrapped handlers fail while their endpoint is disabled and are
+// called once it is enabled again.
+func TestSwitches_Wrap(t *testing.T) {
+	s := NewSwitches()
+	called := 0
+	desc := &grpc.ServiceDesc{
+		ServiceName: "test.Service",
+		Methods: []grpc.MethodDesc{{
+			MethodName: "Unary",
+			Handler: func(interface{}, context.Context, func(interface{}) error,
+				grpc.UnaryServerInterceptor) (interface{}, error) {
+				called++
+				return nil, nil
+			},
+		}},
+		Streams: []grpc.StreamDesc{{
+			StreamName: "Stream",
+			Handler: func(interface{}, grpc.ServerStream) error {
+				called++
+				return nil
+			},
+			ServerStreams: true,
+		}},
+	}
+	wrapped := s.Wrap(desc)
+	unary := wrapped.Methods[0].Handler
+	stream := wrapped.Streams[0].Handler
+	if !wrapped.Streams[0].ServerStreams {
+		t.Errorf("Stream description was not copied.")
+	}
+
+	s.Disable(MethodName("test.Service", "Unary"), "maintenance")
+	s.Disable(MethodName("test.Service", "Stream"), "maintenance")
+
+	_, err := unary(nil, context.Background(), nil, nil)
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("Unexpected error from disabled endpoint: %+v", err)
+	}
+	if err = stream(nil, nil); status.Code(err) != codes.Unavailable {
+		t.Errorf("Unexpected error from disabled stream: %+v", err)
+	}
+	if called != 0 {
+		t.Errorf("Disabled handlers were called.")
+	}
+
+	s.Enable(MethodName("test.Service", "Unary"))
+	s.Enable(MethodName("test.Service", "Stream"))
+	if _, err = unary(nil, context.Background(), nil, nil); err != nil {
+		t.Errorf("Enabled endpoint returned an error: %+v", err)
+	}
+	if err = stream(nil, nil); err != nil {
+		t.Errorf("Enabled stream returned an error: %+v", err)
+	}
+	if called != 2 {
+		t.Errorf("Enabled handlers were not called.")
+	}
+}
+
+// Tests that AsDisabled finds the error after it has been rebuilt from its
+// message, as send functions do.
+func TestAsDisabled(t *testing.T) {
+	sent := &DisabledError{
+		Endpoint: "/mixmessages.ClientRegistrar/RegisterUser",
+		Reason:   "down for maintenance",
+	}
+	received := errors.New(status.Convert(sent).Err().Error())
+
+	de, ok := AsDisabled(received)
+	if !ok {
+		t.Fatalf("Disabled error was not found in %q", received)
+	}
+	if *de != *sent {
+		t.Errorf("Unexpected error.\nexpected: %+v\nreceived: %+v", sent, de)
+	}
+
+	if _, ok = AsDisabled(errors.New("other error")); ok {
+		t.Errorf("Unrelated error was reported as disabled.")
+	}
+}
+
+// Tests that commands from admins are applied and others are rejected.
+func TestAdminServer_SetEndpointEnabled(t *testing.T) {
+	adminID := id.NewIdFromString("admin", id.Generic, t)
+	s := NewSwitches(adminID)
+
+	sender, err := connect.NewHost(adminID, "0.0.0.0:1", nil,
+		connect.GetDefaultHostParams())
+	if err != nil {
+		t.Fatalf("Failed to create host: %+v", err)
+	}
+	auth := &connect.Auth{IsAuthenticated: true, Sender: sender}
+	as := &adminServer{
+		switches: s,
+		receiver: func(*messages.AuthenticatedMessage,
+			context.Context) (*connect.Auth, error) {
+			return auth, nil
+		},
+	}
+
+	command := Command{Endpoint: "/test.Service/Unary", Reason: "test"}
+	anyMsg, err := ptypes.MarshalAny(command.message())
+	if err != nil {
+		t.Fatalf("Failed to marshal command: %+v", err)
+	}
+	msg := &messages.AuthenticatedMessage{Message: anyMsg}
+
+	_, err = as.SetEndpointEnabled(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Command from admin failed: %+v", err)
+	}
+	if s.Disabled()[command.Endpoint] != "test" {
+		t.Errorf("Endpoint was not disabled: %v", s.Disabled())
+	}
+
+	// A sender which is not an admin may not enable the endpoint
+	command.Enabled = true
+	msg.Message, _ = ptypes.MarshalAny(command.message())
+	auth.Sender, _ = connect.NewHost(id.NewIdFromString("other", id.Generic, t),
+		"0.0.0.0:1", nil, connect.GetDefaultHostParams())
+	if _, err = as.SetEndpointEnabled(context.Background(), msg); err == nil {
+		t.Errorf("Command from a non-admin was accepted.")
+	}
+	if len(s.Disabled()) != 1 {
+		t.Errorf("Command from a non-admin was applied.")
+	}
+}
+
+// Tests that a command which does not name an endpoint is rejected.
+func TestCommandFromMessage_NoEndpoint(t *testing.T) {
+	_, err := commandFromMessage(&pb.SetEndpointEnabled{Enabled: true})
+	if err == nil {
+		t.Errorf("Accepted a command without an endpoint.")
+	}
+}
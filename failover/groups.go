@@ -0,0 +1,145 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package failover groups the hosts of a connect.Manager into named failover
+// sets, such as the several gateways of a node, so that a send can fall back
+// to the next host of a set when one fails. Members of a group are tried in
+// priority order by SendToGroup until one of them succeeds.
+package failover
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// SendFunc sends to a host of a group, returning an error if the send failed
+// and the next host should be tried.
+type SendFunc func(host *connect.Host) error
+
+// member is a host of a group.
+type member struct {
+	id *id.ID
+	// Members with a lower priority are tried first
+	priority int
+}
+
+// Groups holds the failover groups of the hosts of a manager.
+type Groups struct {
+	manager *connect.Manager
+	// Members of each group, in the order they are tried
+	groups map[string][]member
+	mux    sync.RWMutex
+}
+
+// NewGroups returns Groups without any groups, whose members are looked up
+// in the manager.
+func NewGroups(manager *connect.Manager) *Groups {
+	return &Groups{
+		manager: manager,
+		groups:  make(map[string][]member),
+	}
+}
+
+// Add adds the host to the group, creating the group if it does not exist.
+// Members are tried in increasing order of priority, and members of equal
+// priority in the order they were added. Adding a host already in the group
+// changes its priority.
+func (g *Groups) Add(groupID string, hid *id.ID, priority int) {
+	g.mux.Lock()
+	defer g.mux.Unlock()
+
+	members := removeMember(g.groups[groupID], hid)
+	members = append(members, member{id: hid.DeepCopy(), priority: priority})
+	sort.SliceStable(members, func(i, j int) bool {
+		return members[i].priority < members[j].priority
+	})
+	g.groups[groupID] = members
+}
+
+// Remove removes the host from the group. The group is deleted once it has
+// no members left.
+func (g *Groups) Remove(groupID string, hid *id.ID) {
+	g.mux.Lock()
+	defer g.mux.Unlock()
+
+	members := removeMember(g.groups[groupID], hid)
+	if len(members) == 0 {
+		delete(g.groups, groupID)
+		return
+	}
+	g.groups[groupID] = members
+}
+
+// Delete deletes the group. The hosts are not removed from the manager.
+func (g *Groups) Delete(groupID string) {
+	g.mux.Lock()
+	defer g.mux.Unlock()
+	delete(g.groups, groupID)
+}
+
+// Members returns the IDs of the hosts of the group in the order they are
+// tried.
+func (g *Groups) Members(groupID string) []*id.ID {
+	g.mux.RLock()
+	defer g.mux.RUnlock()
+
+	members := g.groups[groupID]
+	ids := make([]*id.ID, len(members))
+	for i, m := range members {
+		ids[i] = m.id.DeepCopy()
+	}
+	return ids
+}
+
+// SendToGroup calls the send function on the hosts of the group in priority
+// order until it succeeds, returning the host it succeeded on. Members which
+// are not in the manager are skipped. If every member fails, the returned
+// error lists the failure of each.
+func (g *Groups) SendToGroup(groupID string, f SendFunc) (*connect.Host,
+	error) {
+	members := g.Members(groupID)
+	if len(members) == 0 {
+		return nil, errors.Errorf("Failover group %q has no members",
+			groupID)
+	}
+
+	failures := make([]string, 0, len(members))
+	for _, hid := range members {
+		host, exists := g.manager.GetHost(hid)
+		if !exists {
+			failures = append(failures, hid.String()+": host not found")
+			continue
+		}
+
+		err := f(host)
+		if err == nil {
+			return host, nil
+		}
+		jww.DEBUG.Printf("Send to %s of failover group %q failed, trying "+
+			"the next member: %+v", hid, groupID, err)
+		failures = append(failures, hid.String()+": "+err.Error())
+	}
+
+	return nil, errors.Errorf("Send to every member of failover group %q "+
+		"failed: [%s]", groupID, strings.Join(failures, "; "))
+}
+
+// removeMember returns the members without the host.
+func removeMember(members []member, hid *id.ID) []member {
+	for i, m := range members {
+		if m.id.Cmp(hid) {
+			return append(members[:i:i], members[i+1:]...)
+		}
+	}
+	return members
+}
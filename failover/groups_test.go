@@ -0,0 +1,142 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package failover
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+func TestMain(m *testing.M) {
+	connect.TestingOnlyDisableTLS = true
+	os.Exit(m.Run())
+}
+
+// newTestGroups returns groups over a manager holding a host for each name.
+func newTestGroups(t *testing.T, names ...string) (*Groups, []*id.ID) {
+	manager := connect.NewManagerTesting(t)
+	ids := make([]*id.ID, len(names))
+	for i, name := range names {
+		ids[i] = id.NewIdFromString(name, id.Gateway, t)
+		_, err := manager.AddHost(ids[i], "0.0.0.0:5900", nil,
+			connect.GetDefaultHostParams())
+		if err != nil {
+			t.Fatalf("Failed to add host %s: %+v", name, err)
+		}
+	}
+	return NewGroups(manager), ids
+}
+
+// Tests that members are ordered by priority, then by insertion.
+func TestGroups_Add(t *testing.T) {
+	g, ids := newTestGroups(t, "a", "b", "c")
+	g.Add("node", ids[0], 2)
+	g.Add("node", ids[1], 1)
+	g.Add("node", ids[2], 1)
+
+	expected := []*id.ID{ids[1], ids[2], ids[0]}
+	members := g.Members("node")
+	if len(members) != len(expected) {
+		t.Fatalf("Expected %d members, got %d", len(expected), len(members))
+	}
+	for i := range expected {
+		if !members[i].Cmp(expected[i]) {
+			t.Errorf("Member %d is %s, expected %s", i, members[i],
+				expected[i])
+		}
+	}
+
+	// Adding a member again changes its priority
+	g.Add("node", ids[0], 0)
+	if members = g.Members("node"); len(members) != 3 ||
+		!members[0].Cmp(ids[0]) {
+		t.Errorf("Member not moved to the front: %v", members)
+	}
+}
+
+// Tests that removing the last member deletes the group.
+func TestGroups_Remove(t *testing.T) {
+	g, ids := newTestGroups(t, "a", "b")
+	g.Add("node", ids[0], 0)
+	g.Add("node", ids[1], 0)
+
+	g.Remove("node", ids[0])
+	if members := g.Members("node"); len(members) != 1 ||
+		!members[0].Cmp(ids[1]) {
+		t.Errorf("Unexpected members after remove: %v", members)
+	}
+	g.Remove("node", ids[1])
+	if _, exists := g.groups["node"]; exists {
+		t.Error("Empty group not deleted")
+	}
+}
+
+// Tests that SendToGroup falls back to the next member until one succeeds.
+func TestGroups_SendToGroup(t *testing.T) {
+	g, ids := newTestGroups(t, "a", "b", "c")
+	for i, hid := range ids {
+		g.Add("node", hid, i)
+	}
+
+	var tried []*id.ID
+	host, err := g.SendToGroup("node", func(host *connect.Host) error {
+		tried = append(tried, host.GetId())
+		if host.GetId().Cmp(ids[1]) {
+			return nil
+		}
+		return errors.New("unavailable")
+	})
+	if err != nil {
+		t.Fatalf("SendToGroup failed: %+v", err)
+	}
+	if !host.GetId().Cmp(ids[1]) {
+		t.Errorf("Send succeeded on %s, expected %s", host.GetId(), ids[1])
+	}
+	if len(tried) != 2 || !tried[0].Cmp(ids[0]) {
+		t.Errorf("Unexpected members tried: %v", tried)
+	}
+}
+
+// Tests that SendToGroup returns every failure when all members fail, and
+// skips members missing from the manager.
+func TestGroups_SendToGroup_AllFail(t *testing.T) {
+	g, ids := newTestGroups(t, "a")
+	missing := id.NewIdFromString("missing", id.Gateway, t)
+	g.Add("node", missing, 0)
+	g.Add("node", ids[0], 1)
+
+	calls := 0
+	_, err := g.SendToGroup("node", func(*connect.Host) error {
+		calls++
+		return errors.New("unavailable")
+	})
+	if err == nil {
+		t.Fatal("SendToGroup succeeded when every member failed")
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 send, got %d", calls)
+	}
+	if !strings.Contains(err.Error(), "host not found") ||
+		!strings.Contains(err.Error(), "unavailable") {
+		t.Errorf("Error does not list every failure: %+v", err)
+	}
+}
+
+// Tests that SendToGroup fails on an unknown group.
+func TestGroups_SendToGroup_Unknown(t *testing.T) {
+	g, _ := newTestGroups(t)
+	_, err := g.SendToGroup("node", func(*connect.Host) error { return nil })
+	if err == nil {
+		t.Error("SendToGroup succeeded on an unknown group")
+	}
+}
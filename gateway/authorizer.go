@@ -25,7 +25,7 @@ func (g *Comms) SendAuthorizerCertRequest(host *connect.Host, msg *pb.Authorizer
 		defer cancel()
 
 		// Send the message
-		resp, err := pb.NewAuthorizerClient(conn.GetGrpcConn()).
+		resp, err := pb.NewAuthorizerClient(g.Interceptors.ClientConn(conn.GetGrpcConn())).
 			RequestCert(ctx, msg)
 		if err != nil {
 			return nil, err
@@ -53,7 +53,7 @@ func (g *Comms) SendEABCredentialRequest(host *connect.Host, msg *pb.EABCredenti
 		defer cancel()
 
 		// Send the message
-		resp, err := pb.NewAuthorizerClient(conn.GetGrpcConn()).
+		resp, err := pb.NewAuthorizerClient(g.Interceptors.ClientConn(conn.GetGrpcConn())).
 			RequestEABCredentials(ctx, msg)
 		if err != nil {
 			return nil, err
@@ -12,22 +12,63 @@ package gateway
 import (
 	"context"
 	"encoding/base64"
+	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
 	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/elixxir/comms/capability"
+	"gitlab.com/elixxir/comms/hostPool"
 	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/priority"
 	"gitlab.com/xx_network/comms/connect"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 	"io"
 )
 
 // --------------------------- UploadMixedBatch Logic ----------------------------------------//
 
-// UploadUnmixedBatch streams the slots in the batch to the node
+// UploadUnmixedBatch streams the slots in the batch to the node. The node
+// processes the upload once for its IdempotencyKey, which is derived from the
+// batch if the batch info does not set one.
 func (g *Comms) UploadUnmixedBatch(host *connect.Host,
 	batchInfo pb.BatchInfo, batch *pb.Batch) error {
+	return g.uploadUnmixedBatch(host, host, batchInfo, batch)
+}
+
+// UploadUnmixedBatchPooled streams the slots in the batch to the node over
+// the least loaded connection in the pool, so that concurrent uploads are
+// not limited to the streams of a single connection.
+func (g *Comms) UploadUnmixedBatchPooled(pool *hostPool.Pool,
+	batchInfo pb.BatchInfo, batch *pb.Batch) error {
+	conn, release := pool.Acquire(g.ProtoComms)
+	err := g.uploadUnmixedBatch(pool.Host(), conn, batchInfo, batch)
+	release(err)
+	return err
+}
+
+// uploadUnmixedBatch streams the slots in the batch over the connection,
+// authenticated as the host.
+func (g *Comms) uploadUnmixedBatch(host, conn *connect.Host,
+	batchInfo pb.BatchInfo, batch *pb.Batch) error {
+	// Key the upload so the node does not process retries of it twice
+	if batchInfo.IdempotencyKey == "" {
+		slots := make([][]byte, len(batch.Slots))
+		for i, slot := range batch.Slots {
+			data, err := proto.Marshal(slot)
+			if err != nil {
+				return errors.Errorf("Could not marshal slot (%d/%d) "+
+					"for round %d: %v", i, len(batch.Slots),
+					batch.Round.ID, err)
+			}
+			slots[i] = data
+		}
+		batchInfo.IdempotencyKey = pb.UnmixedBatchIdempotencyKey(
+			&batchInfo, slots)
+	}
+
 	// Retrieve the streaming service
 	streamingClient, cancel, err := g.getUnmixedBatchStreamClient(
-		host, batchInfo)
+		host, conn, batchInfo)
 	if err != nil {
 		return errors.Errorf("Could not retrieve steaming service: %v", err)
 	}
@@ -36,6 +77,12 @@ func (g *Comms) UploadUnmixedBatch(host *connect.Host,
 	// Stream each slot
 	for i, slot := range batch.Slots {
 		if err = streamingClient.Send(slot); err != nil {
+			// The node ended the stream early, either failing or
+			// acknowledging a retry of a batch it already processed;
+			// its reply is read below
+			if err == io.EOF {
+				break
+			}
 			return errors.Errorf("Could not stream "+
 				"slot (%d/%d) for round %d: %v",
 				i, len(batch.Slots), batch.Round.ID, err)
@@ -56,16 +103,71 @@ func (g *Comms) UploadUnmixedBatch(host *connect.Host,
 	return nil
 }
 
-// getUnmixedBatchStreamClient gets the streaming client
-// using a header and returns the stream and the cancel context
-// if there are no connection errors
-func (g *Comms) getUnmixedBatchStreamClient(host *connect.Host,
-	header pb.BatchInfo) (pb.Node_UploadUnmixedBatchClient, context.CancelFunc, error) {
+// UploadUnmixedBatchRaw streams already marshalled slots to the node. The
+// slots are written to the stream as-is, so a gateway relaying slots it
+// holds in wire form (see mixmessages.ExtractRawSlot) avoids unmarshalling
+// and re-marshalling each one.
+func (g *Comms) UploadUnmixedBatchRaw(host *connect.Host,
+	batchInfo pb.BatchInfo, slots []pb.RawMessage) error {
+	// Key the upload so the node does not process retries of it twice
+	if batchInfo.IdempotencyKey == "" {
+		data := make([][]byte, len(slots))
+		for i, slot := range slots {
+			data[i] = slot
+		}
+		batchInfo.IdempotencyKey = pb.UnmixedBatchIdempotencyKey(
+			&batchInfo, data)
+	}
 
-	ctx, cancel := g.getUnmixedBatchStreamContext(&header)
+	// Retrieve the streaming service using the pass-through codec
+	streamingClient, cancel, err := g.getUnmixedBatchStreamClient(
+		host, host, batchInfo, grpc.ForceCodec(pb.RawCodec))
+	if err != nil {
+		return errors.Errorf("Could not retrieve steaming service: %v", err)
+	}
+	defer cancel()
 
-	streamClient, err := g.getUnmixedBatchStream(host, ctx)
+	// Stream each slot
+	for i, slot := range slots {
+		if err = streamingClient.SendMsg(slot); err != nil {
+			// The node ended the stream early, either failing or
+			// acknowledging a retry of a batch it already processed;
+			// its reply is read below
+			if err == io.EOF {
+				break
+			}
+			return errors.Errorf("Could not stream "+
+				"slot (%d/%d) for round %d: %v",
+				i, len(slots), batchInfo.Round.GetID(), err)
+		}
+	}
+
+	// Receive ack and cancel client streaming context
+	ack, err := streamingClient.CloseAndRecv()
+	if err != nil {
+		return errors.Errorf("Could not receive final "+
+			"acknowledgement on streaming batch: %v", err)
+	}
+
+	if ack != nil && ack.Error != "" {
+		return errors.Errorf("Remote Server Error: %v", ack.Error)
+	}
+
+	return nil
+}
+
+// getUnmixedBatchStreamClient gets the streaming client over the connection,
+// authenticated as the host, using a header and returns the stream and the
+// cancel context if there are no connection errors
+func (g *Comms) getUnmixedBatchStreamClient(host, conn *connect.Host,
+	header pb.BatchInfo, opts ...grpc.CallOption) (
+	pb.Node_UploadUnmixedBatchClient, context.CancelFunc, error) {
+
+	ctx, cancel := g.getUnmixedBatchStreamContext(host, &header)
+
+	streamClient, err := g.getUnmixedBatchStream(host, conn, ctx, opts...)
 	if err != nil {
+		cancel()
 		return nil, nil, err
 	}
 
@@ -73,27 +175,40 @@ func (g *Comms) getUnmixedBatchStreamClient(host *connect.Host,
 }
 
 // getUnmixedBatchStreamContext is given batchInfo header
-// and creates a streaming context, adds the header to the context
-// and returns the context with the header and a cancel func
-func (g *Comms) getUnmixedBatchStreamContext(batchInfo *pb.BatchInfo) (
+// and creates a streaming context, adds the header and any capability token
+// held for the host to the context and returns the context with the header
+// and a cancel func
+func (g *Comms) getUnmixedBatchStreamContext(host *connect.Host,
+	batchInfo *pb.BatchInfo) (
 	context.Context, context.CancelFunc) {
 
 	// Create streaming context so you can close stream later
 	ctx, cancel := connect.StreamingContext()
+	ctx = priority.AppendToOutgoingContext(ctx, priority.Realtime)
 
 	encodedStr := base64.StdEncoding.EncodeToString([]byte(batchInfo.String()))
 
 	// Add batch information to streaming context
 	ctx = metadata.AppendToOutgoingContext(ctx, pb.UnmixedBatchHeader, encodedStr)
 
+	// Add the capability token authorizing the upload, if one is held
+	if g.Capabilities != nil {
+		token, exists := g.Capabilities.Get(host.GetId(),
+			capability.UploadUnmixedBatch)
+		if exists {
+			ctx = capability.AppendToOutgoingContext(ctx, token)
+		}
+	}
+
 	return ctx, cancel
 }
 
 // getUnmixedBatchStream uses an id and streaming context to retrieve
-// a Node_UploadUnmixedBatchClient object otherwise it returns
-// an error if the connection is unavailable
-func (g *Comms) getUnmixedBatchStream(host *connect.Host,
-	ctx context.Context) (pb.Node_UploadUnmixedBatchClient, error) {
+// a Node_UploadUnmixedBatchClient object over the connection otherwise it
+// returns an error if the connection is unavailable
+func (g *Comms) getUnmixedBatchStream(host, conn *connect.Host,
+	ctx context.Context, opts ...grpc.CallOption) (
+	pb.Node_UploadUnmixedBatchClient, error) {
 
 	// Create the Stream Function
 	f := func(conn connect.Connection) (interface{}, error) {
@@ -102,8 +217,8 @@ func (g *Comms) getUnmixedBatchStream(host *connect.Host,
 		ctx = g.PackAuthenticatedContext(host, ctx)
 
 		// Get the stream client
-		streamClient, err := pb.NewNodeClient(conn.GetGrpcConn()).
-			UploadUnmixedBatch(ctx)
+		streamClient, err := pb.NewNodeClient(g.Interceptors.ClientConn(conn.GetGrpcConn())).
+			UploadUnmixedBatch(ctx, opts...)
 		if err != nil {
 			return nil, errors.New(err.Error())
 		}
@@ -113,7 +228,7 @@ func (g *Comms) getUnmixedBatchStream(host *connect.Host,
 	jww.TRACE.Printf("Streaming UploadUnmixedBatch")
 
 	// Execute the Stream function
-	resultClient, err := g.ProtoComms.Stream(host, f)
+	resultClient, err := g.Stream(conn, f)
 	if err != nil {
 		return nil, err
 	}
@@ -130,6 +245,7 @@ func (g *Comms) DownloadMixedBatch(ready *pb.BatchReady,
 	// Create the Stream Function
 	ctx, cancel := connect.StreamingContext()
 	defer cancel()
+	ctx = priority.AppendToOutgoingContext(ctx, priority.Realtime)
 	f := func(conn connect.Connection) (interface{}, error) {
 		// Pack message into an authenticated message
 		authMsg, err := g.PackAuthenticatedMessage(ready, host, false)
@@ -137,7 +253,7 @@ func (g *Comms) DownloadMixedBatch(ready *pb.BatchReady,
 			return nil, errors.New(err.Error())
 		}
 		// Send the message
-		clientStream, err := pb.NewNodeClient(conn.GetGrpcConn()).
+		clientStream, err := pb.NewNodeClient(g.Interceptors.ClientConn(conn.GetGrpcConn())).
 			DownloadMixedBatch(ctx, authMsg)
 		if err != nil {
 			return nil, errors.New(err.Error())
@@ -145,7 +261,7 @@ func (g *Comms) DownloadMixedBatch(ready *pb.BatchReady,
 		return clientStream, nil
 	}
 
-	resultClient, err := g.ProtoComms.Stream(host, f)
+	resultClient, err := g.Stream(host, f)
 	if err != nil {
 		return nil, err
 	}
@@ -10,7 +10,10 @@ package gateway
 import (
 	"bytes"
 	"context"
+	"github.com/golang/protobuf/proto"
 	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/elixxir/comms/channelBinding"
+	"gitlab.com/elixxir/comms/hostPool"
 	"gitlab.com/elixxir/comms/mixmessages"
 	"gitlab.com/elixxir/comms/node"
 	"gitlab.com/elixxir/comms/testkeys"
@@ -21,6 +24,7 @@ import (
 	"io"
 	"os"
 	"reflect"
+	"sync/atomic"
 	"testing"
 )
 
@@ -167,6 +171,351 @@ func TestComms_StreamUnmixedBatch(t *testing.T) {
 	}
 }
 
+// Tests that batches uploaded over a pool of connections reach the node.
+func TestComms_UploadUnmixedBatchPooled(t *testing.T) {
+	certData := testkeys.LoadFromPath(testkeys.GetNodeCertPath())
+	keyData := testkeys.LoadFromPath(testkeys.GetNodeKeyPath())
+
+	// Init server receiver
+	var processed int32
+	servReceiverAddress := getNextServerAddress()
+	receiverImpl := node.NewImplementation()
+	receiverImpl.Functions.UploadUnmixedBatch = func(server mixmessages.Node_UploadUnmixedBatchServer, auth *connect.Auth) error {
+		atomic.AddInt32(&processed, 1)
+		return mockStreamUnmixedBatch(server)
+	}
+
+	testID := id.NewIdFromString("test", id.Generic, t)
+	serverStreamReceiver := node.StartNode(testID, servReceiverAddress, 0, receiverImpl,
+		certData, keyData)
+	defer serverStreamReceiver.Shutdown()
+
+	// Init sender
+	gwStreamSender := StartGateway(testID, getNextServerAddress(),
+		NewImplementation(), nil, nil, gossip.DefaultManagerFlags())
+	defer gwStreamSender.Shutdown()
+
+	manager := connect.NewManagerTesting(t)
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(testID, servReceiverAddress, certData, params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+	pool, err := hostPool.NewPool(host, certData, params, 2)
+	if err != nil {
+		t.Fatalf("Unable to create pool: %+v", err)
+	}
+	defer pool.Close()
+
+	roundInfo := mixmessages.RoundInfo{ID: 10}
+	const phases = 3
+	for phase := int32(0); phase < phases; phase++ {
+		batchInfo := mixmessages.BatchInfo{
+			Round:     &roundInfo,
+			FromPhase: phase,
+			BatchSize: 1,
+		}
+		mockBatch := &mixmessages.Batch{
+			Round:     &roundInfo,
+			FromPhase: phase,
+			Slots:     []*mixmessages.Slot{{PayloadA: []byte{byte(phase)}}},
+		}
+		err = gwStreamSender.UploadUnmixedBatchPooled(pool, batchInfo, mockBatch)
+		if err != nil {
+			t.Fatalf("Upload of phase %d failed: %+v", phase, err)
+		}
+	}
+
+	if p := atomic.LoadInt32(&processed); p != phases {
+		t.Errorf("Node processed %d batches, expected %d.", p, phases)
+	}
+}
+
+// Tests that batches uploaded over the pooled connections of an authenticated
+// host are authenticated by a node which binds tokens to channels.
+func TestComms_UploadUnmixedBatchPooled_ChannelBinding(t *testing.T) {
+	certData := testkeys.LoadFromPath(testkeys.GetNodeCertPath())
+	keyData := testkeys.LoadFromPath(testkeys.GetNodeKeyPath())
+	nodeID := id.NewIdFromString("node", id.Node, t)
+	gwID := id.NewIdFromString("gateway", id.Gateway, t)
+
+	// Init server receiver
+	var processed, unauthenticated int32
+	servReceiverAddress := getNextServerAddress()
+	receiverImpl := node.NewImplementation()
+	receiverImpl.Functions.UploadUnmixedBatch = func(server mixmessages.Node_UploadUnmixedBatchServer, auth *connect.Auth) error {
+		atomic.AddInt32(&processed, 1)
+		if !auth.IsAuthenticated {
+			atomic.AddInt32(&unauthenticated, 1)
+		}
+		return mockStreamUnmixedBatch(server)
+	}
+
+	serverStreamReceiver := node.StartNode(nodeID, servReceiverAddress, 0, receiverImpl,
+		certData, keyData)
+	defer serverStreamReceiver.Shutdown()
+	serverStreamReceiver.ChannelBinding = channelBinding.NewBinderTesting(false, t)
+	_, err := serverStreamReceiver.AddHost(gwID, "", certData,
+		connect.GetDefaultHostParams())
+	if err != nil {
+		t.Fatalf("Unable to add gateway host: %+v", err)
+	}
+
+	// Init sender
+	gwStreamSender := StartGateway(gwID, getNextServerAddress(),
+		NewImplementation(), certData, keyData, gossip.DefaultManagerFlags())
+	defer gwStreamSender.Shutdown()
+
+	manager := connect.NewManagerTesting(t)
+	params := connect.GetDefaultHostParams()
+	host, err := manager.AddHost(nodeID, servReceiverAddress, certData, params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+	pool, err := hostPool.NewPool(host, certData, params, 2)
+	if err != nil {
+		t.Fatalf("Unable to create pool: %+v", err)
+	}
+	defer pool.Close()
+
+	roundInfo := mixmessages.RoundInfo{ID: 10}
+	upload := func(phase int32) {
+		batchInfo := mixmessages.BatchInfo{
+			Round:     &roundInfo,
+			FromPhase: phase,
+			BatchSize: 1,
+		}
+		mockBatch := &mixmessages.Batch{
+			Round:     &roundInfo,
+			FromPhase: phase,
+			Slots:     []*mixmessages.Slot{{PayloadA: []byte{byte(phase)}}},
+		}
+		err := gwStreamSender.UploadUnmixedBatchPooled(pool, batchInfo, mockBatch)
+		if err != nil {
+			t.Fatalf("Upload of phase %d failed: %+v", phase, err)
+		}
+	}
+
+	// The first upload authenticates the host
+	upload(0)
+
+	// Keep the host busy so the next uploads go over the other connection
+	conn, release := pool.Acquire(gwStreamSender.ProtoComms)
+	if conn != pool.Host() {
+		t.Fatalf("Host was not the least loaded connection.")
+	}
+	upload(1)
+	upload(2)
+	release(nil)
+
+	if p := atomic.LoadInt32(&processed); p != 3 {
+		t.Errorf("Node processed %d batches, expected %d.", p, 3)
+	}
+	if u := atomic.LoadInt32(&unauthenticated); u != 0 {
+		t.Errorf("%d batches were not authenticated.", u)
+	}
+}
+
+// Tests that a retried upload of a batch is acknowledged by the node without
+// being processed again and that the handler is notified of the retry.
+func TestComms_UploadUnmixedBatch_Retry(t *testing.T) {
+	keyPath := testkeys.GetNodeKeyPath()
+	keyData := testkeys.LoadFromPath(keyPath)
+	certPath := testkeys.GetNodeCertPath()
+	certData := testkeys.LoadFromPath(certPath)
+
+	// Init server receiver
+	var processed, duplicates int32
+	servReceiverAddress := getNextServerAddress()
+	receiverImpl := node.NewImplementation()
+	receiverImpl.Functions.UploadUnmixedBatch = func(server mixmessages.Node_UploadUnmixedBatchServer, auth *connect.Auth) error {
+		atomic.AddInt32(&processed, 1)
+		return mockStreamUnmixedBatch(server)
+	}
+	receiverImpl.Functions.DuplicateUnmixedBatch = func(idempotencyKey string, auth *connect.Auth) {
+		atomic.AddInt32(&duplicates, 1)
+	}
+
+	testID := id.NewIdFromString("test", id.Generic, t)
+	serverStreamReceiver := node.StartNode(testID, servReceiverAddress, 0, receiverImpl,
+		certData, keyData)
+
+	// Init sender
+	senderAddress := getNextServerAddress()
+	gwStreamSender := StartGateway(testID, senderAddress,
+		NewImplementation(), nil, nil, gossip.DefaultManagerFlags())
+
+	// Reset TLS-related global variables
+	defer serverStreamReceiver.Shutdown()
+	defer gwStreamSender.Shutdown()
+
+	roundInfo := mixmessages.RoundInfo{ID: 10}
+	batchInfo := mixmessages.BatchInfo{
+		Round:     &roundInfo,
+		FromPhase: 3,
+		BatchSize: 3,
+	}
+
+	manager := connect.NewManagerTesting(t)
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(testID, servReceiverAddress, certData, params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+
+	mockBatch := &mixmessages.Batch{
+		Round:     &roundInfo,
+		FromPhase: batchInfo.FromPhase,
+	}
+	for i := uint32(0); i < batchInfo.BatchSize; i++ {
+		mockBatch.Slots = append(mockBatch.Slots,
+			&mixmessages.Slot{
+				Index:    i,
+				PayloadA: []byte{byte(i)},
+			})
+	}
+
+	for i := 0; i < 2; i++ {
+		err = gwStreamSender.UploadUnmixedBatch(host, batchInfo, mockBatch)
+		if err != nil {
+			t.Fatalf("Upload %d failed: %+v", i, err)
+		}
+	}
+
+	if p := atomic.LoadInt32(&processed); p != 1 {
+		t.Errorf("Batch processed %d times, expected once.", p)
+	}
+	if d := atomic.LoadInt32(&duplicates); d != 1 {
+		t.Errorf("Handler notified of %d retries, expected 1.", d)
+	}
+
+	// A batch from another phase is processed
+	batchInfo.FromPhase++
+	mockBatch.FromPhase++
+	err = gwStreamSender.UploadUnmixedBatch(host, batchInfo, mockBatch)
+	if err != nil {
+		t.Fatalf("Upload of next phase failed: %+v", err)
+	}
+	if p := atomic.LoadInt32(&processed); p != 2 {
+		t.Errorf("Batch processed %d times, expected twice.", p)
+	}
+
+	// Another batch for the same phase is processed
+	mockBatch.Slots[0].PayloadA = []byte{0xff}
+	err = gwStreamSender.UploadUnmixedBatch(host, batchInfo, mockBatch)
+	if err != nil {
+		t.Fatalf("Upload of another batch failed: %+v", err)
+	}
+	if p := atomic.LoadInt32(&processed); p != 3 {
+		t.Errorf("Batch processed %d times, expected 3.", p)
+	}
+
+	// A key set by the caller is kept, even once the batch changes
+	batchInfo.IdempotencyKey = "key"
+	for i := 0; i < 2; i++ {
+		mockBatch.Slots[0].PayloadA = []byte{byte(i)}
+		err = gwStreamSender.UploadUnmixedBatch(host, batchInfo, mockBatch)
+		if err != nil {
+			t.Fatalf("Upload %d with key failed: %+v", i, err)
+		}
+	}
+	if p := atomic.LoadInt32(&processed); p != 4 {
+		t.Errorf("Batch processed %d times, expected 4.", p)
+	}
+	if d := atomic.LoadInt32(&duplicates); d != 2 {
+		t.Errorf("Handler notified of %d retries, expected 2.", d)
+	}
+}
+
+// Tests that slots extracted in wire form from GatewaySlots are received by
+// the node as normal slots.
+func TestComms_UploadUnmixedBatchRaw(t *testing.T) {
+	keyPath := testkeys.GetNodeKeyPath()
+	keyData := testkeys.LoadFromPath(keyPath)
+	certPath := testkeys.GetNodeCertPath()
+	certData := testkeys.LoadFromPath(certPath)
+
+	// Init server receiver
+	servReceiverAddress := getNextServerAddress()
+	receiverImpl := node.NewImplementation()
+	receiverImpl.Functions.UploadUnmixedBatch = func(server mixmessages.Node_UploadUnmixedBatchServer, auth *connect.Auth) error {
+		return mockStreamUnmixedBatch(server)
+	}
+
+	testID := id.NewIdFromString("test", id.Generic, t)
+	serverStreamReceiver := node.StartNode(testID, servReceiverAddress, 0, receiverImpl,
+		certData, keyData)
+
+	// Init sender
+	senderAddress := getNextServerAddress()
+	gwStreamSender := StartGateway(testID, senderAddress,
+		NewImplementation(), nil, nil, gossip.DefaultManagerFlags())
+
+	defer serverStreamReceiver.Shutdown()
+	defer gwStreamSender.Shutdown()
+
+	// Create header
+	roundInfo := mixmessages.RoundInfo{
+		ID: 11,
+	}
+	batchSize := uint32(3)
+	batchInfo := mixmessages.BatchInfo{
+		Round:     &roundInfo,
+		BatchSize: batchSize,
+	}
+
+	// Init host/manager
+	manager := connect.NewManagerTesting(t)
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(testID, servReceiverAddress, certData, params)
+	if err != nil {
+		t.Errorf("Unable to call NewHost: %+v", err)
+	}
+
+	// Build the slots as they would be held by the gateway
+	var expected []*mixmessages.Slot
+	var rawSlots []mixmessages.RawMessage
+	for i := uint32(0); i < batchSize; i++ {
+		slot := &mixmessages.Slot{
+			Index:    i,
+			PayloadA: []byte{byte(i)},
+		}
+		expected = append(expected, slot)
+
+		gwSlot, err := proto.Marshal(&mixmessages.GatewaySlot{
+			Message: slot,
+			RoundID: roundInfo.ID,
+		})
+		if err != nil {
+			t.Fatalf("Failed to marshal GatewaySlot: %+v", err)
+		}
+		raw, err := mixmessages.ExtractRawSlot(gwSlot)
+		if err != nil {
+			t.Fatalf("Failed to extract slot: %+v", err)
+		}
+		rawSlots = append(rawSlots, raw)
+	}
+
+	err = gwStreamSender.UploadUnmixedBatchRaw(host, batchInfo, rawSlots)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	if len(receivedBatch.Slots) != len(expected) {
+		t.Fatalf("Received %d slots, expected %d",
+			len(receivedBatch.Slots), len(expected))
+	}
+	for i := range expected {
+		if !proto.Equal(expected[i], receivedBatch.Slots[i]) {
+			t.Errorf("Slot %d did not match.\nexpected: %+v\nreceived: %+v",
+				i, expected[i], receivedBatch.Slots[i])
+		}
+	}
+}
+
 // Creates a sender and receiver server for post phase
 // unary streaming test.  The test creates a header,
 // sends some slots and blocks until an ack is received
@@ -223,7 +572,7 @@ func TestPhase_StreamPostPhaseSendReceive(t *testing.T) {
 	}
 
 	streamClient, cancel, err := gwStreamSender.getUnmixedBatchStreamClient(
-		host, batchInfo)
+		host, host, batchInfo)
 
 	if err != nil {
 		t.Errorf("Unable to get streaming client %v", err)
@@ -307,7 +656,7 @@ func TestGetPostPhaseStream_ErrorsWhenContextCanceled(t *testing.T) {
 		t.Errorf("Unable to call NewHost: %+v", err)
 	}
 
-	_, err = gwStreamSender.getUnmixedBatchStream(host, ctx)
+	_, err = gwStreamSender.getUnmixedBatchStream(host, host, ctx)
 	if err == nil {
 		t.Errorf("Getting streaming client after canceling context should error")
 	}
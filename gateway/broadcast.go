@@ -36,7 +36,7 @@ func (g *Comms) GetRoundBufferInfo(host *connect.Host) (*pb.RoundBufferInfo, err
 			return nil, errors.New(err.Error())
 		}
 		// Send the message
-		resultMsg, err := pb.NewNodeClient(conn.GetGrpcConn()).
+		resultMsg, err := pb.NewNodeClient(g.Interceptors.ClientConn(conn.GetGrpcConn())).
 			GetRoundBufferInfo(ctx, authMsg)
 		if err != nil {
 			return nil, errors.New(err.Error())
@@ -0,0 +1,80 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the request for capability tokens from permissioning
+
+package gateway
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/elixxir/comms/capability"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// RequestCapability asks permissioning for a token authorizing this gateway to
+// call method on target. The returned token is added to Capabilities, so it is
+// attached to later calls to that method on target.
+func (g *Comms) RequestCapability(host *connect.Host, target *id.ID,
+	method string) (*capability.Token, error) {
+	request, err := capability.NewRequest(target, method).Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+
+		// Pack the message as an authenticated message
+		authMsg, err := g.PackAuthenticatedMessage(
+			&messages.AssignToken{Token: request}, host, false)
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+
+		// Send the message
+		resultMsg, err := pb.NewRegistrationClient(g.Interceptors.ClientConn(conn.GetGrpcConn())).
+			RequestCapability(ctx, authMsg)
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	// Execute the Send function
+	jww.TRACE.Printf("Requesting %s capability for %s", method, target)
+	resultMsg, err := g.Send(host, f)
+	if err != nil {
+		return nil, err
+	}
+
+	// Marshall the result
+	result := &messages.AssignToken{}
+	err = ptypes.UnmarshalAny(resultMsg, result)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := capability.Unmarshal(result.Token)
+	if err != nil {
+		return nil, err
+	}
+	if g.Capabilities != nil {
+		if err = g.Capabilities.Add(token); err != nil {
+			return nil, err
+		}
+	}
+	return token, nil
+}
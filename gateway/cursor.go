@@ -0,0 +1,58 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the paging of RequestMessages responses using message cursors
+
+package gateway
+
+import (
+	"github.com/pkg/errors"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+)
+
+// pageMessages returns the page of the response requested by the cursor of
+// the request. If the request is not paged, the response is returned
+// unchanged. The cursor for the next page, if any, is sent in the
+// NextCursor of the response.
+func (g *Comms) pageMessages(request *pb.GetMessages,
+	response *pb.GetMessagesResponse) (*pb.GetMessagesResponse, error) {
+	if !request.GetPaged() || response == nil {
+		return response, nil
+	}
+
+	// An empty cursor requests the first page
+	start := 0
+	if request.GetCursor() != "" {
+		cursor, err := pb.UnmarshalMessageCursor(request.GetCursor())
+		if err != nil {
+			return nil, errors.Errorf("Invalid message cursor: %+v", err)
+		}
+		if cursor.RoundID != request.GetRoundID() {
+			return nil, errors.Errorf("Message cursor is for round %d, "+
+				"not round %d", cursor.RoundID, request.GetRoundID())
+		}
+		start, err = cursor.Resume(response.Messages)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	end := len(response.Messages)
+	if g.MessagePageSize > 0 && end-start > g.MessagePageSize {
+		end = start + g.MessagePageSize
+	}
+
+	page := &pb.GetMessagesResponse{
+		Messages: response.Messages[start:end],
+		HasRound: response.HasRound,
+	}
+	if end < len(response.Messages) {
+		page.NextCursor = pb.NewMessageCursor(request.GetRoundID(),
+			response.Messages, end).Marshal()
+	}
+	return page, nil
+}
@@ -15,32 +15,56 @@ import (
 	pb "gitlab.com/elixxir/comms/mixmessages"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/netTime"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"strconv"
+	"time"
 )
 
-// Pass-through for Registration Nonce Communication
+// Pass-through for Registration Nonce Communication. The handler is expected
+// to keep the Receipt of the node's response, so that it reaches the client.
 func (g *Comms) RequestClientKey(ctx context.Context,
 	msg *pb.SignedClientKeyRequest) (*pb.SignedKeyResponse, error) {
-
 	return g.handler.RequestClientKey(msg)
 }
 
 // Handles validation of reverse-authentication tokens
 func (g *Comms) AuthenticateToken(ctx context.Context,
 	msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
-	return &messages.Ack{}, g.ValidateToken(msg)
+	return &messages.Ack{}, g.AuthMetrics.Validate(msg, g.ProtoComms.GetHost,
+		func(msg *messages.AuthenticatedMessage) error {
+			return g.ChannelBinding.VerifyToken(ctx, msg, g.ValidateToken)
+		})
 }
 
 // Handles reception of reverse-authentication token requests
-func (g *Comms) RequestToken(context.Context, *messages.Ping) (*messages.AssignToken, error) {
+func (g *Comms) RequestToken(ctx context.Context, _ *messages.Ping) (*messages.AssignToken, error) {
 	token, err := g.GenerateToken()
+	if err == nil {
+		err = g.ChannelBinding.Bind(ctx, token)
+	}
+	g.AuthMetrics.RecordIssued(err)
 	return &messages.AssignToken{
 		Token: token,
 	}, err
 }
 
+// authenticatedReceiver wraps AuthenticatedReceiver, additionally checking
+// that the message arrived over the channel the sender's token is bound to
+func (g *Comms) authenticatedReceiver(msg *messages.AuthenticatedMessage,
+	ctx context.Context) (*connect.Auth, error) {
+	auth, err := g.AuthenticatedReceiver(msg, ctx)
+	if err != nil {
+		return auth, err
+	}
+	g.ChannelBinding.Check(ctx, auth)
+	return auth, nil
+}
+
 // Receives a single message from a client
 func (g *Comms) PutMessage(ctx context.Context, msg *pb.GatewaySlot) (*pb.GatewaySlotResponse,
 	error) {
@@ -50,11 +74,19 @@ func (g *Comms) PutMessage(ctx context.Context, msg *pb.GatewaySlot) (*pb.Gatewa
 		return nil, err
 	}
 
+	err = g.checkSlotScheme(msg.GetSlotScheme(), msg.GetRoundID())
+	if err != nil {
+		return nil, err
+	}
+
 	// Upload a message to the cMix Gateway
 	returnMsg, err := g.handler.PutMessage(msg, ipAddr)
 	if err != nil {
 		returnMsg = &pb.GatewaySlotResponse{}
 
+	} else if returnMsg.GetAccepted() {
+		g.storePending(msg.GetRoundID(), msg)
+		returnMsg.Retention = g.retention(time.Now())
 	}
 	return returnMsg, err
 }
@@ -68,11 +100,19 @@ func (g *Comms) PutManyMessages(ctx context.Context, msgs *pb.GatewaySlots) (*pb
 		return nil, err
 	}
 
+	err = g.checkSlotScheme(msgs.GetSlotScheme(), msgs.GetRoundID())
+	if err != nil {
+		return nil, err
+	}
+
 	// Upload messages to the cMix Gateway
 	returnMsg, err := g.handler.PutManyMessages(msgs, ipAddr)
 	if err != nil {
 		returnMsg = &pb.GatewaySlotResponse{}
 
+	} else if returnMsg.GetAccepted() {
+		g.storePending(msgs.GetRoundID(), msgs.GetMessages()...)
+		returnMsg.Retention = g.retention(time.Now())
 	}
 	return returnMsg, err
 }
@@ -82,7 +122,7 @@ func (g *Comms) PutMessageProxy(ctx context.Context, msg *messages.Authenticated
 	error) {
 
 	// Verify the message authentication
-	authState, err := g.AuthenticatedReceiver(msg, ctx)
+	authState, err := g.authenticatedReceiver(msg, ctx)
 	if err != nil {
 		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
 	}
@@ -99,16 +139,126 @@ func (g *Comms) PutMessageProxy(ctx context.Context, msg *messages.Authenticated
 	if err != nil {
 		returnMsg = &pb.GatewaySlotResponse{}
 
+	} else if returnMsg.GetAccepted() {
+		g.storePending(slot.GetRoundID(), slot)
+		returnMsg.Retention = g.retention(time.Now())
 	}
 	return returnMsg, err
 }
 
+// NotifyAddressUpdate receives a Node -> Gateway notification that the
+// addresses of the node's team have changed
+func (g *Comms) NotifyAddressUpdate(ctx context.Context,
+	msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
+	// Verify the message authentication
+	authState, err := g.authenticatedReceiver(msg, ctx)
+	if err != nil {
+		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
+	}
+
+	// Return an error if the connection is not authenticated
+	if !authState.IsAuthenticated {
+		return &messages.Ack{}, connect.AuthError(authState.Sender.GetId())
+	}
+
+	// Unmarshall the any message to the message type needed
+	update := &pb.NDF{}
+	err = ptypes.UnmarshalAny(msg.Message, update)
+	if err != nil {
+		return nil, errors.New(err.Error())
+	}
+
+	return &messages.Ack{}, g.handler.NotifyAddressUpdate(update, authState)
+}
+
+// MirrorMessages receives a Gateway -> Gateway replica of the messages stored
+// for a round. The messages are only passed to the handler if the manifest
+// sent with them lists them and was signed by the sending gateway.
+func (g *Comms) MirrorMessages(ctx context.Context,
+	msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
+	// Verify the message authentication
+	authState, err := g.authenticatedReceiver(msg, ctx)
+	if err != nil {
+		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
+	}
+
+	// Return an error if the connection is not authenticated
+	if !authState.IsAuthenticated {
+		return &messages.Ack{}, connect.AuthError(authState.Sender.GetId())
+	}
+
+	// Unmarshall the any message to the message type needed
+	mirrored := &pb.MirroredMessages{}
+	err = ptypes.UnmarshalAny(msg.Message, mirrored)
+	if err != nil {
+		return nil, errors.New(err.Error())
+	}
+	msgs, manifest := mirrored.GetMessages(), mirrored.GetManifest()
+
+	// Check the messages against the manifest of the gateway storing them
+	if manifest == nil {
+		return nil, errors.New("No mirror manifest sent with the messages")
+	}
+	origin, err := manifest.GetOriginID()
+	if err != nil {
+		return nil, errors.Errorf("Invalid mirror manifest origin: %+v", err)
+	}
+	if !origin.Cmp(authState.Sender.GetId()) {
+		return nil, errors.Errorf("Mirror manifest from %s was sent by %s",
+			origin, authState.Sender.GetId())
+	}
+	err = manifest.Verify(msgs, authState.Sender.GetPubKey())
+	if err != nil {
+		return nil, errors.Errorf("Invalid mirror manifest for round %d: %+v",
+			msgs.GetRoundId(), err)
+	}
+
+	return &messages.Ack{}, g.handler.MirrorMessages(msgs, manifest, authState)
+}
+
+// UpdateHostAddress receives a Node -> Gateway push of the node's new
+// address. The update is verified against the node's cert and applied to its
+// host before it is passed to the handler.
+func (g *Comms) UpdateHostAddress(ctx context.Context,
+	msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
+	// Verify the message authentication
+	authState, err := g.authenticatedReceiver(msg, ctx)
+	if err != nil {
+		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
+	}
+
+	// Return an error if the connection is not authenticated
+	if !authState.IsAuthenticated {
+		return &messages.Ack{}, connect.AuthError(authState.Sender.GetId())
+	}
+
+	// Unmarshall the any message to the message type needed
+	update := &pb.AddressUpdate{}
+	err = ptypes.UnmarshalAny(msg.Message, update)
+	if err != nil {
+		return nil, errors.New(err.Error())
+	}
+
+	// Check the update is for the sender
+	err = update.Verify(authState.Sender.GetId(), authState.Sender.GetPubKey())
+	if err != nil {
+		return nil, errors.Errorf("Invalid address update from %s: %+v",
+			authState.Sender.GetId(), err)
+	}
+
+	if _, err = g.AddressUpdates.Apply(update); err != nil {
+		return nil, err
+	}
+
+	return &messages.Ack{}, g.handler.UpdateHostAddress(update, authState)
+}
+
 // Upload many messages to the cMix Gateway from a proxy
 func (g *Comms) PutManyMessagesProxy(ctx context.Context, msg *messages.AuthenticatedMessage) (*pb.GatewaySlotResponse,
 	error) {
 
 	// Verify the message authentication
-	authState, err := g.AuthenticatedReceiver(msg, ctx)
+	authState, err := g.authenticatedReceiver(msg, ctx)
 	if err != nil {
 		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
 	}
@@ -125,10 +275,80 @@ func (g *Comms) PutManyMessagesProxy(ctx context.Context, msg *messages.Authenti
 	if err != nil {
 		returnMsg = &pb.GatewaySlotResponse{}
 
+	} else if returnMsg.GetAccepted() {
+		g.storePending(slots.GetRoundID(), slots.GetMessages()...)
+		returnMsg.Retention = g.retention(time.Now())
 	}
 	return returnMsg, err
 }
 
+// StreamRoundUpdates subscribes a client to round updates, which are pushed
+// over the stream until either side closes it. The reception ID of the
+// request and any ephemeral IDs in the interest header make up the interest
+// of the client.
+func (g *Comms) StreamRoundUpdates(msg *pb.GatewayPoll,
+	stream pb.Gateway_StreamRoundUpdatesServer) error {
+	var interest [][]byte
+	if len(msg.GetReceptionID()) != 0 {
+		interest = append(interest, msg.GetReceptionID())
+	}
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	for _, ephID := range md.Get(pb.RoundUpdateInterestHeader) {
+		interest = append(interest, []byte(ephID))
+	}
+
+	return g.handler.StreamRoundUpdates(msg, interest, stream)
+}
+
+// RelayMessage Client -> Gateway relay of an opaque payload to its
+// destination. The sender is not authenticated, so the payload reaches its
+// destination without revealing who sent it. Payloads are limited to
+// MaxRelayPayloadSize bytes and the calls on each connection by the
+// RelayLimiter.
+func (g *Comms) RelayMessage(ctx context.Context, msg *pb.RelayedMessage) (
+	*messages.Ack, error) {
+	if size := len(msg.GetPayload()); size > MaxRelayPayloadSize {
+		return nil, status.Errorf(codes.ResourceExhausted, "Relayed "+
+			"payload larger than max (%d vs. %d)", size, MaxRelayPayloadSize)
+	}
+	destination, err := id.Unmarshal(msg.GetDestination())
+	if err != nil {
+		return nil, errors.Errorf("Invalid relay destination: %+v", err)
+	}
+	if err = g.RelayLimiter.Allow(ctx); err != nil {
+		return nil, err
+	}
+
+	return &messages.Ack{}, g.handler.RelayMessage(destination,
+		msg.GetPayload())
+}
+
+// RequestInclusionProof Client -> Gateway request for proof that a message
+// accepted by this gateway was included in its round. If it was, the
+// response is accepted and carries a proof signed by this gateway.
+func (g *Comms) RequestInclusionProof(ctx context.Context,
+	msg *pb.GatewaySlot) (*pb.GatewaySlotResponse, error) {
+	slotIndex, included, err := g.handler.RequestInclusionProof(msg)
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.GatewaySlotResponse{RoundID: msg.GetRoundID()}
+	if !included {
+		return response, nil
+	}
+
+	proof := pb.NewInclusionProof(g.GetId(), msg.GetRoundID(), slotIndex,
+		msg.GetMessage(), netTime.Now())
+	if err = proof.Sign(g.GetPrivateKey()); err != nil {
+		return nil, errors.Errorf("Failed to sign inclusion proof for "+
+			"round %d: %+v", msg.GetRoundID(), err)
+	}
+
+	response.Accepted = true
+	response.Proof = proof
+	return response, nil
+}
+
 // Client -> Gateway unified polling
 func (g *Comms) Poll(msg *pb.GatewayPoll, stream pb.Gateway_PollServer) error {
 	// Get response from higher level
@@ -137,6 +357,15 @@ func (g *Comms) Poll(msg *pb.GatewayPoll, stream pb.Gateway_PollServer) error {
 		return err
 	}
 
+	// If the client holds a response with the same content, only send the
+	// timestamps of this one
+	incoming, _ := metadata.FromIncomingContext(stream.Context())
+	md := pb.ETagMetadata(pb.PollResponseETag(response),
+		pb.IfNoneMatch(incoming))
+	if pb.IsNotModified(md) {
+		response = pb.NotModifiedPollResponse(response)
+	}
+
 	// Split response into streamable chunks
 	chunks, err := pb.SplitResponseIntoChunks(response)
 	if err != nil {
@@ -144,11 +373,7 @@ func (g *Comms) Poll(msg *pb.GatewayPoll, stream pb.Gateway_PollServer) error {
 	}
 
 	// Send a header informing client-side of the total number of chunks
-	metadataMap := map[string]string{
-		pb.ChunkHeader: strconv.Itoa(len(chunks)),
-	}
-
-	md := metadata.New(metadataMap)
+	md.Set(pb.ChunkHeader, strconv.Itoa(len(chunks)))
 	if err = stream.SendHeader(md); err != nil {
 		return errors.Errorf("Failed to send streaming header: %v", err)
 	}
@@ -172,7 +397,15 @@ func (g *Comms) RequestHistoricalRounds(ctx context.Context, msg *pb.HistoricalR
 
 // Client -> Gateway message request
 func (g *Comms) RequestMessages(ctx context.Context, msg *pb.GetMessages) (*pb.GetMessagesResponse, error) {
-	return g.handler.RequestMessages(msg)
+	response, err := g.handler.RequestMessages(msg)
+	if err != nil {
+		return response, err
+	}
+	response, err = g.pageMessages(msg, response)
+	if err == nil && response != nil {
+		response.Retention = g.retention(time.Time{})
+	}
+	return response, err
 }
 
 func (g *Comms) BatchNodeRegistration(ctx context.Context, msg *pb.SignedClientBatchKeyRequest) (*pb.SignedBatchKeyResponse, error) {
@@ -184,5 +417,47 @@ func (g *Comms) RequestTlsCert(ctx context.Context, msg *pb.RequestGatewayCert)
 }
 
 func (g *Comms) RequestBatchMessages(ctx context.Context, msg *pb.GetMessagesBatch) (*pb.GetMessagesResponseBatch, error) {
-	return g.handler.RequestBatchMessages(msg)
+	response, err := g.handler.RequestBatchMessages(msg)
+	if err == nil && response != nil {
+		response.Retention = g.retention(time.Time{})
+	}
+	return response, err
+}
+
+// checkSlotScheme returns an InvalidArgument error if the slot scheme marked
+// in the request differs from the scheme advertised for the round. Rounds
+// without an advertised scheme accept any.
+func (g *Comms) checkSlotScheme(marker string, roundID uint64) error {
+	if g.RoundSlotScheme == nil {
+		return nil
+	}
+	expected, ok := g.RoundSlotScheme(roundID)
+	if !ok {
+		return nil
+	}
+
+	scheme, err := pb.MarkedSlotScheme(marker)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if scheme != expected {
+		return status.Errorf(codes.InvalidArgument, "Slot scheme %s does "+
+			"not match scheme %s of round %d", scheme, expected, roundID)
+	}
+	return nil
+}
+
+// retention returns the message retention policy sent to the client in the
+// response, or nil if none is advertised. If stored is not zero, the expiry
+// of messages stored then is included.
+func (g *Comms) retention(stored time.Time) *pb.MessageRetentionPolicy {
+	if g.MessageRetention <= 0 {
+		return nil
+	}
+
+	retention := pb.MessageRetention{TTL: g.MessageRetention}
+	if !stored.IsZero() {
+		retention = pb.NewMessageRetention(g.MessageRetention, stored)
+	}
+	return retention.Message()
 }
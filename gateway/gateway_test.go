@@ -9,8 +9,10 @@ package gateway
 
 import (
 	"fmt"
+	"gitlab.com/elixxir/comms/mixmessages"
 	"gitlab.com/elixxir/comms/node"
 	"gitlab.com/elixxir/comms/testkeys"
+	"gitlab.com/elixxir/comms/testutils"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/comms/gossip"
 	"gitlab.com/xx_network/primitives/id"
@@ -87,3 +89,159 @@ func TestBadCerts(t *testing.T) {
 	_ = StartGateway(testID, Address, NewImplementation(),
 		[]byte("bad cert"), []byte("bad key"), gossip.DefaultManagerFlags())
 }
+
+// Tests that an address update from an unauthenticated node is rejected
+// without reaching the handler.
+func TestComms_NotifyAddressUpdate_Unauthenticated(t *testing.T) {
+	GatewayAddress := getNextGatewayAddress()
+	gatewayID := id.NewIdFromString("test", id.Gateway, t)
+	impl := NewImplementation()
+	called := false
+	impl.Functions.NotifyAddressUpdate = func(*mixmessages.NDF, *connect.Auth) error {
+		called = true
+		return nil
+	}
+	gateway := StartGateway(gatewayID, GatewayAddress, impl, nil, nil,
+		gossip.DefaultManagerFlags())
+	defer gateway.Shutdown()
+
+	nodeID := id.NewIdFromString("test", id.Node, t)
+	server := node.StartNode(nodeID, getNextServerAddress(), 0,
+		node.NewImplementation(), nil, nil)
+	defer server.Shutdown()
+
+	manager := connect.NewManagerTesting(t)
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(gatewayID, GatewayAddress, nil, params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+
+	_, err = server.SendAddressUpdate(host, &mixmessages.NDF{})
+	if err == nil {
+		t.Errorf("SendAddressUpdate did not error for an unauthenticated node.")
+	}
+	if called {
+		t.Errorf("Handler called for an unauthenticated node.")
+	}
+}
+
+// Tests that a host address update from an unauthenticated node is rejected
+// without reaching the handler.
+func TestComms_UpdateHostAddress_Unauthenticated(t *testing.T) {
+	GatewayAddress := getNextGatewayAddress()
+	gatewayID := id.NewIdFromString("test", id.Gateway, t)
+	impl := NewImplementation()
+	called := false
+	impl.Functions.UpdateHostAddress = func(*mixmessages.AddressUpdate, *connect.Auth) error {
+		called = true
+		return nil
+	}
+	gateway := StartGateway(gatewayID, GatewayAddress, impl, nil, nil,
+		gossip.DefaultManagerFlags())
+	defer gateway.Shutdown()
+
+	nodeID := id.NewIdFromString("test", id.Node, t)
+	server := node.StartNode(nodeID, getNextServerAddress(), 0,
+		node.NewImplementation(), testkeys.GetNodeCert(),
+		testkeys.GetNodeKey())
+	defer server.Shutdown()
+
+	manager := connect.NewManagerTesting(t)
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(gatewayID, GatewayAddress, nil, params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+
+	_, err = server.SendHostAddressUpdate(host, "0.0.0.0:11420")
+	if err == nil {
+		t.Errorf("SendHostAddressUpdate did not error for an unauthenticated node.")
+	}
+	if called {
+		t.Errorf("Handler called for an unauthenticated node.")
+	}
+}
+
+// Tests that messages mirrored by an unauthenticated gateway are rejected
+// without reaching the handler and that the shortfall of replicas is
+// reported.
+func TestComms_MirrorRoundMessages_Unauthenticated(t *testing.T) {
+	keyData := testkeys.LoadFromPath(testkeys.GetNodeKeyPath())
+	certData := testkeys.LoadFromPath(testkeys.GetNodeCertPath())
+
+	peerAddress := getNextGatewayAddress()
+	peerID := id.NewIdFromString("peer", id.Gateway, t)
+	impl := NewImplementation()
+	called := false
+	impl.Functions.MirrorMessages = func(*mixmessages.RoundMessages,
+		*mixmessages.MirrorManifest, *connect.Auth) error {
+		called = true
+		return nil
+	}
+	peer := StartGateway(peerID, peerAddress, impl, nil, nil,
+		gossip.DefaultManagerFlags())
+	defer peer.Shutdown()
+
+	originID := id.NewIdFromString("origin", id.Gateway, t)
+	origin := StartGateway(originID, getNextGatewayAddress(),
+		NewImplementation(), certData, keyData, gossip.DefaultManagerFlags())
+	defer origin.Shutdown()
+
+	manager := connect.NewManagerTesting(t)
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(peerID, peerAddress, nil, params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+
+	msgs := &mixmessages.RoundMessages{
+		RoundId:  5,
+		Messages: []*mixmessages.Slot{{PayloadA: []byte("payload")}},
+	}
+	mirrored, err := origin.MirrorRoundMessages(
+		[]*connect.Host{host}, 1, msgs)
+	if err == nil {
+		t.Errorf("MirrorRoundMessages did not error for an " +
+			"unauthenticated gateway.")
+	}
+	if len(mirrored) != 0 {
+		t.Errorf("Messages mirrored to %d peers, expected none.",
+			len(mirrored))
+	}
+	if called {
+		t.Errorf("Handler called for an unauthenticated gateway.")
+	}
+}
+
+// Tests that slots are only accepted when marked with the scheme advertised
+// for their round.
+func TestComms_checkSlotScheme(t *testing.T) {
+	advertised := mixmessages.SlotScheme{Name: "cmix", Version: 2}
+	g := &Comms{
+		RoundSlotScheme: func(roundID uint64) (mixmessages.SlotScheme, bool) {
+			return advertised, roundID == 1
+		},
+	}
+	if err := g.checkSlotScheme("cmix/2", 1); err != nil {
+		t.Errorf("Advertised scheme rejected: %+v", err)
+	}
+	if err := g.checkSlotScheme("cmix/1", 1); err == nil {
+		t.Errorf("Scheme other than the advertised one accepted.")
+	}
+	if err := g.checkSlotScheme("", 1); err == nil {
+		t.Errorf("Unmarked slot accepted for a round with a newer scheme.")
+	}
+	if err := g.checkSlotScheme("cmix/1", 2); err != nil {
+		t.Errorf("Slot rejected for a round without a scheme: %+v", err)
+	}
+}
+
+// Tests that every Handler method has an endpoint and every endpoint a
+// Handler method.
+func TestHandler_Endpoints(t *testing.T) {
+	testutils.CheckEndpoints(t, (*Handler)(nil), &mixmessages.Gateway_ServiceDesc)
+}
@@ -28,7 +28,7 @@ func (g *Comms) SendGetPermissioningAddress(host *connect.Host) (string, error)
 		defer cancel()
 
 		// Send the message
-		resultMsg, err := pb.NewNodeClient(conn.GetGrpcConn()).
+		resultMsg, err := pb.NewNodeClient(g.Interceptors.ClientConn(conn.GetGrpcConn())).
 			GetPermissioningAddress(ctx, &messages.Ping{})
 		if err != nil {
 			return nil, errors.New(err.Error())
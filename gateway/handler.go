@@ -13,12 +13,32 @@ package gateway
 
 import (
 	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/elixxir/comms/authMetrics"
+	"gitlab.com/elixxir/comms/buildInfo"
+	"gitlab.com/elixxir/comms/capability"
+	"gitlab.com/elixxir/comms/channelBinding"
+	"gitlab.com/elixxir/comms/chaos"
+	// Registers the compressors the server accepts
+	_ "gitlab.com/elixxir/comms/compression"
+	"gitlab.com/elixxir/comms/drain"
+	"gitlab.com/elixxir/comms/dualStack"
+	"gitlab.com/elixxir/comms/endpointSwitch"
+	"gitlab.com/elixxir/comms/health"
+	"gitlab.com/elixxir/comms/hostCache"
+	"gitlab.com/elixxir/comms/instrumentation"
+	"gitlab.com/elixxir/comms/interceptors"
+	"gitlab.com/elixxir/comms/messageSize"
+	"gitlab.com/elixxir/comms/messageStore"
 	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/rateLimit"
+	"gitlab.com/elixxir/comms/unixSocket"
+	"gitlab.com/elixxir/comms/validation"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/comms/gossip"
 	"gitlab.com/xx_network/comms/messages"
 	"gitlab.com/xx_network/primitives/id"
 	"runtime/debug"
+	"time"
 )
 
 // Comms object bundles low-level connect.ProtoComms,
@@ -27,10 +47,61 @@ type Comms struct {
 	*gossip.Manager
 	*connect.ProtoComms
 	handler Handler
-	*pb.UnimplementedGatewayServer
-	*messages.UnimplementedGenericServer
+	// Results of reverse-authentication handshakes with this gateway
+	AuthMetrics *authMetrics.Tracker
+	// Capability tokens issued to this gateway by permissioning
+	Capabilities *capability.Store
+	// If set, reverse-authentication tokens are bound to the TLS session
+	// they are issued over
+	ChannelBinding *channelBinding.Binder
+	// If nonzero, how long the gateway keeps messages, which is advertised
+	// to clients in the Retention of message storage responses
+	MessageRetention time.Duration
+	// Maximum number of messages in each page of a paged RequestMessages
+	// response. If zero, a page holds all remaining messages.
+	MessagePageSize int
+	// If set, returns the slot scheme advertised for a round. Slots marked
+	// with another scheme are rejected before reaching the handler.
+	RoundSlotScheme func(roundID uint64) (pb.SlotScheme, bool)
+	// Describes what this server is running to remote callers
+	BuildInfo *buildInfo.Server
+	// Liveness and readiness probes of this server
+	Health *health.Server
+	// Interceptors run on the calls received and sent by this server
+	Interceptors *interceptors.Chain
+	// Limits on the size of the messages sent and received by this server
+	MessageSize *messageSize.Limiter
+	// Validators checking the requests received before they reach the
+	// handler
+	Validators *validation.Validators
+	// Holds the messages accepted from clients until their round is
+	// completed. Nothing is stored by default; set a messageStore.Memory, or
+	// a persistent store to recover undelivered messages after a restart.
+	Storage messageStore.Store
+	// Endpoints disabled at runtime
+	Switches *endpointSwitch.Switches
+	// Faults injected into calls received by this gateway, for testing. It
+	// has no rules until some are added.
+	Faults *chaos.Injector
+	// Applies the address updates pushed by nodes to their hosts
+	AddressUpdates *hostCache.AddressUpdates
+	// Limits the rate of the unauthenticated relay calls received on each
+	// connection
+	RelayLimiter *rateLimit.ConnLimiter
+	pb.UnimplementedGatewayServer
+	messages.UnimplementedGenericServer
+	// Callbacks reporting each send
+	sendHooks instrumentation.Hooks
+	// Addresses listened on alongside the one of the ProtoComms
+	extraAddresses []string
 }
 
+// Comms is checked at compile time to serve its gRPC services
+var (
+	_ pb.GatewayServer       = (*Comms)(nil)
+	_ messages.GenericServer = (*Comms)(nil)
+)
+
 // Handler describes the endpoint callbacks for Gateway.
 type Handler interface {
 	PutMessage(message *pb.GatewaySlot, ipAddr string) (*pb.GatewaySlotResponse, error)
@@ -44,36 +115,105 @@ type Handler interface {
 	RequestTlsCert(message *pb.RequestGatewayCert) (*pb.GatewayCertificate, error)
 	BatchNodeRegistration(msg *pb.SignedClientBatchKeyRequest) (*pb.SignedBatchKeyResponse, error)
 	RequestBatchMessages(msg *pb.GetMessagesBatch) (*pb.GetMessagesResponseBatch, error)
+	NotifyAddressUpdate(update *pb.NDF, auth *connect.Auth) error
+	MirrorMessages(msgs *pb.RoundMessages, manifest *pb.MirrorManifest, auth *connect.Auth) error
+	StreamRoundUpdates(msg *pb.GatewayPoll, interest [][]byte, stream pb.Gateway_StreamRoundUpdatesServer) error
+	RelayMessage(destination *id.ID, payload []byte) error
+	RequestInclusionProof(msg *pb.GatewaySlot) (slotIndex uint32, included bool, err error)
+	UpdateHostAddress(update *pb.AddressUpdate, auth *connect.Auth) error
 }
 
 // StartGateway starts a new gateway on the address:port specified by localServer
 // and a callback interface for gateway operations
 // with given path to public and private key for TLS connection.
+// localServer may list several comma separated addresses, e.g.
+// "0.0.0.0:22840,[::]:22840", to listen on both IPv4 and IPv6.
 func StartGateway(id *id.ID, localServer string, handler Handler,
 	certPem, keyPem []byte, gossipFlags gossip.ManagerFlags) *Comms {
 
 	// Initialize the low-level comms listeners
+	localServer, extraAddresses := dualStack.Split(localServer)
 	pc, err := connect.StartCommServer(id, localServer,
 		certPem, keyPem, nil)
 	if err != nil {
 		jww.FATAL.Panicf("Unable to StartCommServer: %+v", err)
 	}
 	gatewayServer := Comms{
-		handler:    handler,
-		ProtoComms: pc,
-		Manager:    gossip.NewManager(pc, gossipFlags),
+		handler:        handler,
+		ProtoComms:     pc,
+		Manager:        gossip.NewManager(pc, gossipFlags),
+		AuthMetrics:    authMetrics.NewTracker(),
+		Capabilities:   capability.NewStore(),
+		AddressUpdates: hostCache.NewAddressUpdates(pc.Manager),
+		extraAddresses: extraAddresses,
 	}
 
 	// Register the high-level comms endpoint functionality
 	grpcServer := gatewayServer.GetServer()
-	pb.RegisterGatewayServer(grpcServer, &gatewayServer)
+	gatewayServer.Switches = endpointSwitch.NewSwitches()
+	gatewayServer.Faults = chaos.NewInjector(0)
+	gatewayServer.Interceptors = interceptors.New()
+	gatewayServer.MessageSize = messageSize.NewLimiter(messageSize.Limits{})
+	gatewayServer.MessageSize.Install(gatewayServer.Interceptors)
+	gatewayServer.Validators = newValidators()
+	gatewayServer.RelayLimiter = rateLimit.NewConnLimiter(DefaultRelayRate,
+		rateLimit.DefaultMaxConnections)
+	gatewayServer.Interceptors.AddUnaryServerInterceptor(
+		gatewayServer.Validators.UnaryServerInterceptor())
+	gatewayServer.Switches.Register(grpcServer, gatewayServer.Interceptors.Wrap(
+		gatewayServer.Faults.Wrap(&pb.Gateway_ServiceDesc)), &gatewayServer)
+	gatewayServer.Switches.RegisterAdmin(grpcServer, gatewayServer.authenticatedReceiver)
 	messages.RegisterGenericServer(grpcServer, &gatewayServer)
+	gatewayServer.BuildInfo = buildInfo.NewServer()
+	gatewayServer.BuildInfo.Register(grpcServer)
+	gatewayServer.Health = health.NewServer(handler)
+	gatewayServer.Health.Register(grpcServer)
 	gossip.RegisterGossipServer(grpcServer, gatewayServer.Manager)
 
 	pc.ServeWithWeb()
+	if err = gatewayServer.serveExtraAddresses(); err != nil {
+		jww.FATAL.Panicf("Unable to StartCommServer: %+v", err)
+	}
 	return &gatewayServer
 }
 
+// ServeAddresses additionally serves the gateway on the TCP addresses, e.g.
+// an IPv6 address alongside the IPv4 address it was started on. Unlike the
+// addresses listed when starting the gateway, these must be served again
+// after RestartGateway.
+func (g *Comms) ServeAddresses(addresses ...string) (*dualStack.Listeners, error) {
+	return dualStack.Serve(g.GetServer(), addresses)
+}
+
+// serveExtraAddresses serves the addresses listed after the first when
+// starting the gateway.
+func (g *Comms) serveExtraAddresses() error {
+	if len(g.extraAddresses) == 0 {
+		return nil
+	}
+	_, err := g.ServeAddresses(g.extraAddresses...)
+	return err
+}
+
+// ServeUnixSocket additionally serves the gateway on the Unix domain socket
+// at the address, e.g. "unix:///run/xxnetwork/gateway.sock", so that a node
+// on the same machine can reach it without going through TCP. The socket
+// must be served again after RestartGateway.
+func (g *Comms) ServeUnixSocket(address string) (*unixSocket.Listener, error) {
+	return unixSocket.Serve(g.GetServer(), address)
+}
+
+// ShutdownGraceful shuts down the gateway like Shutdown, but first stops it
+// accepting new RPCs and waits up to the timeout for in-flight RPCs, including
+// active streams, to complete before closing all host connections. Returns
+// true if every in-flight RPC completed in time.
+func (g *Comms) ShutdownGraceful(timeout time.Duration) bool {
+	g.Health.SetDraining()
+	completed := drain.Stop(g.GetServer(), timeout)
+	g.ProtoComms.Shutdown()
+	return completed
+}
+
 // RestartGateway shuts down &restarts the underlying protocomms server,
 // re-registers grpc handlers & starts basic listeners again.  Intended for use
 // before replacing https certificates
@@ -85,12 +225,16 @@ func (g *Comms) RestartGateway() error {
 	}
 	// Register the high-level comms endpoint functionality
 	grpcServer := g.GetServer()
-	pb.RegisterGatewayServer(grpcServer, g)
+	g.Switches.Register(grpcServer,
+		g.Interceptors.Wrap(g.Faults.Wrap(&pb.Gateway_ServiceDesc)), g)
+	g.Switches.RegisterAdmin(grpcServer, g.authenticatedReceiver)
 	messages.RegisterGenericServer(grpcServer, g)
+	g.BuildInfo.Register(grpcServer)
+	g.Health.Register(grpcServer)
 	gossip.RegisterGossipServer(grpcServer, g.Manager)
 
 	g.ProtoComms.ServeWithWeb()
-	return nil
+	return g.serveExtraAddresses()
 }
 
 // implementationFunctions for the Handler interface.
@@ -106,6 +250,12 @@ type implementationFunctions struct {
 	RequestTlsCert          func(message *pb.RequestGatewayCert) (*pb.GatewayCertificate, error)
 	BatchNodeRegistration   func(msg *pb.SignedClientBatchKeyRequest) (*pb.SignedBatchKeyResponse, error)
 	RequestBatchMessages    func(msg *pb.GetMessagesBatch) (*pb.GetMessagesResponseBatch, error)
+	NotifyAddressUpdate     func(update *pb.NDF, auth *connect.Auth) error
+	MirrorMessages          func(msgs *pb.RoundMessages, manifest *pb.MirrorManifest, auth *connect.Auth) error
+	StreamRoundUpdates      func(msg *pb.GatewayPoll, interest [][]byte, stream pb.Gateway_StreamRoundUpdatesServer) error
+	RelayMessage            func(destination *id.ID, payload []byte) error
+	RequestInclusionProof   func(msg *pb.GatewaySlot) (uint32, bool, error)
+	UpdateHostAddress       func(update *pb.AddressUpdate, auth *connect.Auth) error
 }
 
 // Implementation allows users of the client library to set the
@@ -167,6 +317,30 @@ func NewImplementation() *Implementation {
 				warn(um)
 				return &pb.GetMessagesResponseBatch{}, nil
 			},
+			NotifyAddressUpdate: func(update *pb.NDF, auth *connect.Auth) error {
+				warn(um)
+				return nil
+			},
+			MirrorMessages: func(msgs *pb.RoundMessages, manifest *pb.MirrorManifest, auth *connect.Auth) error {
+				warn(um)
+				return nil
+			},
+			StreamRoundUpdates: func(msg *pb.GatewayPoll, interest [][]byte, stream pb.Gateway_StreamRoundUpdatesServer) error {
+				warn(um)
+				return nil
+			},
+			RelayMessage: func(destination *id.ID, payload []byte) error {
+				warn(um)
+				return nil
+			},
+			RequestInclusionProof: func(msg *pb.GatewaySlot) (uint32, bool, error) {
+				warn(um)
+				return 0, false, nil
+			},
+			UpdateHostAddress: func(update *pb.AddressUpdate, auth *connect.Auth) error {
+				warn(um)
+				return nil
+			},
 		},
 	}
 }
@@ -223,3 +397,43 @@ func (s *Implementation) BatchNodeRegistration(msg *pb.SignedClientBatchKeyReque
 func (s *Implementation) RequestBatchMessages(msg *pb.GetMessagesBatch) (*pb.GetMessagesResponseBatch, error) {
 	return s.Functions.RequestBatchMessages(msg)
 }
+
+// NotifyAddressUpdate handles Node -> Gateway notifications that the
+// addresses of the node's team have changed.
+func (s *Implementation) NotifyAddressUpdate(update *pb.NDF, auth *connect.Auth) error {
+	return s.Functions.NotifyAddressUpdate(update, auth)
+}
+
+// MirrorMessages handles Gateway -> Gateway replication of the messages
+// stored for a round. The manifest has been verified against the messages.
+func (s *Implementation) MirrorMessages(msgs *pb.RoundMessages,
+	manifest *pb.MirrorManifest, auth *connect.Auth) error {
+	return s.Functions.MirrorMessages(msgs, manifest, auth)
+}
+
+// StreamRoundUpdates handles a Client -> Gateway subscription to round
+// updates. The interest holds the ephemeral IDs the client wants updates for;
+// if empty, the client wants every update.
+func (s *Implementation) StreamRoundUpdates(msg *pb.GatewayPoll,
+	interest [][]byte, stream pb.Gateway_StreamRoundUpdatesServer) error {
+	return s.Functions.StreamRoundUpdates(msg, interest, stream)
+}
+
+// RelayMessage handles the relay of an opaque payload to the destination,
+// which the gateway forwards without reading.
+func (s *Implementation) RelayMessage(destination *id.ID, payload []byte) error {
+	return s.Functions.RelayMessage(destination, payload)
+}
+
+// RequestInclusionProof returns the index of the slot the message was
+// included at in its round, or false if it was not included (yet).
+func (s *Implementation) RequestInclusionProof(msg *pb.GatewaySlot) (uint32, bool, error) {
+	return s.Functions.RequestInclusionProof(msg)
+}
+
+// UpdateHostAddress handles a Node -> Gateway push of the node's new address.
+// The update has been verified and applied to the node's host.
+func (s *Implementation) UpdateHostAddress(update *pb.AddressUpdate,
+	auth *connect.Auth) error {
+	return s.Functions.UpdateHostAddress(update, auth)
+}
@@ -0,0 +1,100 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains gateway -> gateway replication of stored messages
+
+package gateway
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/netTime"
+)
+
+// MirrorRoundMessages replicates the messages this gateway stored for a round
+// to the given number of peers, so clients can retrieve them from a replica
+// if this gateway goes offline. Peers are tried in order until enough have
+// accepted the messages. The messages are sent with a manifest signed by
+// this gateway. It returns the IDs of the peers holding a replica, along with
+// an error if there are fewer than requested.
+func (g *Comms) MirrorRoundMessages(peers []*connect.Host, replicas int,
+	msgs *pb.RoundMessages) ([]*id.ID, error) {
+	manifest := pb.NewMirrorManifest(g.GetId(), msgs, netTime.Now())
+	if err := manifest.Sign(g.GetPrivateKey()); err != nil {
+		return nil, errors.Errorf("Failed to sign mirror manifest for "+
+			"round %d: %+v", msgs.GetRoundId(), err)
+	}
+
+	mirrored := make([]*id.ID, 0, replicas)
+	for _, peer := range peers {
+		if len(mirrored) == replicas {
+			break
+		}
+		if err := g.SendMirrorMessages(peer, msgs, manifest); err != nil {
+			jww.WARN.Printf("Failed to mirror messages for round %d to "+
+				"%s: %+v", msgs.GetRoundId(), peer.GetId(), err)
+			continue
+		}
+		mirrored = append(mirrored, peer.GetId())
+	}
+
+	if len(mirrored) < replicas {
+		return mirrored, errors.Errorf("Mirrored messages for round %d to "+
+			"%d of %d peers", msgs.GetRoundId(), len(mirrored), replicas)
+	}
+	return mirrored, nil
+}
+
+// SendMirrorMessages sends the messages stored for a round to a peer gateway
+// along with their signed manifest.
+func (g *Comms) SendMirrorMessages(host *connect.Host,
+	msgs *pb.RoundMessages, manifest *pb.MirrorManifest) error {
+	mirrored := &pb.MirroredMessages{
+		Messages: msgs,
+		Manifest: manifest,
+	}
+
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+
+		// Pack data into authenticated message
+		authMsg, err := g.PackAuthenticatedMessage(mirrored, host, false)
+		if err != nil {
+			return nil, err
+		}
+
+		// Send the message
+		resultMsg, err := pb.NewGatewayClient(g.Interceptors.ClientConn(conn.GetGrpcConn())).
+			MirrorMessages(ctx, authMsg)
+		if err != nil {
+			return nil, err
+		}
+
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	// Execute the Send function
+	jww.TRACE.Printf("Sending MirrorMessages for round %d",
+		msgs.GetRoundId())
+	resultMsg, err := g.Send(host, f)
+	if err != nil {
+		return err
+	}
+
+	// Marshall the result
+	result := &messages.Ack{}
+	return ptypes.UnmarshalAny(resultMsg, result)
+}
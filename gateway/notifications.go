@@ -31,7 +31,7 @@ func (g *Comms) SendNotificationBatch(host *connect.Host, notifBatch *pb.Notific
 		}
 
 		// Send the message
-		_, err = pb.NewNotificationBotClient(conn.GetGrpcConn()).
+		_, err = pb.NewNotificationBotClient(g.Interceptors.ClientConn(conn.GetGrpcConn())).
 			ReceiveNotificationBatch(ctx, authMsg)
 		return nil, err
 	}
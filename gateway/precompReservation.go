@@ -0,0 +1,123 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains send functions reserving precomputed rounds for batches
+
+package gateway
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+)
+
+// SendReservePrecomputation reserves a precomputed round on the node for a
+// batch of the given size and returns the reserved round. The batch must then
+// be confirmed with SendConfirmPrecomputation before the reservation expires,
+// or released with SendReleasePrecomputation.
+func (g *Comms) SendReservePrecomputation(host *connect.Host,
+	batchSize uint32) (*pb.RoundInfo, error) {
+
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+		// Pack message into an authenticated message
+		authMsg, err := g.PackAuthenticatedMessage(
+			&pb.BatchInfo{BatchSize: batchSize}, host, false)
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+		// Send the message
+		resultMsg, err := pb.NewNodeClient(g.Interceptors.ClientConn(conn.GetGrpcConn())).
+			ReservePrecomputation(ctx, authMsg)
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	// Execute the Send function
+	jww.TRACE.Printf("Sending Reserve Precomputation message for a batch "+
+		"of %d...", batchSize)
+	resultMsg, err := g.Send(host, f)
+	if err != nil {
+		return nil, err
+	}
+
+	// Marshall the result
+	result := &pb.RoundInfo{}
+	return result, ptypes.UnmarshalAny(resultMsg, result)
+}
+
+// SendConfirmPrecomputation confirms to the node that the batch for the
+// reserved round is being uploaded.
+func (g *Comms) SendConfirmPrecomputation(host *connect.Host,
+	roundID uint64) error {
+
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+		// Pack message into an authenticated message
+		authMsg, err := g.PackAuthenticatedMessage(
+			&pb.RoundInfo{ID: roundID}, host, false)
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+		// Send the message
+		resultMsg, err := pb.NewNodeClient(g.Interceptors.ClientConn(conn.GetGrpcConn())).
+			ConfirmPrecomputation(ctx, authMsg)
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	// Execute the Send function
+	jww.TRACE.Printf("Sending Confirm Precomputation message for round "+
+		"%d...", roundID)
+	_, err := g.Send(host, f)
+	return err
+}
+
+// SendReleasePrecomputation releases the reservation of the round on the
+// node, so its precomputation can be used by another batch.
+func (g *Comms) SendReleasePrecomputation(host *connect.Host,
+	roundID uint64) error {
+
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+		// Pack message into an authenticated message
+		authMsg, err := g.PackAuthenticatedMessage(
+			&pb.RoundInfo{ID: roundID}, host, false)
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+		// Send the message
+		resultMsg, err := pb.NewNodeClient(g.Interceptors.ClientConn(conn.GetGrpcConn())).
+			ReleasePrecomputation(ctx, authMsg)
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	// Execute the Send function
+	jww.TRACE.Printf("Sending Release Precomputation message for round "+
+		"%d...", roundID)
+	_, err := g.Send(host, f)
+	return err
+}
@@ -0,0 +1,49 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package gateway
+
+import (
+	"testing"
+
+	"gitlab.com/elixxir/comms/node"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/gossip"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// Tests that the node refuses reservations from unauthenticated gateways.
+func TestComms_SendReservePrecomputation_Unauthenticated(t *testing.T) {
+	GatewayAddress := getNextGatewayAddress()
+	ServerAddress := getNextServerAddress()
+	testID := id.NewIdFromString("test", id.Gateway, t)
+	nodeID := id.NewIdFromString("test", id.Node, t)
+	gateway := StartGateway(testID, GatewayAddress, NewImplementation(), nil,
+		nil, gossip.DefaultManagerFlags())
+	server := node.StartNode(nodeID, ServerAddress, 0, node.NewImplementation(),
+		nil, nil)
+	defer gateway.Shutdown()
+	defer server.Shutdown()
+	manager := connect.NewManagerTesting(t)
+
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(nodeID, ServerAddress, nil, params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+
+	if _, err = gateway.SendReservePrecomputation(host, 32); err == nil {
+		t.Errorf("Reservation accepted from an unauthenticated gateway.")
+	}
+	if err = gateway.SendConfirmPrecomputation(host, 1); err == nil {
+		t.Errorf("Confirmation accepted from an unauthenticated gateway.")
+	}
+	if err = gateway.SendReleasePrecomputation(host, 1); err == nil {
+		t.Errorf("Release accepted from an unauthenticated gateway.")
+	}
+}
@@ -30,7 +30,7 @@ func (g *Comms) SendRequestClientKey(host *connect.Host,
 		defer cancel()
 
 		// Send the message
-		resultMsg, err := pb.NewGatewayClient(conn.GetGrpcConn()).
+		resultMsg, err := pb.NewGatewayClient(g.Interceptors.ClientConn(conn.GetGrpcConn())).
 			RequestClientKey(ctx, messages)
 		if err != nil {
 			return nil, err
@@ -71,7 +71,7 @@ func (g *Comms) SendPutMessageProxy(host *connect.Host, messages *pb.GatewaySlot
 		}
 
 		// Send the message
-		resultMsg, err := pb.NewGatewayClient(conn.GetGrpcConn()).
+		resultMsg, err := pb.NewGatewayClient(g.Interceptors.ClientConn(conn.GetGrpcConn())).
 			PutMessageProxy(ctx, authMsg)
 		if err != nil {
 			return nil, err
@@ -112,7 +112,7 @@ func (g *Comms) SendPutManyMessagesProxy(host *connect.Host, messages *pb.Gatewa
 		}
 
 		// Send the message
-		resultMsg, err := pb.NewGatewayClient(conn.GetGrpcConn()).
+		resultMsg, err := pb.NewGatewayClient(g.Interceptors.ClientConn(conn.GetGrpcConn())).
 			PutManyMessagesProxy(ctx, authMsg)
 		if err != nil {
 			return nil, err
@@ -145,7 +145,7 @@ func (g *Comms) SendRequestMessages(host *connect.Host,
 		defer cancel()
 
 		// Send the message
-		resultMsg, err := pb.NewGatewayClient(conn.GetGrpcConn()).
+		resultMsg, err := pb.NewGatewayClient(g.Interceptors.ClientConn(conn.GetGrpcConn())).
 			RequestMessages(ctx, messages)
 		if err != nil {
 			return nil, err
@@ -33,7 +33,7 @@ func (g *Comms) SendRequestClientKeyMessage(host *connect.Host,
 			return nil, errors.New(err.Error())
 		}
 		// Send the message
-		resultMsg, err := pb.NewNodeClient(conn.GetGrpcConn()).
+		resultMsg, err := pb.NewNodeClient(g.Interceptors.ClientConn(conn.GetGrpcConn())).
 			RequestClientKey(ctx, authMsg)
 		if err != nil {
 			return nil, errors.New(err.Error())
@@ -70,7 +70,7 @@ func (g *Comms) SendPoll(host *connect.Host,
 		}
 
 		// Send the message
-		resultMsg, err := pb.NewNodeClient(conn.GetGrpcConn()).
+		resultMsg, err := pb.NewNodeClient(g.Interceptors.ClientConn(conn.GetGrpcConn())).
 			Poll(ctx, authMsg)
 		if err != nil {
 			return nil, errors.New(err.Error())
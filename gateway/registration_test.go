@@ -10,6 +10,8 @@ package gateway
 import (
 	pb "gitlab.com/elixxir/comms/mixmessages"
 	"gitlab.com/elixxir/comms/node"
+	"gitlab.com/elixxir/comms/testkeys"
+	"gitlab.com/elixxir/comms/testutils"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/comms/gossip"
 	"gitlab.com/xx_network/comms/messages"
@@ -73,3 +75,53 @@ func TestPoll(t *testing.T) {
 		t.Errorf("TestDemandNdf: Error received: %s", err)
 	}
 }
+
+// Tests that the registration receipt signed by the node is returned in the
+// response by SendRequestClientKeyMessage to be passed on to the client.
+func TestComms_SendRequestClientKeyMessage_Receipt(t *testing.T) {
+	keyData := testkeys.LoadFromPath(testkeys.GetNodeKeyPath())
+	certData := testkeys.LoadFromPath(testkeys.GetNodeCertPath())
+
+	GatewayAddress := getNextGatewayAddress()
+	ServerAddress := getNextServerAddress()
+	testID := id.NewIdFromString("test", id.Generic, t)
+	gateway := StartGateway(testID, GatewayAddress, NewImplementation(), nil,
+		nil, gossip.DefaultManagerFlags())
+
+	expected := &pb.SignedKeyResponse{KeyResponse: []byte("key response")}
+	impl := node.NewImplementation()
+	impl.Functions.RequestClientKey = func(*pb.SignedClientKeyRequest,
+		*connect.Auth) (*pb.SignedKeyResponse, error) {
+		return expected, nil
+	}
+	server := node.StartNode(testID, ServerAddress, 0, impl, certData, keyData)
+	defer gateway.Shutdown()
+	defer server.Shutdown()
+	manager := connect.NewManagerTesting(t)
+
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(testID, ServerAddress, certData, params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+
+	response, err := gateway.SendRequestClientKeyMessage(host,
+		&pb.SignedClientKeyRequest{})
+	if err != nil {
+		t.Fatalf("SendRequestClientKeyMessage: Error received: %+v", err)
+	}
+
+	receipt := response.GetReceipt()
+	if receipt == nil {
+		t.Fatalf("No registration receipt was returned with the response.")
+	}
+
+	pubKey, err := testutils.LoadPublicKeyTesting(t)
+	if err != nil {
+		t.Fatalf("Failed to load public key: %+v", err)
+	}
+	if err = receipt.Verify(expected, pubKey); err != nil {
+		t.Errorf("Failed to verify receipt: %+v", err)
+	}
+}
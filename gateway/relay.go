@@ -0,0 +1,65 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the Gateway -> Gateway relay of sealed payloads
+
+package gateway
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/rateLimit"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// MaxRelayPayloadSize is the largest payload, in bytes, relayed by a gateway.
+const MaxRelayPayloadSize = 64 * 1024
+
+// DefaultRelayRate is the rate relay calls are allowed at on each connection
+// to a gateway by default.
+var DefaultRelayRate = rateLimit.Rate{PerSecond: 10, Burst: 20}
+
+// SendRelayMessage Gateway -> Gateway forwarding of an opaque payload
+// addressed to the destination. The message is not authenticated, so the
+// payload, which is sealed for the destination or an entity it is relayed
+// on to, is passed on without identifying its original sender.
+func (g *Comms) SendRelayMessage(host *connect.Host, destination *id.ID,
+	payload []byte) (*messages.Ack, error) {
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+
+		// Send the message
+		resultMsg, err := pb.NewGatewayClient(g.Interceptors.ClientConn(conn.GetGrpcConn())).
+			RelayMessage(ctx, &pb.RelayedMessage{
+				Destination: destination.Marshal(),
+				Payload:     payload,
+			})
+		if err != nil {
+			return nil, err
+		}
+
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	// Execute the Send function
+	jww.TRACE.Printf("Relaying %d bytes to %s", len(payload), destination)
+	resultMsg, err := g.Send(host, f)
+	if err != nil {
+		return nil, err
+	}
+
+	// Marshall the result
+	result := &messages.Ack{}
+	return result, ptypes.UnmarshalAny(resultMsg, result)
+}
@@ -0,0 +1,110 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"testing"
+
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/rateLimit"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/gossip"
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Tests that relayed payloads reach the handler of the receiving gateway
+// with their destination.
+func TestComms_SendRelayMessage(t *testing.T) {
+	senderAddress := getNextGatewayAddress()
+	receiverAddress := getNextGatewayAddress()
+	senderID := id.NewIdFromString("sender", id.Gateway, t)
+	receiverID := id.NewIdFromString("receiver", id.Gateway, t)
+	destination := id.NewIdFromString("destination", id.Node, t)
+	payload := []byte("sealed payload")
+
+	received := make(chan []byte, 1)
+	impl := NewImplementation()
+	impl.Functions.RelayMessage = func(dest *id.ID, p []byte) error {
+		if !dest.Cmp(destination) {
+			t.Errorf("Received destination %s, expected %s", dest,
+				destination)
+		}
+		received <- p
+		return nil
+	}
+
+	sender := StartGateway(senderID, senderAddress, NewImplementation(), nil,
+		nil, gossip.DefaultManagerFlags())
+	receiver := StartGateway(receiverID, receiverAddress, impl, nil, nil,
+		gossip.DefaultManagerFlags())
+	defer sender.Shutdown()
+	defer receiver.Shutdown()
+
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := connect.NewManagerTesting(t).AddHost(receiverID,
+		receiverAddress, nil, params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+
+	if _, err = sender.SendRelayMessage(host, destination, payload); err != nil {
+		t.Fatalf("SendRelayMessage returned an error: %+v", err)
+	}
+	if p := <-received; !bytes.Equal(p, payload) {
+		t.Errorf("Received payload %q, expected %q", p, payload)
+	}
+}
+
+// Tests that oversized payloads and calls beyond the rate of a connection
+// are rejected without reaching the handler.
+func TestComms_RelayMessage_Limits(t *testing.T) {
+	gwID := id.NewIdFromString("receiver", id.Gateway, t)
+	destination := id.NewIdFromString("destination", id.Node, t)
+	relayed := 0
+	impl := NewImplementation()
+	impl.Functions.RelayMessage = func(*id.ID, []byte) error {
+		relayed++
+		return nil
+	}
+	gw := StartGateway(gwID, getNextGatewayAddress(), impl, nil, nil,
+		gossip.DefaultManagerFlags())
+	defer gw.Shutdown()
+	gw.RelayLimiter = rateLimit.NewConnLimiter(
+		rateLimit.Rate{PerSecond: 0.001, Burst: 1}, 10)
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1000}})
+	msg := &pb.RelayedMessage{
+		Destination: destination.Marshal(),
+		Payload:     make([]byte, MaxRelayPayloadSize+1),
+	}
+	_, err := gw.RelayMessage(ctx, msg)
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Oversized payload returned %v", err)
+	}
+
+	msg.Payload = []byte("sealed payload")
+	if _, err = gw.RelayMessage(ctx, msg); err != nil {
+		t.Fatalf("RelayMessage returned an error: %+v", err)
+	}
+	_, err = gw.RelayMessage(ctx, msg)
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Call beyond the rate returned %v", err)
+	}
+
+	if relayed != 1 {
+		t.Errorf("Handler called %d times, expected 1", relayed)
+	}
+}
@@ -0,0 +1,125 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains fan-out of round updates to subscribed clients
+
+package gateway
+
+import (
+	"sync"
+
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// roundUpdateBuffer is the number of updates queued for a subscriber before
+// it is considered too slow and disconnected.
+const roundUpdateBuffer = 64
+
+// subscriber is a client subscribed to round updates.
+type subscriber struct {
+	// Ephemeral IDs of interest; if empty, every update is wanted
+	interest map[string]struct{}
+	updates  chan *pb.RoundInfo
+	// Closed when the subscriber is disconnected for falling behind
+	dropped  chan struct{}
+	dropOnce sync.Once
+}
+
+// wants returns true if the update is for one of the ephemeral IDs of the
+// subscriber. Updates without recipients are for every subscriber.
+func (s *subscriber) wants(recipients [][]byte) bool {
+	if len(s.interest) == 0 || len(recipients) == 0 {
+		return true
+	}
+	for _, ephID := range recipients {
+		if _, exists := s.interest[string(ephID)]; exists {
+			return true
+		}
+	}
+	return false
+}
+
+// RoundUpdateHub pushes round updates to the clients subscribed through
+// StreamRoundUpdates. A Handler serves a subscription by calling Serve, and
+// the gateway publishes each round update as it arrives.
+type RoundUpdateHub struct {
+	subscribers map[*subscriber]struct{}
+	mux         sync.RWMutex
+}
+
+// NewRoundUpdateHub returns a hub without subscribers.
+func NewRoundUpdateHub() *RoundUpdateHub {
+	return &RoundUpdateHub{subscribers: make(map[*subscriber]struct{})}
+}
+
+// Publish pushes the signed round update to every subscriber interested in
+// one of the recipients, which are the ephemeral IDs the round has messages
+// for. Updates with no recipients, such as network-wide state changes, go to
+// every subscriber. Subscribers too slow to keep up are disconnected and are
+// expected to resubscribe from the last round they received.
+func (h *RoundUpdateHub) Publish(round *pb.RoundInfo, recipients [][]byte) {
+	h.mux.RLock()
+	defer h.mux.RUnlock()
+	for s := range h.subscribers {
+		if !s.wants(recipients) {
+			continue
+		}
+		select {
+		case s.updates <- round:
+		default:
+			s.dropOnce.Do(func() { close(s.dropped) })
+		}
+	}
+}
+
+// Subscribers returns the number of clients subscribed.
+func (h *RoundUpdateHub) Subscribers() int {
+	h.mux.RLock()
+	defer h.mux.RUnlock()
+	return len(h.subscribers)
+}
+
+// Serve pushes published updates matching the interest over the stream until
+// the client disconnects or falls behind. Updates the client missed before
+// subscribing, i.e. those after msg.LastRound, must be sent by the caller
+// before calling Serve.
+func (h *RoundUpdateHub) Serve(interest [][]byte,
+	stream pb.Gateway_StreamRoundUpdatesServer) error {
+	s := &subscriber{
+		interest: make(map[string]struct{}, len(interest)),
+		updates:  make(chan *pb.RoundInfo, roundUpdateBuffer),
+		dropped:  make(chan struct{}),
+	}
+	for _, ephID := range interest {
+		s.interest[string(ephID)] = struct{}{}
+	}
+
+	h.mux.Lock()
+	h.subscribers[s] = struct{}{}
+	h.mux.Unlock()
+	defer func() {
+		h.mux.Lock()
+		delete(h.subscribers, s)
+		h.mux.Unlock()
+	}()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-s.dropped:
+			return status.Error(codes.ResourceExhausted,
+				"Round update subscriber fell behind")
+		case round := <-s.updates:
+			if err := stream.Send(round); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,45 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the storage of the messages accepted by the gateway
+
+package gateway
+
+import (
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+)
+
+// storePending stores messages the handler accepted for a round, so that
+// they can be recovered if the gateway restarts before the round is mixed.
+func (g *Comms) storePending(roundID uint64, msgs ...*pb.GatewaySlot) {
+	if g.Storage == nil || len(msgs) == 0 {
+		return
+	}
+	if err := g.Storage.AddPending(roundID, msgs...); err != nil {
+		jww.ERROR.Printf("Failed to store %d accepted messages for round "+
+			"%d: %+v", len(msgs), roundID, err)
+	}
+}
+
+// CompleteRound stores the batch of a round once it has been mixed, in place
+// of the messages pending for the round.
+func (g *Comms) CompleteRound(batch *pb.RoundMessages) error {
+	if g.Storage == nil {
+		return nil
+	}
+	if err := g.Storage.AddCompleted(batch); err != nil {
+		return errors.Errorf("Failed to store the batch of round %d: %+v",
+			batch.GetRoundId(), err)
+	}
+	if err := g.Storage.RemovePending(batch.GetRoundId()); err != nil {
+		return errors.Errorf("Failed to remove the pending messages of "+
+			"round %d: %+v", batch.GetRoundId(), err)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package gateway
+
+import (
+	"testing"
+
+	"gitlab.com/elixxir/comms/messageStore"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+)
+
+// Tests that completing a round replaces its pending messages with its batch.
+func TestComms_CompleteRound(t *testing.T) {
+	g := &Comms{Storage: messageStore.NewMemory(messageStore.EvictionPolicy{})}
+	g.storePending(5, &pb.GatewaySlot{RoundID: 5}, &pb.GatewaySlot{RoundID: 5})
+	if msgs, _ := g.Storage.Pending(5); len(msgs) != 2 {
+		t.Fatalf("Expected 2 pending messages, got %d.", len(msgs))
+	}
+
+	batch := &pb.RoundMessages{RoundId: 5}
+	if err := g.CompleteRound(batch); err != nil {
+		t.Fatalf("Failed to complete round: %+v", err)
+	}
+	if msgs, _ := g.Storage.Pending(5); len(msgs) != 0 {
+		t.Errorf("Pending messages kept after completion: %v", msgs)
+	}
+	if stored, exists, _ := g.Storage.Completed(5); !exists || stored != batch {
+		t.Errorf("Batch not stored: %v", stored)
+	}
+
+	// Without storage nothing is kept
+	g.Storage = nil
+	g.storePending(6, &pb.GatewaySlot{RoundID: 6})
+	if err := g.CompleteRound(&pb.RoundMessages{RoundId: 6}); err != nil {
+		t.Errorf("Failed to complete round without storage: %+v", err)
+	}
+}
@@ -0,0 +1,218 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package health serves liveness and readiness probes for comms servers, so
+// that orchestration systems can tell when a gateway or node should be
+// restarted or sent traffic. Probes are served on the standard gRPC health
+// service, which Kubernetes and grpc_health_probe query directly, under the
+// service names "liveness" and "readiness", and optionally over HTTP.
+package health
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// Service names of the probes on the gRPC health service. The empty service
+// name reports readiness.
+const (
+	LivenessService  = "liveness"
+	ReadinessService = "readiness"
+)
+
+// HTTP paths of the probes served by ServeHTTP.
+const (
+	LivenessPath  = "/livez"
+	ReadinessPath = "/readyz"
+)
+
+// Check returns nil if a dependency of the server is healthy.
+type Check func() error
+
+// Checker is implemented by handlers which check their own dependencies,
+// e.g. a database connection. Its check is added to the readiness probe of
+// the server it is passed to.
+type Checker interface {
+	HealthCheck() error
+}
+
+// Server serves the probes of a comms server. It is live while the process
+// is running and ready once its handlers are registered and every check
+// passes.
+type Server struct {
+	checks map[string]Check
+	// Set once the handlers are registered on the gRPC server
+	registered bool
+	// Set while the server is shutting down
+	draining bool
+	mux      sync.RWMutex
+	healthpb.UnimplementedHealthServer
+}
+
+// NewServer returns a Server for a comms server with the handler. If the
+// handler implements Checker, its check is added as "handler".
+func NewServer(handler interface{}) *Server {
+	s := &Server{checks: make(map[string]Check)}
+	if checker, ok := handler.(Checker); ok {
+		s.AddCheck("handler", checker.HealthCheck)
+	}
+	return s
+}
+
+// Register registers the server on the gRPC server. It must be called after
+// the handlers are registered, as it marks the server as ready for them.
+func (s *Server) Register(grpcServer *grpc.Server) {
+	healthpb.RegisterHealthServer(grpcServer, s)
+	s.mux.Lock()
+	s.registered = true
+	s.draining = false
+	s.mux.Unlock()
+}
+
+// AddCheck adds a named check to the readiness probe, replacing any check
+// with the same name.
+func (s *Server) AddCheck(name string, check Check) {
+	s.mux.Lock()
+	s.checks[name] = check
+	s.mux.Unlock()
+}
+
+// RemoveCheck removes the named check from the readiness probe.
+func (s *Server) RemoveCheck(name string) {
+	s.mux.Lock()
+	delete(s.checks, name)
+	s.mux.Unlock()
+}
+
+// SetDraining marks the server as shutting down, so that it is reported as
+// not ready and stops being sent new traffic, while remaining live.
+func (s *Server) SetDraining() {
+	s.mux.Lock()
+	s.draining = true
+	s.mux.Unlock()
+}
+
+// Live returns nil while the server is live.
+func (s *Server) Live() error {
+	return nil
+}
+
+// Ready returns nil if the server is ready, or an error listing the reasons
+// it is not, including every failing check.
+func (s *Server) Ready() error {
+	s.mux.RLock()
+	registered, draining := s.registered, s.draining
+	names := make([]string, 0, len(s.checks))
+	for name := range s.checks {
+		names = append(names, name)
+	}
+	checks := make(map[string]Check, len(s.checks))
+	for name, check := range s.checks {
+		checks[name] = check
+	}
+	s.mux.RUnlock()
+
+	if !registered {
+		return errors.New("Handlers are not registered")
+	}
+	if draining {
+		return errors.New("Server is shutting down")
+	}
+
+	// Checks run outside the lock, as they may be slow
+	sort.Strings(names)
+	var failures []string
+	for _, name := range names {
+		if err := checks[name](); err != nil {
+			failures = append(failures, name+": "+err.Error())
+		}
+	}
+	if len(failures) > 0 {
+		return errors.Errorf("Failed checks: %s",
+			strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// Check serves the probe of the requested service on the gRPC health
+// service. Unknown services return a NotFound error, as the protocol
+// requires.
+func (s *Server) Check(_ context.Context, req *healthpb.HealthCheckRequest) (
+	*healthpb.HealthCheckResponse, error) {
+	var err error
+	switch req.GetService() {
+	case "", ReadinessService:
+		err = s.Ready()
+	case LivenessService:
+		err = s.Live()
+	default:
+		return nil, status.Errorf(codes.NotFound, "Unknown service %q",
+			req.GetService())
+	}
+
+	if err != nil {
+		jww.DEBUG.Printf("Health check of %q failed: %+v", req.GetService(),
+			err)
+		return &healthpb.HealthCheckResponse{
+			Status: healthpb.HealthCheckResponse_NOT_SERVING}, nil
+	}
+	return &healthpb.HealthCheckResponse{
+		Status: healthpb.HealthCheckResponse_SERVING}, nil
+}
+
+// ServeHTTP serves the probes at LivenessPath and ReadinessPath, responding
+// with status 200 if the probe passes and 503 with the reason if it fails.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var err error
+	switch r.URL.Path {
+	case LivenessPath:
+		err = s.Live()
+	case ReadinessPath:
+		err = s.Ready()
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	_, _ = w.Write([]byte("ok\n"))
+}
+
+// StartHTTP serves the probes over HTTP on the address, for orchestration
+// systems which cannot probe gRPC. The returned server must be closed by the
+// caller.
+func (s *Server) StartHTTP(address string) (*http.Server, error) {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, errors.Errorf("Failed to listen for health probes on "+
+			"%s: %+v", address, err)
+	}
+
+	httpServer := &http.Server{Handler: s}
+	go func() {
+		err := httpServer.Serve(listener)
+		if err != nil && err != http.ErrServerClosed {
+			jww.ERROR.Printf("Health probe server on %s stopped: %+v",
+				address, err)
+		}
+	}()
+	return httpServer, nil
+}
@@ -0,0 +1,90 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// mockHandler is a handler checking its own dependencies.
+type mockHandler struct {
+	err error
+}
+
+func (m *mockHandler) HealthCheck() error {
+	return m.err
+}
+
+// checkStatus checks the status returned for the service.
+func checkStatus(s *Server, service string,
+	expected healthpb.HealthCheckResponse_ServingStatus, t *testing.T) {
+	resp, err := s.Check(context.Background(),
+		&healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		t.Fatalf("Check(%q) returned an error: %+v", service, err)
+	}
+	if resp.GetStatus() != expected {
+		t.Errorf("Check(%q) returned %s, expected %s", service,
+			resp.GetStatus(), expected)
+	}
+}
+
+// Tests that the server is only ready once registered, while its checks
+// pass and until it starts draining, and is live throughout.
+func TestServer_Check(t *testing.T) {
+	handler := &mockHandler{}
+	s := NewServer(handler)
+	checkStatus(s, ReadinessService, healthpb.HealthCheckResponse_NOT_SERVING, t)
+	checkStatus(s, LivenessService, healthpb.HealthCheckResponse_SERVING, t)
+
+	s.Register(grpc.NewServer())
+	checkStatus(s, ReadinessService, healthpb.HealthCheckResponse_SERVING, t)
+	checkStatus(s, "", healthpb.HealthCheckResponse_SERVING, t)
+
+	handler.err = errors.New("database unreachable")
+	checkStatus(s, ReadinessService, healthpb.HealthCheckResponse_NOT_SERVING, t)
+	handler.err = nil
+
+	s.AddCheck("failing", func() error { return errors.New("failed") })
+	checkStatus(s, ReadinessService, healthpb.HealthCheckResponse_NOT_SERVING, t)
+	s.RemoveCheck("failing")
+	checkStatus(s, ReadinessService, healthpb.HealthCheckResponse_SERVING, t)
+
+	s.SetDraining()
+	checkStatus(s, ReadinessService, healthpb.HealthCheckResponse_NOT_SERVING, t)
+	checkStatus(s, LivenessService, healthpb.HealthCheckResponse_SERVING, t)
+
+	if _, err := s.Check(context.Background(),
+		&healthpb.HealthCheckRequest{Service: "unknown"}); err == nil {
+		t.Errorf("No error for an unknown service.")
+	}
+}
+
+// Tests that the probes are served over HTTP.
+func TestServer_ServeHTTP(t *testing.T) {
+	s := NewServer(nil)
+	expected := map[string]int{
+		LivenessPath:  http.StatusOK,
+		ReadinessPath: http.StatusServiceUnavailable,
+		"/unknown":    http.StatusNotFound,
+	}
+	for path, code := range expected {
+		w := httptest.NewRecorder()
+		s.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		if w.Code != code {
+			t.Errorf("%s returned %d, expected %d", path, w.Code, code)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains application of signed updates of the address of a host
+
+package hostCache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/netTime"
+)
+
+// maxUpdateSkew is how far ahead of the local clock the timestamp of an
+// address update may be. Later timestamps are rejected, as a host applying
+// one could not apply any update of its own until that time.
+const maxUpdateSkew = 30 * time.Second
+
+// AddressUpdates applies address updates signed by hosts to their hosts in a
+// manager, so a host which moves can be reached before the next NDF lists
+// its new address.
+type AddressUpdates struct {
+	manager *connect.Manager
+	router  Router
+	// Timestamp of the last update applied to each host, so replayed
+	// updates cannot move a host back to an old address
+	applied map[id.ID]int64
+	mux     sync.Mutex
+}
+
+// NewAddressUpdates returns AddressUpdates applied to the hosts of the
+// manager.
+func NewAddressUpdates(manager *connect.Manager) *AddressUpdates {
+	return &AddressUpdates{
+		manager: manager,
+		router:  direct{},
+		applied: make(map[id.ID]int64),
+	}
+}
+
+// SetRouter sets the router changing the addresses of hosts, e.g. a
+// hostProxy.Proxy, so that moved hosts stay routed through it. Passing nil
+// updates the addresses of hosts directly.
+func (a *AddressUpdates) SetRouter(router Router) {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+	if router == nil {
+		router = direct{}
+	}
+	a.router = router
+}
+
+// Apply verifies the update against the certificate of the host it is for
+// and changes the address of the host. The host is disconnected so its next
+// send connects to the new address. Updates no newer than the last applied
+// to the host, or dated more than a small skew into the future, are
+// rejected. An update is only recorded as applied once the host has moved.
+func (a *AddressUpdates) Apply(update *pb.AddressUpdate) (*connect.Host,
+	error) {
+	hid, err := update.GetHostID()
+	if err != nil {
+		return nil, errors.Errorf("Failed to unmarshal ID of address "+
+			"update: %+v", err)
+	}
+	host, exists := a.manager.GetHost(hid)
+	if !exists {
+		return nil, errors.Errorf("Could not update address of %s: host "+
+			"does not exist", hid)
+	}
+	if host.GetPubKey() == nil {
+		return nil, errors.Errorf("Could not update address of %s: host "+
+			"has no certificate to verify the update against", hid)
+	}
+	if err = update.Verify(hid, host.GetPubKey()); err != nil {
+		return nil, errors.WithMessagef(err, "Could not update address "+
+			"of %s", hid)
+	}
+
+	if limit := netTime.Now().Add(maxUpdateSkew); update.GetTime().After(limit) {
+		return nil, errors.Errorf("Could not update address of %s: update "+
+			"from %s is in the future", hid, update.GetTime())
+	}
+
+	a.mux.Lock()
+	defer a.mux.Unlock()
+	if last, exists := a.applied[*hid]; exists && update.Timestamp <= last {
+		return nil, errors.Errorf("Could not update address of %s: update "+
+			"from %s is not newer than the last applied", hid,
+			update.GetTime())
+	}
+
+	if host.GetAddress() != update.Address {
+		jww.INFO.Printf("Updating address of %s from %s to %s", hid,
+			host.GetAddress(), update.Address)
+		if err = a.router.Route(host, update.Address); err != nil {
+			return nil, errors.WithMessagef(err, "Could not update "+
+				"address of %s", hid)
+		}
+		host.Disconnect()
+	}
+	a.applied[*hid] = update.Timestamp
+	return host, nil
+}
+
+// Forget forgets the updates applied to the host, e.g. once it is removed.
+func (a *AddressUpdates) Forget(hid *id.ID) {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+	delete(a.applied, *hid)
+}
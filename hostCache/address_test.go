@@ -0,0 +1,137 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package hostCache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/testkeys"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/crypto/signature/rsa"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// signAddressUpdate returns an update of the host's address signed with the
+// PEM encoded key.
+func signAddressUpdate(hid *id.ID, address string, timestamp time.Time,
+	key []byte, t *testing.T) *pb.AddressUpdate {
+	privateKey, err := rsa.LoadPrivateKeyFromPem(key)
+	if err != nil {
+		t.Fatalf("Failed to load key: %+v", err)
+	}
+	update := pb.NewAddressUpdate(hid, address, timestamp)
+	if err = update.Sign(privateKey); err != nil {
+		t.Fatalf("Failed to sign address update: %+v", err)
+	}
+	return update
+}
+
+// Tests that a signed update changes the address of the host, and that
+// replayed updates and updates signed by another key are rejected.
+func TestAddressUpdates_Apply(t *testing.T) {
+	manager := connect.NewManagerTesting(t)
+	hid := id.NewIdFromString("node", id.Node, t)
+	host, err := manager.AddHost(hid, "0.0.0.0:5971", testkeys.GetNodeCert(),
+		connect.GetDefaultHostParams())
+	if err != nil {
+		t.Fatalf("Failed to add host: %+v", err)
+	}
+	a := NewAddressUpdates(manager)
+	now := time.Now()
+
+	forged := signAddressUpdate(hid, "0.0.0.0:6000", now,
+		testkeys.GetGatewayKey(), t)
+	if _, err = a.Apply(forged); err == nil {
+		t.Errorf("Applied an update signed by another key.")
+	}
+
+	update := signAddressUpdate(hid, "0.0.0.0:5972", now,
+		testkeys.GetNodeKey(), t)
+	updated, err := a.Apply(update)
+	if err != nil {
+		t.Fatalf("Failed to apply update: %+v", err)
+	}
+	if updated != host || host.GetAddress() != "0.0.0.0:5972" {
+		t.Errorf("Host address is %s, expected 0.0.0.0:5972.",
+			host.GetAddress())
+	}
+
+	old := signAddressUpdate(hid, "0.0.0.0:5971", now.Add(-time.Second),
+		testkeys.GetNodeKey(), t)
+	if _, err = a.Apply(old); err == nil {
+		t.Errorf("Applied an update older than the last applied.")
+	}
+	if _, err = a.Apply(update); err == nil {
+		t.Errorf("Applied a replayed update.")
+	}
+	if host.GetAddress() != "0.0.0.0:5972" {
+		t.Errorf("Rejected update changed the address to %s.",
+			host.GetAddress())
+	}
+
+	a.Forget(hid)
+	if _, err = a.Apply(old); err != nil {
+		t.Errorf("Failed to apply update after forgetting: %+v", err)
+	}
+}
+
+// Error path: updates for hosts not in the manager are rejected.
+func TestAddressUpdates_Apply_UnknownHost(t *testing.T) {
+	a := NewAddressUpdates(connect.NewManagerTesting(t))
+	update := signAddressUpdate(id.NewIdFromString("node", id.Node, t),
+		"0.0.0.0:5972", time.Now(), testkeys.GetNodeKey(), t)
+	if _, err := a.Apply(update); err == nil {
+		t.Errorf("Applied an update for an unknown host.")
+	}
+}
+
+// failingRouter is a Router which cannot route hosts.
+type failingRouter struct{ direct }
+
+// Route returns an error.
+func (failingRouter) Route(*connect.Host, string) error {
+	return errors.New("cannot route")
+}
+
+// Tests that updates dated too far in the future are rejected, and that an
+// update which could not be routed is not recorded as applied.
+func TestAddressUpdates_Apply_Rejected(t *testing.T) {
+	manager := connect.NewManagerTesting(t)
+	hid := id.NewIdFromString("node", id.Node, t)
+	host, err := manager.AddHost(hid, "0.0.0.0:5971", testkeys.GetNodeCert(),
+		connect.GetDefaultHostParams())
+	if err != nil {
+		t.Fatalf("Failed to add host: %+v", err)
+	}
+	a := NewAddressUpdates(manager)
+
+	future := signAddressUpdate(hid, "0.0.0.0:5972",
+		time.Now().Add(2*maxUpdateSkew), testkeys.GetNodeKey(), t)
+	if _, err = a.Apply(future); err == nil {
+		t.Errorf("Applied an update from the future.")
+	}
+
+	update := signAddressUpdate(hid, "0.0.0.0:5972", time.Now(),
+		testkeys.GetNodeKey(), t)
+	a.SetRouter(failingRouter{})
+	if _, err = a.Apply(update); err == nil {
+		t.Errorf("Applied an update which could not be routed.")
+	}
+
+	a.SetRouter(nil)
+	if _, err = a.Apply(update); err != nil {
+		t.Errorf("Failed to apply update after routing failed: %+v", err)
+	}
+	if host.GetAddress() != "0.0.0.0:5972" {
+		t.Errorf("Host address is %s, expected 0.0.0.0:5972.",
+			host.GetAddress())
+	}
+}
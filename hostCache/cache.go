@@ -0,0 +1,145 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package hostCache removes and replaces hosts in a connect.Manager, freeing
+// their connections. Manager.RemoveHost only forgets a host, leaving its
+// connection open, and Manager.DisconnectAll closes connections but keeps
+// every host, so a gateway tracking thousands of client hosts leaks a
+// connection for each. A Cache bounds the hosts added through it, evicting
+// the least recently used once full.
+package hostCache
+
+import (
+	"container/list"
+	"sync"
+
+	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// Remove disconnects the host from the manager and removes it. Removing a
+// host not in the manager does nothing.
+func Remove(manager *connect.Manager, hid *id.ID) {
+	host, exists := manager.GetHost(hid)
+	if !exists {
+		return
+	}
+	manager.RemoveHost(hid)
+	host.Disconnect()
+}
+
+// Cache tracks the hosts added to a manager through it, in order of use.
+type Cache struct {
+	manager *connect.Manager
+	// Maximum number of hosts tracked; zero is unbounded
+	maxHosts int
+	// Host IDs, most recently used first
+	order    *list.List
+	elements map[id.ID]*list.Element
+	mux      sync.Mutex
+}
+
+// New returns a Cache adding hosts to the manager. Once more than maxHosts
+// hosts are tracked, the least recently used is removed. A maxHosts of zero
+// never evicts.
+func New(manager *connect.Manager, maxHosts int) *Cache {
+	return &Cache{
+		manager:  manager,
+		maxHosts: maxHosts,
+		order:    list.New(),
+		elements: make(map[id.ID]*list.Element),
+	}
+}
+
+// AddHost adds the host to the manager, or returns the existing host, and
+// marks it as used. Hosts evicted to make room are disconnected and removed.
+func (c *Cache) AddHost(hid *id.ID, address string, cert []byte,
+	params connect.HostParams) (*connect.Host, error) {
+	host, err := c.manager.AddHost(hid, address, cert, params)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mux.Lock()
+	c.touch(hid)
+	var evicted []*id.ID
+	for c.maxHosts > 0 && c.order.Len() > c.maxHosts {
+		evicted = append(evicted, c.forget(c.order.Back()))
+	}
+	c.mux.Unlock()
+
+	for _, eid := range evicted {
+		jww.DEBUG.Printf("Evicting least recently used host %s", eid)
+		Remove(c.manager, eid)
+	}
+
+	return host, nil
+}
+
+// Touch marks the host as used, so it is evicted after every host used
+// before it. It should be called whenever the host is sent to or received
+// from. Hosts not added through the cache are ignored.
+func (c *Cache) Touch(hid *id.ID) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	if element, exists := c.elements[*hid]; exists {
+		c.order.MoveToFront(element)
+	}
+}
+
+// RemoveHost disconnects the host and removes it from the manager and the
+// cache.
+func (c *Cache) RemoveHost(hid *id.ID) {
+	c.mux.Lock()
+	if element, exists := c.elements[*hid]; exists {
+		c.forget(element)
+	}
+	c.mux.Unlock()
+
+	Remove(c.manager, hid)
+}
+
+// DisconnectAll disconnects every host added through the cache and removes
+// them from the manager.
+func (c *Cache) DisconnectAll() {
+	c.mux.Lock()
+	hosts := make([]*id.ID, 0, c.order.Len())
+	for c.order.Len() > 0 {
+		hosts = append(hosts, c.forget(c.order.Front()))
+	}
+	c.mux.Unlock()
+
+	for _, hid := range hosts {
+		Remove(c.manager, hid)
+	}
+}
+
+// Len returns the number of hosts tracked.
+func (c *Cache) Len() int {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return c.order.Len()
+}
+
+// touch moves the host to the front of the order, adding it if it is not
+// tracked. Must be called under the lock.
+func (c *Cache) touch(hid *id.ID) {
+	if element, exists := c.elements[*hid]; exists {
+		c.order.MoveToFront(element)
+		return
+	}
+	c.elements[*hid] = c.order.PushFront(hid.DeepCopy())
+}
+
+// forget stops tracking the host of the element and returns its ID. Must be
+// called under the lock.
+func (c *Cache) forget(element *list.Element) *id.ID {
+	hid := c.order.Remove(element).(*id.ID)
+	delete(c.elements, *hid)
+	return hid
+}
@@ -0,0 +1,103 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package hostCache
+
+import (
+	"os"
+	"strconv"
+	"testing"
+
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+func TestMain(m *testing.M) {
+	connect.TestingOnlyDisableTLS = true
+	os.Exit(m.Run())
+}
+
+// addHosts adds n client hosts to the cache and returns their IDs.
+func addHosts(c *Cache, n int, t *testing.T) []*id.ID {
+	ids := make([]*id.ID, n)
+	for i := range ids {
+		ids[i] = id.NewIdFromString("client"+strconv.Itoa(i), id.User, t)
+		_, err := c.AddHost(ids[i], "0.0.0.0:5970", nil,
+			connect.GetDefaultHostParams())
+		if err != nil {
+			t.Fatalf("Failed to add host %d: %+v", i, err)
+		}
+	}
+	return ids
+}
+
+// Tests that the least recently used host is evicted once the cache is full.
+func TestCache_AddHost_Evicts(t *testing.T) {
+	manager := connect.NewManagerTesting(t)
+	c := New(manager, 2)
+
+	ids := addHosts(c, 2, t)
+	c.Touch(ids[0])
+	ids = append(ids, id.NewIdFromString("client2", id.User, t))
+	_, err := c.AddHost(ids[2], "0.0.0.0:5970", nil,
+		connect.GetDefaultHostParams())
+	if err != nil {
+		t.Fatalf("Failed to add host: %+v", err)
+	}
+
+	if c.Len() != 2 {
+		t.Errorf("Cache holds %d hosts, expected 2.", c.Len())
+	}
+	if _, exists := manager.GetHost(ids[1]); exists {
+		t.Errorf("Least recently used host was not evicted.")
+	}
+	for _, hid := range []*id.ID{ids[0], ids[2]} {
+		if _, exists := manager.GetHost(hid); !exists {
+			t.Errorf("Host %s was evicted.", hid)
+		}
+	}
+}
+
+// Tests that an unbounded cache never evicts.
+func TestCache_AddHost_Unbounded(t *testing.T) {
+	manager := connect.NewManagerTesting(t)
+	c := New(manager, 0)
+
+	for _, hid := range addHosts(c, 10, t) {
+		if _, exists := manager.GetHost(hid); !exists {
+			t.Errorf("Host %s was evicted.", hid)
+		}
+	}
+}
+
+// Tests that RemoveHost and DisconnectAll remove hosts from the manager.
+func TestCache_RemoveHost_DisconnectAll(t *testing.T) {
+	manager := connect.NewManagerTesting(t)
+	c := New(manager, 0)
+	ids := addHosts(c, 3, t)
+
+	c.RemoveHost(ids[0])
+	if _, exists := manager.GetHost(ids[0]); exists {
+		t.Errorf("Removed host still in the manager.")
+	}
+	if c.Len() != 2 {
+		t.Errorf("Cache holds %d hosts, expected 2.", c.Len())
+	}
+
+	c.DisconnectAll()
+	for _, hid := range ids {
+		if _, exists := manager.GetHost(hid); exists {
+			t.Errorf("Host %s still in the manager.", hid)
+		}
+	}
+	if c.Len() != 0 {
+		t.Errorf("Cache holds %d hosts, expected 0.", c.Len())
+	}
+
+	// Removing an unknown host does nothing
+	c.RemoveHost(ids[0])
+}
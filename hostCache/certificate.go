@@ -0,0 +1,47 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains replacement of the TLS certificate of a host
+
+package hostCache
+
+import (
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// UpdateCertificate replaces the host in the manager with one trusting the
+// new certificate, keeping its address, and disconnects the old host. The
+// TLS credentials and RSA public key of a connect.Host are fixed when it is
+// created, so rotating a certificate requires a new host; holders of the old
+// host must fetch the new one from the manager. If the certificate is
+// invalid, the old host is left in place.
+func UpdateCertificate(manager *connect.Manager, hid *id.ID, cert []byte,
+	params connect.HostParams) (*connect.Host, error) {
+	old, exists := manager.GetHost(hid)
+	if !exists {
+		return nil, errors.Errorf("Could not update certificate of %s: "+
+			"host does not exist", hid)
+	}
+
+	// Check the certificate before removing the old host. The check must not
+	// connect, as the old host is still in use.
+	checkParams := params
+	checkParams.DisableLazyConnection = false
+	_, err := connect.NewHost(hid, old.GetAddress(), cert, checkParams)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "Could not update certificate "+
+			"of %s", hid)
+	}
+
+	jww.INFO.Printf("Replacing host %s with a new certificate", hid)
+	manager.RemoveHost(hid)
+	old.Disconnect()
+	return manager.AddHost(hid, old.GetAddress(), cert, params)
+}
@@ -0,0 +1,60 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package hostCache
+
+import (
+	"reflect"
+	"testing"
+
+	"gitlab.com/elixxir/comms/testkeys"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// Tests that the host is replaced by one trusting the new certificate, and
+// that an invalid certificate leaves the old host in place.
+func TestUpdateCertificate(t *testing.T) {
+	manager := connect.NewManagerTesting(t)
+	params := connect.GetDefaultHostParams()
+	hid := id.NewIdFromString("node", id.Node, t)
+	old, err := manager.AddHost(hid, "0.0.0.0:5971", testkeys.GetNodeCert(),
+		params)
+	if err != nil {
+		t.Fatalf("Failed to add host: %+v", err)
+	}
+
+	if _, err = UpdateCertificate(manager, hid, []byte("invalid"),
+		params); err == nil {
+		t.Errorf("Updated host with an invalid certificate.")
+	}
+	if host, _ := manager.GetHost(hid); host != old {
+		t.Errorf("Invalid certificate replaced the host.")
+	}
+
+	host, err := UpdateCertificate(manager, hid, testkeys.GetGatewayCert(),
+		params)
+	if err != nil {
+		t.Fatalf("Failed to update certificate: %+v", err)
+	}
+	if current, _ := manager.GetHost(hid); current != host || host == old {
+		t.Errorf("Host was not replaced.")
+	}
+	if host.GetAddress() != old.GetAddress() {
+		t.Errorf("Address changed from %s to %s.", old.GetAddress(),
+			host.GetAddress())
+	}
+	if reflect.DeepEqual(host.GetPubKey(), old.GetPubKey()) {
+		t.Errorf("Public key was not replaced.")
+	}
+
+	unknown := id.NewIdFromString("unknown", id.Node, t)
+	if _, err = UpdateCertificate(manager, unknown, testkeys.GetNodeCert(),
+		params); err == nil {
+		t.Errorf("Updated a host which does not exist.")
+	}
+}
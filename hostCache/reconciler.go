@@ -0,0 +1,370 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains reconciliation of the hosts in a manager with the NDF
+
+package hostCache
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/ndf"
+)
+
+// HostEntry is the state a host should be in.
+type HostEntry struct {
+	ID      *id.ID
+	Address string
+	Cert    []byte
+	Params  connect.HostParams
+}
+
+// ChangeReport lists the hosts changed by a reconciliation.
+type ChangeReport struct {
+	Added []*id.ID
+	// Hosts whose address changed, but not their certificate
+	AddressChanged []*id.ID
+	// Hosts replaced to trust a new certificate
+	CertificateChanged []*id.ID
+	Removed            []*id.ID
+}
+
+// Empty returns true if nothing changed.
+func (cr *ChangeReport) Empty() bool {
+	return len(cr.Added) == 0 && len(cr.AddressChanged) == 0 &&
+		len(cr.CertificateChanged) == 0 && len(cr.Removed) == 0
+}
+
+// knownHost is the state a host was last reconciled to. Hosts added with
+// AddHost are not listed in the entries and are never removed by Apply.
+type knownHost struct {
+	address  string
+	cert     string
+	unlisted bool
+}
+
+// Reconciler keeps the hosts in a manager in the state of a list of entries,
+// such as the gateways and nodes of the NDF. The Manager cannot list its
+// hosts, so the Reconciler tracks those it has reconciled or added, and only
+// removes hosts it knows of. Hosts already in the manager when first
+// reconciled are adopted as they are.
+//
+// The hosts it knows of are also kept in an immutable snapshot, replaced on
+// every change, so that they can be walked without locks.
+type Reconciler struct {
+	manager *connect.Manager
+	router  Router
+	known   map[id.ID]knownHost
+	// Current snapshot of the known hosts in the order they were first
+	// known, as a []*connect.Host which is never modified once stored
+	snapshot atomic.Value
+	mux      sync.Mutex
+}
+
+// NewReconciler returns a Reconciler of the hosts in the manager.
+func NewReconciler(manager *connect.Manager) *Reconciler {
+	r := &Reconciler{
+		manager: manager,
+		router:  direct{},
+		known:   make(map[id.ID]knownHost),
+	}
+	r.snapshot.Store([]*connect.Host(nil))
+	return r
+}
+
+// SetRouter sets the router creating the hosts and changing their addresses,
+// e.g. a hostProxy.Proxy, for hosts created or moved afterwards. Passing nil
+// connects to hosts directly.
+func (r *Reconciler) SetRouter(router Router) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	if router == nil {
+		router = direct{}
+	}
+	r.router = router
+}
+
+// AddHost adds a host which is not in the entries to the manager, or returns
+// the existing host, and tracks it. Apply does not remove it, but updates it
+// if it is listed in the entries.
+func (r *Reconciler) AddHost(hid *id.ID, address string, cert []byte,
+	params connect.HostParams) (*connect.Host, error) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	host, err := r.router.AddHost(r.manager, hid, address, cert, params)
+	if err != nil {
+		return nil, err
+	}
+	if _, isKnown := r.known[*hid]; !isKnown {
+		r.known[*hid] = knownHost{
+			address:  address,
+			cert:     string(cert),
+			unlisted: true,
+		}
+	}
+	r.track(host)
+	return host, nil
+}
+
+// RemoveHost disconnects the host and removes it from the manager, as Remove
+// does, and stops tracking it.
+func (r *Reconciler) RemoveHost(hid *id.ID) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	Remove(r.manager, hid)
+	delete(r.known, *hid)
+	r.untrack(hid)
+}
+
+// GetAllHosts returns the hosts the Reconciler knows of, in the order they
+// were first known. The returned slice must not be modified; it is not
+// changed by later reconciliations.
+func (r *Reconciler) GetAllHosts() []*connect.Host {
+	return r.snapshot.Load().([]*connect.Host)
+}
+
+// track adds the host to the snapshot, replacing any host with the same ID.
+// This is assumed to be called under the lock.
+func (r *Reconciler) track(host *connect.Host) {
+	old := r.GetAllHosts()
+	hosts := make([]*connect.Host, len(old), len(old)+1)
+	copy(hosts, old)
+	for i, h := range hosts {
+		if h.GetId().Cmp(host.GetId()) {
+			if h != host {
+				hosts[i] = host
+				r.snapshot.Store(hosts)
+			}
+			return
+		}
+	}
+	r.snapshot.Store(append(hosts, host))
+}
+
+// untrack removes the host with the ID from the snapshot. This is assumed to
+// be called under the lock.
+func (r *Reconciler) untrack(hid *id.ID) {
+	old := r.GetAllHosts()
+	hosts := make([]*connect.Host, 0, len(old))
+	for _, h := range old {
+		if !h.GetId().Cmp(hid) {
+			hosts = append(hosts, h)
+		}
+	}
+	r.snapshot.Store(hosts)
+}
+
+// OnCreateFunc is called with each host a reconciliation creates, e.g. to
+// set its window size.
+type OnCreateFunc func(host *connect.Host)
+
+// Apply adds the hosts of entries missing from the manager, updates the
+// addresses and certificates of those which changed and removes the known
+// hosts of the given types which are no longer listed. Every entry is
+// validated before any host is changed, so an invalid entry leaves the
+// manager untouched. onCreate may be nil.
+func (r *Reconciler) Apply(entries []HostEntry, onCreate OnCreateFunc,
+	types ...id.Type) (*ChangeReport, error) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if err := r.validate(entries); err != nil {
+		return nil, err
+	}
+
+	report := &ChangeReport{}
+	listed := make(map[id.ID]bool, len(entries))
+	for _, e := range entries {
+		listed[*e.ID] = true
+		known, isKnown := r.known[*e.ID]
+
+		host, exists := r.manager.GetHost(e.ID)
+		var err error
+		switch {
+		case !exists:
+			host, err = r.router.AddHost(r.manager, e.ID, e.Address, e.Cert,
+				e.Params)
+			if err != nil {
+				return report, errors.WithMessagef(err, "Could not add "+
+					"host %s", e.ID)
+			}
+			report.Added = append(report.Added, e.ID)
+		case isKnown && known.cert != string(e.Cert):
+			host, err = UpdateCertificate(r.manager, e.ID, e.Cert, e.Params)
+			if err != nil {
+				return report, err
+			}
+			if err = r.router.Route(host, e.Address); err != nil {
+				return report, errors.WithMessagef(err, "Could not route "+
+					"host %s", e.ID)
+			}
+			report.CertificateChanged = append(report.CertificateChanged,
+				e.ID)
+		case lastAddress(host, known, isKnown) != e.Address:
+			if err = r.router.Route(host, e.Address); err != nil {
+				return report, errors.WithMessagef(err, "Could not route "+
+					"host %s", e.ID)
+			}
+			report.AddressChanged = append(report.AddressChanged, e.ID)
+		}
+		if onCreate != nil &&
+			(!exists || (isKnown && known.cert != string(e.Cert))) {
+			onCreate(host)
+		}
+
+		r.known[*e.ID] = knownHost{address: e.Address, cert: string(e.Cert)}
+		r.track(host)
+	}
+
+	for hid, known := range r.known {
+		if listed[hid] || known.unlisted || !hasType(hid.GetType(), types) {
+			continue
+		}
+		removed := hid
+		Remove(r.manager, &removed)
+		delete(r.known, hid)
+		r.untrack(&removed)
+		report.Removed = append(report.Removed, &removed)
+	}
+
+	return report, nil
+}
+
+// lastAddress returns the address the host was last reconciled to or, for a
+// host it has not reconciled, the address of the host. The address of a known
+// host is not compared, as a router may reach it at a different address.
+func lastAddress(host *connect.Host, known knownHost,
+	isKnown bool) string {
+	if isKnown {
+		return known.address
+	}
+	return host.GetAddress()
+}
+
+// validate returns an error if any entry is invalid: listed twice,
+// colliding with a hard coded ID or with a certificate which does not parse.
+func (r *Reconciler) validate(entries []HostEntry) error {
+	seen := make(map[id.ID]bool, len(entries))
+	for _, e := range entries {
+		if seen[*e.ID] {
+			return errors.Errorf("Host %s is listed multiple times", e.ID)
+		}
+		seen[*e.ID] = true
+		if id.CollidesWithHardCodedID(e.ID) {
+			return errors.Errorf("Host ID invalid, collides with a hard "+
+				"coded ID. Invalid ID: %v", e.ID.Marshal())
+		}
+
+		// Check certificates which will be used to create a host. The check
+		// must not connect.
+		_, exists := r.manager.GetHost(e.ID)
+		known, isKnown := r.known[*e.ID]
+		if !exists || (isKnown && known.cert != string(e.Cert)) {
+			checkParams := e.Params
+			checkParams.DisableLazyConnection = false
+			_, err := connect.NewHost(e.ID, e.Address, e.Cert, checkParams)
+			if err != nil {
+				return errors.WithMessagef(err, "Invalid host %s", e.ID)
+			}
+		}
+	}
+	return nil
+}
+
+// NdfOptions selects the hosts of an NDF which are reconciled and how they
+// are created.
+type NdfOptions struct {
+	Gateways      bool
+	Nodes         bool
+	GatewayParams connect.HostParams
+	NodeParams    connect.HostParams
+	// If set, returns the address used for a host in place of the address
+	// in the NDF, e.g. to apply IP overrides
+	Address func(hid *id.ID, address string) string
+	// If set, called with each host created
+	OnCreate OnCreateFunc
+}
+
+// DefaultNdfOptions returns options reconciling both the gateways and nodes
+// of the NDF with the default host parameters.
+func DefaultNdfOptions() NdfOptions {
+	return NdfOptions{
+		Gateways:      true,
+		Nodes:         true,
+		GatewayParams: connect.GetDefaultHostParams(),
+		NodeParams:    connect.GetDefaultHostParams(),
+	}
+}
+
+// ApplyNdf reconciles the hosts in the manager with the gateways and nodes
+// of the NDF selected by the options, as Apply does. Gateways share the ID of
+// their node with the gateway type.
+func (r *Reconciler) ApplyNdf(def *ndf.NetworkDefinition, opts NdfOptions) (
+	*ChangeReport, error) {
+	address := func(hid *id.ID, address string) string {
+		if opts.Address != nil {
+			return opts.Address(hid, address)
+		}
+		return address
+	}
+
+	var entries []HostEntry
+	var types []id.Type
+	if opts.Gateways {
+		types = append(types, id.Gateway)
+		for index, gateway := range def.Gateways {
+			if index >= len(def.Nodes) {
+				return nil, errors.Errorf("Gateway %d has no node in the "+
+					"NDF", index)
+			}
+			gwid, err := id.Unmarshal(def.Nodes[index].ID)
+			if err != nil {
+				return nil, err
+			}
+			gwid.SetType(id.Gateway)
+			entries = append(entries, HostEntry{
+				ID:      gwid,
+				Address: address(gwid, gateway.Address),
+				Cert:    []byte(gateway.TlsCertificate),
+				Params:  opts.GatewayParams,
+			})
+		}
+	}
+	if opts.Nodes {
+		types = append(types, id.Node)
+		for _, node := range def.Nodes {
+			nid, err := id.Unmarshal(node.ID)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, HostEntry{
+				ID:      nid,
+				Address: address(nid, node.Address),
+				Cert:    []byte(node.TlsCertificate),
+				Params:  opts.NodeParams,
+			})
+		}
+	}
+
+	return r.Apply(entries, opts.OnCreate, types...)
+}
+
+// hasType returns true if the type is in the list.
+func hasType(t id.Type, types []id.Type) bool {
+	for _, listed := range types {
+		if listed == t {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,295 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package hostCache
+
+import (
+	"testing"
+
+	"gitlab.com/elixxir/comms/testkeys"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/ndf"
+)
+
+// Tests that Apply adds, updates and removes hosts, reporting each change.
+func TestReconciler_Apply(t *testing.T) {
+	manager := connect.NewManagerTesting(t)
+	r := NewReconciler(manager)
+	params := connect.GetDefaultHostParams()
+	a := id.NewIdFromString("a", id.Node, t)
+	b := id.NewIdFromString("b", id.Node, t)
+	c := id.NewIdFromString("c", id.Node, t)
+
+	created := 0
+	onCreate := func(*connect.Host) { created++ }
+
+	report, err := r.Apply([]HostEntry{
+		{ID: a, Address: "0.0.0.0:5970", Cert: testkeys.GetNodeCert(), Params: params},
+		{ID: b, Address: "0.0.0.0:5971", Cert: testkeys.GetNodeCert(), Params: params},
+	}, onCreate, id.Node)
+	if err != nil {
+		t.Fatalf("Failed to apply: %+v", err)
+	}
+	if len(report.Added) != 2 || created != 2 {
+		t.Errorf("Expected 2 hosts added, got %d (%d created).",
+			len(report.Added), created)
+	}
+
+	oldB, _ := manager.GetHost(b)
+	report, err = r.Apply([]HostEntry{
+		{ID: a, Address: "0.0.0.0:6000", Cert: testkeys.GetNodeCert(), Params: params},
+		{ID: b, Address: "0.0.0.0:5971", Cert: testkeys.GetGatewayCert(), Params: params},
+		{ID: c, Address: "0.0.0.0:5972", Cert: testkeys.GetNodeCert(), Params: params},
+	}, onCreate, id.Node)
+	if err != nil {
+		t.Fatalf("Failed to apply: %+v", err)
+	}
+	if len(report.Added) != 1 || !report.Added[0].Cmp(c) {
+		t.Errorf("Expected %s added, got %v.", c, report.Added)
+	}
+	if len(report.AddressChanged) != 1 || !report.AddressChanged[0].Cmp(a) {
+		t.Errorf("Expected address of %s changed, got %v.", a,
+			report.AddressChanged)
+	}
+	if len(report.CertificateChanged) != 1 ||
+		!report.CertificateChanged[0].Cmp(b) {
+		t.Errorf("Expected certificate of %s changed, got %v.", b,
+			report.CertificateChanged)
+	}
+	if host, _ := manager.GetHost(a); host.GetAddress() != "0.0.0.0:6000" {
+		t.Errorf("Address of %s not updated: %s", a, host.GetAddress())
+	}
+	if host, _ := manager.GetHost(b); host == oldB {
+		t.Errorf("Host %s not replaced.", b)
+	}
+	if created != 4 {
+		t.Errorf("Expected 4 hosts created, got %d.", created)
+	}
+
+	report, err = r.Apply([]HostEntry{
+		{ID: c, Address: "0.0.0.0:5972", Cert: testkeys.GetNodeCert(), Params: params},
+	}, nil, id.Node)
+	if err != nil {
+		t.Fatalf("Failed to apply: %+v", err)
+	}
+	if len(report.Removed) != 2 {
+		t.Errorf("Expected 2 hosts removed, got %v.", report.Removed)
+	}
+	for _, hid := range []*id.ID{a, b} {
+		if _, exists := manager.GetHost(hid); exists {
+			t.Errorf("Host %s was not removed.", hid)
+		}
+	}
+
+	report, err = r.Apply([]HostEntry{
+		{ID: c, Address: "0.0.0.0:5972", Cert: testkeys.GetNodeCert(), Params: params},
+	}, nil, id.Node)
+	if err != nil {
+		t.Fatalf("Failed to apply: %+v", err)
+	}
+	if !report.Empty() {
+		t.Errorf("Expected no changes, got %+v.", report)
+	}
+}
+
+// Tests that an invalid entry leaves the manager unchanged.
+func TestReconciler_Apply_Invalid(t *testing.T) {
+	manager := connect.NewManagerTesting(t)
+	r := NewReconciler(manager)
+	params := connect.GetDefaultHostParams()
+	a := id.NewIdFromString("a", id.Node, t)
+	b := id.NewIdFromString("b", id.Node, t)
+
+	_, err := r.Apply([]HostEntry{
+		{ID: a, Address: "0.0.0.0:5970", Cert: testkeys.GetNodeCert(), Params: params},
+		{ID: b, Address: "0.0.0.0:5971", Cert: []byte("invalid"), Params: params},
+	}, nil, id.Node)
+	if err == nil {
+		t.Errorf("Applied an invalid certificate.")
+	}
+	if _, exists := manager.GetHost(a); exists {
+		t.Errorf("Host %s added despite the invalid entry.", a)
+	}
+
+	_, err = r.Apply([]HostEntry{
+		{ID: a, Address: "0.0.0.0:5970", Params: params},
+		{ID: a, Address: "0.0.0.0:5971", Params: params},
+	}, nil, id.Node)
+	if err == nil {
+		t.Errorf("Applied a host listed twice.")
+	}
+
+	_, err = r.Apply([]HostEntry{
+		{ID: &id.Permissioning, Address: "0.0.0.0:5970", Params: params},
+	}, nil, id.Node)
+	if err == nil {
+		t.Errorf("Applied a hard coded ID.")
+	}
+}
+
+// Tests that ApplyNdf only removes hosts of the types it reconciles.
+func TestReconciler_ApplyNdf(t *testing.T) {
+	manager := connect.NewManagerTesting(t)
+	r := NewReconciler(manager)
+	a := id.NewIdFromString("a", id.Node, t)
+	b := id.NewIdFromString("b", id.Node, t)
+	def := &ndf.NetworkDefinition{
+		Nodes: []ndf.Node{
+			{ID: a.Marshal(), Address: "0.0.0.0:5970"},
+			{ID: b.Marshal(), Address: "0.0.0.0:5971"},
+		},
+		Gateways: []ndf.Gateway{
+			{Address: "0.0.0.0:6970"},
+			{Address: "0.0.0.0:6971"},
+		},
+	}
+
+	report, err := r.ApplyNdf(def, DefaultNdfOptions())
+	if err != nil {
+		t.Fatalf("Failed to apply NDF: %+v", err)
+	}
+	if len(report.Added) != 4 {
+		t.Errorf("Expected 4 hosts added, got %v.", report.Added)
+	}
+	gwid := b.DeepCopy()
+	gwid.SetType(id.Gateway)
+	if host, exists := manager.GetHost(gwid); !exists ||
+		host.GetAddress() != "0.0.0.0:6971" {
+		t.Errorf("Gateway %s not added with its address.", gwid)
+	}
+
+	def.Nodes, def.Gateways = def.Nodes[:1], def.Gateways[:1]
+	opts := DefaultNdfOptions()
+	opts.Nodes = false
+	report, err = r.ApplyNdf(def, opts)
+	if err != nil {
+		t.Fatalf("Failed to apply NDF: %+v", err)
+	}
+	if len(report.Removed) != 1 || !report.Removed[0].Cmp(gwid) {
+		t.Errorf("Expected %s removed, got %v.", gwid, report.Removed)
+	}
+	if _, exists := manager.GetHost(b); !exists {
+		t.Errorf("Node %s removed by a gateway only reconciliation.", b)
+	}
+
+	def.Gateways = append(def.Gateways, ndf.Gateway{Address: "0.0.0.0:6971"})
+	if _, err = r.ApplyNdf(def, opts); err == nil {
+		t.Errorf("Applied an NDF with a gateway without a node.")
+	}
+}
+
+// Tests that GetAllHosts follows the hosts reconciled and added, and that
+// hosts added with AddHost are not removed by Apply.
+func TestReconciler_GetAllHosts(t *testing.T) {
+	manager := connect.NewManagerTesting(t)
+	r := NewReconciler(manager)
+	params := connect.GetDefaultHostParams()
+	a := id.NewIdFromString("a", id.Node, t)
+	b := id.NewIdFromString("b", id.Node, t)
+	added := id.NewIdFromString("added", id.Node, t)
+
+	_, err := r.Apply([]HostEntry{
+		{ID: a, Address: "0.0.0.0:5970", Cert: testkeys.GetNodeCert(), Params: params},
+		{ID: b, Address: "0.0.0.0:5971", Cert: testkeys.GetNodeCert(), Params: params},
+	}, nil, id.Node)
+	if err != nil {
+		t.Fatalf("Failed to apply: %+v", err)
+	}
+	if _, err = r.AddHost(added, "0.0.0.0:5972", nil, params); err != nil {
+		t.Fatalf("Failed to add host: %+v", err)
+	}
+	snapshot := r.GetAllHosts()
+	if len(snapshot) != 3 {
+		t.Fatalf("Expected 3 hosts, got %d.", len(snapshot))
+	}
+
+	_, err = r.Apply([]HostEntry{
+		{ID: a, Address: "0.0.0.0:5970", Cert: testkeys.GetGatewayCert(), Params: params},
+	}, nil, id.Node)
+	if err != nil {
+		t.Fatalf("Failed to apply: %+v", err)
+	}
+	hosts := r.GetAllHosts()
+	if len(hosts) != 2 || !hosts[0].GetId().Cmp(a) ||
+		!hosts[1].GetId().Cmp(added) {
+		t.Fatalf("Unexpected hosts after reconciling: %v", hosts)
+	}
+	if current, _ := manager.GetHost(a); hosts[0] != current {
+		t.Errorf("Snapshot holds the host replaced for its certificate.")
+	}
+	if len(snapshot) != 3 {
+		t.Errorf("Reconciling changed an earlier snapshot.")
+	}
+
+	r.RemoveHost(added)
+	if len(r.GetAllHosts()) != 1 {
+		t.Errorf("Removed host still tracked.")
+	}
+	if _, exists := manager.GetHost(added); exists {
+		t.Errorf("Removed host still in the manager.")
+	}
+}
+
+// recordingRouter is a Router recording the addresses it routes hosts to.
+type recordingRouter struct {
+	routed map[id.ID]string
+}
+
+// AddHost adds the host at a placeholder address, as a proxy would.
+func (rr *recordingRouter) AddHost(manager *connect.Manager, hid *id.ID,
+	address string, cert []byte, params connect.HostParams) (
+	*connect.Host, error) {
+	rr.routed[*hid] = address
+	return manager.AddHost(hid, "127.0.0.1:1", cert, params)
+}
+
+// Route records the address.
+func (rr *recordingRouter) Route(host *connect.Host, address string) error {
+	rr.routed[*host.GetId()] = address
+	return nil
+}
+
+// Tests that hosts are created and moved through the router, and that a
+// routed host is not moved again while its listed address is unchanged.
+func TestReconciler_SetRouter(t *testing.T) {
+	manager := connect.NewManagerTesting(t)
+	r := NewReconciler(manager)
+	router := &recordingRouter{routed: make(map[id.ID]string)}
+	r.SetRouter(router)
+	params := connect.GetDefaultHostParams()
+	a := id.NewIdFromString("a", id.Node, t)
+
+	entries := []HostEntry{
+		{ID: a, Address: "0.0.0.0:5970", Cert: testkeys.GetNodeCert(), Params: params},
+	}
+	if _, err := r.Apply(entries, nil, id.Node); err != nil {
+		t.Fatalf("Failed to apply: %+v", err)
+	}
+	if router.routed[*a] != "0.0.0.0:5970" {
+		t.Errorf("Host not created through the router: %v", router.routed)
+	}
+
+	report, err := r.Apply(entries, nil, id.Node)
+	if err != nil {
+		t.Fatalf("Failed to apply: %+v", err)
+	}
+	if !report.Empty() {
+		t.Errorf("Routed host reported as changed: %+v", report)
+	}
+
+	entries[0].Address = "0.0.0.0:6000"
+	if _, err = r.Apply(entries, nil, id.Node); err != nil {
+		t.Fatalf("Failed to apply: %+v", err)
+	}
+	if router.routed[*a] != "0.0.0.0:6000" {
+		t.Errorf("Host not moved through the router: %v", router.routed)
+	}
+	if host, _ := manager.GetHost(a); host.GetAddress() != "127.0.0.1:1" {
+		t.Errorf("Address of routed host changed to %s.", host.GetAddress())
+	}
+}
@@ -0,0 +1,41 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the routing of the hosts created and moved by this package
+
+package hostCache
+
+import (
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// Router creates hosts and changes their addresses, e.g. to route them
+// through a proxy. hostProxy.Proxy implements it.
+type Router interface {
+	// AddHost adds the host to the manager, reached at the address, or
+	// returns the existing host
+	AddHost(manager *connect.Manager, hid *id.ID, address string,
+		cert []byte, params connect.HostParams) (*connect.Host, error)
+	// Route changes the address the host is reached at
+	Route(host *connect.Host, address string) error
+}
+
+// direct is the Router connecting to hosts at their address.
+type direct struct{}
+
+// AddHost adds the host to the manager.
+func (direct) AddHost(manager *connect.Manager, hid *id.ID, address string,
+	cert []byte, params connect.HostParams) (*connect.Host, error) {
+	return manager.AddHost(hid, address, cert, params)
+}
+
+// Route updates the address of the host.
+func (direct) Route(host *connect.Host, address string) error {
+	host.UpdateAddress(address)
+	return nil
+}
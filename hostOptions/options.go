@@ -0,0 +1,198 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package hostOptions builds the parameters of a host from functional
+// options, so that call sites name what they change rather than filling in a
+// connect.HostParams by hand. Besides the fields of HostParams, options
+// cover the settings kept outside the host by this repository: the size of
+// its hostPool and its compressor. AddHost adds a host with all of them.
+package hostOptions
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/elixxir/comms/compression"
+	"gitlab.com/elixxir/comms/hostPool"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc/keepalive"
+)
+
+// Options are the settings of a host.
+type Options struct {
+	Params connect.HostParams
+	// Number of connections pooled to the remote, including the host. A
+	// size of one or less creates no pool.
+	PoolSize int
+	// Hosts the compressor is set on, and the compressor. No compressor is
+	// set if Compression is nil.
+	Compression *compression.Hosts
+	Compressor  string
+}
+
+// Option changes an Options.
+type Option func(o *Options)
+
+// New returns the options, starting from connect.GetDefaultHostParams.
+func New(opts ...Option) Options {
+	o := Options{Params: connect.GetDefaultHostParams()}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// Params returns the host parameters of the options, starting from
+// connect.GetDefaultHostParams.
+func Params(opts ...Option) connect.HostParams {
+	return New(opts...).Params
+}
+
+// WithParams replaces the host parameters. Options after it change the
+// given parameters.
+func WithParams(params connect.HostParams) Option {
+	return func(o *Options) {
+		o.Params = params
+	}
+}
+
+// WithRetries sets the maximum number of connection and send attempts.
+func WithRetries(maxRetries, maxSendRetries uint32) Option {
+	return func(o *Options) {
+		o.Params.MaxRetries = maxRetries
+		o.Params.MaxSendRetries = maxSendRetries
+	}
+}
+
+// WithAuth sets whether the host authenticates before sending.
+func WithAuth(enabled bool) Option {
+	return func(o *Options) {
+		o.Params.AuthEnabled = enabled
+	}
+}
+
+// WithTimeouts sets the deadlines of sends and of pings checking the host
+// is online. A zero duration leaves the deadline unchanged.
+func WithTimeouts(send, ping time.Duration) Option {
+	return func(o *Options) {
+		if send != 0 {
+			o.Params.SendTimeout = send
+		}
+		if ping != 0 {
+			o.Params.PingTimeout = ping
+		}
+	}
+}
+
+// WithKeepalive sets the keepalive parameters of the connection.
+func WithKeepalive(params keepalive.ClientParameters) Option {
+	return func(o *Options) {
+		o.Params.KaClientOpts = params
+	}
+}
+
+// WithCoolOff enables cooling off after the number of sends, for the
+// timeout.
+func WithCoolOff(sends uint32, timeout time.Duration) Option {
+	return func(o *Options) {
+		o.Params.EnableCoolOff = true
+		o.Params.NumSendsBeforeCoolOff = sends
+		o.Params.CoolOffTimeout = timeout
+	}
+}
+
+// WithEagerConnection connects the host as soon as it is added, rather than
+// at its first send.
+func WithEagerConnection() Option {
+	return func(o *Options) {
+		o.Params.DisableLazyConnection = true
+	}
+}
+
+// WithoutAutoConnect makes sends to a host without a connection fail rather
+// than connect it.
+func WithoutAutoConnect() Option {
+	return func(o *Options) {
+		o.Params.DisableAutoConnect = true
+	}
+}
+
+// WithMetrics enables the metrics of the host, not counting the errors
+// listed.
+func WithMetrics(excludeErrors ...string) Option {
+	return func(o *Options) {
+		o.Params.EnableMetrics = true
+		o.Params.ExcludeMetricErrors = append(
+			o.Params.ExcludeMetricErrors, excludeErrors...)
+	}
+}
+
+// WithWeb connects to the host over grpc-web rather than gRPC.
+func WithWeb() Option {
+	return func(o *Options) {
+		o.Params.ConnectionType = connect.Web
+	}
+}
+
+// WithPoolSize pools the number of connections to the remote, including
+// the host itself.
+func WithPoolSize(size int) Option {
+	return func(o *Options) {
+		o.PoolSize = size
+	}
+}
+
+// WithCompression compresses the calls and streams sent to the host with the
+// compressor, which is set on the hosts.
+func WithCompression(hosts *compression.Hosts, compressor string) Option {
+	return func(o *Options) {
+		o.Compression = hosts
+		o.Compressor = compressor
+	}
+}
+
+// Host is a host added with AddHost.
+type Host struct {
+	*connect.Host
+	// Pool of connections to the remote, or nil if no pool was requested
+	Pool *hostPool.Pool
+}
+
+// AddHost adds a host with the options to the manager, sets its compressor
+// and creates its pool.
+func AddHost(manager *connect.Manager, hid *id.ID, address string,
+	cert []byte, opts ...Option) (*Host, error) {
+	o := New(opts...)
+
+	host, err := manager.AddHost(hid, address, cert, o.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	if o.Compression != nil {
+		if err = o.Compression.Set(host, o.Compressor); err != nil {
+			manager.RemoveHost(hid)
+			return nil, err
+		}
+	}
+
+	h := &Host{Host: host}
+	if o.PoolSize > 1 {
+		h.Pool, err = hostPool.NewPool(host, cert, o.Params, o.PoolSize)
+		if err != nil {
+			if o.Compression != nil {
+				_ = o.Compression.Set(host, compression.None)
+			}
+			manager.RemoveHost(hid)
+			return nil, errors.WithMessagef(err,
+				"Failed to pool connections to %s", hid)
+		}
+	}
+
+	return h, nil
+}
@@ -0,0 +1,97 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package hostOptions
+
+import (
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"gitlab.com/elixxir/comms/compression"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+func TestMain(m *testing.M) {
+	connect.TestingOnlyDisableTLS = true
+	os.Exit(m.Run())
+}
+
+// Tests that options change the default parameters.
+func TestParams(t *testing.T) {
+	expected := connect.GetDefaultHostParams()
+	expected.MaxRetries = 5
+	expected.MaxSendRetries = 1
+	expected.AuthEnabled = false
+	expected.SendTimeout = time.Minute
+	expected.DisableLazyConnection = true
+	expected.EnableMetrics = true
+	expected.ExcludeMetricErrors = append(expected.ExcludeMetricErrors,
+		"ignored")
+	expected.ConnectionType = connect.Web
+
+	params := Params(WithRetries(5, 1), WithAuth(false),
+		WithTimeouts(time.Minute, 0), WithEagerConnection(),
+		WithMetrics("ignored"), WithWeb())
+	if !reflect.DeepEqual(params, expected) {
+		t.Errorf("Unexpected parameters.\nexpected: %+v\nreceived: %+v",
+			expected, params)
+	}
+}
+
+// Tests that options after WithParams change the given parameters.
+func TestWithParams(t *testing.T) {
+	base := connect.GetDefaultHostParams()
+	base.MaxRetries = 1
+	params := Params(WithParams(base), WithAuth(false))
+	if params.MaxRetries != 1 || params.AuthEnabled {
+		t.Errorf("Unexpected parameters: %+v", params)
+	}
+}
+
+// Tests that AddHost adds the host with its compressor and pool.
+func TestAddHost(t *testing.T) {
+	manager := connect.NewManagerTesting(t)
+	compressed := compression.NewHosts()
+	hid := id.NewIdFromString("node", id.Node, t)
+
+	host, err := AddHost(manager, hid, "0.0.0.0:5980", nil,
+		WithAuth(false), WithPoolSize(3),
+		WithCompression(compressed, compression.Zstd))
+	if err != nil {
+		t.Fatalf("Failed to add host: %+v", err)
+	}
+	defer host.Pool.Close()
+
+	if h, exists := manager.GetHost(hid); !exists || h != host.Host {
+		t.Error("Host not added to the manager")
+	}
+	if name := compressed.Get(hid); name != compression.Zstd {
+		t.Errorf("Host compressed with %q, expected %q", name,
+			compression.Zstd)
+	}
+	if host.Pool == nil || host.Pool.Size() != 3 {
+		t.Errorf("Unexpected pool: %+v", host.Pool)
+	}
+}
+
+// Tests that AddHost removes the host again if its compressor is unknown.
+func TestAddHost_BadCompressor(t *testing.T) {
+	manager := connect.NewManagerTesting(t)
+	hid := id.NewIdFromString("node", id.Node, t)
+
+	_, err := AddHost(manager, hid, "0.0.0.0:5981", nil,
+		WithCompression(compression.NewHosts(), "unknown"))
+	if err == nil {
+		t.Fatal("Host added with an unknown compressor")
+	}
+	if _, exists := manager.GetHost(hid); exists {
+		t.Error("Host left in the manager")
+	}
+}
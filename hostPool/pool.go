@@ -0,0 +1,215 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package hostPool spreads sends to a remote over several gRPC connections.
+// A connect.Host holds a single connection, so a gateway streaming large
+// batches to its node serializes on one HTTP/2 connection and runs into its
+// stream limit under load. A Pool opens extra connections to the same
+// remote and hands out the least loaded one for each send.
+package hostPool
+
+import (
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/elixxir/comms/channelBinding"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/messages"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Sender sends to hosts and packs authenticated messages for them, as
+// connect.ProtoComms does.
+type Sender interface {
+	Send(host *connect.Host, f func(conn connect.Connection) (*any.Any,
+		error)) (*any.Any, error)
+	PackAuthenticatedMessage(msg proto.Message, host *connect.Host,
+		enableSignature bool) (*messages.AuthenticatedMessage, error)
+}
+
+// Pool holds a Host and extra connections to the same remote. The remote
+// keeps a single authentication token per sender, so only the Host
+// authenticates; the extra connections are plain hosts which carry the
+// Host's token. Callers must therefore pack authentication with Host() and
+// send over the connection returned by Acquire.
+//
+// If the remote binds tokens to the channel they were issued over (see
+// channelBinding), each extra connection joins the Host's binding before its
+// first send, and again after a send over it fails to authenticate.
+type Pool struct {
+	host *connect.Host
+	// All connections, the first of which is the Host
+	conns []*connect.Host
+	// Number of sends in progress on each connection
+	load []int
+	// Whether each connection may carry the Host's token
+	joined []bool
+	// Set once a send over the Host succeeds, which means it has
+	// authenticated if the remote requires it. Until then every send goes
+	// over the Host.
+	ready bool
+	mux   sync.Mutex
+}
+
+// NewPool returns a pool of size connections to the remote of the host, one
+// of which is the host itself. The certificate must be the one the host was
+// created with. A size of one or less pools only the host.
+func NewPool(host *connect.Host, cert []byte, params connect.HostParams,
+	size int) (*Pool, error) {
+	p := &Pool{
+		host:  host,
+		conns: []*connect.Host{host},
+	}
+
+	params.AuthEnabled = false
+	for i := 1; i < size; i++ {
+		conn, err := connect.NewHost(host.GetId(), host.GetAddress(), cert,
+			params)
+		if err != nil {
+			p.Close()
+			return nil, errors.Errorf("Failed to create connection %d of "+
+				"%d to %s: %+v", i+1, size, host.GetId(), err)
+		}
+		p.conns = append(p.conns, conn)
+	}
+	p.load = make([]int, len(p.conns))
+	p.joined = make([]bool, len(p.conns))
+	p.joined[0] = true
+
+	return p, nil
+}
+
+// Host returns the host the pool was created with, which holds the
+// authentication token of the pool.
+func (p *Pool) Host() *connect.Host {
+	return p.host
+}
+
+// Size returns the number of connections in the pool.
+func (p *Pool) Size() int {
+	return len(p.conns)
+}
+
+// Acquire returns the connection with the fewest sends in progress. A
+// connection which has not joined the Host's binding on the remote joins it
+// first, using s to send; if that fails, the Host is returned instead. The
+// returned release function must be called with the result of the send once
+// it finishes.
+func (p *Pool) Acquire(s Sender) (conn *connect.Host,
+	release func(err error)) {
+	p.mux.Lock()
+	chosen := 0
+	if p.ready {
+		for i := range p.conns {
+			if p.load[i] < p.load[chosen] {
+				chosen = i
+			}
+		}
+	}
+	p.load[chosen]++
+	joined := p.joined[chosen]
+	p.mux.Unlock()
+
+	if !joined {
+		err := p.join(s, p.conns[chosen])
+
+		p.mux.Lock()
+		if err != nil {
+			jww.WARN.Printf("Failed to join pooled connection %d to %s; "+
+				"sending over the host: %+v", chosen, p.host.GetId(), err)
+			p.load[chosen]--
+			chosen = 0
+			p.load[chosen]++
+		} else {
+			p.joined[chosen] = true
+		}
+		p.mux.Unlock()
+	}
+
+	var once sync.Once
+	release = func(err error) {
+		once.Do(func() {
+			p.mux.Lock()
+			defer p.mux.Unlock()
+			p.load[chosen]--
+			if chosen == 0 && err == nil {
+				p.ready = true
+			}
+			if chosen != 0 && err != nil && connect.IsAuthError(err) {
+				p.joined[chosen] = false
+			}
+		})
+	}
+	return p.conns[chosen], release
+}
+
+// join binds the connection to the Host's token on the remote. A token is
+// requested over the connection and, if the remote bound it to the
+// connection's channel, returned over the Host with the Host's token, which
+// adds the connection's channel to the Host's binding. Nothing more is needed
+// if the remote does not bind tokens.
+func (p *Pool) join(s Sender, conn *connect.Host) error {
+	var header metadata.MD
+	resultMsg, err := s.Send(conn, func(c connect.Connection) (*any.Any,
+		error) {
+		ctx, cancel := conn.GetMessagingContext()
+		defer cancel()
+
+		result, err := messages.NewGenericClient(c.GetGrpcConn()).
+			RequestToken(ctx, &messages.Ping{}, grpc.Header(&header))
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+		return ptypes.MarshalAny(result)
+	})
+	if err != nil {
+		return errors.Errorf("Failed to request token: %+v", err)
+	}
+
+	mode := header.Get(channelBinding.Header)
+	if len(mode) == 0 || mode[0] != channelBinding.Bound {
+		return nil
+	}
+
+	token := &messages.AssignToken{}
+	if err = ptypes.UnmarshalAny(resultMsg, token); err != nil {
+		return err
+	}
+
+	_, err = s.Send(p.host, func(c connect.Connection) (*any.Any, error) {
+		ctx, cancel := p.host.GetMessagingContext()
+		defer cancel()
+
+		authMsg, err := s.PackAuthenticatedMessage(token, p.host, false)
+		if err != nil {
+			return nil, err
+		}
+		result, err := messages.NewGenericClient(c.GetGrpcConn()).
+			AuthenticateToken(ctx, authMsg)
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+		return ptypes.MarshalAny(result)
+	})
+	if err != nil {
+		return errors.Errorf("Failed to join binding of %s: %+v",
+			p.host.GetId(), err)
+	}
+	return nil
+}
+
+// Close disconnects the extra connections. The host is left connected.
+func (p *Pool) Close() {
+	for _, conn := range p.conns[1:] {
+		conn.Disconnect()
+	}
+}
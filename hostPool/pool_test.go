@@ -0,0 +1,174 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package hostPool
+
+import (
+	"os"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/pkg/errors"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+func TestMain(m *testing.M) {
+	connect.TestingOnlyDisableTLS = true
+	os.Exit(m.Run())
+}
+
+// testSender records the hosts sent to without contacting them. Sends fail
+// with err if it is set.
+type testSender struct {
+	sent []*connect.Host
+	err  error
+}
+
+func (s *testSender) Send(host *connect.Host,
+	_ func(conn connect.Connection) (*any.Any, error)) (*any.Any, error) {
+	s.sent = append(s.sent, host)
+	return nil, s.err
+}
+
+func (s *testSender) PackAuthenticatedMessage(proto.Message, *connect.Host,
+	bool) (*messages.AuthenticatedMessage, error) {
+	return &messages.AuthenticatedMessage{}, nil
+}
+
+// newTestPool returns a pool of the given size to an unused address.
+func newTestPool(size int, t *testing.T) *Pool {
+	params := connect.GetDefaultHostParams()
+	hostID := id.NewIdFromString("node", id.Node, t)
+	host, err := connect.NewHost(hostID, "0.0.0.0:5900", nil, params)
+	if err != nil {
+		t.Fatalf("Failed to create host: %+v", err)
+	}
+	p, err := NewPool(host, nil, params, size)
+	if err != nil {
+		t.Fatalf("Failed to create pool: %+v", err)
+	}
+	return p
+}
+
+// Tests that every send goes over the host until one over it succeeds.
+func TestPool_Acquire_NotReady(t *testing.T) {
+	p := newTestPool(3, t)
+	defer p.Close()
+
+	conn, release := p.Acquire(&testSender{})
+	if conn != p.Host() {
+		t.Fatalf("First send not made over the host.")
+	}
+	conn2, release2 := p.Acquire(&testSender{})
+	if conn2 != p.Host() {
+		t.Errorf("Send made over another connection before the host " +
+			"succeeded.")
+	}
+
+	// Failed sends do not make the pool ready
+	release2(errors.New("failed"))
+	release(errors.New("failed"))
+	conn, release = p.Acquire(&testSender{})
+	if conn != p.Host() {
+		t.Errorf("Send made over another connection after the host failed.")
+	}
+	release(nil)
+}
+
+// Tests that sends are spread over the least loaded connections.
+func TestPool_Acquire_LeastLoaded(t *testing.T) {
+	p := newTestPool(3, t)
+	defer p.Close()
+
+	_, release := p.Acquire(&testSender{})
+	release(nil)
+
+	seen := make(map[*connect.Host]bool)
+	releases := make([]func(error), 0, p.Size())
+	for i := 0; i < p.Size(); i++ {
+		conn, release := p.Acquire(&testSender{})
+		if seen[conn] {
+			t.Errorf("Connection reused while another was idle.")
+		}
+		seen[conn] = true
+		releases = append(releases, release)
+	}
+
+	// Release the second connection, which should be chosen next
+	releases[1](nil)
+	releases[1](nil)
+	conn, _ := p.Acquire(&testSender{})
+	if conn != p.conns[1] {
+		t.Errorf("Least loaded connection was not chosen.")
+	}
+	if p.load[1] != 1 {
+		t.Errorf("Releasing twice changed the load: %d", p.load[1])
+	}
+}
+
+// Tests that a connection joins before its first send, and that the host is
+// used instead if joining fails.
+func TestPool_Acquire_Join(t *testing.T) {
+	p := newTestPool(2, t)
+	defer p.Close()
+
+	s := &testSender{}
+	_, release := p.Acquire(s)
+	release(nil)
+	if len(s.sent) != 0 {
+		t.Errorf("Host joined its own binding.")
+	}
+
+	// Load the host so the other connection is chosen
+	_, releaseHost := p.Acquire(s)
+	defer releaseHost(nil)
+
+	s.err = errors.New("failed")
+	conn, release := p.Acquire(s)
+	if conn != p.Host() {
+		t.Errorf("Connection used after failing to join.")
+	}
+	if len(s.sent) != 1 || s.sent[0] != p.conns[1] {
+		t.Errorf("Join not requested over the connection: %v", s.sent)
+	}
+	if p.load[1] != 0 {
+		t.Errorf("Failed join left load on the connection: %d", p.load[1])
+	}
+	release(nil)
+
+	// A remote which does not bind tokens needs no more than the request
+	s.err = nil
+	s.sent = nil
+	conn, release = p.Acquire(s)
+	if conn != p.conns[1] {
+		t.Errorf("Joined connection not used.")
+	}
+	if len(s.sent) != 1 {
+		t.Errorf("Unexpected sends to join: %d", len(s.sent))
+	}
+
+	// Authentication failures over the connection make it join again
+	release(errors.New("Failed to authenticate"))
+	s.sent = nil
+	_, release = p.Acquire(s)
+	release(nil)
+	if len(s.sent) != 1 {
+		t.Errorf("Connection did not join again after failing to " +
+			"authenticate.")
+	}
+}
+
+// Tests that a pool of size one pools only the host.
+func TestNewPool_Single(t *testing.T) {
+	p := newTestPool(0, t)
+	if p.Size() != 1 {
+		t.Errorf("Unexpected pool size %d.", p.Size())
+	}
+}
@@ -0,0 +1,97 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains dialers connecting through SOCKS5 and HTTP CONNECT proxies
+
+package hostProxy
+
+import (
+	"bufio"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/proxy"
+)
+
+// NewDialer returns a dialer connecting through the proxy at the URL. The
+// schemes "socks5" and "socks5h" dial through a SOCKS5 proxy, such as Tor,
+// which resolves host names itself; "http" dials through an HTTP CONNECT
+// proxy. Credentials in the URL are sent to the proxy.
+func NewDialer(proxyURL *url.URL) (proxy.Dialer, error) {
+	switch proxyURL.Scheme {
+	case "socks5", "socks5h":
+		return proxy.FromURL(proxyURL, proxy.Direct)
+	case "http":
+		return &connectDialer{proxyURL: proxyURL}, nil
+	default:
+		return nil, errors.Errorf("Unsupported proxy scheme %q",
+			proxyURL.Scheme)
+	}
+}
+
+// connectDialer dials through an HTTP proxy using CONNECT.
+type connectDialer struct {
+	proxyURL *url.URL
+}
+
+// Dial connects to the address through the proxy.
+func (d *connectDialer) Dial(network, addr string) (net.Conn, error) {
+	conn, err := net.Dial(network, d.proxyURL.Host)
+	if err != nil {
+		return nil, errors.Errorf("Failed to connect to proxy %s: %+v",
+			d.proxyURL.Host, err)
+	}
+
+	request := fmt.Sprintf("CONNECT %s HTTP/1.1\r\nHost: %s\r\n", addr, addr)
+	if user := d.proxyURL.User; user != nil {
+		password, _ := user.Password()
+		credentials := base64.StdEncoding.EncodeToString(
+			[]byte(user.Username() + ":" + password))
+		request += "Proxy-Authorization: Basic " + credentials + "\r\n"
+	}
+	if _, err = conn.Write([]byte(request + "\r\n")); err != nil {
+		_ = conn.Close()
+		return nil, errors.Errorf("Failed to send CONNECT to proxy %s: %+v",
+			d.proxyURL.Host, err)
+	}
+
+	reader := bufio.NewReader(conn)
+	response, err := http.ReadResponse(reader, nil)
+	if err != nil {
+		_ = conn.Close()
+		return nil, errors.Errorf("Failed to read CONNECT response from "+
+			"proxy %s: %+v", d.proxyURL.Host, err)
+	}
+	_ = response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		_ = conn.Close()
+		return nil, errors.Errorf("Proxy %s refused CONNECT to %s: %s",
+			d.proxyURL.Host, addr, response.Status)
+	}
+
+	// The remote may have sent data the reader buffered along with the
+	// response
+	if reader.Buffered() > 0 {
+		return &bufferedConn{Conn: conn, reader: reader}, nil
+	}
+	return conn, nil
+}
+
+// bufferedConn reads data buffered while reading the CONNECT response before
+// reading from the connection.
+type bufferedConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.reader.Read(b)
+}
@@ -0,0 +1,224 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package hostProxy routes the connections of hosts through a SOCKS5 or HTTP
+// CONNECT proxy, for clients behind restrictive networks or routing through
+// Tor. A connect.Host dials its address directly and takes no dialer, so each
+// routed host is given the address of a local tunnel which forwards its
+// connections through the proxy to the real address. Tunnels listen on Unix
+// sockets in directories only the current user can open, so other users
+// cannot route through the proxy. TLS is unaffected, as hosts verify the name
+// in their certificate rather than their address.
+package hostProxy
+
+import (
+	"io"
+	"io/ioutil"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+	"golang.org/x/net/proxy"
+)
+
+// Proxy routes hosts through a proxy.
+type Proxy struct {
+	dialer proxy.Dialer
+	// Tunnel of each routed host
+	tunnels map[id.ID]*Tunnel
+	mux     sync.Mutex
+}
+
+// New returns a Proxy dialing through the proxy at the URL, e.g.
+// "socks5://127.0.0.1:9050" for a local Tor client. See NewDialer for the
+// supported schemes.
+func New(proxyURL string) (*Proxy, error) {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, errors.Errorf("Invalid proxy URL: %+v", err)
+	}
+	dialer, err := NewDialer(u)
+	if err != nil {
+		return nil, err
+	}
+	return NewWithDialer(dialer), nil
+}
+
+// NewWithDialer returns a Proxy dialing with the dialer.
+func NewWithDialer(dialer proxy.Dialer) *Proxy {
+	return &Proxy{
+		dialer:  dialer,
+		tunnels: make(map[id.ID]*Tunnel),
+	}
+}
+
+// AddHost adds the host to the manager and routes it through the proxy. The
+// address of a host which already exists is left unchanged. Web hosts cannot
+// be routed, as grpc-web dials its address over HTTP.
+func (p *Proxy) AddHost(manager *connect.Manager, hid *id.ID, address string,
+	cert []byte, params connect.HostParams) (*connect.Host, error) {
+	if host, exists := manager.GetHost(hid); exists {
+		return host, nil
+	}
+	if params.ConnectionType == connect.Web {
+		return nil, errors.Errorf("Cannot route web host %s through a "+
+			"proxy", hid)
+	}
+
+	t, err := p.tunnel(hid, address)
+	if err != nil {
+		return nil, err
+	}
+	host, err := manager.AddHost(hid, t.Addr(), cert, params)
+	if err != nil {
+		p.Remove(hid)
+		return nil, err
+	}
+	return host, nil
+}
+
+// Route routes the existing host to the address through the proxy, updating
+// its address. It must be called again instead of Host.UpdateAddress when
+// the address of the host changes.
+func (p *Proxy) Route(host *connect.Host, address string) error {
+	t, err := p.tunnel(host.GetId(), address)
+	if err != nil {
+		return err
+	}
+	host.UpdateAddress(t.Addr())
+	host.Disconnect()
+	return nil
+}
+
+// Remove closes the tunnel of the host.
+func (p *Proxy) Remove(hid *id.ID) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	if t, exists := p.tunnels[*hid]; exists {
+		t.Close()
+		delete(p.tunnels, *hid)
+	}
+}
+
+// Close closes every tunnel.
+func (p *Proxy) Close() {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	for hid, t := range p.tunnels {
+		t.Close()
+		delete(p.tunnels, hid)
+	}
+}
+
+// tunnel opens a tunnel to the address for the host, replacing any it
+// already has.
+func (p *Proxy) tunnel(hid *id.ID, address string) (*Tunnel, error) {
+	t, err := NewTunnel(p.dialer, address)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	if old, exists := p.tunnels[*hid]; exists {
+		old.Close()
+	}
+	p.tunnels[*hid] = t
+	return t, nil
+}
+
+// Tunnel forwards the connections made to a local Unix socket through a
+// dialer to a target address.
+type Tunnel struct {
+	listener net.Listener
+	// Directory holding the socket, readable only by the current user
+	dir    string
+	dialer proxy.Dialer
+	target string
+}
+
+// NewTunnel starts forwarding connections made to a new local Unix socket to
+// the target through the dialer.
+func NewTunnel(dialer proxy.Dialer, target string) (*Tunnel, error) {
+	// TempDir creates the directory readable only by the current user
+	dir, err := ioutil.TempDir("", "hostProxy")
+	if err != nil {
+		return nil, errors.Errorf("Failed to open tunnel to %s: %+v",
+			target, err)
+	}
+	listener, err := net.Listen("unix", filepath.Join(dir, "tunnel.sock"))
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, errors.Errorf("Failed to open tunnel to %s: %+v",
+			target, err)
+	}
+	t := &Tunnel{
+		listener: listener,
+		dir:      dir,
+		dialer:   dialer,
+		target:   target,
+	}
+	go t.serve()
+	return t, nil
+}
+
+// Addr returns the gRPC target of the socket forwarded to the target.
+func (t *Tunnel) Addr() string {
+	return "unix://" + t.Path()
+}
+
+// Path returns the path of the socket forwarded to the target.
+func (t *Tunnel) Path() string {
+	return t.listener.Addr().String()
+}
+
+// Close stops accepting connections and removes the socket. Connections
+// already forwarded are left open until either side closes them.
+func (t *Tunnel) Close() {
+	_ = t.listener.Close()
+	_ = os.RemoveAll(t.dir)
+}
+
+// serve accepts connections until the tunnel is closed.
+func (t *Tunnel) serve() {
+	for {
+		local, err := t.listener.Accept()
+		if err != nil {
+			return
+		}
+		go t.forward(local)
+	}
+}
+
+// forward copies data between the local connection and a connection to the
+// target through the dialer until either closes.
+func (t *Tunnel) forward(local net.Conn) {
+	remote, err := t.dialer.Dial("tcp", t.target)
+	if err != nil {
+		jww.WARN.Printf("Failed to dial %s through proxy: %+v", t.target,
+			err)
+		_ = local.Close()
+		return
+	}
+
+	done := make(chan struct{}, 2)
+	pipe := func(dst, src net.Conn) {
+		_, _ = io.Copy(dst, src)
+		done <- struct{}{}
+	}
+	go pipe(remote, local)
+	go pipe(local, remote)
+	<-done
+	_ = local.Close()
+	_ = remote.Close()
+}
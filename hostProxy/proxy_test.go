@@ -0,0 +1,202 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package hostProxy
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+	"golang.org/x/net/proxy"
+)
+
+func TestMain(m *testing.M) {
+	connect.TestingOnlyDisableTLS = true
+	os.Exit(m.Run())
+}
+
+// startEcho starts a server echoing everything it receives and returns its
+// address.
+func startEcho(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %+v", err)
+	}
+	t.Cleanup(func() { _ = l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				_, _ = io.Copy(conn, conn)
+				_ = conn.Close()
+			}()
+		}
+	}()
+	return l.Addr().String()
+}
+
+// startConnectProxy starts an HTTP CONNECT proxy recording the targets it is
+// asked for and returns its address.
+func startConnectProxy(targets chan<- string, t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %+v", err)
+	}
+	t.Cleanup(func() { _ = l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				req, err := http.ReadRequest(bufio.NewReader(conn))
+				if err != nil || req.Method != http.MethodConnect {
+					_ = conn.Close()
+					return
+				}
+				targets <- req.Host
+				remote, err := net.Dial("tcp", req.Host)
+				if err != nil {
+					_ = conn.Close()
+					return
+				}
+				_, _ = conn.Write([]byte("HTTP/1.1 200 OK\r\n\r\n"))
+				go func() { _, _ = io.Copy(remote, conn) }()
+				_, _ = io.Copy(conn, remote)
+				_ = conn.Close()
+			}()
+		}
+	}()
+	return l.Addr().String()
+}
+
+// checkEcho checks that data sent over the connection is echoed back.
+func checkEcho(conn net.Conn, t *testing.T) {
+	msg := []byte("hello")
+	if _, err := conn.Write(msg); err != nil {
+		t.Fatalf("Failed to write: %+v", err)
+	}
+	received := make([]byte, len(msg))
+	if _, err := io.ReadFull(conn, received); err != nil {
+		t.Fatalf("Failed to read: %+v", err)
+	}
+	if string(received) != string(msg) {
+		t.Errorf("Received %q, expected %q", received, msg)
+	}
+}
+
+// Tests that the HTTP dialer connects to the target through the proxy.
+func TestNewDialer_HttpConnect(t *testing.T) {
+	target := startEcho(t)
+	targets := make(chan string, 1)
+	proxyAddr := startConnectProxy(targets, t)
+
+	dialer, err := NewDialer(&url.URL{Scheme: "http", Host: proxyAddr})
+	if err != nil {
+		t.Fatalf("Failed to create dialer: %+v", err)
+	}
+	conn, err := dialer.Dial("tcp", target)
+	if err != nil {
+		t.Fatalf("Failed to dial through proxy: %+v", err)
+	}
+	defer conn.Close()
+
+	if requested := <-targets; requested != target {
+		t.Errorf("Proxy asked for %s, expected %s", requested, target)
+	}
+	checkEcho(conn, t)
+}
+
+// Tests that unsupported proxy schemes are rejected.
+func TestNewDialer_Unsupported(t *testing.T) {
+	if _, err := NewDialer(&url.URL{Scheme: "ftp", Host: "proxy"}); err == nil {
+		t.Errorf("Dialer created for an unsupported scheme.")
+	}
+}
+
+// Tests that connections to a tunnel reach its target.
+func TestTunnel(t *testing.T) {
+	target := startEcho(t)
+	tunnel, err := NewTunnel(proxy.Direct, target)
+	if err != nil {
+		t.Fatalf("Failed to open tunnel: %+v", err)
+	}
+	defer tunnel.Close()
+
+	info, err := os.Stat(filepath.Dir(tunnel.Path()))
+	if err != nil {
+		t.Fatalf("Failed to stat tunnel directory: %+v", err)
+	}
+	if info.Mode().Perm()&0077 != 0 {
+		t.Errorf("Tunnel directory is open to other users: %s", info.Mode())
+	}
+
+	conn, err := net.Dial("unix", tunnel.Path())
+	if err != nil {
+		t.Fatalf("Failed to dial tunnel: %+v", err)
+	}
+	defer conn.Close()
+	checkEcho(conn, t)
+
+	tunnel.Close()
+	if _, err = os.Stat(tunnel.Path()); !os.IsNotExist(err) {
+		t.Errorf("Tunnel socket not removed on close: %+v", err)
+	}
+}
+
+// Tests that hosts added through the proxy are given the address of a tunnel
+// to their real address.
+func TestProxy_AddHost(t *testing.T) {
+	target := startEcho(t)
+	p := NewWithDialer(proxy.Direct)
+	defer p.Close()
+
+	manager := connect.NewManagerTesting(t)
+	hid := id.NewIdFromString("gateway", id.Gateway, t)
+	host, err := p.AddHost(manager, hid, target, nil,
+		connect.GetDefaultHostParams())
+	if err != nil {
+		t.Fatalf("Failed to add host: %+v", err)
+	}
+	if host.GetAddress() == target {
+		t.Fatalf("Host was not routed through a tunnel.")
+	}
+
+	path := strings.TrimPrefix(host.GetAddress(), "unix://")
+	conn, err := net.Dial("unix", path)
+	if err != nil {
+		t.Fatalf("Failed to dial host address: %+v", err)
+	}
+	defer conn.Close()
+	checkEcho(conn, t)
+
+	p.Remove(hid)
+	if _, err = net.Dial("unix", path); err == nil {
+		t.Errorf("Tunnel still open after the host was removed.")
+	}
+
+	params := connect.GetDefaultHostParams()
+	params.ConnectionType = connect.Web
+	_, err = p.AddHost(manager, id.NewIdFromString("web", id.Gateway, t),
+		target, nil, params)
+	if err == nil {
+		t.Errorf("Routed a web host through the proxy.")
+	}
+}
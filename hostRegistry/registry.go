@@ -0,0 +1,71 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package hostRegistry lets monitoring code walk the hosts of a
+// connect.Manager, which keeps them in an unexported map with no way to
+// iterate it. A Registry is a view of the hosts a hostCache.Reconciler knows
+// of, the hosts of the NDF and those added through it, so the hosts created
+// by network.Instance are seen without keeping a second list. The Reconciler
+// keeps an immutable snapshot of them, replaced on every change, so the hosts
+// can be walked without locks while hosts are added on other goroutines.
+package hostRegistry
+
+import (
+	"gitlab.com/elixxir/comms/hostCache"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// Registry walks the hosts known to a reconciler. Hosts added to or removed
+// from its manager directly are not seen.
+type Registry struct {
+	hosts *hostCache.Reconciler
+}
+
+// New returns a Registry of the hosts known to the reconciler, such as the
+// one returned by network.Instance.GetHosts.
+func New(hosts *hostCache.Reconciler) *Registry {
+	return &Registry{hosts: hosts}
+}
+
+// AddHost adds the host to the manager through the reconciler, or returns
+// the existing host, and tracks it.
+func (r *Registry) AddHost(hid *id.ID, address string, cert []byte,
+	params connect.HostParams) (*connect.Host, error) {
+	return r.hosts.AddHost(hid, address, cert, params)
+}
+
+// RemoveHost disconnects the host, removes it from the manager and stops
+// tracking it.
+func (r *Registry) RemoveHost(hid *id.ID) {
+	r.hosts.RemoveHost(hid)
+}
+
+// GetAllHosts returns the tracked hosts, in the order they were added. The
+// returned slice belongs to the caller.
+func (r *Registry) GetAllHosts() []*connect.Host {
+	hosts := r.hosts.GetAllHosts()
+	return append(make([]*connect.Host, 0, len(hosts)), hosts...)
+}
+
+// ForEach calls f with each host tracked when it is called, in the order
+// they were added, stopping at and returning the first error. No lock is
+// held while f runs, so it may add and remove hosts; those changes are not
+// seen by this walk.
+func (r *Registry) ForEach(f func(host *connect.Host) error) error {
+	for _, host := range r.hosts.GetAllHosts() {
+		if err := f(host); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Len returns the number of tracked hosts.
+func (r *Registry) Len() int {
+	return len(r.hosts.GetAllHosts())
+}
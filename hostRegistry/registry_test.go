@@ -0,0 +1,134 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package hostRegistry
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+
+	"gitlab.com/elixxir/comms/hostCache"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+func TestMain(m *testing.M) {
+	connect.TestingOnlyDisableTLS = true
+	os.Exit(m.Run())
+}
+
+// addHosts adds n client hosts to the registry and returns their IDs.
+func addHosts(r *Registry, n int, t *testing.T) []*id.ID {
+	ids := make([]*id.ID, n)
+	for i := range ids {
+		ids[i] = id.NewIdFromString("client"+strconv.Itoa(i), id.User, t)
+		_, err := r.AddHost(ids[i], "0.0.0.0:5970", nil,
+			connect.GetDefaultHostParams())
+		if err != nil {
+			t.Fatalf("Failed to add host %d: %+v", i, err)
+		}
+	}
+	return ids
+}
+
+// Tests that GetAllHosts returns the added hosts in order, without
+// duplicates, and that removed hosts are dropped.
+func TestRegistry_GetAllHosts(t *testing.T) {
+	manager := connect.NewManagerTesting(t)
+	r := New(hostCache.NewReconciler(manager))
+
+	ids := addHosts(r, 3, t)
+	_, err := r.AddHost(ids[0], "0.0.0.0:5970", nil,
+		connect.GetDefaultHostParams())
+	if err != nil {
+		t.Fatalf("Failed to add host again: %+v", err)
+	}
+
+	hosts := r.GetAllHosts()
+	if len(hosts) != 3 {
+		t.Fatalf("Received %d hosts, expected 3.", len(hosts))
+	}
+	for i, host := range hosts {
+		if !host.GetId().Cmp(ids[i]) {
+			t.Errorf("Host %d is %s, expected %s.", i, host.GetId(), ids[i])
+		}
+	}
+
+	r.RemoveHost(ids[1])
+	if r.Len() != 2 {
+		t.Errorf("Registry holds %d hosts, expected 2.", r.Len())
+	}
+	if _, exists := manager.GetHost(ids[1]); exists {
+		t.Errorf("Removed host still in the manager.")
+	}
+	if len(hosts) != 3 {
+		t.Errorf("Removing a host changed a returned snapshot.")
+	}
+}
+
+// Tests that ForEach walks the hosts tracked when it is called, even as f
+// adds hosts, and stops at the first error.
+func TestRegistry_ForEach(t *testing.T) {
+	r := New(hostCache.NewReconciler(connect.NewManagerTesting(t)))
+	addHosts(r, 2, t)
+
+	visited := 0
+	err := r.ForEach(func(host *connect.Host) error {
+		visited++
+		_, err := r.AddHost(id.NewIdFromString("new"+strconv.Itoa(visited),
+			id.User, t), "0.0.0.0:5970", nil, connect.GetDefaultHostParams())
+		return err
+	})
+	if err != nil {
+		t.Fatalf("ForEach error: %+v", err)
+	}
+	if visited != 2 || r.Len() != 4 {
+		t.Errorf("Visited %d of %d hosts, expected 2 of 4.", visited, r.Len())
+	}
+
+	expected := errors.New("stop")
+	visited = 0
+	err = r.ForEach(func(*connect.Host) error {
+		visited++
+		return expected
+	})
+	if err != expected || visited != 1 {
+		t.Errorf("ForEach visited %d hosts and returned %v.", visited, err)
+	}
+}
+
+// Tests that the hosts can be walked while others are added concurrently.
+// Run with -race to detect races.
+func TestRegistry_Concurrent(t *testing.T) {
+	r := New(hostCache.NewReconciler(connect.NewManagerTesting(t)))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 25; j++ {
+				hid := id.NewIdFromString(
+					"client"+strconv.Itoa(i)+"-"+strconv.Itoa(j), id.User, t)
+				_, err := r.AddHost(hid, "0.0.0.0:5970", nil,
+					connect.GetDefaultHostParams())
+				if err != nil {
+					t.Errorf("Failed to add host: %+v", err)
+				}
+				_ = r.ForEach(func(*connect.Host) error { return nil })
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if r.Len() != 100 {
+		t.Errorf("Registry holds %d hosts, expected 100.", r.Len())
+	}
+}
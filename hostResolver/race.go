@@ -0,0 +1,137 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains racing of dials to the candidate addresses of a host
+
+package hostResolver
+
+import (
+	"context"
+	"net"
+	"strings"
+	"time"
+
+	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/xx_network/comms/connect"
+)
+
+const (
+	// DefaultRaceDelay is how long a race waits for a dial before also
+	// dialing the next address, as recommended by RFC 8305.
+	DefaultRaceDelay = 250 * time.Millisecond
+
+	// raceTimeout bounds a race in which no dial completes.
+	raceTimeout = 10 * time.Second
+)
+
+// dialFunc dials an address, as net.Dialer.DialContext.
+type dialFunc func(ctx context.Context, network, address string) (net.Conn,
+	error)
+
+// dialResult is the result of dialing the address at an index.
+type dialResult struct {
+	index int
+	conn  net.Conn
+	err   error
+}
+
+// Addresses returns a Resolver of fixed candidate addresses, e.g. the public
+// IP and DNS name a node behind NAT advertises.
+func Addresses(addresses ...string) Resolver {
+	addresses = append([]string(nil), addresses...)
+	return Func(func(context.Context) ([]string, error) {
+		return addresses, nil
+	})
+}
+
+// Race returns a Resolver racing TCP dials to the addresses resolved by r,
+// as in the happy eyeballs algorithm. The addresses are dialed in order, each
+// started once the previous fails or has not connected within the delay. The
+// address which connects first is returned first, followed by the others in
+// order, so gRPC connects to it. If no address connects, they are returned
+// unchanged for gRPC to try.
+func Race(r Resolver, delay time.Duration) Resolver {
+	dialer := &net.Dialer{}
+	return Func(func(ctx context.Context) ([]string, error) {
+		addresses, err := r.Resolve(ctx)
+		if err != nil || len(addresses) < 2 {
+			return addresses, err
+		}
+		return race(ctx, addresses, delay, dialer.DialContext), nil
+	})
+}
+
+// UseCandidates resolves the host to the first of the candidate addresses
+// which accepts a connection each time it connects.
+func UseCandidates(host *connect.Host, addresses ...string) {
+	Use(host, Race(Addresses(addresses...), DefaultRaceDelay))
+}
+
+// race dials the addresses as described by Race, returning them with the
+// first to connect moved to the front.
+func race(ctx context.Context, addresses []string, delay time.Duration,
+	dial dialFunc) []string {
+	ctx, cancel := context.WithTimeout(ctx, raceTimeout)
+	defer cancel()
+
+	results := make(chan dialResult, len(addresses))
+	next, pending := 0, 0
+	var wait <-chan time.Time
+	start := func() {
+		go func(i int) {
+			conn, err := dial(ctx, "tcp", addresses[i])
+			results <- dialResult{i, conn, err}
+		}(next)
+		next++
+		pending++
+		wait = nil
+		if next < len(addresses) {
+			wait = time.After(delay)
+		}
+	}
+
+	start()
+	var errs []string
+	for pending > 0 {
+		select {
+		case res := <-results:
+			pending--
+			if res.err != nil {
+				errs = append(errs, res.err.Error())
+				if next < len(addresses) {
+					start()
+				}
+				continue
+			}
+
+			// The connection only probes the address; gRPC makes its own
+			_ = res.conn.Close()
+			go closeLosers(results, pending)
+
+			won := make([]string, 0, len(addresses))
+			won = append(won, addresses[res.index])
+			won = append(won, addresses[:res.index]...)
+			return append(won, addresses[res.index+1:]...)
+		case <-wait:
+			start()
+		}
+	}
+
+	jww.DEBUG.Printf("No candidate address connected: %s",
+		strings.Join(errs, "; "))
+	return addresses
+}
+
+// closeLosers closes the connections of the dials still pending when a race
+// was won.
+func closeLosers(results <-chan dialResult, pending int) {
+	for ; pending > 0; pending-- {
+		if res := <-results; res.err == nil {
+			_ = res.conn.Close()
+		}
+	}
+}
@@ -0,0 +1,84 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package hostResolver
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// closedAddress returns a local address nothing listens on.
+func closedAddress(t *testing.T) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %+v", err)
+	}
+	address := lis.Addr().String()
+	_ = lis.Close()
+	return address
+}
+
+// Tests that the address which accepts a connection is moved to the front,
+// and that the addresses are returned unchanged if none does.
+func TestRace(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %+v", err)
+	}
+	defer lis.Close()
+	closed := closedAddress(t)
+
+	r := Race(Addresses(closed, lis.Addr().String()), time.Hour)
+	addresses, err := r.Resolve(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to resolve: %+v", err)
+	}
+	expected := []string{lis.Addr().String(), closed}
+	if !reflect.DeepEqual(addresses, expected) {
+		t.Errorf("Unexpected addresses.\nexpected: %v\nreceived: %v",
+			expected, addresses)
+	}
+
+	other := closedAddress(t)
+	r = Race(Addresses(closed, other), time.Hour)
+	addresses, err = r.Resolve(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to resolve: %+v", err)
+	}
+	if !reflect.DeepEqual(addresses, []string{closed, other}) {
+		t.Errorf("Addresses changed when none connected: %v", addresses)
+	}
+}
+
+// Tests that the next address is dialed once a dial has not connected within
+// the delay, and wins if it connects first.
+func TestRace_Delay(t *testing.T) {
+	dial := func(ctx context.Context, _, address string) (net.Conn, error) {
+		if address == "slow" {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+		client, server := net.Pipe()
+		_ = server.Close()
+		return client, nil
+	}
+
+	start := time.Now()
+	addresses := race(context.Background(),
+		[]string{"slow", "fast", "unused"}, 10*time.Millisecond, dial)
+	if !reflect.DeepEqual(addresses, []string{"fast", "slow", "unused"}) {
+		t.Errorf("Unexpected addresses: %v", addresses)
+	}
+	if elapsed := time.Since(start); elapsed < 10*time.Millisecond {
+		t.Errorf("Second address dialed after %s, before the delay.",
+			elapsed)
+	}
+}
@@ -0,0 +1,224 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package hostResolver resolves the addresses of hosts each time they
+// connect, so that a host whose address changes keeps working without being
+// recreated. A connect.Host dials the address it holds as a gRPC target, so
+// a host using a Resolver is given an address in the "xxhost" scheme, which
+// gRPC resolves through this package when it dials and again when the
+// connection fails.
+//
+// Hosts using a Resolver must connect over gRPC; web connections and
+// connect.Host.IsOnline dial the address directly and do not support it.
+// Their certificate must name the DNS name the host is verified against, as
+// the address no longer does.
+package hostResolver
+
+import (
+	"context"
+	"encoding/base64"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/ndf"
+	"google.golang.org/grpc/resolver"
+)
+
+// Scheme is the scheme of the addresses resolved by this package.
+const Scheme = "xxhost"
+
+// Resolver returns the current addresses of a host. Addresses are in the
+// host:port form; the first which accepts a connection is used.
+type Resolver interface {
+	Resolve(ctx context.Context) ([]string, error)
+}
+
+// Func is a Resolver calling a function, e.g. to read the address of a host
+// from a custom supplier.
+type Func func(ctx context.Context) ([]string, error)
+
+// Resolve calls the function.
+func (f Func) Resolve(ctx context.Context) ([]string, error) {
+	return f(ctx)
+}
+
+// Static returns a Resolver of a fixed address.
+func Static(address string) Resolver {
+	return Func(func(context.Context) ([]string, error) {
+		return []string{address}, nil
+	})
+}
+
+// DNS returns a Resolver looking up the IP addresses of the host name each
+// time it resolves.
+func DNS(host string, port int) Resolver {
+	return Func(func(ctx context.Context) ([]string, error) {
+		ips, err := net.DefaultResolver.LookupHost(ctx, host)
+		if err != nil {
+			return nil, errors.Errorf("Failed to look up %s: %+v", host, err)
+		}
+		addresses := make([]string, len(ips))
+		for i, ip := range ips {
+			addresses[i] = net.JoinHostPort(ip, strconv.Itoa(port))
+		}
+		return addresses, nil
+	})
+}
+
+// Ndf returns a Resolver reading the address of the node or gateway from the
+// NDF returned by get, e.g. the latest NDF of a network.Instance. Gateways
+// share the ID of their node with the gateway type.
+func Ndf(get func() *ndf.NetworkDefinition, hid *id.ID) Resolver {
+	return Func(func(context.Context) ([]string, error) {
+		def := get()
+		if def == nil {
+			return nil, errors.New("No NDF to resolve from")
+		}
+		nodeID := hid.DeepCopy()
+		nodeID.SetType(id.Node)
+		for i, n := range def.Nodes {
+			nid, err := id.Unmarshal(n.ID)
+			if err != nil || !nid.Cmp(nodeID) {
+				continue
+			}
+			if hid.GetType() != id.Gateway {
+				return []string{n.Address}, nil
+			}
+			if i >= len(def.Gateways) {
+				break
+			}
+			return []string{def.Gateways[i].Address}, nil
+		}
+		return nil, errors.Errorf("%s is not in the NDF", hid)
+	})
+}
+
+var (
+	resolvers = make(map[string]Resolver)
+	mux       sync.RWMutex
+)
+
+func init() {
+	resolver.Register(builder{})
+}
+
+// Set resolves the addresses of the host with the ID with the Resolver and
+// returns the address the host must be given, which does not change with the
+// Resolver.
+func Set(hid *id.ID, r Resolver) string {
+	k := key(hid)
+	mux.Lock()
+	resolvers[k] = r
+	mux.Unlock()
+	return Scheme + ":///" + k
+}
+
+// Remove stops resolving the addresses of the host with the ID.
+func Remove(hid *id.ID) {
+	mux.Lock()
+	delete(resolvers, key(hid))
+	mux.Unlock()
+}
+
+// Use resolves the addresses of the host with the Resolver from its next
+// connection onwards.
+func Use(host *connect.Host, r Resolver) {
+	host.UpdateAddress(Set(host.GetId(), r))
+}
+
+// IsAddress returns true if the address is resolved by this package.
+func IsAddress(address string) bool {
+	return strings.HasPrefix(address, Scheme+":")
+}
+
+// key returns the key of the host with the ID in its address.
+func key(hid *id.ID) string {
+	return base64.RawURLEncoding.EncodeToString(hid.Marshal())
+}
+
+// get returns the Resolver of the key.
+func get(k string) (Resolver, bool) {
+	mux.RLock()
+	defer mux.RUnlock()
+	r, exists := resolvers[k]
+	return r, exists
+}
+
+// builder builds the gRPC resolvers of addresses in the xxhost scheme.
+type builder struct{}
+
+// Build returns a gRPC resolver of the host in the target and resolves it.
+func (builder) Build(target resolver.Target, cc resolver.ClientConn,
+	_ resolver.BuildOptions) (resolver.Resolver, error) {
+	k := strings.TrimPrefix(target.URL.Path, "/")
+	if k == "" {
+		k = target.URL.Opaque
+	}
+	if _, exists := get(k); !exists {
+		return nil, errors.Errorf("No resolver for %s", target.URL.String())
+	}
+
+	gr := &grpcResolver{key: k, cc: cc}
+	if err := gr.resolve(); err != nil {
+		return nil, err
+	}
+	return gr, nil
+}
+
+// Scheme returns the scheme of the addresses resolved by this package.
+func (builder) Scheme() string {
+	return Scheme
+}
+
+// grpcResolver resolves a host for gRPC with the Resolver last set for it.
+type grpcResolver struct {
+	key string
+	cc  resolver.ClientConn
+}
+
+// resolve passes the current addresses of the host to gRPC.
+func (gr *grpcResolver) resolve() error {
+	r, exists := get(gr.key)
+	if !exists {
+		err := errors.Errorf("No resolver for host %s", gr.key)
+		gr.cc.ReportError(err)
+		return err
+	}
+
+	addresses, err := r.Resolve(context.Background())
+	if err == nil && len(addresses) == 0 {
+		err = errors.New("no addresses")
+	}
+	if err != nil {
+		err = errors.Errorf("Failed to resolve host %s: %+v", gr.key, err)
+		gr.cc.ReportError(err)
+		return err
+	}
+
+	state := resolver.State{Addresses: make([]resolver.Address, len(addresses))}
+	for i, address := range addresses {
+		state.Addresses[i] = resolver.Address{Addr: address}
+	}
+	return gr.cc.UpdateState(state)
+}
+
+// ResolveNow resolves the host again, which gRPC requests when a connection
+// to it fails.
+func (gr *grpcResolver) ResolveNow(resolver.ResolveNowOptions) {
+	if err := gr.resolve(); err != nil {
+		jww.WARN.Printf("%+v", err)
+	}
+}
+
+// Close does nothing, as the resolver holds no resources.
+func (gr *grpcResolver) Close() {}
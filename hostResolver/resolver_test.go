@@ -0,0 +1,114 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package hostResolver
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/ndf"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Tests that a connection to an address of the scheme reaches the address
+// returned by the Resolver.
+func TestSet_Dial(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %+v", err)
+	}
+	server := grpc.NewServer()
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	go func() { _ = server.Serve(lis) }()
+	defer server.Stop()
+
+	hid := id.NewIdFromString("host", id.Node, t)
+	resolved := make(chan struct{}, 10)
+	address := Set(hid, Func(func(context.Context) ([]string, error) {
+		resolved <- struct{}{}
+		return []string{lis.Addr().String()}, nil
+	}))
+	defer Remove(hid)
+	if !IsAddress(address) {
+		t.Errorf("%s is not an address of the scheme.", address)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, address, grpc.WithInsecure(),
+		grpc.WithBlock())
+	if err != nil {
+		t.Fatalf("Failed to dial %s: %+v", address, err)
+	}
+	defer conn.Close()
+
+	_, err = healthpb.NewHealthClient(conn).Check(ctx,
+		&healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Errorf("Failed to call the resolved server: %+v", err)
+	}
+	if len(resolved) == 0 {
+		t.Errorf("Resolver was not called.")
+	}
+}
+
+// Tests that a host without a Resolver cannot be dialed.
+func TestBuild_NoResolver(t *testing.T) {
+	hid := id.NewIdFromString("unknown", id.Node, t)
+	address := Set(hid, Static("127.0.0.1:1"))
+	Remove(hid)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, err := grpc.DialContext(ctx, address, grpc.WithInsecure(),
+		grpc.WithBlock()); err == nil {
+		t.Errorf("Dialed a host without a resolver.")
+	}
+}
+
+// Tests that Ndf resolves nodes and their gateways from the latest NDF.
+func TestNdf(t *testing.T) {
+	nid := id.NewIdFromString("node", id.Node, t)
+	gwID := nid.DeepCopy()
+	gwID.SetType(id.Gateway)
+	def := &ndf.NetworkDefinition{
+		Nodes:    []ndf.Node{{ID: nid.Marshal(), Address: "1.2.3.4:11420"}},
+		Gateways: []ndf.Gateway{{Address: "1.2.3.4:22840"}},
+	}
+	get := func() *ndf.NetworkDefinition { return def }
+
+	ctx := context.Background()
+	if addresses, err := Ndf(get, nid).Resolve(ctx); err != nil ||
+		!reflect.DeepEqual(addresses, []string{"1.2.3.4:11420"}) {
+		t.Errorf("Unexpected node addresses: %v (%v)", addresses, err)
+	}
+	if addresses, err := Ndf(get, gwID).Resolve(ctx); err != nil ||
+		!reflect.DeepEqual(addresses, []string{"1.2.3.4:22840"}) {
+		t.Errorf("Unexpected gateway addresses: %v (%v)", addresses, err)
+	}
+
+	def = &ndf.NetworkDefinition{
+		Nodes:    []ndf.Node{{ID: nid.Marshal(), Address: "5.6.7.8:11420"}},
+		Gateways: []ndf.Gateway{{Address: "5.6.7.8:22840"}},
+	}
+	if addresses, _ := Ndf(get, gwID).Resolve(ctx); !reflect.DeepEqual(
+		addresses, []string{"5.6.7.8:22840"}) {
+		t.Errorf("Address not updated from the new NDF: %v", addresses)
+	}
+
+	other := id.NewIdFromString("other", id.Node, t)
+	if _, err := Ndf(get, other).Resolve(ctx); err == nil {
+		t.Errorf("Resolved a node missing from the NDF.")
+	}
+}
@@ -0,0 +1,253 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package hostState notifies listeners when the connection of a host changes
+// state. A connect.Host only reports whether it is connected when asked, so
+// higher layers, such as round tracking in the client, poll Connected() to
+// find a gateway which has gone down. A Notifier watches the gRPC connection
+// of each of its hosts and calls listeners as soon as its state changes.
+package hostState
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/any"
+	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+// DefaultInterval is the default interval at which hosts without a
+// connection are checked for a new one.
+const DefaultInterval = time.Second
+
+// StateListener is called when the connection of a host changes state.
+type StateListener func(old, new connectivity.State)
+
+// Listener is called when the connection of any watched host changes state.
+type Listener func(host *connect.Host, old, new connectivity.State)
+
+// watched is a host watched by a Notifier.
+type watched struct {
+	host *connect.Host
+	// Last state observed. Hosts start out Idle.
+	state     connectivity.State
+	listeners map[uint64]StateListener
+	cancel    context.CancelFunc
+}
+
+// Notifier watches the connection state of its hosts.
+type Notifier struct {
+	comms *connect.ProtoComms
+	// Interval at which hosts without a connection are checked
+	interval  time.Duration
+	hosts     map[id.ID]*watched
+	listeners map[uint64]Listener
+	nextID    uint64
+	mux       sync.Mutex
+}
+
+// New returns a Notifier without hosts, which looks up the connections of
+// hosts through the comms. The ProtoComms embedded in a Comms object is
+// taken, rather than the Comms, so that the lookups do not run its send hooks.
+// Hosts without a connection are checked for a new one on the interval; if it
+// is zero, DefaultInterval is used.
+func New(comms *connect.ProtoComms, interval time.Duration) *Notifier {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &Notifier{
+		comms:     comms,
+		interval:  interval,
+		hosts:     make(map[id.ID]*watched),
+		listeners: make(map[uint64]Listener),
+	}
+}
+
+// Watch starts watching the connection of the host. Watching a host does not
+// connect it; a host without a connection is reported as Shutdown until it
+// connects. Watching a watched host does nothing.
+func (n *Notifier) Watch(host *connect.Host) {
+	n.mux.Lock()
+	defer n.mux.Unlock()
+	n.watch(host)
+}
+
+// watch starts watching the host if it is not yet watched and returns it.
+// Must be called with the lock held.
+func (n *Notifier) watch(host *connect.Host) *watched {
+	if w, exists := n.hosts[*host.GetId()]; exists {
+		return w
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &watched{
+		host:      host,
+		state:     connectivity.Idle,
+		listeners: make(map[uint64]StateListener),
+		cancel:    cancel,
+	}
+	n.hosts[*host.GetId()] = w
+	go n.run(ctx, w)
+	return w
+}
+
+// Unwatch stops watching the host and drops its listeners.
+func (n *Notifier) Unwatch(hid *id.ID) {
+	n.mux.Lock()
+	defer n.mux.Unlock()
+	if w, exists := n.hosts[*hid]; exists {
+		w.cancel()
+		delete(n.hosts, *hid)
+	}
+}
+
+// Stop stops watching every host.
+func (n *Notifier) Stop() {
+	n.mux.Lock()
+	defer n.mux.Unlock()
+	for hid, w := range n.hosts {
+		w.cancel()
+		delete(n.hosts, hid)
+	}
+}
+
+// RegisterStateListener calls the listener each time the connection of the
+// host changes state, watching the host if it is not yet watched. Listeners
+// are called on the goroutine watching the host and so must not block. The
+// returned function unregisters the listener.
+func (n *Notifier) RegisterStateListener(host *connect.Host,
+	listener StateListener) func() {
+	n.mux.Lock()
+	defer n.mux.Unlock()
+
+	w := n.watch(host)
+	n.nextID++
+	listenerID := n.nextID
+	w.listeners[listenerID] = listener
+
+	return func() {
+		n.mux.Lock()
+		defer n.mux.Unlock()
+		delete(w.listeners, listenerID)
+	}
+}
+
+// Subscribe calls the listener each time the connection of any watched host
+// changes state. Listeners are called on the goroutine watching the host and
+// so must not block. The returned function unsubscribes the listener.
+func (n *Notifier) Subscribe(listener Listener) func() {
+	n.mux.Lock()
+	defer n.mux.Unlock()
+
+	n.nextID++
+	listenerID := n.nextID
+	n.listeners[listenerID] = listener
+
+	return func() {
+		n.mux.Lock()
+		defer n.mux.Unlock()
+		delete(n.listeners, listenerID)
+	}
+}
+
+// State returns the last observed state of the connection of the host, or
+// false if the host is not watched.
+func (n *Notifier) State(hid *id.ID) (connectivity.State, bool) {
+	n.mux.Lock()
+	defer n.mux.Unlock()
+	if w, exists := n.hosts[*hid]; exists {
+		return w.state, true
+	}
+	return connectivity.Shutdown, false
+}
+
+// run follows the state of the connection of the host until the context is
+// cancelled. A connection ends in Shutdown once the host disconnects, after
+// which the host is checked on the interval for a new connection.
+func (n *Notifier) run(ctx context.Context, w *watched) {
+	for {
+		conn, state := n.conn(w.host)
+		for conn != nil {
+			state = conn.GetState()
+			n.set(w, state)
+			if state == connectivity.Shutdown {
+				break
+			}
+			if !conn.WaitForStateChange(ctx, state) {
+				return
+			}
+		}
+		n.set(w, state)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(n.interval):
+		}
+	}
+}
+
+// conn returns the gRPC connection of the host if it is connected. If it is
+// not, or is connected over the web, where the connection state is not
+// available, the state to report is returned instead.
+func (n *Notifier) conn(host *connect.Host) (*grpc.ClientConn,
+	connectivity.State) {
+	if connected, _ := host.Connected(); !connected {
+		return nil, connectivity.Shutdown
+	}
+	if host.IsWeb() {
+		return nil, connectivity.Ready
+	}
+
+	var conn *grpc.ClientConn
+	_, err := n.comms.Send(host, func(c connect.Connection) (
+		*any.Any, error) {
+		conn = c.GetGrpcConn()
+		return nil, nil
+	})
+	if err != nil {
+		jww.DEBUG.Printf("Failed to get the connection of %s: %+v",
+			host.GetId(), err)
+		return nil, connectivity.TransientFailure
+	}
+	return conn, connectivity.Ready
+}
+
+// set records the state of the host and, if it changed, calls the listeners.
+// Nothing is done once the host is no longer watched.
+func (n *Notifier) set(w *watched, state connectivity.State) {
+	n.mux.Lock()
+	old := w.state
+	if old == state || n.hosts[*w.host.GetId()] != w {
+		n.mux.Unlock()
+		return
+	}
+	w.state = state
+	hostListeners := make([]StateListener, 0, len(w.listeners))
+	for _, l := range w.listeners {
+		hostListeners = append(hostListeners, l)
+	}
+	listeners := make([]Listener, 0, len(n.listeners))
+	for _, l := range n.listeners {
+		listeners = append(listeners, l)
+	}
+	n.mux.Unlock()
+
+	jww.DEBUG.Printf("Connection to %s changed from %s to %s",
+		w.host.GetId(), old, state)
+	for _, l := range hostListeners {
+		l(old, state)
+	}
+	for _, l := range listeners {
+		l(w.host, old, state)
+	}
+}
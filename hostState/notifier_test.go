@@ -0,0 +1,120 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package hostState
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"gitlab.com/elixxir/comms/gateway"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/gossip"
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc/connectivity"
+)
+
+func TestMain(m *testing.M) {
+	connect.TestingOnlyDisableTLS = true
+	os.Exit(m.Run())
+}
+
+// transition is a state change received by a listener.
+type transition struct {
+	old, new connectivity.State
+}
+
+// waitFor waits for a transition to the state.
+func waitFor(t *testing.T, c chan transition, state connectivity.State) {
+	t.Helper()
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case tr := <-c:
+			if tr.new == state {
+				return
+			}
+		case <-timeout:
+			t.Fatalf("Timed out waiting for state %s", state)
+		}
+	}
+}
+
+// Tests that listeners are told when a watched host connects and when its
+// connection goes down.
+func TestNotifier_RegisterStateListener(t *testing.T) {
+	remoteAddress := "0.0.0.0:5970"
+	remoteID := id.NewIdFromString("remote", id.Gateway, t)
+	remote := gateway.StartGateway(remoteID, remoteAddress,
+		gateway.NewImplementation(), nil, nil, gossip.DefaultManagerFlags())
+	defer remote.Shutdown()
+
+	localID := id.NewIdFromString("local", id.Gateway, t)
+	local := gateway.StartGateway(localID, "0.0.0.0:5971",
+		gateway.NewImplementation(), nil, nil, gossip.DefaultManagerFlags())
+	defer local.Shutdown()
+
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := local.AddHost(remoteID, remoteAddress, nil, params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+
+	n := New(local.ProtoComms, 10*time.Millisecond)
+	defer n.Stop()
+
+	hostChanges := make(chan transition, 16)
+	unregister := n.RegisterStateListener(host,
+		func(old, new connectivity.State) {
+			hostChanges <- transition{old, new}
+		})
+	defer unregister()
+	allChanges := make(chan transition, 16)
+	n.Subscribe(func(h *connect.Host, old, new connectivity.State) {
+		if h.GetId().Cmp(remoteID) {
+			allChanges <- transition{old, new}
+		}
+	})
+
+	// Watching the host does not connect it
+	waitFor(t, hostChanges, connectivity.Shutdown)
+
+	if err = host.Connect(); err != nil {
+		t.Fatalf("Failed to connect: %+v", err)
+	}
+	waitFor(t, hostChanges, connectivity.Ready)
+	waitFor(t, allChanges, connectivity.Ready)
+	if state, _ := n.State(remoteID); state != connectivity.Ready {
+		t.Errorf("State is %s, expected %s", state, connectivity.Ready)
+	}
+
+	host.Disconnect()
+	waitFor(t, hostChanges, connectivity.Shutdown)
+	waitFor(t, allChanges, connectivity.Shutdown)
+}
+
+// Tests that an unwatched host is no longer reported.
+func TestNotifier_Unwatch(t *testing.T) {
+	hostID := id.NewIdFromString("host", id.Gateway, t)
+	host, err := connect.NewHost(hostID, "0.0.0.0:5972", nil,
+		connect.GetDefaultHostParams())
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+
+	n := New(nil, time.Hour)
+	n.Watch(host)
+	if _, watched := n.State(hostID); !watched {
+		t.Fatal("Host is not watched")
+	}
+	n.Unwatch(hostID)
+	if _, watched := n.State(hostID); watched {
+		t.Error("Host is still watched")
+	}
+}
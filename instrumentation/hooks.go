@@ -0,0 +1,285 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package instrumentation reports every send made through a Comms object to
+// callbacks registered by the user, so upper layers can feed their own
+// telemetry without wrapping each Send function. It also keeps counters of
+// the sends to each host, so operators can see which hosts are failing.
+package instrumentation
+
+import (
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+	"gitlab.com/elixxir/comms/bandwidth"
+	"gitlab.com/elixxir/comms/chaos"
+	"gitlab.com/elixxir/comms/circuitBreaker"
+	"gitlab.com/elixxir/comms/rateLimit"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc/connectivity"
+)
+
+// SendInfo describes a send as it starts.
+type SendInfo struct {
+	// Name of the Send function, e.g. "SendPoll"
+	RPC   string
+	Host  *id.ID
+	Start time.Time
+}
+
+// SendResult describes a completed send.
+type SendResult struct {
+	SendInfo
+	Duration time.Duration
+	// Size of the marshalled response; zero if the send failed
+	Bytes int
+	Err   error
+}
+
+// Callbacks are called for every send. Either may be nil. They are called
+// synchronously on the sending goroutine and so must not block.
+type Callbacks struct {
+	OnSendStart    func(info SendInfo)
+	OnSendComplete func(result SendResult)
+}
+
+// Hooks holds the callbacks of a Comms object. The zero value has no
+// callbacks and is ready to use.
+type Hooks struct {
+	callbacks Callbacks
+	// Faults injected into sends and streams, for testing
+	faults *chaos.Injector
+	// Limits the rate of sends and streams to each host
+	limiter *rateLimit.Limiter
+	// Accounts for the bytes sent to each host and enforces their quotas
+	meter *bandwidth.Meter
+	// Fail sends and streams fast to hosts which keep failing
+	breakers *circuitBreaker.Breakers
+	// Counters of the sends to each host; created on first use
+	metrics *Metrics
+	mux     sync.RWMutex
+}
+
+// Metrics returns the metrics of the hosts sent to.
+func (h *Hooks) Metrics() *Metrics {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	if h.metrics == nil {
+		h.metrics = NewMetrics()
+	}
+	return h.metrics
+}
+
+// Set replaces the callbacks. Passing the zero Callbacks disables reporting.
+func (h *Hooks) Set(callbacks Callbacks) {
+	h.mux.Lock()
+	h.callbacks = callbacks
+	h.mux.Unlock()
+}
+
+// SetFaults sets the injector whose faults are injected into sends and
+// streams. Passing nil disables fault injection.
+func (h *Hooks) SetFaults(faults *chaos.Injector) {
+	h.mux.Lock()
+	h.faults = faults
+	h.mux.Unlock()
+}
+
+// SetRateLimiter sets the limiter of the rate of sends and streams to each
+// host. Passing nil removes the limits.
+func (h *Hooks) SetRateLimiter(limiter *rateLimit.Limiter) {
+	h.mux.Lock()
+	h.limiter = limiter
+	h.mux.Unlock()
+}
+
+// SetBandwidthMeter sets the meter enforcing the bandwidth quota of each
+// host, and binds the connections sent over to their hosts so that the
+// meter's interceptors can account for them. Passing nil stops enforcing
+// quotas.
+func (h *Hooks) SetBandwidthMeter(meter *bandwidth.Meter) {
+	h.mux.Lock()
+	h.meter = meter
+	h.mux.Unlock()
+}
+
+// SetCircuitBreakers sets the breakers which fail sends and streams fast to
+// hosts which keep failing. Passing nil disables them.
+func (h *Hooks) SetCircuitBreakers(breakers *circuitBreaker.Breakers) {
+	h.mux.Lock()
+	h.breakers = breakers
+	h.mux.Unlock()
+}
+
+// Send calls pc.Send, reporting the send to the callbacks and recording it
+// in the metrics of the host. It must be called directly from the Send method
+// of a Comms object so that the name of the Send function can be found.
+func (h *Hooks) Send(pc *connect.ProtoComms, host *connect.Host,
+	f func(conn connect.Connection) (*any.Any, error)) (*any.Any, error) {
+	h.mux.RLock()
+	callbacks := h.callbacks
+	faults := h.faults
+	limiter := h.limiter
+	meter := h.meter
+	breakers := h.breakers
+	h.mux.RUnlock()
+	metrics := h.Metrics()
+
+	info := SendInfo{
+		// Skip this function and the Comms Send method
+		RPC:   callerName(3),
+		Host:  host.GetId(),
+		Start: time.Now(),
+	}
+	if callbacks.OnSendStart != nil {
+		callbacks.OnSendStart(info)
+	}
+
+	_, connections := host.Connected()
+	state := connectivity.Idle
+	var result *any.Any
+	err := limiter.Allow(info.Host)
+	if err == nil {
+		err = meter.Allow(info.Host)
+	}
+	if err == nil {
+		err = faults.Inject(info.RPC, info.Host)
+	}
+	if err == nil {
+		err = breakers.Allow(info.Host)
+	}
+	if err == nil {
+		result, err = pc.Send(host, func(conn connect.Connection) (
+			*any.Any, error) {
+			if !conn.IsWeb() {
+				meter.Bind(conn.GetGrpcConn(), info.Host)
+			}
+			defer func() {
+				if !conn.IsWeb() {
+					state = conn.GetGrpcConn().GetState()
+				}
+			}()
+			return f(conn)
+		})
+		breakers.Record(info.Host, err)
+	}
+	_, newConnections := host.Connected()
+
+	sr := SendResult{
+		SendInfo: info,
+		Duration: time.Since(info.Start),
+		Err:      err,
+	}
+	if result != nil {
+		sr.Bytes = proto.Size(result)
+	}
+	// The first connection to a host is not a reconnection
+	metrics.record(sr, connections != 0 && newConnections != connections,
+		state)
+	if callbacks.OnSendComplete != nil {
+		callbacks.OnSendComplete(sr)
+	}
+
+	return result, err
+}
+
+// Stream calls pc.Stream, applying the rate limit, bandwidth quota and
+// circuit breaker of the host and injecting any faults into the stream. It
+// must be called directly from the Stream method of a Comms object so that
+// the name of the function opening the stream can be found.
+func (h *Hooks) Stream(pc *connect.ProtoComms, host *connect.Host,
+	f func(conn connect.Connection) (interface{}, error)) (interface{}, error) {
+	h.mux.RLock()
+	faults := h.faults
+	limiter := h.limiter
+	meter := h.meter
+	breakers := h.breakers
+	h.mux.RUnlock()
+
+	if err := limiter.Allow(host.GetId()); err != nil {
+		return nil, err
+	}
+	if err := meter.Allow(host.GetId()); err != nil {
+		return nil, err
+	}
+	if faults != nil {
+		// Skip this function and the Comms Stream method
+		err := faults.Inject(callerName(3), host.GetId())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := breakers.Allow(host.GetId()); err != nil {
+		return nil, err
+	}
+
+	stream, err := pc.Stream(host, func(conn connect.Connection) (
+		interface{}, error) {
+		if !conn.IsWeb() {
+			meter.Bind(conn.GetGrpcConn(), host.GetId())
+		}
+		return f(conn)
+	})
+	breakers.Record(host.GetId(), err)
+	return stream, err
+}
+
+// maxCallerDepth is the number of frames searched for the Send function.
+const maxCallerDepth = 8
+
+// callerName returns the name of the exported function which made the send,
+// skipping the given number of frames. Unexported helpers and closures are
+// skipped, so a send made by sendFoo inside a closure in Foo is named "Foo".
+// If no exported function is found, the name of the first caller is used.
+func callerName(skip int) string {
+	pcs := make([]uintptr, maxCallerDepth)
+	n := runtime.Callers(skip+1, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	first := ""
+	for {
+		frame, more := frames.Next()
+		name := funcName(frame.Function)
+		if first == "" {
+			first = name
+		}
+		if name != "" && unicode.IsUpper([]rune(name)[0]) {
+			return name
+		}
+		if !more {
+			return first
+		}
+	}
+}
+
+// funcName returns the function or method name from a fully qualified
+// function name, e.g. "SendPoll" from
+// "gitlab.com/elixxir/comms/client.(*Comms).SendPoll", with any closure
+// suffixes (".func1") removed.
+func funcName(qualified string) string {
+	// Remove the package path
+	if i := strings.LastIndex(qualified, "/"); i >= 0 {
+		qualified = qualified[i+1:]
+	}
+
+	// Closures are named "func1", with nested closures numbered ".2"
+	parts := strings.Split(qualified, ".")
+	for i := len(parts) - 1; i > 0; i-- {
+		if !strings.HasPrefix(parts[i], "func") &&
+			strings.TrimFunc(parts[i], unicode.IsDigit) != "" {
+			return parts[i]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,39 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package instrumentation
+
+import "testing"
+
+// Tests that funcName extracts the function name from qualified names.
+func Test_funcName(t *testing.T) {
+	tests := map[string]string{
+		"gitlab.com/elixxir/comms/client.(*Comms).SendPoll":         "SendPoll",
+		"gitlab.com/elixxir/comms/client.(*Comms).RequestNdf.func1": "RequestNdf",
+		"gitlab.com/elixxir/comms/client.(*Comms).Stream.func1.2":   "Stream",
+		"gitlab.com/elixxir/comms/client.newThing":                  "newThing",
+		"main.main": "main",
+		"":          "",
+	}
+
+	for qualified, expected := range tests {
+		if name := funcName(qualified); name != expected {
+			t.Errorf("funcName(%q) = %q, expected %q", qualified, name,
+				expected)
+		}
+	}
+}
+
+func sendHelper() string { return callerName(1) }
+
+// Tests that callerName skips unexported helpers and closures.
+func Test_callerName(t *testing.T) {
+	name := func() string { return sendHelper() }()
+	if name != "Test_callerName" {
+		t.Errorf("Unexpected caller name %q", name)
+	}
+}
@@ -0,0 +1,113 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the histogram of the round-trip latency of the sends to a host
+
+package instrumentation
+
+import (
+	"time"
+)
+
+// latencyBuckets is the number of bounded buckets of a LatencyHistogram.
+// Their upper bounds double from a millisecond, the last being about nine
+// minutes; longer sends fall in an overflow bucket.
+const latencyBuckets = 20
+
+// latencyBound returns the upper bound of the bucket.
+func latencyBound(bucket int) time.Duration {
+	return time.Millisecond << uint(bucket)
+}
+
+// LatencyStats summarise the round-trip latency of the successful sends to a
+// host.
+type LatencyStats struct {
+	Count uint64
+	Mean  time.Duration
+	P50   time.Duration
+	P95   time.Duration
+	P99   time.Duration
+	Max   time.Duration
+}
+
+// LatencyHistogram counts the round-trip latency of sends in buckets whose
+// bounds double, so percentiles are estimated to within a factor of two.
+type LatencyHistogram struct {
+	// Number of sends in each bucket, the last being the overflow
+	Counts [latencyBuckets + 1]uint64
+	Count  uint64
+	Sum    time.Duration
+	Max    time.Duration
+}
+
+// Add counts a send which took the duration.
+func (h *LatencyHistogram) Add(d time.Duration) {
+	bucket := 0
+	for bucket < latencyBuckets && d > latencyBound(bucket) {
+		bucket++
+	}
+	h.Counts[bucket]++
+	h.Count++
+	h.Sum += d
+	if d > h.Max {
+		h.Max = d
+	}
+}
+
+// Percentile returns the estimated latency below which the fraction p of
+// sends fall, interpolating within the bucket it lies in. Returns zero if
+// no sends were counted.
+func (h LatencyHistogram) Percentile(p float64) time.Duration {
+	if h.Count == 0 {
+		return 0
+	}
+	if p <= 0 {
+		p = 0
+	} else if p > 1 {
+		p = 1
+	}
+
+	rank := p * float64(h.Count)
+	var below uint64
+	for bucket, count := range h.Counts {
+		if count == 0 || float64(below+count) < rank {
+			below += count
+			continue
+		}
+
+		lower := time.Duration(0)
+		if bucket > 0 {
+			lower = latencyBound(bucket - 1)
+		}
+		upper := h.Max
+		if bucket < latencyBuckets && latencyBound(bucket) < upper {
+			upper = latencyBound(bucket)
+		}
+		if upper <= lower {
+			return upper
+		}
+
+		fraction := (rank - float64(below)) / float64(count)
+		return lower + time.Duration(fraction*float64(upper-lower))
+	}
+	return h.Max
+}
+
+// Stats returns the summary of the histogram.
+func (h LatencyHistogram) Stats() LatencyStats {
+	stats := LatencyStats{
+		Count: h.Count,
+		P50:   h.Percentile(0.50),
+		P95:   h.Percentile(0.95),
+		P99:   h.Percentile(0.99),
+		Max:   h.Max,
+	}
+	if h.Count > 0 {
+		stats.Mean = h.Sum / time.Duration(h.Count)
+	}
+	return stats
+}
@@ -0,0 +1,88 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package instrumentation
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc/connectivity"
+)
+
+// Tests that percentiles are estimated to within the bucket they fall in.
+func TestLatencyHistogram_Percentile(t *testing.T) {
+	var h LatencyHistogram
+	if h.Percentile(0.5) != 0 {
+		t.Error("Empty histogram has a non-zero percentile")
+	}
+
+	// 90 fast sends and 10 slow ones
+	for i := 0; i < 90; i++ {
+		h.Add(3 * time.Millisecond)
+	}
+	for i := 0; i < 10; i++ {
+		h.Add(700 * time.Millisecond)
+	}
+
+	if p50 := h.Percentile(0.5); p50 < 2*time.Millisecond ||
+		p50 > 4*time.Millisecond {
+		t.Errorf("p50 %s outside the bucket of 3ms", p50)
+	}
+	if p99 := h.Percentile(0.99); p99 < 512*time.Millisecond ||
+		p99 > 700*time.Millisecond {
+		t.Errorf("p99 %s outside the bucket of 700ms", p99)
+	}
+	if max := h.Percentile(1); max != 700*time.Millisecond {
+		t.Errorf("p100 is %s, expected the maximum", max)
+	}
+}
+
+// Tests that sends longer than the last bound fall in the overflow bucket.
+func TestLatencyHistogram_Overflow(t *testing.T) {
+	var h LatencyHistogram
+	long := latencyBound(latencyBuckets) * 2
+	h.Add(long)
+	if h.Counts[latencyBuckets] != 1 {
+		t.Errorf("Send not counted in the overflow bucket: %v", h.Counts)
+	}
+	if p := h.Percentile(0.5); p < latencyBound(latencyBuckets-1) || p > long {
+		t.Errorf("Unexpected percentile %s", p)
+	}
+}
+
+// Tests that only successful sends are counted in the latency of a host.
+func TestMetrics_GetLatencyStats(t *testing.T) {
+	hostID := id.NewIdFromString("gateway", id.Gateway, t)
+	m := NewMetrics()
+	if _, exists := m.GetLatencyStats(hostID); exists {
+		t.Error("Stats returned for a host never sent to")
+	}
+
+	info := SendInfo{Host: hostID, Start: time.Now()}
+	m.record(SendResult{SendInfo: info, Duration: 10 * time.Millisecond},
+		false, connectivity.Ready)
+	m.record(SendResult{SendInfo: info, Duration: 30 * time.Millisecond},
+		false, connectivity.Ready)
+	m.record(SendResult{SendInfo: info, Duration: time.Minute,
+		Err: errors.New("failed")}, false, connectivity.Ready)
+
+	stats, exists := m.GetLatencyStats(hostID)
+	if !exists {
+		t.Fatal("No stats for the host")
+	}
+	if stats.Count != 2 || stats.Mean != 20*time.Millisecond ||
+		stats.Max != 30*time.Millisecond {
+		t.Errorf("Unexpected stats: %+v", stats)
+	}
+	if stats.P50 > stats.P95 || stats.P95 > stats.P99 ||
+		stats.P99 > stats.Max {
+		t.Errorf("Percentiles out of order: %+v", stats)
+	}
+}
@@ -0,0 +1,210 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains per-host connection metrics gathered from sends
+
+package instrumentation
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc/connectivity"
+)
+
+// HostMetrics are the counters of the sends made to a host.
+type HostMetrics struct {
+	Sends        uint64
+	SendFailures uint64
+	// Total size of the marshalled responses received
+	BytesReceived uint64
+	// Number of sends which had to reconnect to the host first, not
+	// counting the first connection
+	Reconnects uint64
+	// Duration of the last successful send
+	LastRTT time.Duration
+	// Durations of every successful send
+	Latency LatencyHistogram
+	// Time the last send completed
+	LastSend time.Time
+	// State of the gRPC connection as of the last send. Web connections are
+	// always reported as Idle.
+	State connectivity.State
+}
+
+// Metrics holds the HostMetrics of every host sent to. It is safe for
+// concurrent use.
+type Metrics struct {
+	hosts map[id.ID]*HostMetrics
+	mux   sync.RWMutex
+}
+
+// NewMetrics returns Metrics without any hosts.
+func NewMetrics() *Metrics {
+	return &Metrics{hosts: make(map[id.ID]*HostMetrics)}
+}
+
+// Get returns a copy of the metrics of the host. Returns false if nothing was
+// sent to the host.
+func (m *Metrics) Get(hid *id.ID) (HostMetrics, bool) {
+	m.mux.RLock()
+	defer m.mux.RUnlock()
+	hm, exists := m.hosts[*hid]
+	if !exists {
+		return HostMetrics{}, false
+	}
+	return *hm, true
+}
+
+// GetAll returns a copy of the metrics of every host sent to.
+func (m *Metrics) GetAll() map[id.ID]HostMetrics {
+	m.mux.RLock()
+	defer m.mux.RUnlock()
+	all := make(map[id.ID]HostMetrics, len(m.hosts))
+	for hid, hm := range m.hosts {
+		all[hid] = *hm
+	}
+	return all
+}
+
+// GetLatencyStats returns the percentiles of the round-trip latency of the
+// successful sends to the host. Returns false if nothing was sent to the
+// host.
+func (m *Metrics) GetLatencyStats(hid *id.ID) (LatencyStats, bool) {
+	m.mux.RLock()
+	defer m.mux.RUnlock()
+	hm, exists := m.hosts[*hid]
+	if !exists {
+		return LatencyStats{}, false
+	}
+	return hm.Latency.Stats(), true
+}
+
+// Remove deletes the metrics of the host, e.g. after it is removed from the
+// manager.
+func (m *Metrics) Remove(hid *id.ID) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	delete(m.hosts, *hid)
+}
+
+// record adds a completed send to the metrics of its host.
+func (m *Metrics) record(result SendResult, reconnected bool,
+	state connectivity.State) {
+	if result.Host == nil {
+		return
+	}
+
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	hm, exists := m.hosts[*result.Host]
+	if !exists {
+		hm = &HostMetrics{}
+		m.hosts[*result.Host] = hm
+	}
+
+	hm.Sends++
+	if result.Err != nil {
+		hm.SendFailures++
+	} else {
+		hm.LastRTT = result.Duration
+		hm.Latency.Add(result.Duration)
+	}
+	hm.BytesReceived += uint64(result.Bytes)
+	if reconnected {
+		hm.Reconnects++
+	}
+	hm.LastSend = result.Start.Add(result.Duration)
+	hm.State = state
+}
+
+// WritePrometheus writes the metrics of every host to w in the Prometheus
+// text exposition format, with each metric name starting with the prefix
+// and labelled by host. It can be served directly by a metrics endpoint.
+func (m *Metrics) WritePrometheus(w io.Writer, prefix string) error {
+	all := m.GetAll()
+	hosts := make([]id.ID, 0, len(all))
+	for hid := range all {
+		hosts = append(hosts, hid)
+	}
+	sort.Slice(hosts, func(i, j int) bool {
+		return hosts[i].String() < hosts[j].String()
+	})
+
+	metrics := []struct {
+		name, help, kind string
+		value            func(hm HostMetrics) float64
+	}{
+		{"sends_total", "Sends made to the host.", "counter",
+			func(hm HostMetrics) float64 { return float64(hm.Sends) }},
+		{"send_failures_total", "Sends to the host which failed.", "counter",
+			func(hm HostMetrics) float64 { return float64(hm.SendFailures) }},
+		{"received_bytes_total", "Bytes of responses received from the host.",
+			"counter",
+			func(hm HostMetrics) float64 { return float64(hm.BytesReceived) }},
+		{"reconnects_total", "Reconnections to the host.", "counter",
+			func(hm HostMetrics) float64 { return float64(hm.Reconnects) }},
+		{"last_rtt_seconds", "Duration of the last successful send.", "gauge",
+			func(hm HostMetrics) float64 { return hm.LastRTT.Seconds() }},
+		{"connectivity_state",
+			"gRPC connectivity state of the host as of the last send.", "gauge",
+			func(hm HostMetrics) float64 { return float64(hm.State) }},
+	}
+
+	for _, metric := range metrics {
+		name := prefix + metric.name
+		_, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name,
+			metric.help, name, metric.kind)
+		if err != nil {
+			return err
+		}
+		for _, hid := range hosts {
+			_, err = fmt.Fprintf(w, "%s{host=%q} %g\n", name, hid.String(),
+				metric.value(all[hid]))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return writeLatencyHistograms(w, prefix+"rtt_seconds", hosts, all)
+}
+
+// writeLatencyHistograms writes the latency histogram of each host as a
+// Prometheus histogram.
+func writeLatencyHistograms(w io.Writer, name string, hosts []id.ID,
+	all map[id.ID]HostMetrics) error {
+	_, err := fmt.Fprintf(w, "# HELP %s Duration of the successful sends "+
+		"to the host.\n# TYPE %s histogram\n", name, name)
+	if err != nil {
+		return err
+	}
+	for _, hid := range hosts {
+		h := all[hid].Latency
+		var cumulative uint64
+		for bucket := 0; bucket < latencyBuckets; bucket++ {
+			cumulative += h.Counts[bucket]
+			_, err = fmt.Fprintf(w, "%s_bucket{host=%q,le=\"%g\"} %d\n",
+				name, hid.String(), latencyBound(bucket).Seconds(), cumulative)
+			if err != nil {
+				return err
+			}
+		}
+		_, err = fmt.Fprintf(w, "%s_bucket{host=%q,le=\"+Inf\"} %d\n"+
+			"%s_sum{host=%q} %g\n%s_count{host=%q} %d\n",
+			name, hid.String(), h.Count, name, hid.String(), h.Sum.Seconds(),
+			name, hid.String(), h.Count)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
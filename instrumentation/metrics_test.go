@@ -0,0 +1,93 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package instrumentation
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc/connectivity"
+)
+
+// Tests that recorded sends are counted against their host.
+func TestMetrics_record(t *testing.T) {
+	hostID := id.NewIdFromString("gateway", id.Gateway, t)
+	m := NewMetrics()
+
+	if _, exists := m.Get(hostID); exists {
+		t.Fatalf("Metrics returned for a host never sent to.")
+	}
+
+	info := SendInfo{RPC: "SendPoll", Host: hostID, Start: time.Now()}
+	m.record(SendResult{SendInfo: info, Duration: time.Second, Bytes: 10},
+		false, connectivity.Ready)
+	m.record(SendResult{SendInfo: info, Duration: time.Minute,
+		Err: errors.New("failed")}, true, connectivity.TransientFailure)
+	m.record(SendResult{SendInfo: SendInfo{RPC: "SendPoll"}}, false,
+		connectivity.Ready)
+
+	hm, exists := m.Get(hostID)
+	if !exists {
+		t.Fatalf("No metrics for the host sent to.")
+	}
+	var latency LatencyHistogram
+	latency.Add(time.Second)
+	expected := HostMetrics{
+		Sends:         2,
+		SendFailures:  1,
+		BytesReceived: 10,
+		Reconnects:    1,
+		LastRTT:       time.Second,
+		Latency:       latency,
+		LastSend:      info.Start.Add(time.Minute),
+		State:         connectivity.TransientFailure,
+	}
+	if hm != expected {
+		t.Errorf("Unexpected metrics.\nexpected: %+v\nreceived: %+v",
+			expected, hm)
+	}
+
+	if all := m.GetAll(); len(all) != 1 || all[*hostID] != expected {
+		t.Errorf("Unexpected metrics of all hosts: %+v", all)
+	}
+
+	m.Remove(hostID)
+	if _, exists = m.Get(hostID); exists {
+		t.Errorf("Metrics returned for a removed host.")
+	}
+}
+
+// Tests that WritePrometheus writes a sample of each metric for each host.
+func TestMetrics_WritePrometheus(t *testing.T) {
+	hostID := id.NewIdFromString("gateway", id.Gateway, t)
+	m := NewMetrics()
+	m.record(SendResult{SendInfo: SendInfo{Host: hostID}, Bytes: 42}, false,
+		connectivity.Ready)
+
+	var buf bytes.Buffer
+	if err := m.WritePrometheus(&buf, "comms_host_"); err != nil {
+		t.Fatalf("WritePrometheus error: %+v", err)
+	}
+
+	for _, line := range []string{
+		"# TYPE comms_host_sends_total counter",
+		"comms_host_sends_total{host=\"" + hostID.String() + "\"} 1",
+		"comms_host_received_bytes_total{host=\"" + hostID.String() + "\"} 42",
+		"comms_host_connectivity_state{host=\"" + hostID.String() + "\"} 2",
+		"# TYPE comms_host_rtt_seconds histogram",
+		"comms_host_rtt_seconds_count{host=\"" + hostID.String() + "\"} 1",
+	} {
+		if !strings.Contains(buf.String(), line+"\n") {
+			t.Errorf("Output missing %q:\n%s", line, buf.String())
+		}
+	}
+}
@@ -0,0 +1,225 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package interceptors lets users of a comms object plug gRPC interceptors,
+// e.g. for tracing, auditing or request logging, into its calls. The gRPC
+// server and connections of a ProtoComms are created without options which
+// could carry interceptors, so a Chain instead wraps the handlers of the
+// service of the comms and the connections its sends are made over.
+// Interceptors are looked up on each call and so may be added at any time.
+package interceptors
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc"
+)
+
+// Chain holds the interceptors of a comms object. Interceptors run in the
+// order they were added, the first being the outermost. A nil Chain has no
+// interceptors.
+type Chain struct {
+	unaryServer  []grpc.UnaryServerInterceptor
+	streamServer []grpc.StreamServerInterceptor
+	unaryClient  []grpc.UnaryClientInterceptor
+	streamClient []grpc.StreamClientInterceptor
+	mux          sync.RWMutex
+}
+
+// New returns a Chain without interceptors.
+func New() *Chain {
+	return &Chain{}
+}
+
+// AddUnaryServerInterceptor adds an interceptor run on each unary call
+// received.
+func (c *Chain) AddUnaryServerInterceptor(i grpc.UnaryServerInterceptor) {
+	c.mux.Lock()
+	c.unaryServer = append(c.unaryServer, i)
+	c.mux.Unlock()
+}
+
+// AddStreamServerInterceptor adds an interceptor run on each stream
+// received.
+func (c *Chain) AddStreamServerInterceptor(i grpc.StreamServerInterceptor) {
+	c.mux.Lock()
+	c.streamServer = append(c.streamServer, i)
+	c.mux.Unlock()
+}
+
+// AddUnaryClientInterceptor adds an interceptor run on each unary call sent.
+func (c *Chain) AddUnaryClientInterceptor(i grpc.UnaryClientInterceptor) {
+	c.mux.Lock()
+	c.unaryClient = append(c.unaryClient, i)
+	c.mux.Unlock()
+}
+
+// AddStreamClientInterceptor adds an interceptor run on each stream opened.
+func (c *Chain) AddStreamClientInterceptor(i grpc.StreamClientInterceptor) {
+	c.mux.Lock()
+	c.streamClient = append(c.streamClient, i)
+	c.mux.Unlock()
+}
+
+// Wrap returns a copy of the service description whose handlers run the
+// server interceptors. A nil Chain returns the description unchanged.
+func (c *Chain) Wrap(desc *grpc.ServiceDesc) *grpc.ServiceDesc {
+	if c == nil {
+		return desc
+	}
+	wrapped := *desc
+
+	wrapped.Methods = make([]grpc.MethodDesc, len(desc.Methods))
+	for j, md := range desc.Methods {
+		handler := md.Handler
+		wrapped.Methods[j] = grpc.MethodDesc{
+			MethodName: md.MethodName,
+			Handler: func(srv interface{}, ctx context.Context,
+				dec func(interface{}) error,
+				interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				return handler(srv, ctx, dec, c.unaryServerInterceptor(
+					interceptor))
+			},
+		}
+	}
+
+	wrapped.Streams = make([]grpc.StreamDesc, len(desc.Streams))
+	for j, sd := range desc.Streams {
+		handler := sd.Handler
+		info := &grpc.StreamServerInfo{
+			FullMethod:     "/" + desc.ServiceName + "/" + sd.StreamName,
+			IsClientStream: sd.ClientStreams,
+			IsServerStream: sd.ServerStreams,
+		}
+		wrapped.Streams[j] = sd
+		wrapped.Streams[j].Handler = func(srv interface{},
+			stream grpc.ServerStream) error {
+			return c.streamServerHandler(handler, info)(srv, stream)
+		}
+	}
+
+	return &wrapped
+}
+
+// unaryServerInterceptor returns an interceptor running the unary server
+// interceptors, followed by the interceptor of the gRPC server, if any.
+func (c *Chain) unaryServerInterceptor(
+	last grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	c.mux.RLock()
+	chain := append([]grpc.UnaryServerInterceptor(nil), c.unaryServer...)
+	c.mux.RUnlock()
+	if last != nil {
+		chain = append(chain, last)
+	}
+	if len(chain) == 0 {
+		return nil
+	}
+
+	return func(ctx context.Context, req interface{},
+		info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (
+		interface{}, error) {
+		for j := len(chain) - 1; j >= 0; j-- {
+			interceptor, next := chain[j], handler
+			handler = func(ctx context.Context, req interface{}) (
+				interface{}, error) {
+				return interceptor(ctx, req, info, next)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// streamServerHandler returns the stream handler wrapped in the stream
+// server interceptors.
+func (c *Chain) streamServerHandler(handler grpc.StreamHandler,
+	info *grpc.StreamServerInfo) grpc.StreamHandler {
+	c.mux.RLock()
+	chain := append([]grpc.StreamServerInterceptor(nil), c.streamServer...)
+	c.mux.RUnlock()
+
+	for j := len(chain) - 1; j >= 0; j-- {
+		interceptor, next := chain[j], handler
+		handler = func(srv interface{}, stream grpc.ServerStream) error {
+			return interceptor(srv, stream, info, next)
+		}
+	}
+	return handler
+}
+
+// ClientConn returns the connection with the client interceptors run on the
+// calls and streams made over it. A nil Chain returns the connection
+// unchanged.
+func (c *Chain) ClientConn(cc grpc.ClientConnInterface) grpc.ClientConnInterface {
+	if c == nil {
+		return cc
+	}
+	return &clientConn{ClientConnInterface: cc, chain: c}
+}
+
+// clientConn runs the client interceptors of a Chain.
+type clientConn struct {
+	grpc.ClientConnInterface
+	chain *Chain
+}
+
+// Invoke makes a unary call through the unary client interceptors.
+func (cc *clientConn) Invoke(ctx context.Context, method string,
+	args, reply interface{}, opts ...grpc.CallOption) error {
+	cc.chain.mux.RLock()
+	chain := append([]grpc.UnaryClientInterceptor(nil),
+		cc.chain.unaryClient...)
+	cc.chain.mux.RUnlock()
+
+	invoker := func(ctx context.Context, method string, args,
+		reply interface{}, _ *grpc.ClientConn,
+		opts ...grpc.CallOption) error {
+		return cc.ClientConnInterface.Invoke(ctx, method, args, reply,
+			opts...)
+	}
+	for j := len(chain) - 1; j >= 0; j-- {
+		interceptor, next := chain[j], invoker
+		invoker = func(ctx context.Context, method string, args,
+			reply interface{}, conn *grpc.ClientConn,
+			opts ...grpc.CallOption) error {
+			return interceptor(ctx, method, args, reply, conn, next,
+				opts...)
+		}
+	}
+	return invoker(ctx, method, args, reply, cc.grpcConn(), opts...)
+}
+
+// NewStream opens a stream through the stream client interceptors.
+func (cc *clientConn) NewStream(ctx context.Context, desc *grpc.StreamDesc,
+	method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	cc.chain.mux.RLock()
+	chain := append([]grpc.StreamClientInterceptor(nil),
+		cc.chain.streamClient...)
+	cc.chain.mux.RUnlock()
+
+	streamer := func(ctx context.Context, desc *grpc.StreamDesc,
+		_ *grpc.ClientConn, method string,
+		opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return cc.ClientConnInterface.NewStream(ctx, desc, method, opts...)
+	}
+	for j := len(chain) - 1; j >= 0; j-- {
+		interceptor, next := chain[j], streamer
+		streamer = func(ctx context.Context, desc *grpc.StreamDesc,
+			conn *grpc.ClientConn, method string,
+			opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			return interceptor(ctx, desc, conn, method, next, opts...)
+		}
+	}
+	return streamer(ctx, desc, cc.grpcConn(), method, opts...)
+}
+
+// grpcConn returns the wrapped connection if it is a *grpc.ClientConn, as
+// passed to client interceptors, or nil.
+func (cc *clientConn) grpcConn() *grpc.ClientConn {
+	conn, _ := cc.ClientConnInterface.(*grpc.ClientConn)
+	return conn
+}
@@ -0,0 +1,173 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package interceptors
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc"
+)
+
+// testDesc is a service with a unary method and a stream, both recording
+// that they ran.
+func testDesc(log *[]string) *grpc.ServiceDesc {
+	return &grpc.ServiceDesc{
+		ServiceName: "test.Service",
+		Methods: []grpc.MethodDesc{{
+			MethodName: "Unary",
+			Handler: func(srv interface{}, ctx context.Context,
+				dec func(interface{}) error,
+				interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				handler := func(context.Context, interface{}) (
+					interface{}, error) {
+					*log = append(*log, "handler")
+					return "response", nil
+				}
+				if interceptor == nil {
+					return handler(ctx, nil)
+				}
+				return interceptor(ctx, nil, &grpc.UnaryServerInfo{
+					FullMethod: "/test.Service/Unary"}, handler)
+			},
+		}},
+		Streams: []grpc.StreamDesc{{
+			StreamName: "Stream",
+			Handler: func(interface{}, grpc.ServerStream) error {
+				*log = append(*log, "handler")
+				return nil
+			},
+			ServerStreams: true,
+		}},
+	}
+}
+
+// Tests that unary server interceptors run in the order added, around the
+// handler.
+func TestChain_Wrap_Unary(t *testing.T) {
+	var log []string
+	c := New()
+	for _, name := range []string{"first", "second"} {
+		name := name
+		c.AddUnaryServerInterceptor(func(ctx context.Context,
+			req interface{}, info *grpc.UnaryServerInfo,
+			handler grpc.UnaryHandler) (interface{}, error) {
+			log = append(log, name+" "+info.FullMethod)
+			return handler(ctx, req)
+		})
+	}
+
+	desc := c.Wrap(testDesc(&log))
+	resp, err := desc.Methods[0].Handler(nil, context.Background(), nil, nil)
+	if err != nil || resp != "response" {
+		t.Fatalf("Unexpected result: %v, %+v", resp, err)
+	}
+	expected := []string{"first /test.Service/Unary",
+		"second /test.Service/Unary", "handler"}
+	if !reflect.DeepEqual(log, expected) {
+		t.Errorf("Unexpected order.\nexpected: %v\nreceived: %v", expected,
+			log)
+	}
+}
+
+// Tests that stream server interceptors are given the stream information
+// and run before the handler.
+func TestChain_Wrap_Stream(t *testing.T) {
+	var log []string
+	c := New()
+	c.AddStreamServerInterceptor(func(srv interface{},
+		ss grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+		if !info.IsServerStream || info.IsClientStream {
+			t.Errorf("Unexpected stream info: %+v", info)
+		}
+		log = append(log, info.FullMethod)
+		return handler(srv, ss)
+	})
+
+	desc := c.Wrap(testDesc(&log))
+	if err := desc.Streams[0].Handler(nil, nil); err != nil {
+		t.Fatalf("Stream handler returned an error: %+v", err)
+	}
+	expected := []string{"/test.Service/Stream", "handler"}
+	if !reflect.DeepEqual(log, expected) {
+		t.Errorf("Unexpected order.\nexpected: %v\nreceived: %v", expected,
+			log)
+	}
+}
+
+// Tests that a nil Chain leaves descriptions and connections unchanged.
+func TestChain_Nil(t *testing.T) {
+	var c *Chain
+	desc := &grpc.ServiceDesc{}
+	if c.Wrap(desc) != desc {
+		t.Errorf("Description changed by a nil Chain.")
+	}
+	cc := &mockConn{}
+	if c.ClientConn(cc) != cc {
+		t.Errorf("Connection changed by a nil Chain.")
+	}
+}
+
+// mockConn records the calls made over it.
+type mockConn struct {
+	methods []string
+}
+
+func (m *mockConn) Invoke(_ context.Context, method string, _,
+	_ interface{}, _ ...grpc.CallOption) error {
+	m.methods = append(m.methods, method)
+	return nil
+}
+
+func (m *mockConn) NewStream(_ context.Context, _ *grpc.StreamDesc,
+	method string, _ ...grpc.CallOption) (grpc.ClientStream, error) {
+	m.methods = append(m.methods, method)
+	return nil, nil
+}
+
+// Tests that client interceptors run on calls and streams made over the
+// connection.
+func TestChain_ClientConn(t *testing.T) {
+	var intercepted []string
+	c := New()
+	c.AddUnaryClientInterceptor(func(ctx context.Context, method string,
+		req, reply interface{}, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		intercepted = append(intercepted, method)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	})
+	c.AddStreamClientInterceptor(func(ctx context.Context,
+		desc *grpc.StreamDesc, cc *grpc.ClientConn, method string,
+		streamer grpc.Streamer, opts ...grpc.CallOption) (
+		grpc.ClientStream, error) {
+		intercepted = append(intercepted, method)
+		return streamer(ctx, desc, cc, method, opts...)
+	})
+
+	mock := &mockConn{}
+	cc := c.ClientConn(mock)
+	if err := cc.Invoke(context.Background(), "/a", nil, nil); err != nil {
+		t.Fatalf("Invoke returned an error: %+v", err)
+	}
+	if _, err := cc.NewStream(context.Background(), &grpc.StreamDesc{},
+		"/b"); err != nil {
+		t.Fatalf("NewStream returned an error: %+v", err)
+	}
+
+	expected := []string{"/a", "/b"}
+	if !reflect.DeepEqual(intercepted, expected) {
+		t.Errorf("Calls not intercepted.\nexpected: %v\nreceived: %v",
+			expected, intercepted)
+	}
+	if !reflect.DeepEqual(mock.methods, expected) {
+		t.Errorf("Calls not made.\nexpected: %v\nreceived: %v", expected,
+			mock.methods)
+	}
+}
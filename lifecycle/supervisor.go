@@ -0,0 +1,238 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package lifecycle starts and stops the comms objects of a binary together.
+// A Supervisor owns several comms, e.g. a node, or the private and public
+// servers of a gateway, starting them in order, waiting for each to be ready
+// before starting the next and shutting them down in reverse order, with each
+// step bounded by a timeout.
+package lifecycle
+
+import (
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+)
+
+// Default timeouts of a Supervisor.
+const (
+	DefaultStartTimeout = 30 * time.Second
+	DefaultStopTimeout  = 10 * time.Second
+)
+
+// readyPollInterval is the interval between readiness checks while starting.
+const readyPollInterval = 50 * time.Millisecond
+
+// Stopper is a started component. Every comms object implements it.
+type Stopper interface {
+	Shutdown()
+}
+
+// StartFunc starts a component, e.g. by calling node.StartNode. Panics are
+// recovered and returned as errors, so constructors which panic on failure
+// can be used directly.
+type StartFunc func() (Stopper, error)
+
+// ReadyFunc returns nil once a started component is ready to be used.
+type ReadyFunc func() error
+
+// component is a comms object owned by a Supervisor.
+type component struct {
+	name    string
+	start   StartFunc
+	ready   ReadyFunc
+	stopper Stopper
+}
+
+// Supervisor starts and stops its components in order.
+type Supervisor struct {
+	StartTimeout time.Duration
+	StopTimeout  time.Duration
+
+	components []*component
+	// Number of components started, in order
+	started int
+	// Closed once every component is ready
+	ready chan struct{}
+	mux   sync.Mutex
+}
+
+// New returns a Supervisor without components using the default timeouts.
+func New() *Supervisor {
+	return &Supervisor{
+		StartTimeout: DefaultStartTimeout,
+		StopTimeout:  DefaultStopTimeout,
+		ready:        make(chan struct{}),
+	}
+}
+
+// Add adds a component, which is started after every component added before
+// it and stopped before them. The ready function may be nil if the component
+// is ready once started.
+func (s *Supervisor) Add(name string, start StartFunc, ready ReadyFunc) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.components = append(s.components,
+		&component{name: name, start: start, ready: ready})
+}
+
+// Start starts every component in order, waiting for each to be ready before
+// starting the next. If a component fails to start or become ready within
+// the StartTimeout, the components already started are stopped and an error
+// is returned. Starting a running Supervisor does nothing.
+func (s *Supervisor) Start() error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	select {
+	case <-s.ready:
+		return nil
+	default:
+	}
+
+	for s.started < len(s.components) {
+		c := s.components[s.started]
+		jww.INFO.Printf("Starting %s", c.name)
+		err := s.startComponent(c)
+		if err != nil {
+			// Stop the component too if it started but never became ready
+			if c.stopper != nil {
+				s.started++
+			}
+			stopErr := s.stopUnsafe()
+			if stopErr != nil {
+				jww.ERROR.Printf("Failed to stop after %s failed to start: "+
+					"%+v", c.name, stopErr)
+			}
+			return errors.WithMessagef(err, "Failed to start %s", c.name)
+		}
+		s.started++
+	}
+
+	close(s.ready)
+	return nil
+}
+
+// Ready returns a channel closed once every component has started and is
+// ready.
+func (s *Supervisor) Ready() <-chan struct{} {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return s.ready
+}
+
+// Stop shuts down the started components in reverse order. Components which
+// do not shut down within the StopTimeout are left running and reported in
+// the returned error. The Supervisor can be started again once stopped.
+func (s *Supervisor) Stop() error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return s.stopUnsafe()
+}
+
+// stopUnsafe stops the started components. Must be called under the lock.
+func (s *Supervisor) stopUnsafe() error {
+	var timedOut []string
+	for ; s.started > 0; s.started-- {
+		c := s.components[s.started-1]
+		if c.stopper == nil {
+			continue
+		}
+		jww.INFO.Printf("Stopping %s", c.name)
+
+		done := make(chan struct{})
+		go func(stopper Stopper) {
+			stopper.Shutdown()
+			close(done)
+		}(c.stopper)
+		select {
+		case <-done:
+		case <-time.After(s.StopTimeout):
+			timedOut = append(timedOut, c.name)
+		}
+		c.stopper = nil
+	}
+
+	select {
+	case <-s.ready:
+		s.ready = make(chan struct{})
+	default:
+	}
+
+	if len(timedOut) > 0 {
+		return errors.Errorf("Timed out after %s stopping %s", s.StopTimeout,
+			strings.Join(timedOut, ", "))
+	}
+	return nil
+}
+
+// startComponent starts the component and waits for it to be ready.
+func (s *Supervisor) startComponent(c *component) error {
+	deadline := time.Now().Add(s.StartTimeout)
+
+	type result struct {
+		stopper Stopper
+		err     error
+	}
+	started := make(chan result, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				started <- result{err: errors.Errorf("%v", r)}
+			}
+		}()
+		stopper, err := c.start()
+		started <- result{stopper, err}
+	}()
+
+	select {
+	case r := <-started:
+		if r.err != nil {
+			return r.err
+		}
+		c.stopper = r.stopper
+	case <-time.After(time.Until(deadline)):
+		// Shut the component down if it does start late
+		go func() {
+			if r := <-started; r.stopper != nil {
+				r.stopper.Shutdown()
+			}
+		}()
+		return errors.Errorf("Timed out after %s", s.StartTimeout)
+	}
+
+	if c.ready == nil {
+		return nil
+	}
+	for {
+		err := c.ready()
+		if err == nil {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return errors.WithMessagef(err, "Not ready after %s",
+				s.StartTimeout)
+		}
+		time.Sleep(readyPollInterval)
+	}
+}
+
+// ListeningReady returns a ReadyFunc which is ready once a TCP connection
+// can be opened to the address.
+func ListeningReady(address string) ReadyFunc {
+	return func() error {
+		conn, err := net.DialTimeout("tcp", address, readyPollInterval)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	}
+}
@@ -0,0 +1,125 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package lifecycle
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// mockComms records when it is started and stopped.
+type mockComms struct {
+	name  string
+	log   *[]string
+	block chan struct{}
+}
+
+func (m *mockComms) Shutdown() {
+	if m.block != nil {
+		<-m.block
+	}
+	*m.log = append(*m.log, "stop "+m.name)
+}
+
+// mockStart returns a StartFunc starting a mockComms.
+func mockStart(name string, log *[]string) StartFunc {
+	return func() (Stopper, error) {
+		*log = append(*log, "start "+name)
+		return &mockComms{name: name, log: log}, nil
+	}
+}
+
+// Tests that components are started in order and stopped in reverse order.
+func TestSupervisor_StartStop(t *testing.T) {
+	var log []string
+	s := New()
+	s.Add("node", mockStart("node", &log), nil)
+	s.Add("gateway", mockStart("gateway", &log), func() error { return nil })
+
+	if err := s.Start(); err != nil {
+		t.Fatalf("Failed to start: %+v", err)
+	}
+	select {
+	case <-s.Ready():
+	default:
+		t.Errorf("Not ready after starting.")
+	}
+	if err := s.Start(); err != nil {
+		t.Errorf("Failed to start a running supervisor: %+v", err)
+	}
+	if err := s.Stop(); err != nil {
+		t.Fatalf("Failed to stop: %+v", err)
+	}
+
+	expected := []string{"start node", "start gateway", "stop gateway",
+		"stop node"}
+	if !reflect.DeepEqual(log, expected) {
+		t.Errorf("Unexpected order.\nexpected: %v\nreceived: %v", expected,
+			log)
+	}
+}
+
+// Tests that components already started are stopped when a later one fails
+// to start, panics or never becomes ready.
+func TestSupervisor_Start_Failure(t *testing.T) {
+	failures := map[string]struct {
+		start StartFunc
+		ready ReadyFunc
+	}{
+		"error": {func() (Stopper, error) {
+			return nil, errors.New("failed")
+		}, nil},
+		"panic": {func() (Stopper, error) {
+			panic("failed")
+		}, nil},
+		"unready": {nil, func() error { return errors.New("not ready") }},
+	}
+
+	for name, failure := range failures {
+		var log []string
+		s := New()
+		s.StartTimeout = 100 * time.Millisecond
+		s.Add("node", mockStart("node", &log), nil)
+		start := failure.start
+		if start == nil {
+			start = mockStart("gateway", &log)
+		}
+		s.Add("gateway", start, failure.ready)
+
+		if err := s.Start(); err == nil {
+			t.Errorf("%s: start succeeded.", name)
+		}
+		if log[len(log)-1] != "stop node" {
+			t.Errorf("%s: started components not stopped: %v", name, log)
+		}
+		if failure.start == nil && log[len(log)-2] != "stop gateway" {
+			t.Errorf("%s: unready component not stopped: %v", name, log)
+		}
+	}
+}
+
+// Tests that components which do not shut down in time are reported.
+func TestSupervisor_Stop_Timeout(t *testing.T) {
+	var log []string
+	block := make(chan struct{})
+	defer close(block)
+	s := New()
+	s.StopTimeout = 10 * time.Millisecond
+	s.Add("gateway", func() (Stopper, error) {
+		return &mockComms{name: "gateway", log: &log, block: block}, nil
+	}, nil)
+
+	if err := s.Start(); err != nil {
+		t.Fatalf("Failed to start: %+v", err)
+	}
+	if err := s.Stop(); err == nil {
+		t.Errorf("No error for a component which did not stop.")
+	}
+}
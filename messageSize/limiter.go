@@ -0,0 +1,163 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package messageSize limits the size of the messages a comms object sends
+// and receives. The gRPC servers and connections of a ProtoComms are created
+// with fixed size limits, so a Limiter instead applies its limits through
+// the interceptors of the comms: calls and streams sent carry the limits as
+// call options, and messages received or returned by a server are checked
+// against them. Limits may be changed at any time.
+package messageSize
+
+import (
+	"context"
+	"sync"
+
+	"gitlab.com/elixxir/comms/interceptors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Limits are the largest messages sent and received, in bytes. Zero leaves
+// the size unlimited by the Limiter.
+type Limits struct {
+	MaxRecv int
+	MaxSend int
+}
+
+// Limiter applies Limits to the calls of a comms object.
+type Limiter struct {
+	limits Limits
+	mux    sync.RWMutex
+}
+
+// NewLimiter returns a Limiter with the limits.
+func NewLimiter(limits Limits) *Limiter {
+	return &Limiter{limits: limits}
+}
+
+// Set replaces the limits.
+func (l *Limiter) Set(limits Limits) {
+	l.mux.Lock()
+	l.limits = limits
+	l.mux.Unlock()
+}
+
+// Get returns the limits.
+func (l *Limiter) Get() Limits {
+	l.mux.RLock()
+	defer l.mux.RUnlock()
+	return l.limits
+}
+
+// Install adds the interceptors of the Limiter to the chain.
+func (l *Limiter) Install(chain *interceptors.Chain) {
+	chain.AddUnaryServerInterceptor(l.UnaryServerInterceptor())
+	chain.AddStreamServerInterceptor(l.StreamServerInterceptor())
+	chain.AddUnaryClientInterceptor(l.UnaryClientInterceptor())
+	chain.AddStreamClientInterceptor(l.StreamClientInterceptor())
+}
+
+// check returns a RESOURCE_EXHAUSTED error if the message is larger than
+// max, matching the errors of gRPC's own limits.
+func check(msg interface{}, max int, direction string) error {
+	m, ok := msg.(proto.Message)
+	if max <= 0 || !ok {
+		return nil
+	}
+	if size := proto.Size(m); size > max {
+		return status.Errorf(codes.ResourceExhausted, "%s message larger "+
+			"than max (%d vs. %d)", direction, size, max)
+	}
+	return nil
+}
+
+// UnaryServerInterceptor returns an interceptor rejecting requests and
+// responses larger than the limits.
+func (l *Limiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{},
+		info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (
+		interface{}, error) {
+		limits := l.Get()
+		if err := check(req, limits.MaxRecv, "received"); err != nil {
+			return nil, err
+		}
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, err
+		}
+		if err = check(resp, limits.MaxSend, "trying to send"); err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}
+}
+
+// StreamServerInterceptor returns an interceptor rejecting the messages of
+// streams larger than the limits.
+func (l *Limiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream,
+		info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &serverStream{ServerStream: stream, limiter: l})
+	}
+}
+
+// serverStream checks the messages of a stream against the limits.
+type serverStream struct {
+	grpc.ServerStream
+	limiter *Limiter
+}
+
+// RecvMsg receives a message, returning an error if it is too large.
+func (s *serverStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return check(m, s.limiter.Get().MaxRecv, "received")
+}
+
+// SendMsg sends the message unless it is too large.
+func (s *serverStream) SendMsg(m interface{}) error {
+	if err := check(m, s.limiter.Get().MaxSend, "trying to send"); err != nil {
+		return err
+	}
+	return s.ServerStream.SendMsg(m)
+}
+
+// callOptions returns the call options applying the limits.
+func (l *Limiter) callOptions(opts []grpc.CallOption) []grpc.CallOption {
+	limits := l.Get()
+	if limits.MaxRecv > 0 {
+		opts = append(opts, grpc.MaxCallRecvMsgSize(limits.MaxRecv))
+	}
+	if limits.MaxSend > 0 {
+		opts = append(opts, grpc.MaxCallSendMsgSize(limits.MaxSend))
+	}
+	return opts
+}
+
+// UnaryClientInterceptor returns an interceptor applying the limits to the
+// calls sent.
+func (l *Limiter) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{},
+		cc *grpc.ClientConn, invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption) error {
+		return invoker(ctx, method, req, reply, cc, l.callOptions(opts)...)
+	}
+}
+
+// StreamClientInterceptor returns an interceptor applying the limits to the
+// streams opened.
+func (l *Limiter) StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc,
+		cc *grpc.ClientConn, method string, streamer grpc.Streamer,
+		opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(ctx, desc, cc, method, l.callOptions(opts)...)
+	}
+}
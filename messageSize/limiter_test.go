@@ -0,0 +1,84 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package messageSize
+
+import (
+	"context"
+	"testing"
+
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Tests that requests and responses larger than the limits are rejected.
+func TestLimiter_UnaryServerInterceptor(t *testing.T) {
+	l := NewLimiter(Limits{})
+	interceptor := l.UnaryServerInterceptor()
+	large := &pb.GatewaySlot{MAC: make([]byte, 100)}
+	echo := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return req, nil
+	}
+
+	if _, err := interceptor(context.Background(), large,
+		&grpc.UnaryServerInfo{}, echo); err != nil {
+		t.Errorf("Unlimited limiter rejected a request: %+v", err)
+	}
+
+	l.Set(Limits{MaxRecv: 50})
+	if _, err := interceptor(context.Background(), large,
+		&grpc.UnaryServerInfo{}, echo); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Expected %s for a large request, got %v",
+			codes.ResourceExhausted, err)
+	}
+
+	l.Set(Limits{MaxSend: 50})
+	if _, err := interceptor(context.Background(), large,
+		&grpc.UnaryServerInfo{}, echo); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Expected %s for a large response, got %v",
+			codes.ResourceExhausted, err)
+	}
+
+	if _, err := interceptor(context.Background(), &pb.GatewaySlot{},
+		&grpc.UnaryServerInfo{}, echo); err != nil {
+		t.Errorf("Small request rejected: %+v", err)
+	}
+}
+
+// Tests that the limits are added to the options of the calls sent.
+func TestLimiter_UnaryClientInterceptor(t *testing.T) {
+	l := NewLimiter(Limits{})
+	interceptor := l.UnaryClientInterceptor()
+
+	var received []grpc.CallOption
+	invoker := func(ctx context.Context, method string, req, reply interface{},
+		cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		received = opts
+		return nil
+	}
+
+	_ = interceptor(context.Background(), "", nil, nil, nil, invoker)
+	if len(received) != 0 {
+		t.Errorf("Unlimited limiter added %d options.", len(received))
+	}
+
+	l.Set(Limits{MaxRecv: 10, MaxSend: 20})
+	_ = interceptor(context.Background(), "", nil, nil, nil, invoker)
+	if len(received) != 2 {
+		t.Fatalf("Expected 2 options, got %d.", len(received))
+	}
+	if recv, ok := received[0].(grpc.MaxRecvMsgSizeCallOption); !ok ||
+		recv.MaxRecvMsgSize != 10 {
+		t.Errorf("Unexpected receive option %+v.", received[0])
+	}
+	if send, ok := received[1].(grpc.MaxSendMsgSizeCallOption); !ok ||
+		send.MaxSendMsgSize != 20 {
+		t.Errorf("Unexpected send option %+v.", received[1])
+	}
+}
@@ -0,0 +1,229 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the in-memory Store
+
+package messageStore
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+)
+
+// EvictionPolicy limits the rounds a Memory store keeps. Pending messages and
+// completed batches are limited separately. Zero values disable a limit.
+type EvictionPolicy struct {
+	// Maximum number of rounds kept; the rounds stored first are evicted
+	MaxRounds int
+	// Time after which a round is evicted, counted from when it was first
+	// stored
+	MaxAge time.Duration
+	// Maximum number of messages kept across all rounds; the rounds stored
+	// first are evicted until the rest fit
+	MaxMessages int
+	// Maximum size in bytes of the messages kept across all rounds, as
+	// marshalled
+	MaxBytes int
+}
+
+// DefaultEvictionPolicy returns the policy of a gateway keeping the rounds of
+// the last few hours, up to a million messages and 1 GiB of each kind.
+func DefaultEvictionPolicy() EvictionPolicy {
+	return EvictionPolicy{
+		MaxRounds:   10000,
+		MaxAge:      3 * time.Hour,
+		MaxMessages: 1000000,
+		MaxBytes:    1 << 30,
+	}
+}
+
+// Memory is a Store held in memory, which is lost on restart.
+type Memory struct {
+	policy    EvictionPolicy
+	pending   rounds
+	completed rounds
+	now       func() time.Time
+	mux       sync.Mutex
+}
+
+// round is the data stored for a round, when it was first stored and the
+// number and size of its messages.
+type round struct {
+	stored    time.Time
+	messages  []*pb.GatewaySlot
+	completed *pb.RoundMessages
+	count     int
+	size      int
+}
+
+// rounds are the rounds of one kind, in the order they were first stored,
+// and the total number and size of their messages.
+type rounds struct {
+	byID  map[uint64]*round
+	order []uint64
+	count int
+	size  int
+}
+
+// NewMemory returns an empty Memory store evicting rounds by the policy.
+func NewMemory(policy EvictionPolicy) *Memory {
+	return &Memory{
+		policy:    policy,
+		pending:   rounds{byID: make(map[uint64]*round)},
+		completed: rounds{byID: make(map[uint64]*round)},
+		now:       time.Now,
+	}
+}
+
+// AddPending stores messages accepted for a round which has not been mixed
+// yet, after those already stored for it.
+func (m *Memory) AddPending(roundID uint64, msgs ...*pb.GatewaySlot) error {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	r := m.pending.get(roundID, m.now())
+	r.messages = append(r.messages, msgs...)
+	size := 0
+	for _, msg := range msgs {
+		size += proto.Size(msg)
+	}
+	m.pending.resize(r, r.count+len(msgs), r.size+size)
+	m.pending.evict(m.policy, m.now(), "pending messages")
+	return nil
+}
+
+// Pending returns the messages stored for the round in the order they were
+// added.
+func (m *Memory) Pending(roundID uint64) ([]*pb.GatewaySlot, error) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	m.pending.evict(m.policy, m.now(), "pending messages")
+	r, exists := m.pending.byID[roundID]
+	if !exists {
+		return nil, nil
+	}
+	msgs := make([]*pb.GatewaySlot, len(r.messages))
+	copy(msgs, r.messages)
+	return msgs, nil
+}
+
+// PendingRounds returns the rounds with pending messages in ascending order.
+func (m *Memory) PendingRounds() ([]uint64, error) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	m.pending.evict(m.policy, m.now(), "pending messages")
+	ids := make([]uint64, len(m.pending.order))
+	copy(ids, m.pending.order)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids, nil
+}
+
+// RemovePending removes the pending messages of the round.
+func (m *Memory) RemovePending(roundID uint64) error {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	m.pending.remove(roundID)
+	return nil
+}
+
+// AddCompleted stores the batch of a completed round, replacing any already
+// stored for it.
+func (m *Memory) AddCompleted(batch *pb.RoundMessages) error {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	r := m.completed.get(batch.GetRoundId(), m.now())
+	r.completed = batch
+	m.completed.resize(r, len(batch.GetMessages()), proto.Size(batch))
+	m.completed.evict(m.policy, m.now(), "completed batch")
+	return nil
+}
+
+// Completed returns the batch of the round, or false if there is none.
+func (m *Memory) Completed(roundID uint64) (*pb.RoundMessages, bool, error) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	m.completed.evict(m.policy, m.now(), "completed batch")
+	r, exists := m.completed.byID[roundID]
+	if !exists {
+		return nil, false, nil
+	}
+	return r.completed, true, nil
+}
+
+// RemoveCompleted removes the batch of the round.
+func (m *Memory) RemoveCompleted(roundID uint64) error {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	m.completed.remove(roundID)
+	return nil
+}
+
+// get returns the round, adding it if it is not stored.
+func (rs *rounds) get(roundID uint64, now time.Time) *round {
+	r, exists := rs.byID[roundID]
+	if !exists {
+		r = &round{stored: now}
+		rs.byID[roundID] = r
+		rs.order = append(rs.order, roundID)
+	}
+	return r
+}
+
+// resize sets the number and size of the messages of the stored round.
+func (rs *rounds) resize(r *round, count, size int) {
+	rs.count += count - r.count
+	rs.size += size - r.size
+	r.count, r.size = count, size
+}
+
+// remove removes the round if it is stored.
+func (rs *rounds) remove(roundID uint64) {
+	r, exists := rs.byID[roundID]
+	if !exists {
+		return
+	}
+	rs.resize(r, 0, 0)
+	delete(rs.byID, roundID)
+	for i, id := range rs.order {
+		if id == roundID {
+			rs.order = append(rs.order[:i], rs.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// evict removes the rounds stored first until the policy is met.
+func (rs *rounds) evict(policy EvictionPolicy, now time.Time, kind string) {
+	evicted := 0
+	for len(rs.order) > 0 {
+		oldest := rs.order[0]
+		tooMany := policy.MaxRounds > 0 && len(rs.order) > policy.MaxRounds
+		tooOld := policy.MaxAge > 0 &&
+			now.Sub(rs.byID[oldest].stored) > policy.MaxAge
+		tooLarge := (policy.MaxMessages > 0 && rs.count > policy.MaxMessages) ||
+			(policy.MaxBytes > 0 && rs.size > policy.MaxBytes)
+		if !tooMany && !tooOld && !tooLarge {
+			break
+		}
+		rs.resize(rs.byID[oldest], 0, 0)
+		delete(rs.byID, oldest)
+		rs.order = rs.order[1:]
+		evicted++
+	}
+	if evicted > 0 {
+		jww.DEBUG.Printf("Evicted the %s of %d rounds", kind, evicted)
+	}
+}
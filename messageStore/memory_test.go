@@ -0,0 +1,138 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package messageStore
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+)
+
+// Tests that pending messages are kept in order per round until removed.
+func TestMemory_Pending(t *testing.T) {
+	var s Store = NewMemory(EvictionPolicy{})
+	a := &pb.GatewaySlot{RoundID: 1, Message: &pb.Slot{PayloadA: []byte("a")}}
+	b := &pb.GatewaySlot{RoundID: 1, Message: &pb.Slot{PayloadA: []byte("b")}}
+	c := &pb.GatewaySlot{RoundID: 2, Message: &pb.Slot{PayloadA: []byte("c")}}
+
+	for _, msg := range []*pb.GatewaySlot{c, a, b} {
+		if err := s.AddPending(msg.RoundID, msg); err != nil {
+			t.Fatalf("Failed to add pending message: %+v", err)
+		}
+	}
+
+	msgs, err := s.Pending(1)
+	if err != nil || !reflect.DeepEqual(msgs, []*pb.GatewaySlot{a, b}) {
+		t.Errorf("Unexpected pending messages of round 1: %v (%v)", msgs, err)
+	}
+	ids, err := s.PendingRounds()
+	if err != nil || !reflect.DeepEqual(ids, []uint64{1, 2}) {
+		t.Errorf("Unexpected pending rounds: %v (%v)", ids, err)
+	}
+
+	if err = s.RemovePending(1); err != nil {
+		t.Fatalf("Failed to remove pending messages: %+v", err)
+	}
+	if msgs, _ = s.Pending(1); len(msgs) != 0 {
+		t.Errorf("Pending messages not removed: %v", msgs)
+	}
+	if ids, _ = s.PendingRounds(); !reflect.DeepEqual(ids, []uint64{2}) {
+		t.Errorf("Unexpected pending rounds after removal: %v", ids)
+	}
+}
+
+// Tests that completed batches are replaced and removed.
+func TestMemory_Completed(t *testing.T) {
+	s := NewMemory(EvictionPolicy{})
+	first := &pb.RoundMessages{RoundId: 3}
+	second := &pb.RoundMessages{RoundId: 3,
+		Messages: []*pb.Slot{{PayloadA: []byte("a")}}}
+
+	if _, exists, _ := s.Completed(3); exists {
+		t.Errorf("Found a batch before adding one.")
+	}
+	_ = s.AddCompleted(first)
+	_ = s.AddCompleted(second)
+	if batch, exists, err := s.Completed(3); err != nil || !exists ||
+		batch != second {
+		t.Errorf("Unexpected batch: %v, %t (%v)", batch, exists, err)
+	}
+
+	_ = s.RemoveCompleted(3)
+	if _, exists, _ := s.Completed(3); exists {
+		t.Errorf("Batch not removed.")
+	}
+}
+
+// Tests that rounds are evicted by number and age, oldest first.
+func TestMemory_Evict(t *testing.T) {
+	now := time.Unix(1000, 0)
+	s := NewMemory(EvictionPolicy{MaxRounds: 2, MaxAge: time.Minute})
+	s.now = func() time.Time { return now }
+
+	for id := uint64(1); id <= 3; id++ {
+		_ = s.AddPending(id, &pb.GatewaySlot{RoundID: id})
+		_ = s.AddCompleted(&pb.RoundMessages{RoundId: id})
+		now = now.Add(time.Second)
+	}
+	if ids, _ := s.PendingRounds(); !reflect.DeepEqual(ids, []uint64{2, 3}) {
+		t.Errorf("Expected round 1 evicted, got rounds %v.", ids)
+	}
+	if _, exists, _ := s.Completed(1); exists {
+		t.Errorf("Completed batch of round 1 not evicted.")
+	}
+
+	now = now.Add(time.Minute - time.Second)
+	if ids, _ := s.PendingRounds(); !reflect.DeepEqual(ids, []uint64{3}) {
+		t.Errorf("Expected round 2 evicted by age, got rounds %v.", ids)
+	}
+	if _, exists, _ := s.Completed(3); !exists {
+		t.Errorf("Completed batch of round 3 evicted early.")
+	}
+}
+
+// Tests that the rounds stored first are evicted once the messages of all
+// rounds exceed the message or byte limit, and that removed rounds no longer
+// count towards them.
+func TestMemory_Evict_Size(t *testing.T) {
+	slot := &pb.GatewaySlot{RoundID: 1, Message: &pb.Slot{
+		PayloadA: make([]byte, 100)}}
+	size := proto.Size(slot)
+
+	s := NewMemory(EvictionPolicy{MaxMessages: 3})
+	_ = s.AddPending(1, slot, slot)
+	_ = s.AddPending(2, slot)
+	if ids, _ := s.PendingRounds(); !reflect.DeepEqual(ids, []uint64{1, 2}) {
+		t.Errorf("Expected rounds 1 and 2 kept, got rounds %v.", ids)
+	}
+	_ = s.AddPending(2, slot)
+	if ids, _ := s.PendingRounds(); !reflect.DeepEqual(ids, []uint64{2}) {
+		t.Errorf("Expected round 1 evicted, got rounds %v.", ids)
+	}
+	_ = s.RemovePending(2)
+	_ = s.AddPending(3, slot, slot, slot)
+	if ids, _ := s.PendingRounds(); !reflect.DeepEqual(ids, []uint64{3}) {
+		t.Errorf("Removed round still counted, got rounds %v.", ids)
+	}
+
+	s = NewMemory(EvictionPolicy{MaxBytes: 2 * size})
+	batch := &pb.RoundMessages{RoundId: 1,
+		Messages: []*pb.Slot{slot.Message}}
+	_ = s.AddCompleted(batch)
+	_ = s.AddCompleted(&pb.RoundMessages{RoundId: 2,
+		Messages: []*pb.Slot{slot.Message, slot.Message}})
+	if _, exists, _ := s.Completed(1); exists {
+		t.Errorf("Completed batch of round 1 not evicted by size.")
+	}
+	if _, exists, _ := s.Completed(2); !exists {
+		t.Errorf("Completed batch of round 2 evicted.")
+	}
+}
@@ -0,0 +1,44 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package messageStore holds the messages a gateway accepted from clients
+// until their round is mixed, and the batches of completed rounds until
+// clients retrieve them. Gateway comms write to a Store as messages are
+// accepted, so that a gateway backed by a persistent Store can recover
+// undelivered messages after a restart. Gateways store nothing unless given a
+// Store. Memory is an in-memory implementation, bounded by its
+// EvictionPolicy; disk or key-value backed implementations can be passed in
+// its place.
+package messageStore
+
+import (
+	pb "gitlab.com/elixxir/comms/mixmessages"
+)
+
+// Store holds the pending messages and completed batches of rounds. It must
+// be safe for concurrent use. Implementations may evict rounds, e.g. by
+// their age or number, in which case they are no longer returned.
+type Store interface {
+	// AddPending stores messages accepted for a round which has not been
+	// mixed yet, after those already stored for it.
+	AddPending(roundID uint64, msgs ...*pb.GatewaySlot) error
+	// Pending returns the messages stored for the round in the order they
+	// were added, or none if there are none.
+	Pending(roundID uint64) ([]*pb.GatewaySlot, error)
+	// PendingRounds returns the rounds with pending messages.
+	PendingRounds() ([]uint64, error)
+	// RemovePending removes the pending messages of the round.
+	RemovePending(roundID uint64) error
+
+	// AddCompleted stores the batch of a completed round, replacing any
+	// already stored for it.
+	AddCompleted(batch *pb.RoundMessages) error
+	// Completed returns the batch of the round, or false if there is none.
+	Completed(roundID uint64) (*pb.RoundMessages, bool, error)
+	// RemoveCompleted removes the batch of the round.
+	RemoveCompleted(roundID uint64) error
+}
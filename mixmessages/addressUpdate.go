@@ -0,0 +1,95 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains address updates, which a node signs when its address changes so
+// that those holding a host for it can verify the new address against the
+// node's cert without waiting for the next NDF
+
+package mixmessages
+
+import (
+	"encoding/binary"
+	"hash"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/comms/signature"
+	"gitlab.com/xx_network/crypto/signature/rsa"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// NewAddressUpdate creates an unsigned update of the host's address.
+func NewAddressUpdate(hid *id.ID, address string,
+	timestamp time.Time) *AddressUpdate {
+	return &AddressUpdate{
+		ID:        hid.Marshal(),
+		Address:   address,
+		Timestamp: timestamp.UnixNano(),
+	}
+}
+
+// Sign signs the update with the host's private key.
+func (u *AddressUpdate) Sign(key *rsa.PrivateKey) error {
+	return signature.SignRsa(u, key)
+}
+
+// Verify checks that the update is for the host and was signed by the holder
+// of the public key.
+func (u *AddressUpdate) Verify(hid *id.ID, pubKey *rsa.PublicKey) error {
+	updateID, err := u.GetHostID()
+	if err != nil {
+		return errors.Errorf("Failed to unmarshal ID of address update: "+
+			"%+v", err)
+	}
+	if !updateID.Cmp(hid) {
+		return errors.Errorf("Address update is for %s, not %s", updateID,
+			hid)
+	}
+	if u.Address == "" {
+		return errors.New("Address update has no address")
+	}
+	return signature.VerifyRsa(u, pubKey)
+}
+
+// GetHostID returns the ID of the host whose address changed.
+func (u *AddressUpdate) GetHostID() (*id.ID, error) {
+	return id.Unmarshal(u.ID)
+}
+
+// GetTime returns the time the update was created.
+func (u *AddressUpdate) GetTime() time.Time {
+	return time.Unix(0, u.Timestamp)
+}
+
+// GetSig returns the RSA signature.
+// IF none exists, it creates it, adds it to the object, then returns it.
+func (u *AddressUpdate) GetSig() *messages.RSASignature {
+	if u.Signature != nil {
+		return u.Signature
+	}
+
+	u.Signature = new(messages.RSASignature)
+
+	return u.Signature
+}
+
+// Digest hashes the contents of the update in a repeatable manner
+// using the provided cryptographic hash. It includes the nonce in the hash
+func (u *AddressUpdate) Digest(nonce []byte, h hash.Hash) []byte {
+	h.Reset()
+
+	timestamp := make([]byte, 8)
+	binary.BigEndian.PutUint64(timestamp, uint64(u.Timestamp))
+
+	h.Write(u.ID)
+	h.Write([]byte(u.Address))
+	h.Write(timestamp)
+	h.Write(nonce)
+
+	return h.Sum(nil)
+}
@@ -0,0 +1,82 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package mixmessages
+
+import (
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"gitlab.com/xx_network/crypto/signature/rsa"
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/protobuf/proto"
+)
+
+// Happy path: an update sent in a message verifies against its host.
+func TestAddressUpdate_SignVerify(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %+v", err)
+	}
+
+	nid := id.NewIdFromString("node", id.Node, t)
+	update := NewAddressUpdate(nid, "10.0.0.1:11420", time.Unix(0, 42))
+	if err = update.Sign(privateKey); err != nil {
+		t.Fatalf("Failed to sign update: %+v", err)
+	}
+
+	data, err := proto.Marshal(update)
+	if err != nil {
+		t.Fatalf("Failed to marshal update: %+v", err)
+	}
+	received := &AddressUpdate{}
+	if err = proto.Unmarshal(data, received); err != nil {
+		t.Fatalf("Failed to unmarshal update: %+v", err)
+	}
+
+	if received.Address != update.Address ||
+		!received.GetTime().Equal(time.Unix(0, 42)) {
+		t.Errorf("Unexpected update.\nexpected: %+v\nreceived: %+v",
+			update, received)
+	}
+	if err = received.Verify(nid, privateKey.GetPublic()); err != nil {
+		t.Errorf("Failed to verify update: %+v", err)
+	}
+}
+
+// Error path: an update does not verify for another host, under another key
+// or after it is modified.
+func TestAddressUpdate_Verify_Error(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %+v", err)
+	}
+	otherKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %+v", err)
+	}
+
+	nid := id.NewIdFromString("node", id.Node, t)
+	update := NewAddressUpdate(nid, "10.0.0.1:11420", time.Now())
+	if err = update.Sign(privateKey); err != nil {
+		t.Fatalf("Failed to sign update: %+v", err)
+	}
+
+	other := id.NewIdFromString("other", id.Node, t)
+	if update.Verify(other, privateKey.GetPublic()) == nil {
+		t.Error("Update verified for another host.")
+	}
+	if update.Verify(nid, otherKey.GetPublic()) == nil {
+		t.Error("Update verified under another key.")
+	}
+
+	update.Address = "10.0.0.2:11420"
+	if update.Verify(nid, privateKey.GetPublic()) == nil {
+		t.Error("Update verified after its address was modified.")
+	}
+}
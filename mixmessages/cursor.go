@@ -0,0 +1,96 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the cursors used to retrieve the messages in a round in pages
+
+package mixmessages
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+)
+
+// Length of a marshalled cursor: round ID, offset and slot hash
+const messageCursorLen = 8 + 4 + sha256.Size
+
+// MessageCursor marks the position in a round's messages after the last
+// message delivered. The hash of that message is kept along with its offset
+// so that the position is found again if messages before it are pruned.
+type MessageCursor struct {
+	RoundID uint64
+	Offset  uint32
+	Last    []byte
+}
+
+// HashSlot returns the hash of the slot a cursor refers to.
+func HashSlot(slot *Slot) []byte {
+	data, _ := proto.Marshal(slot)
+	h := sha256.Sum256(data)
+	return h[:]
+}
+
+// NewMessageCursor returns the cursor after the offset'th message, which must
+// be the last delivered.
+func NewMessageCursor(roundID uint64, messages []*Slot,
+	offset int) *MessageCursor {
+	return &MessageCursor{
+		RoundID: roundID,
+		Offset:  uint32(offset),
+		Last:    HashSlot(messages[offset-1]),
+	}
+}
+
+// Marshal returns the cursor in the opaque form sent in the Cursor of a
+// GetMessages request and the NextCursor of its response.
+func (mc *MessageCursor) Marshal() string {
+	b := make([]byte, 12, messageCursorLen)
+	binary.BigEndian.PutUint64(b, mc.RoundID)
+	binary.BigEndian.PutUint32(b[8:], mc.Offset)
+	b = append(b, mc.Last...)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// UnmarshalMessageCursor parses a cursor from its opaque form.
+func UnmarshalMessageCursor(value string) (*MessageCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, errors.Errorf("Failed to decode cursor: %+v", err)
+	}
+	if len(b) != messageCursorLen {
+		return nil, errors.Errorf("Cursor has length %d, expected %d",
+			len(b), messageCursorLen)
+	}
+	return &MessageCursor{
+		RoundID: binary.BigEndian.Uint64(b),
+		Offset:  binary.BigEndian.Uint32(b[8:]),
+		Last:    b[12:],
+	}, nil
+}
+
+// Resume returns the index in messages of the first message not yet
+// delivered. It returns an error if the last delivered message is no longer
+// among the messages, in which case the position cannot be found.
+func (mc *MessageCursor) Resume(messages []*Slot) (int, error) {
+	// Messages are usually unchanged between pages
+	if int(mc.Offset) <= len(messages) && mc.Offset > 0 &&
+		bytes.Equal(HashSlot(messages[mc.Offset-1]), mc.Last) {
+		return int(mc.Offset), nil
+	}
+
+	for i, slot := range messages {
+		if bytes.Equal(HashSlot(slot), mc.Last) {
+			return i + 1, nil
+		}
+	}
+	return 0, errors.Errorf("Last message delivered from round %d is no "+
+		"longer held", mc.RoundID)
+}
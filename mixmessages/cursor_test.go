@@ -0,0 +1,59 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package mixmessages
+
+import (
+	"reflect"
+	"testing"
+)
+
+func testSlots(payloads ...string) []*Slot {
+	slots := make([]*Slot, len(payloads))
+	for i, p := range payloads {
+		slots[i] = &Slot{PayloadA: []byte(p)}
+	}
+	return slots
+}
+
+// Happy path: a cursor survives marshalling and resumes after the last
+// delivered message.
+func TestMessageCursor_Marshal(t *testing.T) {
+	slots := testSlots("a", "b", "c")
+	expected := NewMessageCursor(42, slots, 2)
+
+	received, err := UnmarshalMessageCursor(expected.Marshal())
+	if err != nil {
+		t.Fatalf("Failed to unmarshal cursor: %+v", err)
+	}
+	if !reflect.DeepEqual(expected, received) {
+		t.Errorf("Unexpected cursor.\nexpected: %+v\nreceived: %+v",
+			expected, received)
+	}
+
+	if i, err := received.Resume(slots); err != nil || i != 2 {
+		t.Errorf("Unexpected resume position %d: %+v", i, err)
+	}
+
+	if _, err = UnmarshalMessageCursor("AAAA"); err == nil {
+		t.Errorf("Short cursor was accepted.")
+	}
+}
+
+// Tests that the position is found after earlier messages are pruned and
+// that an error is returned once the last delivered message is pruned.
+func TestMessageCursor_Resume_Pruned(t *testing.T) {
+	cursor := NewMessageCursor(42, testSlots("a", "b", "c"), 2)
+
+	if i, err := cursor.Resume(testSlots("b", "c")); err != nil || i != 1 {
+		t.Errorf("Unexpected resume position %d: %+v", i, err)
+	}
+
+	if _, err := cursor.Resume(testSlots("c")); err == nil {
+		t.Errorf("Cursor resumed after its message was pruned.")
+	}
+}
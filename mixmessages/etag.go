@@ -0,0 +1,87 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the entity tags of poll responses, letting servers answer a poll
+// whose response has not changed with a small "not modified" response
+
+package mixmessages
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/metadata"
+	protoV2 "google.golang.org/protobuf/proto"
+)
+
+// Headers of conditional polls. A poller sends the tag of the response it
+// holds in IfNoneMatchHeader. The server sends the tag of its response in
+// ETagHeader, and sets NotModifiedHeader to "true" if it matches, in which
+// case the response carries nothing the poller does not already hold.
+const (
+	IfNoneMatchHeader = "if-none-match"
+	ETagHeader        = "etag"
+	NotModifiedHeader = "not-modified"
+)
+
+// ETag returns the entity tag of the message: the hash of its deterministic
+// encoding.
+func ETag(msg proto.Message) string {
+	data, err := protoV2.MarshalOptions{Deterministic: true}.Marshal(
+		proto.MessageV2(msg))
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// PollResponseETag returns the entity tag of the content of the poll
+// response: the NDF, round updates, known rounds and filters. The timestamps
+// used for clock offset estimation differ on every poll and are excluded.
+func PollResponseETag(resp *GatewayPollResponse) string {
+	content := proto.Clone(resp).(*GatewayPollResponse)
+	content.ReceivedTs, content.GatewayDelay = 0, 0
+	return ETag(content)
+}
+
+// NotModifiedPollResponse returns the response sent in place of an unchanged
+// poll response, holding only its timestamps.
+func NotModifiedPollResponse(resp *GatewayPollResponse) *GatewayPollResponse {
+	return &GatewayPollResponse{
+		ReceivedTs:   resp.ReceivedTs,
+		GatewayDelay: resp.GatewayDelay,
+	}
+}
+
+// IfNoneMatch returns the tag in the IfNoneMatchHeader of the request, or
+// an empty string if there is none.
+func IfNoneMatch(md metadata.MD) string {
+	values := md.Get(IfNoneMatchHeader)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// IsNotModified returns true if the response header marks the response as
+// not modified.
+func IsNotModified(md metadata.MD) bool {
+	values := md.Get(NotModifiedHeader)
+	return len(values) != 0 && values[0] == "true"
+}
+
+// ETagMetadata returns the response header carrying the tag, marked as not
+// modified if it matches the tag the request was sent with.
+func ETagMetadata(etag, ifNoneMatch string) metadata.MD {
+	md := metadata.Pairs(ETagHeader, etag)
+	if etag != "" && etag == ifNoneMatch {
+		md.Set(NotModifiedHeader, "true")
+	}
+	return md
+}
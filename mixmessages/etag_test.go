@@ -0,0 +1,73 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package mixmessages
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// Tests that the tag of a poll response ignores its timestamps but not its
+// content.
+func TestPollResponseETag(t *testing.T) {
+	resp := &GatewayPollResponse{
+		KnownRounds:  []byte{1, 2, 3},
+		ReceivedTs:   5,
+		GatewayDelay: 10,
+	}
+	etag := PollResponseETag(resp)
+
+	later := &GatewayPollResponse{
+		KnownRounds:  []byte{1, 2, 3},
+		ReceivedTs:   50,
+		GatewayDelay: 100,
+	}
+	if PollResponseETag(later) != etag {
+		t.Errorf("Tag changed with the timestamps.")
+	}
+	if resp.ReceivedTs != 5 {
+		t.Errorf("Computing the tag modified the response.")
+	}
+
+	later.EarliestRound = 7
+	if PollResponseETag(later) == etag {
+		t.Errorf("Tag did not change with the content.")
+	}
+
+	notModified := NotModifiedPollResponse(resp)
+	if notModified.ReceivedTs != 5 || notModified.GatewayDelay != 10 ||
+		notModified.KnownRounds != nil {
+		t.Errorf("Unexpected not modified response %+v.", notModified)
+	}
+}
+
+// Tests that the response header is marked not modified only when the tags
+// match.
+func TestETagMetadata(t *testing.T) {
+	etag := ETag(&NDF{Ndf: []byte("ndf")})
+	if etag == "" || etag != ETag(&NDF{Ndf: []byte("ndf")}) {
+		t.Fatalf("Tag is not stable: %q", etag)
+	}
+
+	request := metadata.Pairs(IfNoneMatchHeader, etag)
+	md := ETagMetadata(etag, IfNoneMatch(request))
+	if !IsNotModified(md) {
+		t.Errorf("Matching tag not marked as not modified.")
+	}
+	if md.Get(ETagHeader)[0] != etag {
+		t.Errorf("Tag header not set.")
+	}
+
+	if IsNotModified(ETagMetadata(etag, IfNoneMatch(metadata.MD{}))) {
+		t.Errorf("Request without a tag marked as not modified.")
+	}
+	if IsNotModified(ETagMetadata(ETag(&NDF{}), etag)) {
+		t.Errorf("Changed response marked as not modified.")
+	}
+}
@@ -0,0 +1,100 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains inclusion proofs, which a gateway signs to prove that a message
+// it accepted was included in a round
+
+package mixmessages
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/comms/signature"
+	"gitlab.com/xx_network/crypto/signature/rsa"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// NewInclusionProof creates an unsigned proof that the message was included
+// in the round at the slot index by the gateway.
+func NewInclusionProof(gateway *id.ID, roundID uint64, slotIndex uint32,
+	msg *Slot, timestamp time.Time) *InclusionProof {
+	return &InclusionProof{
+		RoundID:     roundID,
+		SlotIndex:   slotIndex,
+		Gateway:     gateway.Marshal(),
+		MessageHash: HashSlot(msg),
+		Timestamp:   timestamp.UnixNano(),
+	}
+}
+
+// Sign signs the proof with the gateway's private key.
+func (ip *InclusionProof) Sign(key *rsa.PrivateKey) error {
+	return signature.SignRsa(ip, key)
+}
+
+// Verify checks that the proof is for the message sent in the round and was
+// signed by the holder of the public key.
+func (ip *InclusionProof) Verify(msg *GatewaySlot,
+	pubKey *rsa.PublicKey) error {
+	if ip.RoundID != msg.GetRoundID() {
+		return errors.Errorf("Inclusion proof is for round %d, not round %d",
+			ip.RoundID, msg.GetRoundID())
+	}
+	if !bytes.Equal(ip.MessageHash, HashSlot(msg.GetMessage())) {
+		return errors.New("Inclusion proof is for another message")
+	}
+	return signature.VerifyRsa(ip, pubKey)
+}
+
+// GetGatewayID returns the ID of the gateway which accepted the message.
+func (ip *InclusionProof) GetGatewayID() (*id.ID, error) {
+	return id.Unmarshal(ip.Gateway)
+}
+
+// GetTime returns the time the proof was created.
+func (ip *InclusionProof) GetTime() time.Time {
+	return time.Unix(0, ip.Timestamp)
+}
+
+// GetSig returns the RSA signature.
+// IF none exists, it creates it, adds it to the object, then returns it.
+func (ip *InclusionProof) GetSig() *messages.RSASignature {
+	if ip.Signature != nil {
+		return ip.Signature
+	}
+
+	ip.Signature = new(messages.RSASignature)
+
+	return ip.Signature
+}
+
+// Digest hashes the contents of the proof in a repeatable manner
+// using the provided cryptographic hash. It includes the nonce in the hash
+func (ip *InclusionProof) Digest(nonce []byte, h hash.Hash) []byte {
+	h.Reset()
+
+	roundID := make([]byte, 8)
+	binary.BigEndian.PutUint64(roundID, ip.RoundID)
+	slotIndex := make([]byte, 4)
+	binary.BigEndian.PutUint32(slotIndex, ip.SlotIndex)
+	timestamp := make([]byte, 8)
+	binary.BigEndian.PutUint64(timestamp, uint64(ip.Timestamp))
+
+	h.Write(roundID)
+	h.Write(slotIndex)
+	h.Write(ip.Gateway)
+	h.Write(ip.MessageHash)
+	h.Write(timestamp)
+	h.Write(nonce)
+
+	return h.Sum(nil)
+}
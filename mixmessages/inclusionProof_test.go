@@ -0,0 +1,92 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package mixmessages
+
+import (
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"gitlab.com/xx_network/crypto/signature/rsa"
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/protobuf/proto"
+)
+
+// Happy path: a proof sent in a response verifies against its message.
+func TestInclusionProof_SignVerify(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %+v", err)
+	}
+
+	gwID := id.NewIdFromString("gateway", id.Gateway, t)
+	msg := &GatewaySlot{RoundID: 7, Message: &Slot{PayloadA: []byte("a")}}
+	proof := NewInclusionProof(gwID, 7, 3, msg.Message, time.Unix(0, 42))
+	if err = proof.Sign(privateKey); err != nil {
+		t.Fatalf("Failed to sign proof: %+v", err)
+	}
+
+	data, err := proto.Marshal(&GatewaySlotResponse{Accepted: true,
+		RoundID: 7, Proof: proof})
+	if err != nil {
+		t.Fatalf("Failed to marshal response: %+v", err)
+	}
+	response := &GatewaySlotResponse{}
+	if err = proto.Unmarshal(data, response); err != nil {
+		t.Fatalf("Failed to unmarshal response: %+v", err)
+	}
+	received := response.GetProof()
+
+	receivedGateway, err := received.GetGatewayID()
+	if err != nil {
+		t.Fatalf("Failed to get gateway: %+v", err)
+	}
+	if !receivedGateway.Cmp(gwID) || received.SlotIndex != 3 ||
+		!received.GetTime().Equal(time.Unix(0, 42)) {
+		t.Errorf("Unexpected proof: %+v", received)
+	}
+	if err = received.Verify(msg, privateKey.GetPublic()); err != nil {
+		t.Errorf("Failed to verify proof: %+v", err)
+	}
+}
+
+// Error path: a proof does not verify against another message, round or key.
+func TestInclusionProof_Verify_Error(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %+v", err)
+	}
+	otherKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %+v", err)
+	}
+
+	gwID := id.NewIdFromString("gateway", id.Gateway, t)
+	msg := &GatewaySlot{RoundID: 7, Message: &Slot{PayloadA: []byte("a")}}
+	proof := NewInclusionProof(gwID, 7, 3, msg.Message, time.Unix(0, 42))
+	if err = proof.Sign(privateKey); err != nil {
+		t.Fatalf("Failed to sign proof: %+v", err)
+	}
+
+	other := &GatewaySlot{RoundID: 7, Message: &Slot{PayloadA: []byte("b")}}
+	if err = proof.Verify(other, privateKey.GetPublic()); err == nil {
+		t.Errorf("Verified a proof against another message.")
+	}
+	otherRound := &GatewaySlot{RoundID: 8, Message: msg.Message}
+	if err = proof.Verify(otherRound, privateKey.GetPublic()); err == nil {
+		t.Errorf("Verified a proof against another round.")
+	}
+	if err = proof.Verify(msg, otherKey.GetPublic()); err == nil {
+		t.Errorf("Verified a proof against another key.")
+	}
+
+	proof.SlotIndex = 4
+	if err = proof.Verify(msg, privateKey.GetPublic()); err == nil {
+		t.Errorf("Verified a modified proof.")
+	}
+}
@@ -0,0 +1,110 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains mirror manifests, which a gateway signs over the messages it
+// stored for a round so that peers replicating them can prove where they
+// came from
+
+package mixmessages
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/comms/signature"
+	"gitlab.com/xx_network/crypto/signature/rsa"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// NewMirrorManifest creates an unsigned manifest of the messages stored on
+// the origin gateway.
+func NewMirrorManifest(origin *id.ID, msgs *RoundMessages,
+	timestamp time.Time) *MirrorManifest {
+	hashes := make([][]byte, len(msgs.GetMessages()))
+	for i, slot := range msgs.GetMessages() {
+		hashes[i] = HashSlot(slot)
+	}
+	return &MirrorManifest{
+		RoundID:       msgs.GetRoundId(),
+		Origin:        origin.Marshal(),
+		MessageHashes: hashes,
+		Timestamp:     timestamp.UnixNano(),
+	}
+}
+
+// Sign signs the manifest with the origin gateway's private key.
+func (m *MirrorManifest) Sign(key *rsa.PrivateKey) error {
+	return signature.SignRsa(m, key)
+}
+
+// Verify checks that the manifest lists exactly the messages, in order, and
+// was signed by the holder of the public key.
+func (m *MirrorManifest) Verify(msgs *RoundMessages,
+	pubKey *rsa.PublicKey) error {
+	if m.RoundID != msgs.GetRoundId() {
+		return errors.Errorf("Mirror manifest is for round %d, not round %d",
+			m.RoundID, msgs.GetRoundId())
+	}
+	if len(m.MessageHashes) != len(msgs.GetMessages()) {
+		return errors.Errorf("Mirror manifest lists %d messages, received %d",
+			len(m.MessageHashes), len(msgs.GetMessages()))
+	}
+	for i, slot := range msgs.GetMessages() {
+		if !bytes.Equal(m.MessageHashes[i], HashSlot(slot)) {
+			return errors.Errorf("Message %d does not match the mirror "+
+				"manifest", i)
+		}
+	}
+	return signature.VerifyRsa(m, pubKey)
+}
+
+// GetOriginID returns the ID of the gateway the messages were stored on.
+func (m *MirrorManifest) GetOriginID() (*id.ID, error) {
+	return id.Unmarshal(m.Origin)
+}
+
+// GetTime returns the time the manifest was created.
+func (m *MirrorManifest) GetTime() time.Time {
+	return time.Unix(0, m.Timestamp)
+}
+
+// GetSig returns the RSA signature.
+// IF none exists, it creates it, adds it to the object, then returns it.
+func (m *MirrorManifest) GetSig() *messages.RSASignature {
+	if m.Signature != nil {
+		return m.Signature
+	}
+
+	m.Signature = new(messages.RSASignature)
+
+	return m.Signature
+}
+
+// Digest hashes the contents of the manifest in a repeatable manner
+// using the provided cryptographic hash. It includes the nonce in the hash
+func (m *MirrorManifest) Digest(nonce []byte, h hash.Hash) []byte {
+	h.Reset()
+
+	roundID := make([]byte, 8)
+	binary.BigEndian.PutUint64(roundID, m.RoundID)
+	timestamp := make([]byte, 8)
+	binary.BigEndian.PutUint64(timestamp, uint64(m.Timestamp))
+
+	h.Write(roundID)
+	h.Write(m.Origin)
+	for _, messageHash := range m.MessageHashes {
+		h.Write(messageHash)
+	}
+	h.Write(timestamp)
+	h.Write(nonce)
+
+	return h.Sum(nil)
+}
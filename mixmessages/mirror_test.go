@@ -0,0 +1,108 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package mixmessages
+
+import (
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"gitlab.com/xx_network/crypto/signature/rsa"
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/protobuf/proto"
+)
+
+// newMirrorTestMessages returns the messages of a round used in the tests.
+func newMirrorTestMessages() *RoundMessages {
+	return &RoundMessages{
+		RoundId: 7,
+		Messages: []*Slot{
+			{Index: 0, PayloadA: []byte("a")},
+			{Index: 1, PayloadA: []byte("b")},
+		},
+	}
+}
+
+// Happy path: a manifest sent with its messages verifies against them.
+func TestMirrorManifest_SignVerify(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %+v", err)
+	}
+
+	origin := id.NewIdFromString("origin", id.Gateway, t)
+	msgs := newMirrorTestMessages()
+	manifest := NewMirrorManifest(origin, msgs, time.Unix(0, 42))
+	if err = manifest.Sign(privateKey); err != nil {
+		t.Fatalf("Failed to sign manifest: %+v", err)
+	}
+
+	data, err := proto.Marshal(&MirroredMessages{
+		Messages: msgs,
+		Manifest: manifest,
+	})
+	if err != nil {
+		t.Fatalf("Failed to marshal mirrored messages: %+v", err)
+	}
+	mirrored := &MirroredMessages{}
+	if err = proto.Unmarshal(data, mirrored); err != nil {
+		t.Fatalf("Failed to unmarshal mirrored messages: %+v", err)
+	}
+	received := mirrored.GetManifest()
+
+	receivedOrigin, err := received.GetOriginID()
+	if err != nil {
+		t.Fatalf("Failed to get origin: %+v", err)
+	}
+	if !receivedOrigin.Cmp(origin) {
+		t.Errorf("Unexpected origin.\nexpected: %s\nreceived: %s",
+			origin, receivedOrigin)
+	}
+	err = received.Verify(mirrored.GetMessages(), privateKey.GetPublic())
+	if err != nil {
+		t.Errorf("Failed to verify manifest: %+v", err)
+	}
+}
+
+// Error path: a manifest does not verify against other messages or after it
+// is modified.
+func TestMirrorManifest_Verify_Error(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %+v", err)
+	}
+
+	origin := id.NewIdFromString("origin", id.Gateway, t)
+	manifest := NewMirrorManifest(origin, newMirrorTestMessages(), time.Now())
+	if err = manifest.Sign(privateKey); err != nil {
+		t.Fatalf("Failed to sign manifest: %+v", err)
+	}
+
+	modified := newMirrorTestMessages()
+	modified.Messages[1].PayloadA = []byte("c")
+	if manifest.Verify(modified, privateKey.GetPublic()) == nil {
+		t.Error("Manifest verified against modified messages.")
+	}
+
+	dropped := newMirrorTestMessages()
+	dropped.Messages = dropped.Messages[:1]
+	if manifest.Verify(dropped, privateKey.GetPublic()) == nil {
+		t.Error("Manifest verified against missing messages.")
+	}
+
+	otherRound := newMirrorTestMessages()
+	otherRound.RoundId++
+	if manifest.Verify(otherRound, privateKey.GetPublic()) == nil {
+		t.Error("Manifest verified against messages of another round.")
+	}
+
+	manifest.Origin = id.NewIdFromString("other", id.Gateway, t).Marshal()
+	if manifest.Verify(newMirrorTestMessages(), privateKey.GetPublic()) == nil {
+		t.Error("Manifest verified after its origin was modified.")
+	}
+}
@@ -410,6 +410,8 @@ type SignedKeyResponse struct {
 	KeyResponseSignedByGateway *messages.RSASignature `protobuf:"bytes,2,opt,name=KeyResponseSignedByGateway,proto3" json:"KeyResponseSignedByGateway,omitempty"`
 	ClientGatewayKey           []byte                 `protobuf:"bytes,3,opt,name=ClientGatewayKey,proto3" json:"ClientGatewayKey,omitempty"` // Stripped off by node gateway
 	Error                      string                 `protobuf:"bytes,4,opt,name=Error,proto3" json:"Error,omitempty"`
+	// Set by the node; gateways relaying the response pass it on unchanged
+	Receipt *RegistrationReceipt `protobuf:"bytes,5,opt,name=Receipt,proto3" json:"Receipt,omitempty"`
 }
 
 func (x *SignedKeyResponse) Reset() {
@@ -472,6 +474,80 @@ func (x *SignedKeyResponse) GetError() string {
 	return ""
 }
 
+func (x *SignedKeyResponse) GetReceipt() *RegistrationReceipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+// A node's signature over the KeyResponse of a SignedKeyResponse and the time
+// it was issued, letting the client prove later that registration succeeded
+type RegistrationReceipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Hash of the KeyResponse (see HashKeyResponse)
+	ResponseHash []byte `protobuf:"bytes,1,opt,name=ResponseHash,proto3" json:"ResponseHash,omitempty"`
+	// Unix nanoseconds
+	Timestamp int64                  `protobuf:"varint,2,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Signature *messages.RSASignature `protobuf:"bytes,3,opt,name=Signature,proto3" json:"Signature,omitempty"`
+}
+
+func (x *RegistrationReceipt) Reset() {
+	*x = RegistrationReceipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegistrationReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegistrationReceipt) ProtoMessage() {}
+
+func (x *RegistrationReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegistrationReceipt.ProtoReflect.Descriptor instead.
+func (*RegistrationReceipt) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RegistrationReceipt) GetResponseHash() []byte {
+	if x != nil {
+		return x.ResponseHash
+	}
+	return nil
+}
+
+func (x *RegistrationReceipt) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *RegistrationReceipt) GetSignature() *messages.RSASignature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
 type PostPrecompResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -484,7 +560,7 @@ type PostPrecompResult struct {
 func (x *PostPrecompResult) Reset() {
 	*x = PostPrecompResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[6]
+		mi := &file_mixmessages_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -497,7 +573,7 @@ func (x *PostPrecompResult) String() string {
 func (*PostPrecompResult) ProtoMessage() {}
 
 func (x *PostPrecompResult) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[6]
+	mi := &file_mixmessages_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -510,7 +586,7 @@ func (x *PostPrecompResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PostPrecompResult.ProtoReflect.Descriptor instead.
 func (*PostPrecompResult) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{6}
+	return file_mixmessages_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PostPrecompResult) GetRoundId() uint64 {
@@ -540,7 +616,7 @@ type RoundBufferInfo struct {
 func (x *RoundBufferInfo) Reset() {
 	*x = RoundBufferInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[7]
+		mi := &file_mixmessages_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -553,7 +629,7 @@ func (x *RoundBufferInfo) String() string {
 func (*RoundBufferInfo) ProtoMessage() {}
 
 func (x *RoundBufferInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[7]
+	mi := &file_mixmessages_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -566,7 +642,7 @@ func (x *RoundBufferInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoundBufferInfo.ProtoReflect.Descriptor instead.
 func (*RoundBufferInfo) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{7}
+	return file_mixmessages_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *RoundBufferInfo) GetRoundBufferSize() uint32 {
@@ -588,7 +664,7 @@ type RoundPublicKey struct {
 func (x *RoundPublicKey) Reset() {
 	*x = RoundPublicKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[8]
+		mi := &file_mixmessages_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -601,7 +677,7 @@ func (x *RoundPublicKey) String() string {
 func (*RoundPublicKey) ProtoMessage() {}
 
 func (x *RoundPublicKey) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[8]
+	mi := &file_mixmessages_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -614,7 +690,7 @@ func (x *RoundPublicKey) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoundPublicKey.ProtoReflect.Descriptor instead.
 func (*RoundPublicKey) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{8}
+	return file_mixmessages_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *RoundPublicKey) GetRound() *RoundInfo {
@@ -642,7 +718,7 @@ type RoundMetrics struct {
 func (x *RoundMetrics) Reset() {
 	*x = RoundMetrics{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[9]
+		mi := &file_mixmessages_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -655,7 +731,7 @@ func (x *RoundMetrics) String() string {
 func (*RoundMetrics) ProtoMessage() {}
 
 func (x *RoundMetrics) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[9]
+	mi := &file_mixmessages_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -668,7 +744,7 @@ func (x *RoundMetrics) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoundMetrics.ProtoReflect.Descriptor instead.
 func (*RoundMetrics) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{9}
+	return file_mixmessages_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *RoundMetrics) GetRoundMetricJSON() string {
@@ -692,7 +768,7 @@ type Batch struct {
 func (x *Batch) Reset() {
 	*x = Batch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[10]
+		mi := &file_mixmessages_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -705,7 +781,7 @@ func (x *Batch) String() string {
 func (*Batch) ProtoMessage() {}
 
 func (x *Batch) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[10]
+	mi := &file_mixmessages_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -718,7 +794,7 @@ func (x *Batch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Batch.ProtoReflect.Descriptor instead.
 func (*Batch) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{10}
+	return file_mixmessages_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Batch) GetRound() *RoundInfo {
@@ -754,7 +830,7 @@ type CompletedBatch struct {
 func (x *CompletedBatch) Reset() {
 	*x = CompletedBatch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[11]
+		mi := &file_mixmessages_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -767,7 +843,7 @@ func (x *CompletedBatch) String() string {
 func (*CompletedBatch) ProtoMessage() {}
 
 func (x *CompletedBatch) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[11]
+	mi := &file_mixmessages_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -780,7 +856,7 @@ func (x *CompletedBatch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompletedBatch.ProtoReflect.Descriptor instead.
 func (*CompletedBatch) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{11}
+	return file_mixmessages_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *CompletedBatch) GetRoundID() uint64 {
@@ -806,12 +882,14 @@ type BatchInfo struct {
 	Round     *RoundInfo `protobuf:"bytes,1,opt,name=Round,proto3" json:"Round,omitempty"`
 	FromPhase int32      `protobuf:"varint,2,opt,name=FromPhase,proto3" json:"FromPhase,omitempty"`
 	BatchSize uint32     `protobuf:"varint,3,opt,name=BatchSize,proto3" json:"BatchSize,omitempty"`
+	// Identifies an unmixed batch upload so retries are not processed twice
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=IdempotencyKey,proto3" json:"IdempotencyKey,omitempty"`
 }
 
 func (x *BatchInfo) Reset() {
 	*x = BatchInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[12]
+		mi := &file_mixmessages_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -824,7 +902,7 @@ func (x *BatchInfo) String() string {
 func (*BatchInfo) ProtoMessage() {}
 
 func (x *BatchInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[12]
+	mi := &file_mixmessages_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -837,7 +915,7 @@ func (x *BatchInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchInfo.ProtoReflect.Descriptor instead.
 func (*BatchInfo) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{12}
+	return file_mixmessages_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *BatchInfo) GetRound() *RoundInfo {
@@ -861,6 +939,13 @@ func (x *BatchInfo) GetBatchSize() uint32 {
 	return 0
 }
 
+func (x *BatchInfo) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 // Used for collecting metrics on a round trip of the system
 type RoundTripPing struct {
 	state         protoimpl.MessageState
@@ -874,7 +959,7 @@ type RoundTripPing struct {
 func (x *RoundTripPing) Reset() {
 	*x = RoundTripPing{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[13]
+		mi := &file_mixmessages_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -887,7 +972,7 @@ func (x *RoundTripPing) String() string {
 func (*RoundTripPing) ProtoMessage() {}
 
 func (x *RoundTripPing) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[13]
+	mi := &file_mixmessages_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -900,7 +985,7 @@ func (x *RoundTripPing) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoundTripPing.ProtoReflect.Descriptor instead.
 func (*RoundTripPing) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{13}
+	return file_mixmessages_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *RoundTripPing) GetPayload() *anypb.Any {
@@ -934,7 +1019,7 @@ type ServerPoll struct {
 func (x *ServerPoll) Reset() {
 	*x = ServerPoll{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[14]
+		mi := &file_mixmessages_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -947,7 +1032,7 @@ func (x *ServerPoll) String() string {
 func (*ServerPoll) ProtoMessage() {}
 
 func (x *ServerPoll) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[14]
+	mi := &file_mixmessages_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -960,7 +1045,7 @@ func (x *ServerPoll) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerPoll.ProtoReflect.Descriptor instead.
 func (*ServerPoll) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{14}
+	return file_mixmessages_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ServerPoll) GetFull() *NDFHash {
@@ -1026,7 +1111,7 @@ type ServerPollResponse struct {
 func (x *ServerPollResponse) Reset() {
 	*x = ServerPollResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[15]
+		mi := &file_mixmessages_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1039,7 +1124,7 @@ func (x *ServerPollResponse) String() string {
 func (*ServerPollResponse) ProtoMessage() {}
 
 func (x *ServerPollResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[15]
+	mi := &file_mixmessages_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1052,7 +1137,7 @@ func (x *ServerPollResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerPollResponse.ProtoReflect.Descriptor instead.
 func (*ServerPollResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{15}
+	return file_mixmessages_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ServerPollResponse) GetId() []byte {
@@ -1136,7 +1221,7 @@ type BatchReady struct {
 func (x *BatchReady) Reset() {
 	*x = BatchReady{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[16]
+		mi := &file_mixmessages_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1149,7 +1234,7 @@ func (x *BatchReady) String() string {
 func (*BatchReady) ProtoMessage() {}
 
 func (x *BatchReady) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[16]
+	mi := &file_mixmessages_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1162,7 +1247,7 @@ func (x *BatchReady) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchReady.ProtoReflect.Descriptor instead.
 func (*BatchReady) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{16}
+	return file_mixmessages_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *BatchReady) GetRoundId() uint64 {
@@ -1189,7 +1274,7 @@ type SharePiece struct {
 func (x *SharePiece) Reset() {
 	*x = SharePiece{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[17]
+		mi := &file_mixmessages_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1202,7 +1287,7 @@ func (x *SharePiece) String() string {
 func (*SharePiece) ProtoMessage() {}
 
 func (x *SharePiece) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[17]
+	mi := &file_mixmessages_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1215,7 +1300,7 @@ func (x *SharePiece) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SharePiece.ProtoReflect.Descriptor instead.
 func (*SharePiece) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{17}
+	return file_mixmessages_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *SharePiece) GetPiece() []byte {
@@ -1246,6 +1331,80 @@ func (x *SharePiece) GetSignature() *messages.RSASignature {
 	return nil
 }
 
+// A host's signature over its new address
+type AddressUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the host whose address changed
+	ID      []byte `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=Address,proto3" json:"Address,omitempty"`
+	// Unix nanoseconds
+	Timestamp int64                  `protobuf:"varint,3,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Signature *messages.RSASignature `protobuf:"bytes,4,opt,name=Signature,proto3" json:"Signature,omitempty"`
+}
+
+func (x *AddressUpdate) Reset() {
+	*x = AddressUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressUpdate) ProtoMessage() {}
+
+func (x *AddressUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressUpdate.ProtoReflect.Descriptor instead.
+func (*AddressUpdate) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AddressUpdate) GetID() []byte {
+	if x != nil {
+		return x.ID
+	}
+	return nil
+}
+
+func (x *AddressUpdate) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AddressUpdate) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *AddressUpdate) GetSignature() *messages.RSASignature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
 type RequestGatewayCert struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1255,7 +1414,7 @@ type RequestGatewayCert struct {
 func (x *RequestGatewayCert) Reset() {
 	*x = RequestGatewayCert{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[18]
+		mi := &file_mixmessages_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1268,7 +1427,7 @@ func (x *RequestGatewayCert) String() string {
 func (*RequestGatewayCert) ProtoMessage() {}
 
 func (x *RequestGatewayCert) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[18]
+	mi := &file_mixmessages_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1281,7 +1440,7 @@ func (x *RequestGatewayCert) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestGatewayCert.ProtoReflect.Descriptor instead.
 func (*RequestGatewayCert) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{18}
+	return file_mixmessages_proto_rawDescGZIP(), []int{20}
 }
 
 type GatewayCertificate struct {
@@ -1296,7 +1455,7 @@ type GatewayCertificate struct {
 func (x *GatewayCertificate) Reset() {
 	*x = GatewayCertificate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[19]
+		mi := &file_mixmessages_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1309,7 +1468,7 @@ func (x *GatewayCertificate) String() string {
 func (*GatewayCertificate) ProtoMessage() {}
 
 func (x *GatewayCertificate) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[19]
+	mi := &file_mixmessages_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1322,7 +1481,7 @@ func (x *GatewayCertificate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GatewayCertificate.ProtoReflect.Descriptor instead.
 func (*GatewayCertificate) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{19}
+	return file_mixmessages_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GatewayCertificate) GetCertificate() []byte {
@@ -1352,7 +1511,7 @@ type StreamChunk struct {
 func (x *StreamChunk) Reset() {
 	*x = StreamChunk{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[20]
+		mi := &file_mixmessages_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1365,7 +1524,7 @@ func (x *StreamChunk) String() string {
 func (*StreamChunk) ProtoMessage() {}
 
 func (x *StreamChunk) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[20]
+	mi := &file_mixmessages_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1378,7 +1537,7 @@ func (x *StreamChunk) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamChunk.ProtoReflect.Descriptor instead.
 func (*StreamChunk) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{20}
+	return file_mixmessages_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *StreamChunk) GetDatum() []byte {
@@ -1400,7 +1559,7 @@ type HistoricalRounds struct {
 func (x *HistoricalRounds) Reset() {
 	*x = HistoricalRounds{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[21]
+		mi := &file_mixmessages_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1413,7 +1572,7 @@ func (x *HistoricalRounds) String() string {
 func (*HistoricalRounds) ProtoMessage() {}
 
 func (x *HistoricalRounds) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[21]
+	mi := &file_mixmessages_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1426,7 +1585,7 @@ func (x *HistoricalRounds) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HistoricalRounds.ProtoReflect.Descriptor instead.
 func (*HistoricalRounds) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{21}
+	return file_mixmessages_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *HistoricalRounds) GetRounds() []uint64 {
@@ -1448,7 +1607,7 @@ type HistoricalRoundsResponse struct {
 func (x *HistoricalRoundsResponse) Reset() {
 	*x = HistoricalRoundsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[22]
+		mi := &file_mixmessages_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1461,7 +1620,7 @@ func (x *HistoricalRoundsResponse) String() string {
 func (*HistoricalRoundsResponse) ProtoMessage() {}
 
 func (x *HistoricalRoundsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[22]
+	mi := &file_mixmessages_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1474,7 +1633,7 @@ func (x *HistoricalRoundsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HistoricalRoundsResponse.ProtoReflect.Descriptor instead.
 func (*HistoricalRoundsResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{22}
+	return file_mixmessages_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *HistoricalRoundsResponse) GetRounds() []*RoundInfo {
@@ -1496,7 +1655,7 @@ type GetMessagesBatch struct {
 func (x *GetMessagesBatch) Reset() {
 	*x = GetMessagesBatch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[23]
+		mi := &file_mixmessages_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1509,7 +1668,7 @@ func (x *GetMessagesBatch) String() string {
 func (*GetMessagesBatch) ProtoMessage() {}
 
 func (x *GetMessagesBatch) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[23]
+	mi := &file_mixmessages_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1522,7 +1681,7 @@ func (x *GetMessagesBatch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMessagesBatch.ProtoReflect.Descriptor instead.
 func (*GetMessagesBatch) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{23}
+	return file_mixmessages_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GetMessagesBatch) GetRequests() []*GetMessages {
@@ -1546,12 +1705,14 @@ type GetMessagesResponseBatch struct {
 
 	Results []*GetMessagesResponse `protobuf:"bytes,1,rep,name=Results,proto3" json:"Results,omitempty"`
 	Errors  []string               `protobuf:"bytes,3,rep,name=Errors,proto3" json:"Errors,omitempty"`
+	// How long the gateway keeps messages, if it advertises it
+	Retention *MessageRetentionPolicy `protobuf:"bytes,4,opt,name=Retention,proto3" json:"Retention,omitempty"`
 }
 
 func (x *GetMessagesResponseBatch) Reset() {
 	*x = GetMessagesResponseBatch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[24]
+		mi := &file_mixmessages_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1564,7 +1725,7 @@ func (x *GetMessagesResponseBatch) String() string {
 func (*GetMessagesResponseBatch) ProtoMessage() {}
 
 func (x *GetMessagesResponseBatch) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[24]
+	mi := &file_mixmessages_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1577,7 +1738,7 @@ func (x *GetMessagesResponseBatch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMessagesResponseBatch.ProtoReflect.Descriptor instead.
 func (*GetMessagesResponseBatch) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{24}
+	return file_mixmessages_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetMessagesResponseBatch) GetResults() []*GetMessagesResponse {
@@ -1594,6 +1755,13 @@ func (x *GetMessagesResponseBatch) GetErrors() []string {
 	return nil
 }
 
+func (x *GetMessagesResponseBatch) GetRetention() *MessageRetentionPolicy {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
 // Client -> Gateway request for available messages
 // The query will be a request for all messages
 // available in a round.
@@ -1605,12 +1773,16 @@ type GetMessages struct {
 	ClientID []byte `protobuf:"bytes,1,opt,name=ClientID,proto3" json:"ClientID,omitempty"`
 	RoundID  uint64 `protobuf:"varint,2,opt,name=RoundID,proto3" json:"RoundID,omitempty"`
 	Target   []byte `protobuf:"bytes,3,opt,name=Target,proto3" json:"Target,omitempty"`
+	// Requests the page of messages after the Cursor, which is empty for the
+	// first page. Requests which are not paged receive all messages.
+	Paged  bool   `protobuf:"varint,4,opt,name=Paged,proto3" json:"Paged,omitempty"`
+	Cursor string `protobuf:"bytes,5,opt,name=Cursor,proto3" json:"Cursor,omitempty"`
 }
 
 func (x *GetMessages) Reset() {
 	*x = GetMessages{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[25]
+		mi := &file_mixmessages_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1623,7 +1795,7 @@ func (x *GetMessages) String() string {
 func (*GetMessages) ProtoMessage() {}
 
 func (x *GetMessages) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[25]
+	mi := &file_mixmessages_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1636,7 +1808,7 @@ func (x *GetMessages) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMessages.ProtoReflect.Descriptor instead.
 func (*GetMessages) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{25}
+	return file_mixmessages_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetMessages) GetClientID() []byte {
@@ -1660,6 +1832,20 @@ func (x *GetMessages) GetTarget() []byte {
 	return nil
 }
 
+func (x *GetMessages) GetPaged() bool {
+	if x != nil {
+		return x.Paged
+	}
+	return false
+}
+
+func (x *GetMessages) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
 // Gateway response to a GetMessages request
 type GetMessagesResponse struct {
 	state         protoimpl.MessageState
@@ -1668,12 +1854,17 @@ type GetMessagesResponse struct {
 
 	Messages []*Slot `protobuf:"bytes,1,rep,name=Messages,proto3" json:"Messages,omitempty"`
 	HasRound bool    `protobuf:"varint,2,opt,name=HasRound,proto3" json:"HasRound,omitempty"`
+	// How long the gateway keeps messages, if it advertises it
+	Retention *MessageRetentionPolicy `protobuf:"bytes,3,opt,name=Retention,proto3" json:"Retention,omitempty"`
+	// Cursor of the next page of a paged request, or empty if the page is
+	// the last
+	NextCursor string `protobuf:"bytes,4,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
 }
 
 func (x *GetMessagesResponse) Reset() {
 	*x = GetMessagesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[26]
+		mi := &file_mixmessages_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1686,7 +1877,7 @@ func (x *GetMessagesResponse) String() string {
 func (*GetMessagesResponse) ProtoMessage() {}
 
 func (x *GetMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[26]
+	mi := &file_mixmessages_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1699,7 +1890,7 @@ func (x *GetMessagesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMessagesResponse.ProtoReflect.Descriptor instead.
 func (*GetMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{26}
+	return file_mixmessages_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GetMessagesResponse) GetMessages() []*Slot {
@@ -1716,7 +1907,80 @@ func (x *GetMessagesResponse) GetHasRound() bool {
 	return false
 }
 
-// Gateway -> Gateway message sharing within a team
+func (x *GetMessagesResponse) GetRetention() *MessageRetentionPolicy {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+func (x *GetMessagesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// How long a gateway keeps messages
+type MessageRetentionPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Duration in nanoseconds
+	TTL int64 `protobuf:"varint,1,opt,name=TTL,proto3" json:"TTL,omitempty"`
+	// When the messages of the request expire, in Unix nanoseconds. Zero if
+	// the response does not refer to specific messages.
+	Expiry int64 `protobuf:"varint,2,opt,name=Expiry,proto3" json:"Expiry,omitempty"`
+}
+
+func (x *MessageRetentionPolicy) Reset() {
+	*x = MessageRetentionPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageRetentionPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageRetentionPolicy) ProtoMessage() {}
+
+func (x *MessageRetentionPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageRetentionPolicy.ProtoReflect.Descriptor instead.
+func (*MessageRetentionPolicy) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *MessageRetentionPolicy) GetTTL() int64 {
+	if x != nil {
+		return x.TTL
+	}
+	return 0
+}
+
+func (x *MessageRetentionPolicy) GetExpiry() int64 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+// Gateway -> Gateway message sharing within a team
 type RoundMessages struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1729,7 +1993,7 @@ type RoundMessages struct {
 func (x *RoundMessages) Reset() {
 	*x = RoundMessages{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[27]
+		mi := &file_mixmessages_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1742,7 +2006,7 @@ func (x *RoundMessages) String() string {
 func (*RoundMessages) ProtoMessage() {}
 
 func (x *RoundMessages) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[27]
+	mi := &file_mixmessages_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1755,7 +2019,7 @@ func (x *RoundMessages) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoundMessages.ProtoReflect.Descriptor instead.
 func (*RoundMessages) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{27}
+	return file_mixmessages_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *RoundMessages) GetRoundId() uint64 {
@@ -1772,6 +2036,145 @@ func (x *RoundMessages) GetMessages() []*Slot {
 	return nil
 }
 
+// Gateway -> Gateway replica of the messages stored for a round
+type MirroredMessages struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Messages *RoundMessages  `protobuf:"bytes,1,opt,name=Messages,proto3" json:"Messages,omitempty"`
+	Manifest *MirrorManifest `protobuf:"bytes,2,opt,name=Manifest,proto3" json:"Manifest,omitempty"`
+}
+
+func (x *MirroredMessages) Reset() {
+	*x = MirroredMessages{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MirroredMessages) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MirroredMessages) ProtoMessage() {}
+
+func (x *MirroredMessages) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MirroredMessages.ProtoReflect.Descriptor instead.
+func (*MirroredMessages) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *MirroredMessages) GetMessages() *RoundMessages {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *MirroredMessages) GetManifest() *MirrorManifest {
+	if x != nil {
+		return x.Manifest
+	}
+	return nil
+}
+
+// A gateway's signature over the hashes of the messages it stored for a round
+type MirrorManifest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoundID uint64 `protobuf:"varint,1,opt,name=RoundID,proto3" json:"RoundID,omitempty"`
+	// ID of the gateway the messages were stored on
+	Origin []byte `protobuf:"bytes,2,opt,name=Origin,proto3" json:"Origin,omitempty"`
+	// Hash of each message, in the order they are stored (see HashSlot)
+	MessageHashes [][]byte `protobuf:"bytes,3,rep,name=MessageHashes,proto3" json:"MessageHashes,omitempty"`
+	// Unix nanoseconds
+	Timestamp int64                  `protobuf:"varint,4,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Signature *messages.RSASignature `protobuf:"bytes,5,opt,name=Signature,proto3" json:"Signature,omitempty"`
+}
+
+func (x *MirrorManifest) Reset() {
+	*x = MirrorManifest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MirrorManifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MirrorManifest) ProtoMessage() {}
+
+func (x *MirrorManifest) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MirrorManifest.ProtoReflect.Descriptor instead.
+func (*MirrorManifest) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *MirrorManifest) GetRoundID() uint64 {
+	if x != nil {
+		return x.RoundID
+	}
+	return 0
+}
+
+func (x *MirrorManifest) GetOrigin() []byte {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
+}
+
+func (x *MirrorManifest) GetMessageHashes() [][]byte {
+	if x != nil {
+		return x.MessageHashes
+	}
+	return nil
+}
+
+func (x *MirrorManifest) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *MirrorManifest) GetSignature() *messages.RSASignature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
 // The message for clients to poll the gateway for Message IDs
 type IDList struct {
 	state         protoimpl.MessageState
@@ -1784,7 +2187,7 @@ type IDList struct {
 func (x *IDList) Reset() {
 	*x = IDList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[28]
+		mi := &file_mixmessages_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1797,7 +2200,7 @@ func (x *IDList) String() string {
 func (*IDList) ProtoMessage() {}
 
 func (x *IDList) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[28]
+	mi := &file_mixmessages_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1810,7 +2213,7 @@ func (x *IDList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IDList.ProtoReflect.Descriptor instead.
 func (*IDList) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{28}
+	return file_mixmessages_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *IDList) GetIDs() []string {
@@ -1847,7 +2250,7 @@ type Slot struct {
 func (x *Slot) Reset() {
 	*x = Slot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[29]
+		mi := &file_mixmessages_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1860,7 +2263,7 @@ func (x *Slot) String() string {
 func (*Slot) ProtoMessage() {}
 
 func (x *Slot) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[29]
+	mi := &file_mixmessages_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1873,7 +2276,7 @@ func (x *Slot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Slot.ProtoReflect.Descriptor instead.
 func (*Slot) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{29}
+	return file_mixmessages_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *Slot) GetIndex() uint32 {
@@ -1996,7 +2399,7 @@ type GatewayPoll struct {
 func (x *GatewayPoll) Reset() {
 	*x = GatewayPoll{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[30]
+		mi := &file_mixmessages_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2009,7 +2412,7 @@ func (x *GatewayPoll) String() string {
 func (*GatewayPoll) ProtoMessage() {}
 
 func (x *GatewayPoll) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[30]
+	mi := &file_mixmessages_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2022,7 +2425,7 @@ func (x *GatewayPoll) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GatewayPoll.ProtoReflect.Descriptor instead.
 func (*GatewayPoll) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{30}
+	return file_mixmessages_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *GatewayPoll) GetPartial() *NDFHash {
@@ -2108,7 +2511,7 @@ type GatewayPollResponse struct {
 func (x *GatewayPollResponse) Reset() {
 	*x = GatewayPollResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[31]
+		mi := &file_mixmessages_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2121,7 +2524,7 @@ func (x *GatewayPollResponse) String() string {
 func (*GatewayPollResponse) ProtoMessage() {}
 
 func (x *GatewayPollResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[31]
+	mi := &file_mixmessages_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2134,7 +2537,7 @@ func (x *GatewayPollResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GatewayPollResponse.ProtoReflect.Descriptor instead.
 func (*GatewayPollResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{31}
+	return file_mixmessages_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GatewayPollResponse) GetPartialNDF() *NDF {
@@ -2207,7 +2610,7 @@ type ClientBlooms struct {
 func (x *ClientBlooms) Reset() {
 	*x = ClientBlooms{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[32]
+		mi := &file_mixmessages_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2220,7 +2623,7 @@ func (x *ClientBlooms) String() string {
 func (*ClientBlooms) ProtoMessage() {}
 
 func (x *ClientBlooms) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[32]
+	mi := &file_mixmessages_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2233,7 +2636,7 @@ func (x *ClientBlooms) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClientBlooms.ProtoReflect.Descriptor instead.
 func (*ClientBlooms) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{32}
+	return file_mixmessages_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ClientBlooms) GetPeriod() int64 {
@@ -2271,7 +2674,7 @@ type ClientBloom struct {
 func (x *ClientBloom) Reset() {
 	*x = ClientBloom{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[33]
+		mi := &file_mixmessages_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2284,7 +2687,7 @@ func (x *ClientBloom) String() string {
 func (*ClientBloom) ProtoMessage() {}
 
 func (x *ClientBloom) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[33]
+	mi := &file_mixmessages_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2297,7 +2700,7 @@ func (x *ClientBloom) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClientBloom.ProtoReflect.Descriptor instead.
 func (*ClientBloom) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{33}
+	return file_mixmessages_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ClientBloom) GetFilter() []byte {
@@ -2332,12 +2735,15 @@ type GatewaySlots struct {
 	RoundID uint64 `protobuf:"varint,2,opt,name=RoundID,proto3" json:"RoundID,omitempty"`
 	Target  []byte `protobuf:"bytes,3,opt,name=Target,proto3" json:"Target,omitempty"`
 	IpAddr  string `protobuf:"bytes,4,opt,name=IpAddr,proto3" json:"IpAddr,omitempty"` // IpAddr of client
+	// Encryption scheme of the slot payloads, as "name/version". Empty is the
+	// default scheme.
+	SlotScheme string `protobuf:"bytes,5,opt,name=SlotScheme,proto3" json:"SlotScheme,omitempty"`
 }
 
 func (x *GatewaySlots) Reset() {
 	*x = GatewaySlots{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[34]
+		mi := &file_mixmessages_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2350,7 +2756,7 @@ func (x *GatewaySlots) String() string {
 func (*GatewaySlots) ProtoMessage() {}
 
 func (x *GatewaySlots) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[34]
+	mi := &file_mixmessages_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2363,7 +2769,7 @@ func (x *GatewaySlots) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GatewaySlots.ProtoReflect.Descriptor instead.
 func (*GatewaySlots) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{34}
+	return file_mixmessages_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *GatewaySlots) GetMessages() []*GatewaySlot {
@@ -2394,6 +2800,13 @@ func (x *GatewaySlots) GetIpAddr() string {
 	return ""
 }
 
+func (x *GatewaySlots) GetSlotScheme() string {
+	if x != nil {
+		return x.SlotScheme
+	}
+	return ""
+}
+
 // Client -> Gateway authentication message
 type GatewaySlot struct {
 	state         protoimpl.MessageState
@@ -2405,12 +2818,15 @@ type GatewaySlot struct {
 	MAC     []byte `protobuf:"bytes,3,opt,name=MAC,proto3" json:"MAC,omitempty"`
 	Target  []byte `protobuf:"bytes,4,opt,name=Target,proto3" json:"Target,omitempty"`
 	IpAddr  string `protobuf:"bytes,5,opt,name=IpAddr,proto3" json:"IpAddr,omitempty"` // IpAddr of client
+	// Encryption scheme of the slot payload, as "name/version". Empty is the
+	// default scheme.
+	SlotScheme string `protobuf:"bytes,6,opt,name=SlotScheme,proto3" json:"SlotScheme,omitempty"`
 }
 
 func (x *GatewaySlot) Reset() {
 	*x = GatewaySlot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[35]
+		mi := &file_mixmessages_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2423,7 +2839,7 @@ func (x *GatewaySlot) String() string {
 func (*GatewaySlot) ProtoMessage() {}
 
 func (x *GatewaySlot) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[35]
+	mi := &file_mixmessages_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2436,7 +2852,7 @@ func (x *GatewaySlot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GatewaySlot.ProtoReflect.Descriptor instead.
 func (*GatewaySlot) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{35}
+	return file_mixmessages_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GatewaySlot) GetMessage() *Slot {
@@ -2474,6 +2890,13 @@ func (x *GatewaySlot) GetIpAddr() string {
 	return ""
 }
 
+func (x *GatewaySlot) GetSlotScheme() string {
+	if x != nil {
+		return x.SlotScheme
+	}
+	return ""
+}
+
 // Gateway -> Client authentication response
 type GatewaySlotResponse struct {
 	state         protoimpl.MessageState
@@ -2482,12 +2905,17 @@ type GatewaySlotResponse struct {
 
 	Accepted bool   `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
 	RoundID  uint64 `protobuf:"varint,2,opt,name=RoundID,proto3" json:"RoundID,omitempty"`
+	// Proof that the message was included in the round, set by accepted
+	// responses to RequestInclusionProof
+	Proof *InclusionProof `protobuf:"bytes,3,opt,name=Proof,proto3" json:"Proof,omitempty"`
+	// How long the gateway keeps the accepted messages, if it advertises it
+	Retention *MessageRetentionPolicy `protobuf:"bytes,4,opt,name=Retention,proto3" json:"Retention,omitempty"`
 }
 
 func (x *GatewaySlotResponse) Reset() {
 	*x = GatewaySlotResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[36]
+		mi := &file_mixmessages_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2500,7 +2928,7 @@ func (x *GatewaySlotResponse) String() string {
 func (*GatewaySlotResponse) ProtoMessage() {}
 
 func (x *GatewaySlotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[36]
+	mi := &file_mixmessages_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2513,7 +2941,7 @@ func (x *GatewaySlotResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GatewaySlotResponse.ProtoReflect.Descriptor instead.
 func (*GatewaySlotResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{36}
+	return file_mixmessages_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *GatewaySlotResponse) GetAccepted() bool {
@@ -2530,34 +2958,55 @@ func (x *GatewaySlotResponse) GetRoundID() uint64 {
 	return 0
 }
 
-// Gateway -> Gateway gossip of all Sender IDs in a Batch
-type BatchSenders struct {
+func (x *GatewaySlotResponse) GetProof() *InclusionProof {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+func (x *GatewaySlotResponse) GetRetention() *MessageRetentionPolicy {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+// A gateway's signature stating that a message was included in a round at a
+// slot index
+type InclusionProof struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SenderIds [][]byte `protobuf:"bytes,1,rep,name=SenderIds,proto3" json:"SenderIds,omitempty"`
-	RoundID   uint64   `protobuf:"varint,2,opt,name=RoundID,proto3" json:"RoundID,omitempty"`
-	Ips       [][]byte `protobuf:"bytes,3,rep,name=Ips,proto3" json:"Ips,omitempty"`
+	RoundID   uint64 `protobuf:"varint,1,opt,name=RoundID,proto3" json:"RoundID,omitempty"`
+	SlotIndex uint32 `protobuf:"varint,2,opt,name=SlotIndex,proto3" json:"SlotIndex,omitempty"`
+	// ID of the gateway which accepted the message
+	Gateway []byte `protobuf:"bytes,3,opt,name=Gateway,proto3" json:"Gateway,omitempty"`
+	// Hash of the message (see HashSlot)
+	MessageHash []byte `protobuf:"bytes,4,opt,name=MessageHash,proto3" json:"MessageHash,omitempty"`
+	// Unix nanoseconds
+	Timestamp int64                  `protobuf:"varint,5,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Signature *messages.RSASignature `protobuf:"bytes,6,opt,name=Signature,proto3" json:"Signature,omitempty"`
 }
 
-func (x *BatchSenders) Reset() {
-	*x = BatchSenders{}
+func (x *InclusionProof) Reset() {
+	*x = InclusionProof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[37]
+		mi := &file_mixmessages_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BatchSenders) String() string {
+func (x *InclusionProof) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchSenders) ProtoMessage() {}
+func (*InclusionProof) ProtoMessage() {}
 
-func (x *BatchSenders) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[37]
+func (x *InclusionProof) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2568,60 +3017,81 @@ func (x *BatchSenders) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchSenders.ProtoReflect.Descriptor instead.
-func (*BatchSenders) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use InclusionProof.ProtoReflect.Descriptor instead.
+func (*InclusionProof) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *BatchSenders) GetSenderIds() [][]byte {
+func (x *InclusionProof) GetRoundID() uint64 {
 	if x != nil {
-		return x.SenderIds
+		return x.RoundID
 	}
-	return nil
+	return 0
 }
 
-func (x *BatchSenders) GetRoundID() uint64 {
+func (x *InclusionProof) GetSlotIndex() uint32 {
 	if x != nil {
-		return x.RoundID
+		return x.SlotIndex
 	}
 	return 0
 }
 
-func (x *BatchSenders) GetIps() [][]byte {
+func (x *InclusionProof) GetGateway() []byte {
 	if x != nil {
-		return x.Ips
+		return x.Gateway
 	}
 	return nil
 }
 
-// Gateway -> Gateway gossip of all recipient IDs in a Batch
-type Recipients struct {
+func (x *InclusionProof) GetMessageHash() []byte {
+	if x != nil {
+		return x.MessageHash
+	}
+	return nil
+}
+
+func (x *InclusionProof) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *InclusionProof) GetSignature() *messages.RSASignature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// Client -> Gateway opaque payload to be relayed to another entity
+type RelayedMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RecipientIds [][]byte `protobuf:"bytes,1,rep,name=RecipientIds,proto3" json:"RecipientIds,omitempty"`
-	RoundID      uint64   `protobuf:"varint,2,opt,name=RoundID,proto3" json:"RoundID,omitempty"`
-	RoundTS      uint64   `protobuf:"varint,3,opt,name=RoundTS,proto3" json:"RoundTS,omitempty"`
+	// Marshalled ID of the entity the payload is addressed to
+	Destination []byte `protobuf:"bytes,1,opt,name=Destination,proto3" json:"Destination,omitempty"`
+	Payload     []byte `protobuf:"bytes,2,opt,name=Payload,proto3" json:"Payload,omitempty"`
 }
 
-func (x *Recipients) Reset() {
-	*x = Recipients{}
+func (x *RelayedMessage) Reset() {
+	*x = RelayedMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[38]
+		mi := &file_mixmessages_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Recipients) String() string {
+func (x *RelayedMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Recipients) ProtoMessage() {}
+func (*RelayedMessage) ProtoMessage() {}
 
-func (x *Recipients) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[38]
+func (x *RelayedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2632,58 +3102,53 @@ func (x *Recipients) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Recipients.ProtoReflect.Descriptor instead.
-func (*Recipients) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use RelayedMessage.ProtoReflect.Descriptor instead.
+func (*RelayedMessage) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *Recipients) GetRecipientIds() [][]byte {
+func (x *RelayedMessage) GetDestination() []byte {
 	if x != nil {
-		return x.RecipientIds
+		return x.Destination
 	}
 	return nil
 }
 
-func (x *Recipients) GetRoundID() uint64 {
-	if x != nil {
-		return x.RoundID
-	}
-	return 0
-}
-
-func (x *Recipients) GetRoundTS() uint64 {
+func (x *RelayedMessage) GetPayload() []byte {
 	if x != nil {
-		return x.RoundTS
+		return x.Payload
 	}
-	return 0
+	return nil
 }
 
-// Server -> Permissioning message for whether a node has been registered
-type RegisteredNodeConfirmation struct {
+// Gateway -> Gateway gossip of all Sender IDs in a Batch
+type BatchSenders struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsRegistered bool `protobuf:"varint,1,opt,name=IsRegistered,proto3" json:"IsRegistered,omitempty"`
+	SenderIds [][]byte `protobuf:"bytes,1,rep,name=SenderIds,proto3" json:"SenderIds,omitempty"`
+	RoundID   uint64   `protobuf:"varint,2,opt,name=RoundID,proto3" json:"RoundID,omitempty"`
+	Ips       [][]byte `protobuf:"bytes,3,rep,name=Ips,proto3" json:"Ips,omitempty"`
 }
 
-func (x *RegisteredNodeConfirmation) Reset() {
-	*x = RegisteredNodeConfirmation{}
+func (x *BatchSenders) Reset() {
+	*x = BatchSenders{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[39]
+		mi := &file_mixmessages_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RegisteredNodeConfirmation) String() string {
+func (x *BatchSenders) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisteredNodeConfirmation) ProtoMessage() {}
+func (*BatchSenders) ProtoMessage() {}
 
-func (x *RegisteredNodeConfirmation) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[39]
+func (x *BatchSenders) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2694,43 +3159,60 @@ func (x *RegisteredNodeConfirmation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisteredNodeConfirmation.ProtoReflect.Descriptor instead.
-func (*RegisteredNodeConfirmation) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use BatchSenders.ProtoReflect.Descriptor instead.
+func (*BatchSenders) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *RegisteredNodeConfirmation) GetIsRegistered() bool {
+func (x *BatchSenders) GetSenderIds() [][]byte {
 	if x != nil {
-		return x.IsRegistered
+		return x.SenderIds
 	}
-	return false
+	return nil
 }
 
-type RegisteredNodeCheck struct {
+func (x *BatchSenders) GetRoundID() uint64 {
+	if x != nil {
+		return x.RoundID
+	}
+	return 0
+}
+
+func (x *BatchSenders) GetIps() [][]byte {
+	if x != nil {
+		return x.Ips
+	}
+	return nil
+}
+
+// Gateway -> Gateway gossip of all recipient IDs in a Batch
+type Recipients struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID []byte `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	RecipientIds [][]byte `protobuf:"bytes,1,rep,name=RecipientIds,proto3" json:"RecipientIds,omitempty"`
+	RoundID      uint64   `protobuf:"varint,2,opt,name=RoundID,proto3" json:"RoundID,omitempty"`
+	RoundTS      uint64   `protobuf:"varint,3,opt,name=RoundTS,proto3" json:"RoundTS,omitempty"`
 }
 
-func (x *RegisteredNodeCheck) Reset() {
-	*x = RegisteredNodeCheck{}
+func (x *Recipients) Reset() {
+	*x = Recipients{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[40]
+		mi := &file_mixmessages_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RegisteredNodeCheck) String() string {
+func (x *Recipients) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisteredNodeCheck) ProtoMessage() {}
+func (*Recipients) ProtoMessage() {}
 
-func (x *RegisteredNodeCheck) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[40]
+func (x *Recipients) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2741,44 +3223,71 @@ func (x *RegisteredNodeCheck) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisteredNodeCheck.ProtoReflect.Descriptor instead.
-func (*RegisteredNodeCheck) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use Recipients.ProtoReflect.Descriptor instead.
+func (*Recipients) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *RegisteredNodeCheck) GetID() []byte {
+func (x *Recipients) GetRecipientIds() [][]byte {
 	if x != nil {
-		return x.ID
+		return x.RecipientIds
 	}
 	return nil
 }
 
-// The ndf Hash used to compare ndf on permissioning and client
-type NDFHash struct {
+func (x *Recipients) GetRoundID() uint64 {
+	if x != nil {
+		return x.RoundID
+	}
+	return 0
+}
+
+func (x *Recipients) GetRoundTS() uint64 {
+	if x != nil {
+		return x.RoundTS
+	}
+	return 0
+}
+
+// Server -> Permissioning structured metrics of a round. Unlike the
+// RoundMetricJSON string of RoundMetrics, its fields can be read without
+// knowing the layout used by the node. Times are in Unix nanoseconds.
+type RoundMetricsReport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hash []byte `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
-}
-
-func (x *NDFHash) Reset() {
-	*x = NDFHash{}
+	RoundID   uint64 `protobuf:"varint,1,opt,name=RoundID,proto3" json:"RoundID,omitempty"`
+	NodeID    string `protobuf:"bytes,2,opt,name=NodeID,proto3" json:"NodeID,omitempty"`
+	Index     uint32 `protobuf:"varint,3,opt,name=Index,proto3" json:"Index,omitempty"`
+	NumNodes  uint32 `protobuf:"varint,4,opt,name=NumNodes,proto3" json:"NumNodes,omitempty"`
+	BatchSize uint32 `protobuf:"varint,5,opt,name=BatchSize,proto3" json:"BatchSize,omitempty"`
+	StartTime int64  `protobuf:"varint,6,opt,name=StartTime,proto3" json:"StartTime,omitempty"`
+	EndTime   int64  `protobuf:"varint,7,opt,name=EndTime,proto3" json:"EndTime,omitempty"`
+	// Timings of each phase, ordered by start time
+	Phases    []*PhaseTiming `protobuf:"bytes,8,rep,name=Phases,proto3" json:"Phases,omitempty"`
+	Resources *ResourceUsage `protobuf:"bytes,9,opt,name=Resources,proto3" json:"Resources,omitempty"`
+	// Timings of round trip pings sent and received during the round
+	RoundTripPings []*RoundTripPingTiming `protobuf:"bytes,10,rep,name=RoundTripPings,proto3" json:"RoundTripPings,omitempty"`
+}
+
+func (x *RoundMetricsReport) Reset() {
+	*x = RoundMetricsReport{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[41]
+		mi := &file_mixmessages_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NDFHash) String() string {
+func (x *RoundMetricsReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NDFHash) ProtoMessage() {}
+func (*RoundMetricsReport) ProtoMessage() {}
 
-func (x *NDFHash) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[41]
+func (x *RoundMetricsReport) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2789,113 +3298,109 @@ func (x *NDFHash) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NDFHash.ProtoReflect.Descriptor instead.
-func (*NDFHash) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use RoundMetricsReport.ProtoReflect.Descriptor instead.
+func (*RoundMetricsReport) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *NDFHash) GetHash() []byte {
+func (x *RoundMetricsReport) GetRoundID() uint64 {
 	if x != nil {
-		return x.Hash
+		return x.RoundID
 	}
-	return nil
+	return 0
 }
 
-// The Network Definition File is defined as a
-// JSON structure in primitives/ndf. Can be provided in a
-// "complete" and "incomplete" format. An incomplete
-// NDF is provided to level 4 (ie clients) to protect the inner levels
-type NDF struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *RoundMetricsReport) GetNodeID() string {
+	if x != nil {
+		return x.NodeID
+	}
+	return ""
+}
 
-	Ndf       []byte                 `protobuf:"bytes,1,opt,name=Ndf,proto3" json:"Ndf,omitempty"`
-	Signature *messages.RSASignature `protobuf:"bytes,2,opt,name=Signature,proto3" json:"Signature,omitempty"`
+func (x *RoundMetricsReport) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
 }
 
-func (x *NDF) Reset() {
-	*x = NDF{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[42]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *RoundMetricsReport) GetNumNodes() uint32 {
+	if x != nil {
+		return x.NumNodes
 	}
+	return 0
 }
 
-func (x *NDF) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RoundMetricsReport) GetBatchSize() uint32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
 }
 
-func (*NDF) ProtoMessage() {}
+func (x *RoundMetricsReport) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
 
-func (x *NDF) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[42]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *RoundMetricsReport) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use NDF.ProtoReflect.Descriptor instead.
-func (*NDF) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{42}
+func (x *RoundMetricsReport) GetPhases() []*PhaseTiming {
+	if x != nil {
+		return x.Phases
+	}
+	return nil
 }
 
-func (x *NDF) GetNdf() []byte {
+func (x *RoundMetricsReport) GetResources() *ResourceUsage {
 	if x != nil {
-		return x.Ndf
+		return x.Resources
 	}
 	return nil
 }
 
-func (x *NDF) GetSignature() *messages.RSASignature {
+func (x *RoundMetricsReport) GetRoundTripPings() []*RoundTripPingTiming {
 	if x != nil {
-		return x.Signature
+		return x.RoundTripPings
 	}
 	return nil
 }
 
-// NodeRegistration contains information to register a node.
-// Note: this includes the desired server and gateway addresses.
-// The registration server is free to ignore these addresses and
-// derive the address from the network connection.
-type NodeRegistration struct {
+// Time a node spent in a phase of a round
+type PhaseTiming struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Salt             []byte `protobuf:"bytes,1,opt,name=Salt,proto3" json:"Salt,omitempty"`
-	ServerTlsCert    string `protobuf:"bytes,2,opt,name=ServerTlsCert,proto3" json:"ServerTlsCert,omitempty"`
-	ServerAddress    string `protobuf:"bytes,3,opt,name=ServerAddress,proto3" json:"ServerAddress,omitempty"`
-	ServerPort       uint32 `protobuf:"varint,4,opt,name=ServerPort,proto3" json:"ServerPort,omitempty"` // Note: there is no uint16
-	GatewayTlsCert   string `protobuf:"bytes,5,opt,name=GatewayTlsCert,proto3" json:"GatewayTlsCert,omitempty"`
-	GatewayAddress   string `protobuf:"bytes,6,opt,name=GatewayAddress,proto3" json:"GatewayAddress,omitempty"`
-	GatewayPort      uint32 `protobuf:"varint,7,opt,name=GatewayPort,proto3" json:"GatewayPort,omitempty"` // Note: there is no uint16
-	RegistrationCode string `protobuf:"bytes,8,opt,name=RegistrationCode,proto3" json:"RegistrationCode,omitempty"`
+	Phase string `protobuf:"bytes,1,opt,name=Phase,proto3" json:"Phase,omitempty"`
+	Start int64  `protobuf:"varint,2,opt,name=Start,proto3" json:"Start,omitempty"`
+	End   int64  `protobuf:"varint,3,opt,name=End,proto3" json:"End,omitempty"`
 }
 
-func (x *NodeRegistration) Reset() {
-	*x = NodeRegistration{}
+func (x *PhaseTiming) Reset() {
+	*x = PhaseTiming{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[43]
+		mi := &file_mixmessages_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NodeRegistration) String() string {
+func (x *PhaseTiming) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeRegistration) ProtoMessage() {}
+func (*PhaseTiming) ProtoMessage() {}
 
-func (x *NodeRegistration) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[43]
+func (x *PhaseTiming) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2906,96 +3411,137 @@ func (x *NodeRegistration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeRegistration.ProtoReflect.Descriptor instead.
-func (*NodeRegistration) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use PhaseTiming.ProtoReflect.Descriptor instead.
+func (*PhaseTiming) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *NodeRegistration) GetSalt() []byte {
+func (x *PhaseTiming) GetPhase() string {
 	if x != nil {
-		return x.Salt
+		return x.Phase
 	}
-	return nil
+	return ""
 }
 
-func (x *NodeRegistration) GetServerTlsCert() string {
+func (x *PhaseTiming) GetStart() int64 {
 	if x != nil {
-		return x.ServerTlsCert
+		return x.Start
 	}
-	return ""
+	return 0
 }
 
-func (x *NodeRegistration) GetServerAddress() string {
+func (x *PhaseTiming) GetEnd() int64 {
 	if x != nil {
-		return x.ServerAddress
+		return x.End
 	}
-	return ""
+	return 0
 }
 
-func (x *NodeRegistration) GetServerPort() uint32 {
-	if x != nil {
-		return x.ServerPort
+// Resource usage of a node during a round
+type ResourceUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MemoryAllocated uint64  `protobuf:"varint,1,opt,name=MemoryAllocated,proto3" json:"MemoryAllocated,omitempty"`
+	MemoryAvailable uint64  `protobuf:"varint,2,opt,name=MemoryAvailable,proto3" json:"MemoryAvailable,omitempty"`
+	NumThreads      uint32  `protobuf:"varint,3,opt,name=NumThreads,proto3" json:"NumThreads,omitempty"`
+	CPUPercentage   float64 `protobuf:"fixed64,4,opt,name=CPUPercentage,proto3" json:"CPUPercentage,omitempty"`
+}
+
+func (x *ResourceUsage) Reset() {
+	*x = ResourceUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *NodeRegistration) GetGatewayTlsCert() string {
+func (x *ResourceUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceUsage) ProtoMessage() {}
+
+func (x *ResourceUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceUsage.ProtoReflect.Descriptor instead.
+func (*ResourceUsage) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ResourceUsage) GetMemoryAllocated() uint64 {
 	if x != nil {
-		return x.GatewayTlsCert
+		return x.MemoryAllocated
 	}
-	return ""
+	return 0
 }
 
-func (x *NodeRegistration) GetGatewayAddress() string {
+func (x *ResourceUsage) GetMemoryAvailable() uint64 {
 	if x != nil {
-		return x.GatewayAddress
+		return x.MemoryAvailable
 	}
-	return ""
+	return 0
 }
 
-func (x *NodeRegistration) GetGatewayPort() uint32 {
+func (x *ResourceUsage) GetNumThreads() uint32 {
 	if x != nil {
-		return x.GatewayPort
+		return x.NumThreads
 	}
 	return 0
 }
 
-func (x *NodeRegistration) GetRegistrationCode() string {
+func (x *ResourceUsage) GetCPUPercentage() float64 {
 	if x != nil {
-		return x.RegistrationCode
+		return x.CPUPercentage
 	}
-	return ""
+	return 0
 }
 
-// UserRegistration message to initialize registration process
-// Client -> ClientRegistrar
-type ClientRegistration struct {
+// Times of a round trip ping, as recorded in a RoundTripTiming. Times the
+// node does not know are zero.
+type RoundTripPingTiming struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RegistrationCode            string `protobuf:"bytes,1,opt,name=RegistrationCode,proto3" json:"RegistrationCode,omitempty"`
-	ClientTransmissionRSAPubKey string `protobuf:"bytes,2,opt,name=ClientTransmissionRSAPubKey,proto3" json:"ClientTransmissionRSAPubKey,omitempty"`
-	ClientReceptionRSAPubKey    string `protobuf:"bytes,3,opt,name=ClientReceptionRSAPubKey,proto3" json:"ClientReceptionRSAPubKey,omitempty"`
+	RoundID uint64 `protobuf:"varint,1,opt,name=RoundID,proto3" json:"RoundID,omitempty"`
+	// ID of the other node
+	Peer            string `protobuf:"bytes,2,opt,name=Peer,proto3" json:"Peer,omitempty"`
+	Sent            int64  `protobuf:"varint,3,opt,name=Sent,proto3" json:"Sent,omitempty"`
+	Received        int64  `protobuf:"varint,4,opt,name=Received,proto3" json:"Received,omitempty"`
+	Returned        int64  `protobuf:"varint,5,opt,name=Returned,proto3" json:"Returned,omitempty"`
+	PayloadVerified bool   `protobuf:"varint,6,opt,name=PayloadVerified,proto3" json:"PayloadVerified,omitempty"`
 }
 
-func (x *ClientRegistration) Reset() {
-	*x = ClientRegistration{}
+func (x *RoundTripPingTiming) Reset() {
+	*x = RoundTripPingTiming{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[44]
+		mi := &file_mixmessages_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClientRegistration) String() string {
+func (x *RoundTripPingTiming) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClientRegistration) ProtoMessage() {}
+func (*RoundTripPingTiming) ProtoMessage() {}
 
-func (x *ClientRegistration) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[44]
+func (x *RoundTripPingTiming) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3006,60 +3552,79 @@ func (x *ClientRegistration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClientRegistration.ProtoReflect.Descriptor instead.
-func (*ClientRegistration) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use RoundTripPingTiming.ProtoReflect.Descriptor instead.
+func (*RoundTripPingTiming) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *ClientRegistration) GetRegistrationCode() string {
+func (x *RoundTripPingTiming) GetRoundID() uint64 {
 	if x != nil {
-		return x.RegistrationCode
+		return x.RoundID
 	}
-	return ""
+	return 0
 }
 
-func (x *ClientRegistration) GetClientTransmissionRSAPubKey() string {
+func (x *RoundTripPingTiming) GetPeer() string {
 	if x != nil {
-		return x.ClientTransmissionRSAPubKey
+		return x.Peer
 	}
 	return ""
 }
 
-func (x *ClientRegistration) GetClientReceptionRSAPubKey() string {
+func (x *RoundTripPingTiming) GetSent() int64 {
 	if x != nil {
-		return x.ClientReceptionRSAPubKey
+		return x.Sent
 	}
-	return ""
+	return 0
 }
 
-// ClientRegistrationConfirmation to confirm registration with Clients
-// ClientRegistrar -> Client (Response to ClientRegistration)
-type ClientRegistrationConfirmation struct {
+func (x *RoundTripPingTiming) GetReceived() int64 {
+	if x != nil {
+		return x.Received
+	}
+	return 0
+}
+
+func (x *RoundTripPingTiming) GetReturned() int64 {
+	if x != nil {
+		return x.Returned
+	}
+	return 0
+}
+
+func (x *RoundTripPingTiming) GetPayloadVerified() bool {
+	if x != nil {
+		return x.PayloadVerified
+	}
+	return false
+}
+
+// Server -> Permissioning message for whether a node has been registered
+type RegisteredNodeConfirmation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RSAPubKey string `protobuf:"bytes,1,opt,name=RSAPubKey,proto3" json:"RSAPubKey,omitempty"`
-	Timestamp int64  `protobuf:"varint,2,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	IsRegistered bool `protobuf:"varint,1,opt,name=IsRegistered,proto3" json:"IsRegistered,omitempty"`
 }
 
-func (x *ClientRegistrationConfirmation) Reset() {
-	*x = ClientRegistrationConfirmation{}
+func (x *RegisteredNodeConfirmation) Reset() {
+	*x = RegisteredNodeConfirmation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[45]
+		mi := &file_mixmessages_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClientRegistrationConfirmation) String() string {
+func (x *RegisteredNodeConfirmation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClientRegistrationConfirmation) ProtoMessage() {}
+func (*RegisteredNodeConfirmation) ProtoMessage() {}
 
-func (x *ClientRegistrationConfirmation) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[45]
+func (x *RegisteredNodeConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3070,51 +3635,43 @@ func (x *ClientRegistrationConfirmation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClientRegistrationConfirmation.ProtoReflect.Descriptor instead.
-func (*ClientRegistrationConfirmation) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{45}
-}
-
-func (x *ClientRegistrationConfirmation) GetRSAPubKey() string {
-	if x != nil {
-		return x.RSAPubKey
-	}
-	return ""
+// Deprecated: Use RegisteredNodeConfirmation.ProtoReflect.Descriptor instead.
+func (*RegisteredNodeConfirmation) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *ClientRegistrationConfirmation) GetTimestamp() int64 {
+func (x *RegisteredNodeConfirmation) GetIsRegistered() bool {
 	if x != nil {
-		return x.Timestamp
+		return x.IsRegistered
 	}
-	return 0
+	return false
 }
 
-type SignedRegistrationConfirmation struct {
+type RegisteredNodeCheck struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClientRegistrationConfirmation []byte                 `protobuf:"bytes,1,opt,name=ClientRegistrationConfirmation,proto3" json:"ClientRegistrationConfirmation,omitempty"`
-	RegistrarSignature             *messages.RSASignature `protobuf:"bytes,2,opt,name=RegistrarSignature,proto3" json:"RegistrarSignature,omitempty"`
+	ID []byte `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
 }
 
-func (x *SignedRegistrationConfirmation) Reset() {
-	*x = SignedRegistrationConfirmation{}
+func (x *RegisteredNodeCheck) Reset() {
+	*x = RegisteredNodeCheck{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[46]
+		mi := &file_mixmessages_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SignedRegistrationConfirmation) String() string {
+func (x *RegisteredNodeCheck) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignedRegistrationConfirmation) ProtoMessage() {}
+func (*RegisteredNodeCheck) ProtoMessage() {}
 
-func (x *SignedRegistrationConfirmation) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[46]
+func (x *RegisteredNodeCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3125,52 +3682,44 @@ func (x *SignedRegistrationConfirmation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignedRegistrationConfirmation.ProtoReflect.Descriptor instead.
-func (*SignedRegistrationConfirmation) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{46}
-}
-
-func (x *SignedRegistrationConfirmation) GetClientRegistrationConfirmation() []byte {
-	if x != nil {
-		return x.ClientRegistrationConfirmation
-	}
-	return nil
+// Deprecated: Use RegisteredNodeCheck.ProtoReflect.Descriptor instead.
+func (*RegisteredNodeCheck) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *SignedRegistrationConfirmation) GetRegistrarSignature() *messages.RSASignature {
+func (x *RegisteredNodeCheck) GetID() []byte {
 	if x != nil {
-		return x.RegistrarSignature
+		return x.ID
 	}
 	return nil
 }
 
-type SignedClientRegistrationConfirmations struct {
+// The ndf Hash used to compare ndf on permissioning and client
+type NDFHash struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClientTransmissionConfirmation *SignedRegistrationConfirmation `protobuf:"bytes,1,opt,name=ClientTransmissionConfirmation,proto3" json:"ClientTransmissionConfirmation,omitempty"`
-	ClientReceptionConfirmation    *SignedRegistrationConfirmation `protobuf:"bytes,2,opt,name=ClientReceptionConfirmation,proto3" json:"ClientReceptionConfirmation,omitempty"`
-	Error                          string                          `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+	Hash []byte `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
 }
 
-func (x *SignedClientRegistrationConfirmations) Reset() {
-	*x = SignedClientRegistrationConfirmations{}
+func (x *NDFHash) Reset() {
+	*x = NDFHash{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[47]
+		mi := &file_mixmessages_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SignedClientRegistrationConfirmations) String() string {
+func (x *NDFHash) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignedClientRegistrationConfirmations) ProtoMessage() {}
+func (*NDFHash) ProtoMessage() {}
 
-func (x *SignedClientRegistrationConfirmations) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[47]
+func (x *NDFHash) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3181,58 +3730,48 @@ func (x *SignedClientRegistrationConfirmations) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignedClientRegistrationConfirmations.ProtoReflect.Descriptor instead.
-func (*SignedClientRegistrationConfirmations) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{47}
-}
-
-func (x *SignedClientRegistrationConfirmations) GetClientTransmissionConfirmation() *SignedRegistrationConfirmation {
-	if x != nil {
-		return x.ClientTransmissionConfirmation
-	}
-	return nil
+// Deprecated: Use NDFHash.ProtoReflect.Descriptor instead.
+func (*NDFHash) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *SignedClientRegistrationConfirmations) GetClientReceptionConfirmation() *SignedRegistrationConfirmation {
+func (x *NDFHash) GetHash() []byte {
 	if x != nil {
-		return x.ClientReceptionConfirmation
+		return x.Hash
 	}
 	return nil
 }
 
-func (x *SignedClientRegistrationConfirmations) GetError() string {
-	if x != nil {
-		return x.Error
-	}
-	return ""
-}
-
-// ClientVersion contains a version string for the client
-type ClientVersion struct {
+// The Network Definition File is defined as a
+// JSON structure in primitives/ndf. Can be provided in a
+// "complete" and "incomplete" format. An incomplete
+// NDF is provided to level 4 (ie clients) to protect the inner levels
+type NDF struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Version string `protobuf:"bytes,1,opt,name=Version,proto3" json:"Version,omitempty"`
+	Ndf       []byte                 `protobuf:"bytes,1,opt,name=Ndf,proto3" json:"Ndf,omitempty"`
+	Signature *messages.RSASignature `protobuf:"bytes,2,opt,name=Signature,proto3" json:"Signature,omitempty"`
 }
 
-func (x *ClientVersion) Reset() {
-	*x = ClientVersion{}
+func (x *NDF) Reset() {
+	*x = NDF{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[48]
+		mi := &file_mixmessages_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClientVersion) String() string {
+func (x *NDF) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClientVersion) ProtoMessage() {}
+func (*NDF) ProtoMessage() {}
 
-func (x *ClientVersion) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[48]
+func (x *NDF) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3243,12 +3782,414 @@ func (x *ClientVersion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClientVersion.ProtoReflect.Descriptor instead.
-func (*ClientVersion) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use NDF.ProtoReflect.Descriptor instead.
+func (*NDF) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *ClientVersion) GetVersion() string {
+func (x *NDF) GetNdf() []byte {
+	if x != nil {
+		return x.Ndf
+	}
+	return nil
+}
+
+func (x *NDF) GetSignature() *messages.RSASignature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// NodeRegistration contains information to register a node.
+// Note: this includes the desired server and gateway addresses.
+// The registration server is free to ignore these addresses and
+// derive the address from the network connection.
+type NodeRegistration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Salt             []byte `protobuf:"bytes,1,opt,name=Salt,proto3" json:"Salt,omitempty"`
+	ServerTlsCert    string `protobuf:"bytes,2,opt,name=ServerTlsCert,proto3" json:"ServerTlsCert,omitempty"`
+	ServerAddress    string `protobuf:"bytes,3,opt,name=ServerAddress,proto3" json:"ServerAddress,omitempty"`
+	ServerPort       uint32 `protobuf:"varint,4,opt,name=ServerPort,proto3" json:"ServerPort,omitempty"` // Note: there is no uint16
+	GatewayTlsCert   string `protobuf:"bytes,5,opt,name=GatewayTlsCert,proto3" json:"GatewayTlsCert,omitempty"`
+	GatewayAddress   string `protobuf:"bytes,6,opt,name=GatewayAddress,proto3" json:"GatewayAddress,omitempty"`
+	GatewayPort      uint32 `protobuf:"varint,7,opt,name=GatewayPort,proto3" json:"GatewayPort,omitempty"` // Note: there is no uint16
+	RegistrationCode string `protobuf:"bytes,8,opt,name=RegistrationCode,proto3" json:"RegistrationCode,omitempty"`
+}
+
+func (x *NodeRegistration) Reset() {
+	*x = NodeRegistration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeRegistration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeRegistration) ProtoMessage() {}
+
+func (x *NodeRegistration) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeRegistration.ProtoReflect.Descriptor instead.
+func (*NodeRegistration) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *NodeRegistration) GetSalt() []byte {
+	if x != nil {
+		return x.Salt
+	}
+	return nil
+}
+
+func (x *NodeRegistration) GetServerTlsCert() string {
+	if x != nil {
+		return x.ServerTlsCert
+	}
+	return ""
+}
+
+func (x *NodeRegistration) GetServerAddress() string {
+	if x != nil {
+		return x.ServerAddress
+	}
+	return ""
+}
+
+func (x *NodeRegistration) GetServerPort() uint32 {
+	if x != nil {
+		return x.ServerPort
+	}
+	return 0
+}
+
+func (x *NodeRegistration) GetGatewayTlsCert() string {
+	if x != nil {
+		return x.GatewayTlsCert
+	}
+	return ""
+}
+
+func (x *NodeRegistration) GetGatewayAddress() string {
+	if x != nil {
+		return x.GatewayAddress
+	}
+	return ""
+}
+
+func (x *NodeRegistration) GetGatewayPort() uint32 {
+	if x != nil {
+		return x.GatewayPort
+	}
+	return 0
+}
+
+func (x *NodeRegistration) GetRegistrationCode() string {
+	if x != nil {
+		return x.RegistrationCode
+	}
+	return ""
+}
+
+// UserRegistration message to initialize registration process
+// Client -> ClientRegistrar
+type ClientRegistration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RegistrationCode            string `protobuf:"bytes,1,opt,name=RegistrationCode,proto3" json:"RegistrationCode,omitempty"`
+	ClientTransmissionRSAPubKey string `protobuf:"bytes,2,opt,name=ClientTransmissionRSAPubKey,proto3" json:"ClientTransmissionRSAPubKey,omitempty"`
+	ClientReceptionRSAPubKey    string `protobuf:"bytes,3,opt,name=ClientReceptionRSAPubKey,proto3" json:"ClientReceptionRSAPubKey,omitempty"`
+}
+
+func (x *ClientRegistration) Reset() {
+	*x = ClientRegistration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientRegistration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientRegistration) ProtoMessage() {}
+
+func (x *ClientRegistration) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientRegistration.ProtoReflect.Descriptor instead.
+func (*ClientRegistration) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ClientRegistration) GetRegistrationCode() string {
+	if x != nil {
+		return x.RegistrationCode
+	}
+	return ""
+}
+
+func (x *ClientRegistration) GetClientTransmissionRSAPubKey() string {
+	if x != nil {
+		return x.ClientTransmissionRSAPubKey
+	}
+	return ""
+}
+
+func (x *ClientRegistration) GetClientReceptionRSAPubKey() string {
+	if x != nil {
+		return x.ClientReceptionRSAPubKey
+	}
+	return ""
+}
+
+// ClientRegistrationConfirmation to confirm registration with Clients
+// ClientRegistrar -> Client (Response to ClientRegistration)
+type ClientRegistrationConfirmation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RSAPubKey string `protobuf:"bytes,1,opt,name=RSAPubKey,proto3" json:"RSAPubKey,omitempty"`
+	Timestamp int64  `protobuf:"varint,2,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+}
+
+func (x *ClientRegistrationConfirmation) Reset() {
+	*x = ClientRegistrationConfirmation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientRegistrationConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientRegistrationConfirmation) ProtoMessage() {}
+
+func (x *ClientRegistrationConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientRegistrationConfirmation.ProtoReflect.Descriptor instead.
+func (*ClientRegistrationConfirmation) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ClientRegistrationConfirmation) GetRSAPubKey() string {
+	if x != nil {
+		return x.RSAPubKey
+	}
+	return ""
+}
+
+func (x *ClientRegistrationConfirmation) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type SignedRegistrationConfirmation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientRegistrationConfirmation []byte                 `protobuf:"bytes,1,opt,name=ClientRegistrationConfirmation,proto3" json:"ClientRegistrationConfirmation,omitempty"`
+	RegistrarSignature             *messages.RSASignature `protobuf:"bytes,2,opt,name=RegistrarSignature,proto3" json:"RegistrarSignature,omitempty"`
+}
+
+func (x *SignedRegistrationConfirmation) Reset() {
+	*x = SignedRegistrationConfirmation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignedRegistrationConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedRegistrationConfirmation) ProtoMessage() {}
+
+func (x *SignedRegistrationConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignedRegistrationConfirmation.ProtoReflect.Descriptor instead.
+func (*SignedRegistrationConfirmation) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SignedRegistrationConfirmation) GetClientRegistrationConfirmation() []byte {
+	if x != nil {
+		return x.ClientRegistrationConfirmation
+	}
+	return nil
+}
+
+func (x *SignedRegistrationConfirmation) GetRegistrarSignature() *messages.RSASignature {
+	if x != nil {
+		return x.RegistrarSignature
+	}
+	return nil
+}
+
+type SignedClientRegistrationConfirmations struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientTransmissionConfirmation *SignedRegistrationConfirmation `protobuf:"bytes,1,opt,name=ClientTransmissionConfirmation,proto3" json:"ClientTransmissionConfirmation,omitempty"`
+	ClientReceptionConfirmation    *SignedRegistrationConfirmation `protobuf:"bytes,2,opt,name=ClientReceptionConfirmation,proto3" json:"ClientReceptionConfirmation,omitempty"`
+	Error                          string                          `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *SignedClientRegistrationConfirmations) Reset() {
+	*x = SignedClientRegistrationConfirmations{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignedClientRegistrationConfirmations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedClientRegistrationConfirmations) ProtoMessage() {}
+
+func (x *SignedClientRegistrationConfirmations) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignedClientRegistrationConfirmations.ProtoReflect.Descriptor instead.
+func (*SignedClientRegistrationConfirmations) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SignedClientRegistrationConfirmations) GetClientTransmissionConfirmation() *SignedRegistrationConfirmation {
+	if x != nil {
+		return x.ClientTransmissionConfirmation
+	}
+	return nil
+}
+
+func (x *SignedClientRegistrationConfirmations) GetClientReceptionConfirmation() *SignedRegistrationConfirmation {
+	if x != nil {
+		return x.ClientReceptionConfirmation
+	}
+	return nil
+}
+
+func (x *SignedClientRegistrationConfirmations) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ClientVersion contains a version string for the client
+type ClientVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version string `protobuf:"bytes,1,opt,name=Version,proto3" json:"Version,omitempty"`
+}
+
+func (x *ClientVersion) Reset() {
+	*x = ClientVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientVersion) ProtoMessage() {}
+
+func (x *ClientVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientVersion.ProtoReflect.Descriptor instead.
+func (*ClientVersion) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ClientVersion) GetVersion() string {
 	if x != nil {
 		return x.Version
 	}
@@ -3277,7 +4218,7 @@ type PermissioningPoll struct {
 func (x *PermissioningPoll) Reset() {
 	*x = PermissioningPoll{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[49]
+		mi := &file_mixmessages_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3290,7 +4231,7 @@ func (x *PermissioningPoll) String() string {
 func (*PermissioningPoll) ProtoMessage() {}
 
 func (x *PermissioningPoll) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[49]
+	mi := &file_mixmessages_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3303,7 +4244,7 @@ func (x *PermissioningPoll) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PermissioningPoll.ProtoReflect.Descriptor instead.
 func (*PermissioningPoll) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{49}
+	return file_mixmessages_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *PermissioningPoll) GetFull() *NDFHash {
@@ -3398,7 +4339,7 @@ type ClientError struct {
 func (x *ClientError) Reset() {
 	*x = ClientError{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[50]
+		mi := &file_mixmessages_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3411,7 +4352,7 @@ func (x *ClientError) String() string {
 func (*ClientError) ProtoMessage() {}
 
 func (x *ClientError) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[50]
+	mi := &file_mixmessages_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3424,7 +4365,7 @@ func (x *ClientError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClientError.ProtoReflect.Descriptor instead.
 func (*ClientError) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{50}
+	return file_mixmessages_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *ClientError) GetClientId() []byte {
@@ -3466,7 +4407,7 @@ type PermissionPollResponse struct {
 func (x *PermissionPollResponse) Reset() {
 	*x = PermissionPollResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[51]
+		mi := &file_mixmessages_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3479,7 +4420,7 @@ func (x *PermissionPollResponse) String() string {
 func (*PermissionPollResponse) ProtoMessage() {}
 
 func (x *PermissionPollResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[51]
+	mi := &file_mixmessages_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3492,7 +4433,7 @@ func (x *PermissionPollResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PermissionPollResponse.ProtoReflect.Descriptor instead.
 func (*PermissionPollResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{51}
+	return file_mixmessages_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *PermissionPollResponse) GetFullNDF() *NDF {
@@ -3562,7 +4503,7 @@ type RegisterTokenRequest struct {
 func (x *RegisterTokenRequest) Reset() {
 	*x = RegisterTokenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[52]
+		mi := &file_mixmessages_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3575,7 +4516,7 @@ func (x *RegisterTokenRequest) String() string {
 func (*RegisterTokenRequest) ProtoMessage() {}
 
 func (x *RegisterTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[52]
+	mi := &file_mixmessages_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3588,7 +4529,7 @@ func (x *RegisterTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterTokenRequest.ProtoReflect.Descriptor instead.
 func (*RegisterTokenRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{52}
+	return file_mixmessages_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *RegisterTokenRequest) GetApp() string {
@@ -3662,7 +4603,7 @@ type UnregisterTokenRequest struct {
 func (x *UnregisterTokenRequest) Reset() {
 	*x = UnregisterTokenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[53]
+		mi := &file_mixmessages_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3675,7 +4616,7 @@ func (x *UnregisterTokenRequest) String() string {
 func (*UnregisterTokenRequest) ProtoMessage() {}
 
 func (x *UnregisterTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[53]
+	mi := &file_mixmessages_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3688,7 +4629,7 @@ func (x *UnregisterTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnregisterTokenRequest.ProtoReflect.Descriptor instead.
 func (*UnregisterTokenRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{53}
+	return file_mixmessages_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *UnregisterTokenRequest) GetApp() string {
@@ -3737,7 +4678,7 @@ type UnregisterTrackedIdRequest struct {
 func (x *UnregisterTrackedIdRequest) Reset() {
 	*x = UnregisterTrackedIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[54]
+		mi := &file_mixmessages_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3750,7 +4691,7 @@ func (x *UnregisterTrackedIdRequest) String() string {
 func (*UnregisterTrackedIdRequest) ProtoMessage() {}
 
 func (x *UnregisterTrackedIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[54]
+	mi := &file_mixmessages_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3763,7 +4704,7 @@ func (x *UnregisterTrackedIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnregisterTrackedIdRequest.ProtoReflect.Descriptor instead.
 func (*UnregisterTrackedIdRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{54}
+	return file_mixmessages_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *UnregisterTrackedIdRequest) GetRequest() *TrackedIntermediaryIdRequest {
@@ -3786,7 +4727,7 @@ type RegisterTrackedIdRequest struct {
 func (x *RegisterTrackedIdRequest) Reset() {
 	*x = RegisterTrackedIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[55]
+		mi := &file_mixmessages_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3799,7 +4740,7 @@ func (x *RegisterTrackedIdRequest) String() string {
 func (*RegisterTrackedIdRequest) ProtoMessage() {}
 
 func (x *RegisterTrackedIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[55]
+	mi := &file_mixmessages_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3812,7 +4753,7 @@ func (x *RegisterTrackedIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterTrackedIdRequest.ProtoReflect.Descriptor instead.
 func (*RegisterTrackedIdRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{55}
+	return file_mixmessages_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *RegisterTrackedIdRequest) GetRequest() *TrackedIntermediaryIdRequest {
@@ -3850,7 +4791,7 @@ type TrackedIntermediaryIdRequest struct {
 func (x *TrackedIntermediaryIdRequest) Reset() {
 	*x = TrackedIntermediaryIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[56]
+		mi := &file_mixmessages_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3863,7 +4804,7 @@ func (x *TrackedIntermediaryIdRequest) String() string {
 func (*TrackedIntermediaryIdRequest) ProtoMessage() {}
 
 func (x *TrackedIntermediaryIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[56]
+	mi := &file_mixmessages_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3876,7 +4817,7 @@ func (x *TrackedIntermediaryIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TrackedIntermediaryIdRequest.ProtoReflect.Descriptor instead.
 func (*TrackedIntermediaryIdRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{56}
+	return file_mixmessages_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *TrackedIntermediaryIdRequest) GetTrackedIntermediaryID() [][]byte {
@@ -3926,7 +4867,7 @@ type NotificationRegisterRequest struct {
 func (x *NotificationRegisterRequest) Reset() {
 	*x = NotificationRegisterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[57]
+		mi := &file_mixmessages_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3939,7 +4880,7 @@ func (x *NotificationRegisterRequest) String() string {
 func (*NotificationRegisterRequest) ProtoMessage() {}
 
 func (x *NotificationRegisterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[57]
+	mi := &file_mixmessages_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3952,7 +4893,7 @@ func (x *NotificationRegisterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotificationRegisterRequest.ProtoReflect.Descriptor instead.
 func (*NotificationRegisterRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{57}
+	return file_mixmessages_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *NotificationRegisterRequest) GetToken() string {
@@ -4016,7 +4957,7 @@ type NotificationUnregisterRequest struct {
 func (x *NotificationUnregisterRequest) Reset() {
 	*x = NotificationUnregisterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[58]
+		mi := &file_mixmessages_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4029,7 +4970,7 @@ func (x *NotificationUnregisterRequest) String() string {
 func (*NotificationUnregisterRequest) ProtoMessage() {}
 
 func (x *NotificationUnregisterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[58]
+	mi := &file_mixmessages_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4042,7 +4983,7 @@ func (x *NotificationUnregisterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotificationUnregisterRequest.ProtoReflect.Descriptor instead.
 func (*NotificationUnregisterRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{58}
+	return file_mixmessages_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *NotificationUnregisterRequest) GetIntermediaryId() []byte {
@@ -4071,7 +5012,7 @@ type UserIdList struct {
 func (x *UserIdList) Reset() {
 	*x = UserIdList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[59]
+		mi := &file_mixmessages_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4084,7 +5025,7 @@ func (x *UserIdList) String() string {
 func (*UserIdList) ProtoMessage() {}
 
 func (x *UserIdList) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[59]
+	mi := &file_mixmessages_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4097,7 +5038,7 @@ func (x *UserIdList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserIdList.ProtoReflect.Descriptor instead.
 func (*UserIdList) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{59}
+	return file_mixmessages_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *UserIdList) GetIDs() [][]byte {
@@ -4120,7 +5061,7 @@ type NotificationBatch struct {
 func (x *NotificationBatch) Reset() {
 	*x = NotificationBatch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[60]
+		mi := &file_mixmessages_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4133,7 +5074,7 @@ func (x *NotificationBatch) String() string {
 func (*NotificationBatch) ProtoMessage() {}
 
 func (x *NotificationBatch) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[60]
+	mi := &file_mixmessages_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4146,7 +5087,7 @@ func (x *NotificationBatch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotificationBatch.ProtoReflect.Descriptor instead.
 func (*NotificationBatch) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{60}
+	return file_mixmessages_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *NotificationBatch) GetRoundID() uint64 {
@@ -4177,7 +5118,7 @@ type NotificationData struct {
 func (x *NotificationData) Reset() {
 	*x = NotificationData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[61]
+		mi := &file_mixmessages_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4190,7 +5131,7 @@ func (x *NotificationData) String() string {
 func (*NotificationData) ProtoMessage() {}
 
 func (x *NotificationData) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[61]
+	mi := &file_mixmessages_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4203,7 +5144,7 @@ func (x *NotificationData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotificationData.ProtoReflect.Descriptor instead.
 func (*NotificationData) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{61}
+	return file_mixmessages_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *NotificationData) GetEphemeralID() int64 {
@@ -4242,7 +5183,7 @@ type ChannelLeaseRequest struct {
 func (x *ChannelLeaseRequest) Reset() {
 	*x = ChannelLeaseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[62]
+		mi := &file_mixmessages_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4255,7 +5196,7 @@ func (x *ChannelLeaseRequest) String() string {
 func (*ChannelLeaseRequest) ProtoMessage() {}
 
 func (x *ChannelLeaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[62]
+	mi := &file_mixmessages_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4268,7 +5209,7 @@ func (x *ChannelLeaseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChannelLeaseRequest.ProtoReflect.Descriptor instead.
 func (*ChannelLeaseRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{62}
+	return file_mixmessages_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *ChannelLeaseRequest) GetUserID() []byte {
@@ -4313,7 +5254,7 @@ type ChannelLeaseResponse struct {
 func (x *ChannelLeaseResponse) Reset() {
 	*x = ChannelLeaseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[63]
+		mi := &file_mixmessages_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4326,7 +5267,7 @@ func (x *ChannelLeaseResponse) String() string {
 func (*ChannelLeaseResponse) ProtoMessage() {}
 
 func (x *ChannelLeaseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[63]
+	mi := &file_mixmessages_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4339,7 +5280,7 @@ func (x *ChannelLeaseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChannelLeaseResponse.ProtoReflect.Descriptor instead.
 func (*ChannelLeaseResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{63}
+	return file_mixmessages_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *ChannelLeaseResponse) GetLease() int64 {
@@ -4376,7 +5317,7 @@ type UsernameValidationRequest struct {
 func (x *UsernameValidationRequest) Reset() {
 	*x = UsernameValidationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[64]
+		mi := &file_mixmessages_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4389,7 +5330,7 @@ func (x *UsernameValidationRequest) String() string {
 func (*UsernameValidationRequest) ProtoMessage() {}
 
 func (x *UsernameValidationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[64]
+	mi := &file_mixmessages_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4402,7 +5343,7 @@ func (x *UsernameValidationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UsernameValidationRequest.ProtoReflect.Descriptor instead.
 func (*UsernameValidationRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{64}
+	return file_mixmessages_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *UsernameValidationRequest) GetUserId() []byte {
@@ -4427,7 +5368,7 @@ type UsernameValidation struct {
 func (x *UsernameValidation) Reset() {
 	*x = UsernameValidation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[65]
+		mi := &file_mixmessages_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4440,7 +5381,7 @@ func (x *UsernameValidation) String() string {
 func (*UsernameValidation) ProtoMessage() {}
 
 func (x *UsernameValidation) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[65]
+	mi := &file_mixmessages_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4453,7 +5394,7 @@ func (x *UsernameValidation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UsernameValidation.ProtoReflect.Descriptor instead.
 func (*UsernameValidation) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{65}
+	return file_mixmessages_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *UsernameValidation) GetSignature() []byte {
@@ -4495,7 +5436,7 @@ type UDBUserRegistration struct {
 func (x *UDBUserRegistration) Reset() {
 	*x = UDBUserRegistration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[66]
+		mi := &file_mixmessages_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4508,7 +5449,7 @@ func (x *UDBUserRegistration) String() string {
 func (*UDBUserRegistration) ProtoMessage() {}
 
 func (x *UDBUserRegistration) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[66]
+	mi := &file_mixmessages_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4521,7 +5462,7 @@ func (x *UDBUserRegistration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UDBUserRegistration.ProtoReflect.Descriptor instead.
 func (*UDBUserRegistration) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{66}
+	return file_mixmessages_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *UDBUserRegistration) GetPermissioningSignature() []byte {
@@ -4587,7 +5528,7 @@ type Identity struct {
 func (x *Identity) Reset() {
 	*x = Identity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[67]
+		mi := &file_mixmessages_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4600,7 +5541,7 @@ func (x *Identity) String() string {
 func (*Identity) ProtoMessage() {}
 
 func (x *Identity) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[67]
+	mi := &file_mixmessages_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4613,7 +5554,7 @@ func (x *Identity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Identity.ProtoReflect.Descriptor instead.
 func (*Identity) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{67}
+	return file_mixmessages_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *Identity) GetUsername() string {
@@ -4651,7 +5592,7 @@ type FactRegisterRequest struct {
 func (x *FactRegisterRequest) Reset() {
 	*x = FactRegisterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[68]
+		mi := &file_mixmessages_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4664,7 +5605,7 @@ func (x *FactRegisterRequest) String() string {
 func (*FactRegisterRequest) ProtoMessage() {}
 
 func (x *FactRegisterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[68]
+	mi := &file_mixmessages_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4677,7 +5618,7 @@ func (x *FactRegisterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FactRegisterRequest.ProtoReflect.Descriptor instead.
 func (*FactRegisterRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{68}
+	return file_mixmessages_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *FactRegisterRequest) GetUID() []byte {
@@ -4714,7 +5655,7 @@ type Fact struct {
 func (x *Fact) Reset() {
 	*x = Fact{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[69]
+		mi := &file_mixmessages_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4727,7 +5668,7 @@ func (x *Fact) String() string {
 func (*Fact) ProtoMessage() {}
 
 func (x *Fact) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[69]
+	mi := &file_mixmessages_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4740,7 +5681,7 @@ func (x *Fact) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Fact.ProtoReflect.Descriptor instead.
 func (*Fact) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{69}
+	return file_mixmessages_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *Fact) GetFact() string {
@@ -4769,7 +5710,7 @@ type FactRegisterResponse struct {
 func (x *FactRegisterResponse) Reset() {
 	*x = FactRegisterResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[70]
+		mi := &file_mixmessages_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4782,7 +5723,7 @@ func (x *FactRegisterResponse) String() string {
 func (*FactRegisterResponse) ProtoMessage() {}
 
 func (x *FactRegisterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[70]
+	mi := &file_mixmessages_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4795,7 +5736,7 @@ func (x *FactRegisterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FactRegisterResponse.ProtoReflect.Descriptor instead.
 func (*FactRegisterResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{70}
+	return file_mixmessages_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *FactRegisterResponse) GetConfirmationID() string {
@@ -4818,7 +5759,7 @@ type FactConfirmRequest struct {
 func (x *FactConfirmRequest) Reset() {
 	*x = FactConfirmRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[71]
+		mi := &file_mixmessages_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4831,7 +5772,7 @@ func (x *FactConfirmRequest) String() string {
 func (*FactConfirmRequest) ProtoMessage() {}
 
 func (x *FactConfirmRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[71]
+	mi := &file_mixmessages_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4844,7 +5785,7 @@ func (x *FactConfirmRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FactConfirmRequest.ProtoReflect.Descriptor instead.
 func (*FactConfirmRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{71}
+	return file_mixmessages_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *FactConfirmRequest) GetConfirmationID() string {
@@ -4875,7 +5816,7 @@ type FactRemovalRequest struct {
 func (x *FactRemovalRequest) Reset() {
 	*x = FactRemovalRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[72]
+		mi := &file_mixmessages_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4888,7 +5829,7 @@ func (x *FactRemovalRequest) String() string {
 func (*FactRemovalRequest) ProtoMessage() {}
 
 func (x *FactRemovalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[72]
+	mi := &file_mixmessages_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4901,7 +5842,7 @@ func (x *FactRemovalRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FactRemovalRequest.ProtoReflect.Descriptor instead.
 func (*FactRemovalRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{72}
+	return file_mixmessages_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *FactRemovalRequest) GetUID() []byte {
@@ -4937,7 +5878,7 @@ type StrAddress struct {
 func (x *StrAddress) Reset() {
 	*x = StrAddress{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[73]
+		mi := &file_mixmessages_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4950,7 +5891,7 @@ func (x *StrAddress) String() string {
 func (*StrAddress) ProtoMessage() {}
 
 func (x *StrAddress) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[73]
+	mi := &file_mixmessages_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4963,7 +5904,7 @@ func (x *StrAddress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StrAddress.ProtoReflect.Descriptor instead.
 func (*StrAddress) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{73}
+	return file_mixmessages_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *StrAddress) GetAddress() string {
@@ -4996,7 +5937,7 @@ type RoundInfo struct {
 func (x *RoundInfo) Reset() {
 	*x = RoundInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[74]
+		mi := &file_mixmessages_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5009,7 +5950,7 @@ func (x *RoundInfo) String() string {
 func (*RoundInfo) ProtoMessage() {}
 
 func (x *RoundInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[74]
+	mi := &file_mixmessages_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5022,7 +5963,7 @@ func (x *RoundInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoundInfo.ProtoReflect.Descriptor instead.
 func (*RoundInfo) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{74}
+	return file_mixmessages_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *RoundInfo) GetID() uint64 {
@@ -5125,7 +6066,7 @@ type RoundError struct {
 func (x *RoundError) Reset() {
 	*x = RoundError{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[75]
+		mi := &file_mixmessages_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5138,7 +6079,7 @@ func (x *RoundError) String() string {
 func (*RoundError) ProtoMessage() {}
 
 func (x *RoundError) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[75]
+	mi := &file_mixmessages_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5151,7 +6092,7 @@ func (x *RoundError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoundError.ProtoReflect.Descriptor instead.
 func (*RoundError) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{75}
+	return file_mixmessages_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *RoundError) GetId() uint64 {
@@ -5191,7 +6132,7 @@ type EABCredentialRequest struct {
 func (x *EABCredentialRequest) Reset() {
 	*x = EABCredentialRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[76]
+		mi := &file_mixmessages_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5204,7 +6145,7 @@ func (x *EABCredentialRequest) String() string {
 func (*EABCredentialRequest) ProtoMessage() {}
 
 func (x *EABCredentialRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[76]
+	mi := &file_mixmessages_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5217,7 +6158,7 @@ func (x *EABCredentialRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EABCredentialRequest.ProtoReflect.Descriptor instead.
 func (*EABCredentialRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{76}
+	return file_mixmessages_proto_rawDescGZIP(), []int{87}
 }
 
 type EABCredentialResponse struct {
@@ -5232,7 +6173,7 @@ type EABCredentialResponse struct {
 func (x *EABCredentialResponse) Reset() {
 	*x = EABCredentialResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[77]
+		mi := &file_mixmessages_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5245,7 +6186,7 @@ func (x *EABCredentialResponse) String() string {
 func (*EABCredentialResponse) ProtoMessage() {}
 
 func (x *EABCredentialResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[77]
+	mi := &file_mixmessages_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5258,7 +6199,7 @@ func (x *EABCredentialResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EABCredentialResponse.ProtoReflect.Descriptor instead.
 func (*EABCredentialResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{77}
+	return file_mixmessages_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *EABCredentialResponse) GetKeyId() string {
@@ -5289,7 +6230,7 @@ type AuthorizerCertRequest struct {
 func (x *AuthorizerCertRequest) Reset() {
 	*x = AuthorizerCertRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[78]
+		mi := &file_mixmessages_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5302,7 +6243,7 @@ func (x *AuthorizerCertRequest) String() string {
 func (*AuthorizerCertRequest) ProtoMessage() {}
 
 func (x *AuthorizerCertRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[78]
+	mi := &file_mixmessages_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5315,7 +6256,7 @@ func (x *AuthorizerCertRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthorizerCertRequest.ProtoReflect.Descriptor instead.
 func (*AuthorizerCertRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{78}
+	return file_mixmessages_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *AuthorizerCertRequest) GetGwID() []byte {
@@ -5361,7 +6302,7 @@ type AuthorizerAuth struct {
 func (x *AuthorizerAuth) Reset() {
 	*x = AuthorizerAuth{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[79]
+		mi := &file_mixmessages_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5374,7 +6315,7 @@ func (x *AuthorizerAuth) String() string {
 func (*AuthorizerAuth) ProtoMessage() {}
 
 func (x *AuthorizerAuth) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[79]
+	mi := &file_mixmessages_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5387,7 +6328,7 @@ func (x *AuthorizerAuth) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthorizerAuth.ProtoReflect.Descriptor instead.
 func (*AuthorizerAuth) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{79}
+	return file_mixmessages_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *AuthorizerAuth) GetNodeID() []byte {
@@ -5438,7 +6379,7 @@ type RsAuthenticationRequest struct {
 func (x *RsAuthenticationRequest) Reset() {
 	*x = RsAuthenticationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[80]
+		mi := &file_mixmessages_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5451,7 +6392,7 @@ func (x *RsAuthenticationRequest) String() string {
 func (*RsAuthenticationRequest) ProtoMessage() {}
 
 func (x *RsAuthenticationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[80]
+	mi := &file_mixmessages_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5464,7 +6405,7 @@ func (x *RsAuthenticationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RsAuthenticationRequest.ProtoReflect.Descriptor instead.
 func (*RsAuthenticationRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{80}
+	return file_mixmessages_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *RsAuthenticationRequest) GetUsername() string {
@@ -5500,7 +6441,7 @@ type RsAuthenticationResponse struct {
 func (x *RsAuthenticationResponse) Reset() {
 	*x = RsAuthenticationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[81]
+		mi := &file_mixmessages_proto_msgTypes[92]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5513,7 +6454,7 @@ func (x *RsAuthenticationResponse) String() string {
 func (*RsAuthenticationResponse) ProtoMessage() {}
 
 func (x *RsAuthenticationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[81]
+	mi := &file_mixmessages_proto_msgTypes[92]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5526,7 +6467,7 @@ func (x *RsAuthenticationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RsAuthenticationResponse.ProtoReflect.Descriptor instead.
 func (*RsAuthenticationResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{81}
+	return file_mixmessages_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *RsAuthenticationResponse) GetToken() []byte {
@@ -5555,7 +6496,7 @@ type RsReadRequest struct {
 func (x *RsReadRequest) Reset() {
 	*x = RsReadRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[82]
+		mi := &file_mixmessages_proto_msgTypes[93]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5568,7 +6509,7 @@ func (x *RsReadRequest) String() string {
 func (*RsReadRequest) ProtoMessage() {}
 
 func (x *RsReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[82]
+	mi := &file_mixmessages_proto_msgTypes[93]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5581,7 +6522,7 @@ func (x *RsReadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RsReadRequest.ProtoReflect.Descriptor instead.
 func (*RsReadRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{82}
+	return file_mixmessages_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *RsReadRequest) GetPath() string {
@@ -5609,7 +6550,7 @@ type RsLastWriteRequest struct {
 func (x *RsLastWriteRequest) Reset() {
 	*x = RsLastWriteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[83]
+		mi := &file_mixmessages_proto_msgTypes[94]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5622,7 +6563,7 @@ func (x *RsLastWriteRequest) String() string {
 func (*RsLastWriteRequest) ProtoMessage() {}
 
 func (x *RsLastWriteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[83]
+	mi := &file_mixmessages_proto_msgTypes[94]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5635,7 +6576,7 @@ func (x *RsLastWriteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RsLastWriteRequest.ProtoReflect.Descriptor instead.
 func (*RsLastWriteRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{83}
+	return file_mixmessages_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *RsLastWriteRequest) GetToken() []byte {
@@ -5656,7 +6597,7 @@ type RsReadResponse struct {
 func (x *RsReadResponse) Reset() {
 	*x = RsReadResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[84]
+		mi := &file_mixmessages_proto_msgTypes[95]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5669,7 +6610,7 @@ func (x *RsReadResponse) String() string {
 func (*RsReadResponse) ProtoMessage() {}
 
 func (x *RsReadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[84]
+	mi := &file_mixmessages_proto_msgTypes[95]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5682,7 +6623,7 @@ func (x *RsReadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RsReadResponse.ProtoReflect.Descriptor instead.
 func (*RsReadResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{84}
+	return file_mixmessages_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *RsReadResponse) GetData() []byte {
@@ -5705,7 +6646,7 @@ type RsWriteRequest struct {
 func (x *RsWriteRequest) Reset() {
 	*x = RsWriteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[85]
+		mi := &file_mixmessages_proto_msgTypes[96]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5718,7 +6659,7 @@ func (x *RsWriteRequest) String() string {
 func (*RsWriteRequest) ProtoMessage() {}
 
 func (x *RsWriteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[85]
+	mi := &file_mixmessages_proto_msgTypes[96]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5731,7 +6672,7 @@ func (x *RsWriteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RsWriteRequest.ProtoReflect.Descriptor instead.
 func (*RsWriteRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{85}
+	return file_mixmessages_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *RsWriteRequest) GetPath() string {
@@ -5766,7 +6707,7 @@ type RsReadDirResponse struct {
 func (x *RsReadDirResponse) Reset() {
 	*x = RsReadDirResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[86]
+		mi := &file_mixmessages_proto_msgTypes[97]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5779,7 +6720,7 @@ func (x *RsReadDirResponse) String() string {
 func (*RsReadDirResponse) ProtoMessage() {}
 
 func (x *RsReadDirResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[86]
+	mi := &file_mixmessages_proto_msgTypes[97]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5792,7 +6733,7 @@ func (x *RsReadDirResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RsReadDirResponse.ProtoReflect.Descriptor instead.
 func (*RsReadDirResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{86}
+	return file_mixmessages_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *RsReadDirResponse) GetData() []string {
@@ -5813,7 +6754,7 @@ type RsTimestampResponse struct {
 func (x *RsTimestampResponse) Reset() {
 	*x = RsTimestampResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[87]
+		mi := &file_mixmessages_proto_msgTypes[98]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5826,7 +6767,161 @@ func (x *RsTimestampResponse) String() string {
 func (*RsTimestampResponse) ProtoMessage() {}
 
 func (x *RsTimestampResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[87]
+	mi := &file_mixmessages_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsTimestampResponse.ProtoReflect.Descriptor instead.
+func (*RsTimestampResponse) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *RsTimestampResponse) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// Version and build of a comms server. Version shares its field number with
+// ClientVersion, so callers decoding a ClientVersion read the version string.
+type ServerBuildInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Version of the server application, as set by the application
+	Version string `protobuf:"bytes,1,opt,name=Version,proto3" json:"Version,omitempty"`
+	// Module version and VCS revision of the comms library
+	CommsVersion string `protobuf:"bytes,2,opt,name=CommsVersion,proto3" json:"CommsVersion,omitempty"`
+	GitCommit    string `protobuf:"bytes,3,opt,name=GitCommit,proto3" json:"GitCommit,omitempty"`
+	GoVersion    string `protobuf:"bytes,4,opt,name=GoVersion,proto3" json:"GoVersion,omitempty"`
+	// Optional protocol features supported by the server
+	Features []string `protobuf:"bytes,5,rep,name=Features,proto3" json:"Features,omitempty"`
+	// Unix nanoseconds the server started at
+	StartTime int64 `protobuf:"varint,6,opt,name=StartTime,proto3" json:"StartTime,omitempty"`
+	// Unix nanoseconds of the VCS revision the server was built from
+	BuildTime int64 `protobuf:"varint,7,opt,name=BuildTime,proto3" json:"BuildTime,omitempty"`
+}
+
+func (x *ServerBuildInfo) Reset() {
+	*x = ServerBuildInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerBuildInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerBuildInfo) ProtoMessage() {}
+
+func (x *ServerBuildInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerBuildInfo.ProtoReflect.Descriptor instead.
+func (*ServerBuildInfo) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ServerBuildInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ServerBuildInfo) GetCommsVersion() string {
+	if x != nil {
+		return x.CommsVersion
+	}
+	return ""
+}
+
+func (x *ServerBuildInfo) GetGitCommit() string {
+	if x != nil {
+		return x.GitCommit
+	}
+	return ""
+}
+
+func (x *ServerBuildInfo) GetGoVersion() string {
+	if x != nil {
+		return x.GoVersion
+	}
+	return ""
+}
+
+func (x *ServerBuildInfo) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *ServerBuildInfo) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *ServerBuildInfo) GetBuildTime() int64 {
+	if x != nil {
+		return x.BuildTime
+	}
+	return 0
+}
+
+// Admin -> Server command enabling or disabling an endpoint
+type SetEndpointEnabled struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Full method name of the endpoint
+	Endpoint string `protobuf:"bytes,1,opt,name=Endpoint,proto3" json:"Endpoint,omitempty"`
+	Enabled  bool   `protobuf:"varint,2,opt,name=Enabled,proto3" json:"Enabled,omitempty"`
+	// Returned to callers of the endpoint while it is disabled
+	Reason string `protobuf:"bytes,3,opt,name=Reason,proto3" json:"Reason,omitempty"`
+}
+
+func (x *SetEndpointEnabled) Reset() {
+	*x = SetEndpointEnabled{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetEndpointEnabled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEndpointEnabled) ProtoMessage() {}
+
+func (x *SetEndpointEnabled) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[100]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5837,16 +6932,30 @@ func (x *RsTimestampResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RsTimestampResponse.ProtoReflect.Descriptor instead.
-func (*RsTimestampResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{87}
+// Deprecated: Use SetEndpointEnabled.ProtoReflect.Descriptor instead.
+func (*SetEndpointEnabled) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *RsTimestampResponse) GetTimestamp() int64 {
+func (x *SetEndpointEnabled) GetEndpoint() string {
 	if x != nil {
-		return x.Timestamp
+		return x.Endpoint
 	}
-	return 0
+	return ""
+}
+
+func (x *SetEndpointEnabled) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetEndpointEnabled) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
 }
 
 var File_mixmessages_proto protoreflect.FileDescriptor
@@ -5924,7 +7033,7 @@ var file_mixmessages_proto_rawDesc = []byte{
 	0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
 	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4b, 0x65,
 	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x53, 0x69, 0x67, 0x6e, 0x65,
-	0x64, 0x4b, 0x65, 0x79, 0x73, 0x22, 0xcf, 0x01, 0x0a, 0x11, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x64, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x8b, 0x02, 0x0a, 0x11, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64,
 	0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x4b,
 	0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
 	0x52, 0x0b, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a,
@@ -5937,1008 +7046,1238 @@ var file_mixmessages_proto_rawDesc = []byte{
 	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
 	0x10, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4b, 0x65,
 	0x79, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x49, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x74, 0x50,
-	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x4e, 0x75, 0x6d, 0x53, 0x6c, 0x6f,
-	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x4e, 0x75, 0x6d, 0x53, 0x6c, 0x6f,
-	0x74, 0x73, 0x22, 0x3b, 0x0a, 0x0f, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x75, 0x66, 0x66, 0x65,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x28, 0x0a, 0x0f, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x75,
-	0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f,
-	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x22,
-	0x50, 0x0a, 0x0e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
-	0x79, 0x12, 0x2c, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12,
-	0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x4b, 0x65,
-	0x79, 0x22, 0x38, 0x0a, 0x0c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x73, 0x12, 0x28, 0x0a, 0x0f, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x52, 0x6f, 0x75, 0x6e,
-	0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x7c, 0x0a, 0x05, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x12, 0x2c, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x52, 0x6f, 0x75,
-	0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x68, 0x61, 0x73, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x68, 0x61, 0x73, 0x65,
-	0x12, 0x27, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x6c,
-	0x6f, 0x74, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x22, 0x53, 0x0a, 0x0e, 0x43, 0x6f, 0x6d,
-	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f,
-	0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x27, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x22, 0x75,
-	0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2c, 0x0a, 0x05, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78,
+	0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3a, 0x0a, 0x07, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x07, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x22, 0x8d, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x34, 0x0a,
+	0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x53, 0x41, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x22, 0x49, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f,
+	0x6d, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x4e, 0x75, 0x6d, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x4e, 0x75, 0x6d, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x22, 0x3b,
+	0x0a, 0x0f, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x28, 0x0a, 0x0f, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72,
+	0x53, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x50, 0x0a, 0x0e, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a,
+	0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d,
+	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x4b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x22, 0x38, 0x0a,
+	0x0c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x28, 0x0a,
+	0x0f, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4a, 0x53, 0x4f, 0x4e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x7c, 0x0a, 0x05, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x2c, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x68, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05,
+	0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x69,
+	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05,
+	0x73, 0x6c, 0x6f, 0x74, 0x73, 0x22, 0x53, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49,
+	0x44, 0x12, 0x27, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53,
+	0x6c, 0x6f, 0x74, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x22, 0x9d, 0x01, 0x0a, 0x09, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2c, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x68,
+	0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x46, 0x72, 0x6f, 0x6d, 0x50,
+	0x68, 0x61, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x49, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x49, 0x64, 0x65, 0x6d,
+	0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x6d, 0x0a, 0x0d, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x54, 0x72, 0x69, 0x70, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x07, 0x50,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41,
+	0x6e, 0x79, 0x52, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78,
 	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x46, 0x72, 0x6f,
-	0x6d, 0x50, 0x68, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x46, 0x72,
-	0x6f, 0x6d, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x6d, 0x0a, 0x0d, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x72,
-	0x69, 0x70, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x07, 0x50,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x22, 0xec, 0x01, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50,
-	0x6f, 0x6c, 0x6c, 0x12, 0x28, 0x0a, 0x04, 0x46, 0x75, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x4e, 0x44, 0x46, 0x48, 0x61, 0x73, 0x68, 0x52, 0x04, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x2e, 0x0a,
-	0x07, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46,
-	0x48, 0x61, 0x73, 0x68, 0x52, 0x07, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1e, 0x0a,
-	0x0a, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x67, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x67,
-	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x22, 0xe9, 0x03, 0x0a, 0x12, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f,
-	0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x07, 0x46, 0x75,
-	0x6c, 0x6c, 0x4e, 0x44, 0x46, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x69,
-	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46, 0x52, 0x07, 0x46,
-	0x75, 0x6c, 0x6c, 0x4e, 0x44, 0x46, 0x12, 0x30, 0x0a, 0x0a, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61,
-	0x6c, 0x4e, 0x44, 0x46, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x69, 0x78,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46, 0x52, 0x0a, 0x50, 0x61,
-	0x72, 0x74, 0x69, 0x61, 0x6c, 0x4e, 0x44, 0x46, 0x12, 0x30, 0x0a, 0x07, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0c, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x42, 0x61, 0x74, 0x63, 0x68, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52, 0x05,
-	0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x30, 0x0a, 0x13, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73,
-	0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x13, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x32, 0x0a, 0x14, 0x45, 0x61, 0x72, 0x6c, 0x69,
-	0x65, 0x73, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x47,
-	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x36, 0x0a, 0x16, 0x45,
-	0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x45, 0x61, 0x72,
-	0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x12, 0x2a, 0x0a, 0x10, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x45,
-	0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x22,
-	0x26, 0x0a, 0x0a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x18, 0x0a,
-	0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
-	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x22, 0x96, 0x01, 0x0a, 0x0a, 0x53, 0x68, 0x61, 0x72,
-	0x65, 0x50, 0x69, 0x65, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x69, 0x65, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x50, 0x69, 0x65, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c,
-	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0c, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x34, 0x0a, 0x09, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x53, 0x41, 0x53, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x22, 0x14, 0x0a, 0x12, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x43, 0x65, 0x72, 0x74, 0x22, 0x54, 0x0a, 0x12, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x23, 0x0a, 0x0b,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x44,
-	0x61, 0x74, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x44, 0x61, 0x74, 0x75,
-	0x6d, 0x22, 0x2a, 0x0a, 0x10, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x22, 0x4a, 0x0a,
-	0x18, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x52, 0x6f, 0x75,
-	0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x06, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x22, 0x62, 0x0a, 0x10, 0x47, 0x65, 0x74,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x34, 0x0a,
-	0x08, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x65,
-	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x08, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x6e, 0x0a,
-	0x18, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x3a, 0x0a, 0x07, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x78,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x5b, 0x0a,
-	0x0b, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e,
-	0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64,
-	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x60, 0x0a, 0x13, 0x47, 0x65,
-	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2d, 0x0a, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x12, 0x1a, 0x0a, 0x08, 0x48, 0x61, 0x73, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x08, 0x48, 0x61, 0x73, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x58, 0x0a, 0x0d,
+	0x66, 0x6f, 0x52, 0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0xec, 0x01, 0x0a, 0x0a, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x28, 0x0a, 0x04, 0x46, 0x75, 0x6c, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46, 0x48, 0x61, 0x73, 0x68, 0x52, 0x04, 0x46, 0x75,
+	0x6c, 0x6c, 0x12, 0x2e, 0x0a, 0x07, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x4e, 0x44, 0x46, 0x48, 0x61, 0x73, 0x68, 0x52, 0x07, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x26, 0x0a, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xe9, 0x03, 0x0a, 0x12, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x49, 0x64, 0x12,
+	0x2a, 0x0a, 0x07, 0x46, 0x75, 0x6c, 0x6c, 0x4e, 0x44, 0x46, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e,
+	0x44, 0x46, 0x52, 0x07, 0x46, 0x75, 0x6c, 0x6c, 0x4e, 0x44, 0x46, 0x12, 0x30, 0x0a, 0x0a, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4e, 0x44, 0x46, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44,
+	0x46, 0x52, 0x0a, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4e, 0x44, 0x46, 0x12, 0x30, 0x0a,
+	0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12,
+	0x3a, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x69, 0x78,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x61, 0x64, 0x79, 0x52, 0x05, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x30, 0x0a, 0x13, 0x45, 0x61,
+	0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73,
+	0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x32, 0x0a, 0x14,
+	0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x45, 0x61, 0x72, 0x6c,
+	0x69, 0x65, 0x73, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x12, 0x36, 0x0a, 0x16, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x16, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2a, 0x0a, 0x10, 0x45, 0x61, 0x72, 0x6c,
+	0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x45, 0x72, 0x72, 0x22, 0x26, 0x0a, 0x0a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x61,
+	0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x22, 0x96, 0x01, 0x0a,
+	0x0a, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50, 0x69, 0x65, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x50,
+	0x69, 0x65, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x50, 0x69, 0x65, 0x63,
+	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x12,
+	0x34, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x53,
+	0x41, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x02, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x34, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x53,
+	0x41, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x65, 0x72, 0x74, 0x22, 0x54, 0x0a, 0x12, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x22, 0x23, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x68, 0x75, 0x6e, 0x6b,
+	0x12, 0x14, 0x0a, 0x05, 0x44, 0x61, 0x74, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x44, 0x61, 0x74, 0x75, 0x6d, 0x22, 0x2a, 0x0a, 0x10, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x69, 0x63, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x73, 0x22, 0x4a, 0x0a, 0x18, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e,
+	0x0a, 0x06, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x22, 0x62,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x34, 0x0a, 0x08, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x08,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x22, 0xb1, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x3a, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x12, 0x41, 0x0a, 0x09, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x09, 0x52, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x89, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x61, 0x67, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x50, 0x61, 0x67, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x22, 0xc3, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d,
+	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52,
+	0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x61, 0x73,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x48, 0x61, 0x73,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x41, 0x0a, 0x09, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x09, 0x52,
+	0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74,
+	0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e, 0x65,
+	0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x42, 0x0a, 0x16, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x54, 0x54, 0x4c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x03, 0x54, 0x54, 0x4c, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22, 0x58, 0x0a, 0x0d,
 	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x18, 0x0a,
 	0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
 	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61,
 	0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
 	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x08, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x1a, 0x0a, 0x06, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x49,
-	0x44, 0x73, 0x22, 0x84, 0x04, 0x0a, 0x04, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x49, 0x6e, 0x64, 0x65,
-	0x78, 0x12, 0x34, 0x0a, 0x15, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x50, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x15, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x41, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x34, 0x0a, 0x15, 0x45, 0x6e, 0x63, 0x72, 0x79,
-	0x70, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x4b, 0x65, 0x79, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65,
-	0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x3c, 0x0a,
-	0x19, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x41,
-	0x43, 0x79, 0x70, 0x68, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x19, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x41, 0x43, 0x79, 0x70, 0x68, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74, 0x12, 0x3c, 0x0a, 0x19, 0x50,
-	0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x43, 0x79,
-	0x70, 0x68, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x19,
-	0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x43,
-	0x79, 0x70, 0x68, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74, 0x12, 0x40, 0x0a, 0x1b, 0x50, 0x61, 0x72,
-	0x74, 0x69, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x43,
-	0x79, 0x70, 0x68, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1b,
-	0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x43, 0x79, 0x70, 0x68, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x53,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x53,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x41, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x50, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x41, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x12,
-	0x12, 0x0a, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x53,
-	0x61, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4b, 0x4d, 0x41, 0x43, 0x73, 0x18, 0x0b, 0x20, 0x03,
-	0x28, 0x0c, 0x52, 0x05, 0x4b, 0x4d, 0x41, 0x43, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x64, 0x32,
-	0x35, 0x35, 0x31, 0x39, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x45, 0x64, 0x32, 0x35,
-	0x35, 0x31, 0x39, 0x12, 0x24, 0x0a, 0x0d, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c,
-	0x4b, 0x65, 0x79, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x08, 0x52, 0x0d, 0x45, 0x70, 0x68, 0x65,
-	0x6d, 0x65, 0x72, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x22, 0xd9, 0x02, 0x0a, 0x0b, 0x47, 0x61,
-	0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x2e, 0x0a, 0x07, 0x50, 0x61, 0x72,
-	0x74, 0x69, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x69, 0x78,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46, 0x48, 0x61, 0x73, 0x68,
-	0x52, 0x07, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x4c, 0x61, 0x73,
-	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x4c,
-	0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65, 0x63,
-	0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b,
-	0x52, 0x65, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x45, 0x6e, 0x64, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a,
-	0x0b, 0x46, 0x61, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0b, 0x46, 0x61, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x12,
-	0x1c, 0x0a, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x26, 0x0a,
-	0x0e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0xe6, 0x02, 0x0a, 0x13, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a,
-	0x0a, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4e, 0x44, 0x46, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x4e, 0x44, 0x46, 0x52, 0x0a, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4e, 0x44, 0x46, 0x12,
-	0x30, 0x0a, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x6f, 0x75,
-	0x6e, 0x64, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x73, 0x52,
-	0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x45, 0x61, 0x72, 0x6c,
-	0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0d, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x2a,
-	0x0a, 0x10, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45,
-	0x72, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65,
-	0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65,
-	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
-	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x47, 0x61,
-	0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0c, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x22, 0x82,
-	0x01, 0x0a, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x73, 0x12,
-	0x16, 0x0a, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x46, 0x69, 0x72, 0x73, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0e, 0x46, 0x69, 0x72, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
-	0x32, 0x0a, 0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x52, 0x07, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x73, 0x22, 0x65, 0x0a, 0x0b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f,
-	0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x46, 0x69,
-	0x72, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
-	0x46, 0x69, 0x72, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x6f,
-	0x75, 0x6e, 0x64, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
-	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x0c, 0x47,
-	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x54,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x54, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x22, 0x96, 0x01, 0x0a, 0x0b,
-	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d,
-	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52,
-	0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e,
-	0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64,
-	0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x41, 0x43, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x03, 0x4d, 0x41, 0x43, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x49, 0x70,
-	0x41, 0x64, 0x64, 0x72, 0x22, 0x4b, 0x0a, 0x13, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53,
-	0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61,
-	0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61,
-	0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64,
-	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49,
-	0x44, 0x22, 0x58, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x70, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x49, 0x70, 0x73, 0x22, 0x64, 0x0a, 0x0a, 0x52,
-	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x63,
-	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52,
-	0x0c, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x18, 0x0a,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x10, 0x4d, 0x69, 0x72, 0x72, 0x6f,
+	0x72, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x08, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x52, 0x08, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x22, 0xbc, 0x01, 0x0a,
+	0x0e, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x4f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x61, 0x73, 0x68,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x34, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x52, 0x53, 0x41, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x1a, 0x0a, 0x06, 0x49,
+	0x44, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x03, 0x49, 0x44, 0x73, 0x22, 0x84, 0x04, 0x0a, 0x04, 0x53, 0x6c, 0x6f, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x34, 0x0a, 0x15, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x4b, 0x65, 0x79, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x34, 0x0a, 0x15,
+	0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x42, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x45, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x4b, 0x65,
+	0x79, 0x73, 0x12, 0x3c, 0x0a, 0x19, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x41, 0x43, 0x79, 0x70, 0x68, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x19, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x43, 0x79, 0x70, 0x68, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74,
+	0x12, 0x3c, 0x0a, 0x19, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x42, 0x43, 0x79, 0x70, 0x68, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x19, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x42, 0x43, 0x79, 0x70, 0x68, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74, 0x12, 0x40,
+	0x0a, 0x1b, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x43, 0x79, 0x70, 0x68, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x1b, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x43, 0x79, 0x70, 0x68, 0x65, 0x72, 0x4b, 0x65, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x42, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x42, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4b, 0x4d, 0x41, 0x43,
+	0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x4b, 0x4d, 0x41, 0x43, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x12, 0x24, 0x0a, 0x0d, 0x45, 0x70, 0x68, 0x65,
+	0x6d, 0x65, 0x72, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x08, 0x52,
+	0x0d, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x22, 0xd9,
+	0x02, 0x0a, 0x0b, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x2e,
+	0x0a, 0x07, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44,
+	0x46, 0x48, 0x61, 0x73, 0x68, 0x52, 0x07, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1e,
+	0x0a, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0b, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x12, 0x26, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x45, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x24, 0x0a, 0x0d,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x46, 0x61, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x46, 0x61, 0x73, 0x74, 0x50, 0x6f, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x44, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0xe6, 0x02, 0x0a, 0x13, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4e, 0x44, 0x46,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46, 0x52, 0x0a, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61,
+	0x6c, 0x4e, 0x44, 0x46, 0x12, 0x30, 0x0a, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x4b, 0x6e, 0x6f,
+	0x77, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x6c,
+	0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x24, 0x0a,
+	0x0d, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x45,
+	0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x12,
+	0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x73, 0x12,
+	0x22, 0x0a, 0x0c, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x65,
+	0x6c, 0x61, 0x79, 0x22, 0x82, 0x01, 0x0a, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x6c,
+	0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x26, 0x0a, 0x0e,
+	0x46, 0x69, 0x72, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x46, 0x69, 0x72, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x32, 0x0a, 0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x52,
+	0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x65, 0x0a, 0x0b, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x1e, 0x0a, 0x0a, 0x46, 0x69, 0x72, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x46, 0x69, 0x72, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12,
+	0x1e, 0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22,
+	0xae, 0x01, 0x0a, 0x0c, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x73,
+	0x12, 0x34, 0x0a, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x08, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x70, 0x41, 0x64,
+	0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72,
+	0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x6c, 0x6f, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x6c, 0x6f, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65,
+	0x22, 0xb6, 0x01, 0x0a, 0x0b, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74,
+	0x12, 0x2b, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x53, 0x6c, 0x6f, 0x74, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a,
 	0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
-	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64,
-	0x54, 0x53, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54,
-	0x53, 0x22, 0x40, 0x0a, 0x1a, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x4e,
-	0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x22, 0x0a, 0x0c, 0x49, 0x73, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x49, 0x73, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x65, 0x64, 0x22, 0x25, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65,
-	0x64, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x49, 0x44, 0x22, 0x1d, 0x0a, 0x07, 0x4e, 0x44,
-	0x46, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x48, 0x61, 0x73, 0x68, 0x22, 0x4d, 0x0a, 0x03, 0x4e, 0x44, 0x46,
-	0x12, 0x10, 0x0a, 0x03, 0x4e, 0x64, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x4e,
-	0x64, 0x66, 0x12, 0x34, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x41, 0x43, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x4d, 0x41, 0x43, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x6c, 0x6f,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53,
+	0x6c, 0x6f, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x22, 0xc1, 0x01, 0x0a, 0x13, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x31, 0x0a, 0x05, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x52, 0x05, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x41, 0x0a, 0x09, 0x52, 0x65,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x09, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd8, 0x01,
+	0x0a, 0x0e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x6c,
+	0x6f, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x53,
+	0x6c, 0x6f, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x61, 0x73,
+	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x34, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
 	0x2e, 0x52, 0x53, 0x41, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xb0, 0x02, 0x0a, 0x10, 0x4e, 0x6f, 0x64,
-	0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
-	0x04, 0x53, 0x61, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x53, 0x61, 0x6c,
-	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x6c, 0x73, 0x43, 0x65,
-	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x54, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a,
-	0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x26, 0x0a,
-	0x0e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x54, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x54, 0x6c,
-	0x73, 0x43, 0x65, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x47,
-	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a,
-	0x0b, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0b, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x12,
-	0x2a, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xbe, 0x01, 0x0a, 0x12,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x40,
-	0x0a, 0x1b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x1b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79,
-	0x12, 0x3a, 0x0a, 0x18, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x18, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x22, 0x5c, 0x0a, 0x1e,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
-	0x0a, 0x09, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xb0, 0x01, 0x0a, 0x1e, 0x53,
-	0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x4c, 0x0a, 0x0e, 0x52, 0x65, 0x6c, 0x61,
+	0x79, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0b, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x50,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x58, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x53, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x49, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x10,
+	0x0a, 0x03, 0x49, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x49, 0x70, 0x73,
+	0x22, 0x64, 0x0a, 0x0a, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x22,
+	0x0a, 0x0c, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x53, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x54, 0x53, 0x22, 0x84, 0x03, 0x0a, 0x12, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x12,
+	0x14, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x4e, 0x75, 0x6d, 0x4e, 0x6f, 0x64, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x4e, 0x75, 0x6d, 0x4e, 0x6f, 0x64, 0x65,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x50, 0x68, 0x61, 0x73, 0x65,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x69, 0x6e,
+	0x67, 0x52, 0x06, 0x50, 0x68, 0x61, 0x73, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d,
+	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x09, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x0e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x69, 0x70,
+	0x50, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69,
+	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54,
+	0x72, 0x69, 0x70, 0x50, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x69, 0x70, 0x50, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x4b, 0x0a,
+	0x0b, 0x50, 0x68, 0x61, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05,
+	0x50, 0x68, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x50, 0x68, 0x61,
+	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x45, 0x6e, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x45, 0x6e, 0x64, 0x22, 0xa9, 0x01, 0x0a, 0x0d, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x0f,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x41, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0f, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x75, 0x6d, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x4e, 0x75, 0x6d, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73,
+	0x12, 0x24, 0x0a, 0x0d, 0x43, 0x50, 0x55, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x43, 0x50, 0x55, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0xb9, 0x01, 0x0a, 0x13, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x54, 0x72, 0x69, 0x70, 0x50, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x18,
+	0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x65, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x65, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x53, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x65, 0x6e, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0f, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x22, 0x40, 0x0a, 0x1a, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x22, 0x0a, 0x0c, 0x49, 0x73, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x49, 0x73, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x22, 0x25, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x49, 0x44, 0x22, 0x1d, 0x0a, 0x07, 0x4e,
+	0x44, 0x46, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x48, 0x61, 0x73, 0x68, 0x22, 0x4d, 0x0a, 0x03, 0x4e, 0x44,
+	0x46, 0x12, 0x10, 0x0a, 0x03, 0x4e, 0x64, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03,
+	0x4e, 0x64, 0x66, 0x12, 0x34, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x52, 0x53, 0x41, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xb0, 0x02, 0x0a, 0x10, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x53, 0x61,
+	0x6c, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x6c, 0x73, 0x43,
+	0x65, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x54, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x26,
+	0x0a, 0x0e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x54, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x54,
+	0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x72, 0x74,
+	0x12, 0x2a, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xbe, 0x01, 0x0a,
+	0x12, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x40, 0x0a, 0x1b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x4b, 0x65,
+	0x79, 0x12, 0x3a, 0x0a, 0x18, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x18, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x22, 0x5c, 0x0a,
 	0x1e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x12, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
-	0x61, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x53, 0x41,
-	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x12, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x72, 0x61, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xa1, 0x02,
-	0x0a, 0x25, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
-	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x73, 0x0a, 0x1e, 0x43, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x69,
-	0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1e, 0x43, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6d, 0x0a, 0x1b,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1c, 0x0a, 0x09, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a,
+	0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xb0, 0x01, 0x0a, 0x1e,
 	0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1b,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x22, 0x29, 0x0a, 0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xcc, 0x03, 0x0a,
-	0x11, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x6f,
-	0x6c, 0x6c, 0x12, 0x28, 0x0a, 0x04, 0x46, 0x75, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e,
-	0x44, 0x46, 0x48, 0x61, 0x73, 0x68, 0x52, 0x04, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x2e, 0x0a, 0x07,
-	0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46, 0x48,
-	0x61, 0x73, 0x68, 0x52, 0x07, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a,
-	0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
-	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x26, 0x0a, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a,
-	0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x52, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x07, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x22, 0x57, 0x0a, 0x0b, 0x43,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x43, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x22, 0xf2, 0x02, 0x0a, 0x16, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x2a, 0x0a, 0x07, 0x46, 0x75, 0x6c, 0x6c, 0x4e, 0x44, 0x46, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x46,
+	0x0a, 0x1e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x12, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x53,
+	0x41, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x12, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xa1,
+	0x02, 0x0a, 0x25, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x73, 0x0a, 0x1e, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1e, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6d, 0x0a,
+	0x1b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x1b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x29, 0x0a, 0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xcc, 0x03,
+	0x0a, 0x11, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x6c, 0x6c, 0x12, 0x28, 0x0a, 0x04, 0x46, 0x75, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x4e, 0x44, 0x46, 0x48, 0x61, 0x73, 0x68, 0x52, 0x04, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x2e, 0x0a,
+	0x07, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46,
+	0x48, 0x61, 0x73, 0x68, 0x52, 0x07, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1e, 0x0a,
+	0x0a, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x26, 0x0a, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24,
+	0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x69, 0x78,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x22, 0x57, 0x0a, 0x0b,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xf2, 0x02, 0x0a, 0x16, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2a, 0x0a, 0x07, 0x46, 0x75, 0x6c, 0x6c, 0x4e, 0x44, 0x46, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x4e, 0x44, 0x46, 0x52, 0x07, 0x46, 0x75, 0x6c, 0x6c, 0x4e, 0x44, 0x46, 0x12, 0x30, 0x0a, 0x0a,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4e, 0x44, 0x46, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x10, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e,
-	0x44, 0x46, 0x52, 0x07, 0x46, 0x75, 0x6c, 0x6c, 0x4e, 0x44, 0x46, 0x12, 0x30, 0x0a, 0x0a, 0x50,
-	0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4e, 0x44, 0x46, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44,
-	0x46, 0x52, 0x0a, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4e, 0x44, 0x46, 0x12, 0x30, 0x0a,
-	0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75,
-	0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12,
-	0x30, 0x0a, 0x13, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x45, 0x61,
-	0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e,
-	0x64, 0x12, 0x32, 0x0a, 0x14, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x47, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x14, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x36, 0x0a, 0x16, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73,
-	0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2a, 0x0a,
-	0x10, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72,
-	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73,
-	0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x22, 0xe6, 0x02, 0x0a, 0x14, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x41, 0x70, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x41, 0x70, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x50, 0x65, 0x6d,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x50, 0x65, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x6c, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x53, 0x61, 0x6c, 0x74, 0x12, 0x34, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x40, 0x0a, 0x1b,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72, 0x53, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x1b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x73, 0x61, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72, 0x53, 0x69, 0x67, 0x12, 0x2a,
+	0x44, 0x46, 0x52, 0x0a, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4e, 0x44, 0x46, 0x12, 0x30,
+	0x0a, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73,
+	0x12, 0x30, 0x0a, 0x13, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x45,
+	0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x12, 0x32, 0x0a, 0x14, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x14, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x36, 0x0a, 0x16, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65,
+	0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2a,
+	0x0a, 0x10, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45,
+	0x72, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65,
+	0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x22, 0xe6, 0x02, 0x0a, 0x14, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x41, 0x70, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x41, 0x70, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x50, 0x65,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x50, 0x65, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x6c, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x53, 0x61, 0x6c, 0x74, 0x12, 0x34, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x40, 0x0a,
+	0x1b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72, 0x53, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x1b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x73, 0x61, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72, 0x53, 0x69, 0x67, 0x12,
+	0x2a, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x26, 0x0a, 0x0e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x22, 0xc4, 0x01, 0x0a, 0x16, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x41, 0x70, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x41, 0x70, 0x70,
+	0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x50, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x73, 0x61, 0x50, 0x65, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x26, 0x0a, 0x0e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x61, 0x0a, 0x1a, 0x55, 0x6e,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd7, 0x01,
+	0x0a, 0x18, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x64, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x07, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x69,
+	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x34, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x40, 0x0a, 0x1b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x72, 0x53, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1b, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x72, 0x53, 0x69, 0x67, 0x22, 0xce, 0x01, 0x0a, 0x1c, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x15, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x44, 0x12, 0x2e,
+	0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73,
+	0x61, 0x50, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x50, 0x65, 0x6d, 0x12, 0x2a,
 	0x0a, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x26, 0x0a, 0x0e, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x22, 0xc4, 0x01, 0x0a, 0x16, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
-	0x03, 0x41, 0x70, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x41, 0x70, 0x70, 0x12,
-	0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x50, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x73, 0x61, 0x50, 0x65, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x12, 0x26, 0x0a, 0x0e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x61, 0x0a, 0x1a, 0x55, 0x6e, 0x72,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd7, 0x01, 0x0a,
-	0x18, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64,
-	0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x07, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x69, 0x78,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34,
-	0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x12, 0x40, 0x0a, 0x1b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72,
-	0x53, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1b, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x72, 0x61, 0x72, 0x53, 0x69, 0x67, 0x22, 0xce, 0x01, 0x0a, 0x1c, 0x54, 0x72, 0x61, 0x63, 0x6b,
-	0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x63, 0x6b,
-	0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x15, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x44, 0x12, 0x2e, 0x0a,
-	0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61,
-	0x50, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x50, 0x65, 0x6d, 0x12, 0x2a, 0x0a,
-	0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xcd, 0x02, 0x0a, 0x1b, 0x4e, 0x6f, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x26, 0x0a,
-	0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69,
-	0x61, 0x72, 0x79, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x12,
-	0x2a, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53,
-	0x61, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x6c, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x53, 0x69,
-	0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x53, 0x69, 0x67, 0x12, 0x34, 0x0a, 0x15, 0x49,
-	0x49, 0x44, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73,
-	0x61, 0x53, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x49, 0x49, 0x44, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x53, 0x69,
-	0x67, 0x12, 0x34, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x7d, 0x0a, 0x1d, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x64,
-	0x12, 0x34, 0x0a, 0x15, 0x49, 0x49, 0x44, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x53, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x15, 0x49, 0x49, 0x44, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x73, 0x61, 0x53, 0x69, 0x67, 0x22, 0x1e, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0c, 0x52, 0x03, 0x49, 0x44, 0x73, 0x22, 0x72, 0x0a, 0x11, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x72,
-	0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x6f,
-	0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x43, 0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d,
-	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x6e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x76, 0x0a, 0x10, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20,
-	0x0a, 0x0b, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x44,
-	0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x50, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x50,
-	0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x61,
-	0x73, 0x68, 0x22, 0xb1, 0x01, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65,
-	0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31,
-	0x39, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x55,
-	0x73, 0x65, 0x72, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79,
-	0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x36,
-	0x0a, 0x16, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x53, 0x41, 0x53,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x16,
-	0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x53, 0x41, 0x53, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x45, 0x64, 0x32,
-	0x35, 0x35, 0x31, 0x39, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x11, 0x55, 0x73, 0x65, 0x72, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x50, 0x75, 0x62,
-	0x4b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x17, 0x55, 0x44, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x64,
-	0x32, 0x35, 0x35, 0x31, 0x39, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x17, 0x55, 0x44, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x64, 0x32,
-	0x35, 0x35, 0x31, 0x39, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x33, 0x0a,
-	0x19, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x22, 0x84, 0x01, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x50, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x15, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x50, 0x65, 0x6d, 0x22, 0xce, 0x02, 0x0a, 0x13, 0x55, 0x44,
-	0x42, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x36, 0x0a, 0x16, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x69,
-	0x6e, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x16, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67,
-	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x53, 0x41,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x65, 0x6d, 0x12, 0x1c, 0x0a,
-	0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x49, 0x0a, 0x14, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x52, 0x14, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x11, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x03, 0x66, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x03, 0x66, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x49, 0x44, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x55, 0x49, 0x44, 0x22, 0x56, 0x0a, 0x08, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x68, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x64, 0x68, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x12,
-	0x0a, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x53, 0x61,
-	0x6c, 0x74, 0x22, 0x68, 0x0a, 0x13, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x55, 0x49, 0x44, 0x12, 0x25, 0x0a, 0x04, 0x46,
-	0x61, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52, 0x04, 0x46, 0x61,
-	0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x46, 0x61, 0x63, 0x74, 0x53, 0x69, 0x67, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x07, 0x46, 0x61, 0x63, 0x74, 0x53, 0x69, 0x67, 0x22, 0x36, 0x0a, 0x04,
-	0x46, 0x61, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x46, 0x61, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x61, 0x63, 0x74,
-	0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x46, 0x61, 0x63, 0x74,
-	0x54, 0x79, 0x70, 0x65, 0x22, 0x3e, 0x0a, 0x14, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x44, 0x22, 0x50, 0x0a, 0x12, 0x46, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x75, 0x0a, 0x12, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x55, 0x49, 0x44, 0x12, 0x33,
-	0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x44,
-	0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x46, 0x61, 0x63, 0x74, 0x53, 0x69, 0x67, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x46, 0x61, 0x63, 0x74, 0x53, 0x69, 0x67, 0x22, 0x26, 0x0a,
-	0x0a, 0x53, 0x74, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xf4, 0x03, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x44, 0x12,
-	0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69,
-	0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53,
-	0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18,
-	0x05, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12,
-	0x1e, 0x0a, 0x0a, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x18, 0x06, 0x20,
-	0x03, 0x28, 0x04, 0x52, 0x0a, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x12,
-	0x2f, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f,
-	0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73,
-	0x12, 0x3c, 0x0a, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73,
-	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x52, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x3e,
-	0x0a, 0x1a, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x1a, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x51, 0x75, 0x65, 0x75,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x34,
-	0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x53, 0x41,
-	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x70, 0x61, 0x63, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x53, 0x69, 0x7a, 0x65,
-	0x12, 0x3a, 0x0a, 0x0c, 0x45, 0x63, 0x63, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x45, 0x43, 0x43, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x0c,
-	0x45, 0x63, 0x63, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x80, 0x01, 0x0a,
-	0x0a, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x4e,
-	0x6f, 0x64, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x4e, 0x6f, 0x64,
-	0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x34, 0x0a, 0x09, 0x53, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x53, 0x41, 0x53, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22,
-	0x16, 0x0a, 0x14, 0x45, 0x41, 0x42, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3f, 0x0a, 0x15, 0x45, 0x41, 0x42, 0x43, 0x72,
-	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x22, 0x85, 0x01, 0x0a, 0x15, 0x41, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x47, 0x77, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x47, 0x77, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x22, 0x96, 0x01, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x41,
-	0x75, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x53,
-	0x61, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x12,
-	0x1c, 0x0a, 0x09, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x50, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x09, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x50, 0x65, 0x6d, 0x12, 0x1c, 0x0a,
-	0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x73,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x6d, 0x0a, 0x17, 0x52, 0x73, 0x41,
-	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x22, 0x0a, 0x0c, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x48, 0x61, 0x73, 0x68,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x22, 0x4e, 0x0a, 0x18, 0x52, 0x73, 0x41, 0x75,
-	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78,
-	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x45,
-	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x39, 0x0a, 0x0d, 0x52, 0x73, 0x52, 0x65,
-	0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74,
-	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a,
-	0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x22, 0x2a, 0x0a, 0x12, 0x52, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x57, 0x72, 0x69,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
-	0x24, 0x0a, 0x0e, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0x4e, 0x0a, 0x0e, 0x52, 0x73, 0x57, 0x72, 0x69, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x44,
-	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12,
-	0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x27, 0x0a, 0x11, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x44,
-	0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61,
-	0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0x33,
-	0x0a, 0x13, 0x52, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x32, 0x96, 0x0a, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x3c, 0x0a, 0x09,
-	0x41, 0x73, 0x6b, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
-	0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1e, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
-	0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x3a, 0x0a,
-	0x12, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x6e, 0x6d, 0x69, 0x78, 0x65, 0x64, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x12, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x28, 0x01, 0x12, 0x36, 0x0a, 0x0e, 0x46, 0x69, 0x6e,
-	0x69, 0x73, 0x68, 0x52, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x11, 0x2e, 0x6d, 0x69,
-	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x1a, 0x0d,
-	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x28,
-	0x01, 0x12, 0x38, 0x0a, 0x10, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x54, 0x65, 0x73, 0x74,
-	0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x28, 0x01, 0x12, 0x3c, 0x0a, 0x09, 0x50,
-	0x6f, 0x73, 0x74, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0f, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x11, 0x2e, 0x6d,
-	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x1a,
-	0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00,
-	0x28, 0x01, 0x12, 0x54, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x75,
-	0x66, 0x66, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x1c, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x75, 0x66, 0x66,
-	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
-	0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x1e, 0x2e, 0x6d,
-	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65,
-	0x64, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44,
-	0x0a, 0x11, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41,
+	0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xcd, 0x02, 0x0a, 0x1b, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x26,
+	0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x72, 0x79, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61,
+	0x12, 0x2a, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x53, 0x61, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x6c, 0x74, 0x12, 0x2e, 0x0a, 0x12,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x53,
+	0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x53, 0x69, 0x67, 0x12, 0x34, 0x0a, 0x15,
+	0x49, 0x49, 0x44, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x73, 0x61, 0x53, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x49, 0x49, 0x44,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x53,
+	0x69, 0x67, 0x12, 0x34, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x7d, 0x0a, 0x1d, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49,
+	0x64, 0x12, 0x34, 0x0a, 0x15, 0x49, 0x49, 0x44, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x53, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x15, 0x49, 0x49, 0x44, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x73, 0x61, 0x53, 0x69, 0x67, 0x22, 0x1e, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x03, 0x49, 0x44, 0x73, 0x22, 0x72, 0x0a, 0x11, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x43, 0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x6e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x76, 0x0a, 0x10, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x20, 0x0a, 0x0b, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x49,
+	0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x50, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x46,
+	0x50, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x61, 0x73, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48,
+	0x61, 0x73, 0x68, 0x22, 0xb1, 0x01, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c,
+	0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x45, 0x64, 0x32, 0x35, 0x35,
+	0x31, 0x39, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11,
+	0x55, 0x73, 0x65, 0x72, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x50, 0x75, 0x62, 0x4b, 0x65,
+	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x36, 0x0a, 0x16, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x53, 0x41,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x16, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x53, 0x41, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x45, 0x64,
+	0x32, 0x35, 0x35, 0x31, 0x39, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x11, 0x55, 0x73, 0x65, 0x72, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x50, 0x75,
+	0x62, 0x4b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x17, 0x55, 0x44, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x45,
+	0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x17, 0x55, 0x44, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x64,
+	0x32, 0x35, 0x35, 0x31, 0x39, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x33,
+	0x0a, 0x19, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x84, 0x01, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x50, 0x65, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x15, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x50, 0x65, 0x6d, 0x22, 0xce, 0x02, 0x0a, 0x13, 0x55,
+	0x44, 0x42, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x16, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x69, 0x6e, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x16, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e,
+	0x67, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x53,
+	0x41, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x65, 0x6d, 0x12, 0x1c,
+	0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x49, 0x0a, 0x14,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x78,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x14, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x11, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x03, 0x66, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x03, 0x66, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x49, 0x44,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x55, 0x49, 0x44, 0x22, 0x56, 0x0a, 0x08, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x68, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x64, 0x68, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x53,
+	0x61, 0x6c, 0x74, 0x22, 0x68, 0x0a, 0x13, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x55, 0x49, 0x44, 0x12, 0x25, 0x0a, 0x04,
+	0x46, 0x61, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x69, 0x78,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52, 0x04, 0x46,
+	0x61, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x46, 0x61, 0x63, 0x74, 0x53, 0x69, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x46, 0x61, 0x63, 0x74, 0x53, 0x69, 0x67, 0x22, 0x36, 0x0a,
+	0x04, 0x46, 0x61, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x46, 0x61, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x61, 0x63,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x46, 0x61, 0x63,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3e, 0x0a, 0x14, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a,
+	0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x50, 0x0a, 0x12, 0x46, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x75, 0x0a, 0x12, 0x46, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x55, 0x49, 0x44, 0x12,
+	0x33, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x46, 0x61, 0x63, 0x74, 0x53, 0x69, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x46, 0x61, 0x63, 0x74, 0x53, 0x69, 0x67, 0x22, 0x26,
+	0x0a, 0x0a, 0x53, 0x74, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xf4, 0x03, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x44,
+	0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73,
+	0x12, 0x2f, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12,
+	0x3e, 0x0a, 0x1a, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x1a, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12,
+	0x34, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x53,
+	0x41, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x70, 0x61, 0x63, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x10, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x45, 0x63, 0x63, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x45, 0x43, 0x43, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52,
+	0x0c, 0x45, 0x63, 0x63, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x80, 0x01,
+	0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x4e, 0x6f,
+	0x64, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x34, 0x0a, 0x09, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x53, 0x41, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x22, 0x16, 0x0a, 0x14, 0x45, 0x41, 0x42, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3f, 0x0a, 0x15, 0x45, 0x41, 0x42, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x22, 0x85, 0x01, 0x0a, 0x15, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x47, 0x77, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x47, 0x77, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x22, 0x96, 0x01, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72,
+	0x41, 0x75, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
+	0x53, 0x61, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x53, 0x61, 0x6c, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x50, 0x65, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x50, 0x65, 0x6d, 0x12, 0x1c,
+	0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x6d, 0x0a, 0x17, 0x52, 0x73,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x48, 0x61, 0x73,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x22, 0x4e, 0x0a, 0x18, 0x52, 0x73, 0x41,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x39, 0x0a, 0x0d, 0x52, 0x73, 0x52,
+	0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14,
+	0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2a, 0x0a, 0x12, 0x52, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x24, 0x0a, 0x0e, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0x4e, 0x0a, 0x0e, 0x52, 0x73, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04,
+	0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x27, 0x0a, 0x11, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64,
+	0x44, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x44,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22,
+	0x33, 0x0a, 0x13, 0x52, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x22, 0xe3, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x73, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x47, 0x69, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x47, 0x69, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x47, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x47, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x62, 0x0a, 0x12, 0x53, 0x65,
+	0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x32, 0xfd,
+	0x0b, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x41, 0x73, 0x6b, 0x4f, 0x6e,
+	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e,
+	0x65, 0x77, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x12, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x55, 0x6e, 0x6d, 0x69, 0x78, 0x65, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x11,
+	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f,
+	0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b,
+	0x22, 0x00, 0x28, 0x01, 0x12, 0x36, 0x0a, 0x0e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65,
+	0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x28, 0x01, 0x12, 0x38, 0x0a, 0x10,
+	0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x54, 0x65, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53,
+	0x6c, 0x6f, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41,
+	0x63, 0x6b, 0x22, 0x00, 0x28, 0x01, 0x12, 0x3c, 0x0a, 0x09, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x68,
+	0x61, 0x73, 0x65, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41,
 	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73,
 	0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41,
-	0x63, 0x6b, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x73, 0x75,
-	0x72, 0x65, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75,
+	0x63, 0x6b, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6f,
+	0x73, 0x74, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x28, 0x01, 0x12, 0x54, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x1a, 0x1c, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x1e, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x11, 0x50, 0x6f, 0x73,
+	0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1e,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12,
+	0x49, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x12, 0x1e, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x19, 0x2e,
+	0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x04, 0x50, 0x6f,
+	0x6c, 0x6c, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75,
 	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x1a, 0x19, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x22, 0x00, 0x12,
-	0x49, 0x0a, 0x04, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x67, 0x65, 0x1a, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x12, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x4d, 0x69, 0x78, 0x65, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1e, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x11, 0x2e, 0x6d, 0x69,
+	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x44, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54,
+	0x72, 0x69, 0x70, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
 	0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x12, 0x44, 0x6f,
-	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x69, 0x78, 0x65, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x0e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x69,
+	0x6e, 0x67, 0x1a, 0x17, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x53, 0x74, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x00, 0x12, 0x42, 0x0a,
+	0x0f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50, 0x68, 0x61, 0x73, 0x65,
 	0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68,
 	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x1a, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53,
-	0x6c, 0x6f, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x44, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x69, 0x70, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
-	0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x3d, 0x0a,
-	0x0a, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1e, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x17,
-	0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x1a, 0x17, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x22, 0x00, 0x12, 0x42, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65,
-	0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0f, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50,
-	0x68, 0x61, 0x73, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
-	0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x53, 0x68,
-	0x61, 0x72, 0x65, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x32, 0xc9, 0x07, 0x0a,
-	0x07, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x59, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x23, 0x2e, 0x6d,
-	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65,
-	0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x15, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x6f, 0x64, 0x65,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x6d,
-	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65,
-	0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a,
-	0x0a, 0x50, 0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x2e, 0x6d, 0x69,
-	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x53, 0x6c, 0x6f, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0f, 0x50, 0x75, 0x74,
-	0x4d, 0x61, 0x6e, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x6d,
-	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x1a, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
+	0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22,
+	0x00, 0x12, 0x42, 0x0a, 0x0f, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x53, 0x68, 0x61, 0x72, 0x65, 0x46, 0x69,
+	0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x1a, 0x16, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x15, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41,
+	0x63, 0x6b, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x15, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x50,
+	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x32, 0xfb,
+	0x0a, 0x0a, 0x07, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x59, 0x0a, 0x10, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x23,
+	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x15, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28,
+	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4a, 0x0a, 0x0a, 0x50, 0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x2e,
+	0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
 	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0f, 0x50,
-	0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x1e,
-	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
-	0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x20,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0f, 0x50,
+	0x75, 0x74, 0x4d, 0x61, 0x6e, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x19,
 	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x5a, 0x0a, 0x14, 0x50, 0x75, 0x74, 0x4d, 0x61, 0x6e, 0x79, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
-	0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x20, 0x2e, 0x6d, 0x69, 0x78,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e,
-	0x0a, 0x04, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x6c, 0x6c,
-	0x1a, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x00, 0x30, 0x01, 0x12, 0x61,
-	0x0a, 0x17, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69,
-	0x63, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x1d, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63,
-	0x61, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x1a, 0x25, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61,
-	0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x4f, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x1a, 0x20,
-	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x5e, 0x0a, 0x14, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x6d, 0x69, 0x78,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x1a, 0x25, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x22, 0x00, 0x12, 0x54, 0x0a, 0x0e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x6c, 0x73,
-	0x43, 0x65, 0x72, 0x74, 0x12, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x43, 0x65, 0x72, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x65, 0x72, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22, 0x00, 0x32, 0x78, 0x0a, 0x0f, 0x43, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72, 0x12, 0x65, 0x0a, 0x0c, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x6d, 0x69,
-	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x32, 0x2e, 0x6d,
-	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65,
-	0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0x00, 0x32, 0xb4, 0x02, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4e,
-	0x6f, 0x64, 0x65, 0x12, 0x1d, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63,
-	0x6b, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x07, 0x50, 0x6f, 0x6c, 0x6c, 0x4e, 0x64, 0x66, 0x12, 0x14,
-	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46,
-	0x48, 0x61, 0x73, 0x68, 0x1a, 0x10, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x04, 0x50, 0x6f, 0x6c, 0x6c,
+	0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x1a, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53,
+	0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a,
+	0x0f, 0x50, 0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79,
 	0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68,
 	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x1a, 0x23, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50,
-	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x6d,
-	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x1a, 0x27,
-	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x32, 0xbc, 0x04, 0x0a, 0x0f, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x74, 0x12, 0x59, 0x0a,
-	0x1a, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x2e, 0x6d, 0x69,
-	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x18, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d,
+	0x1a, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x14, 0x50, 0x75, 0x74, 0x4d, 0x61, 0x6e, 0x79, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x1e, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x20, 0x2e, 0x6d,
+	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3e, 0x0a, 0x04, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f,
+	0x6c, 0x6c, 0x1a, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x61, 0x0a, 0x17, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x1d, 0x2e, 0x6d, 0x69,
+	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x69, 0x63, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x1a, 0x25, 0x2e, 0x6d, 0x69, 0x78,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69,
+	0x63, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x1a, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x14, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x6d,
+	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x1a, 0x25, 0x2e, 0x6d, 0x69,
+	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54,
+	0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x12, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x43, 0x65, 0x72, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x13, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b,
+	0x22, 0x00, 0x12, 0x41, 0x0a, 0x0e, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x12, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x6d, 0x69,
+	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x50, 0x6f, 0x6c, 0x6c, 0x1a, 0x16, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x3c, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x1b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d,
 	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12,
-	0x4b, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1e, 0x2e, 0x6d, 0x65,
+	0x55, 0x0a, 0x15, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73,
+	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x6c,
+	0x6f, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x48, 0x6f, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x2e, 0x6d, 0x65,
 	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
 	0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0d,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x2e,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x32, 0x78, 0x0a, 0x0f,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72, 0x12,
+	0x65, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x1a, 0x32, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x32, 0x8e, 0x04, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x07, 0x50, 0x6f, 0x6c, 0x6c, 0x4e,
+	0x64, 0x66, 0x12, 0x14, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x4e, 0x44, 0x46, 0x48, 0x61, 0x73, 0x68, 0x1a, 0x10, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x04,
+	0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x1a, 0x23, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x11, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x1a, 0x27, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x43, 0x0a,
+	0x0d, 0x50, 0x6f, 0x6c, 0x6c, 0x4e, 0x64, 0x66, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x14,
+	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46,
+	0x48, 0x61, 0x73, 0x68, 0x1a, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x4c, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x61, 0x70,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x00,
+	0x12, 0x45, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x32, 0xbc, 0x04, 0x0a, 0x0f, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x74, 0x12, 0x59, 0x0a, 0x1a, 0x55,
+	0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x18, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x46, 0x6f, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x28, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x4b, 0x0a,
+	0x18, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0d, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x2e, 0x6d, 0x69,
+	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12,
+	0x47, 0x0a, 0x0f, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x23, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x11, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x44, 0x12, 0x25, 0x2e,
 	0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x13, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x44, 0x12, 0x27, 0x2e, 0x6d,
+	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x32, 0x9d, 0x04, 0x0a, 0x03, 0x55, 0x44, 0x42, 0x12, 0x41,
+	0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x20,
+	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x55, 0x44, 0x42,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22,
-	0x00, 0x12, 0x47, 0x0a, 0x0f, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x11, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x44, 0x12,
-	0x25, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x13, 0x55, 0x6e, 0x72, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x44, 0x12, 0x27,
-	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x72,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x32, 0x9d, 0x04, 0x0a, 0x03, 0x55, 0x44, 0x42,
-	0x12, 0x41, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72,
-	0x12, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x55,
-	0x44, 0x42, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63,
-	0x6b, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x12, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63,
-	0x6b, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46,
-	0x61, 0x63, 0x74, 0x12, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x00, 0x12, 0x3e, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61,
+	0x63, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22,
+	0x00, 0x12, 0x55, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x61, 0x63,
+	0x74, 0x12, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
 	0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0b, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x46, 0x61, 0x63, 0x74, 0x12, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0a, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x61, 0x63, 0x74, 0x12, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x13, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61,
-	0x73, 0x65, 0x12, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x10, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x2e,
-	0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x32, 0xed, 0x01, 0x0a, 0x0a, 0x41, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x41, 0x75, 0x74,
-	0x68, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b,
-	0x22, 0x00, 0x12, 0x42, 0x0a, 0x0b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x65, 0x72,
-	0x74, 0x12, 0x22, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x15, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x45, 0x41, 0x42, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12,
-	0x21, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x45, 0x41,
-	0x42, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x45, 0x41, 0x42, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xc3, 0x03, 0x0a, 0x0a, 0x52, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x54, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
-	0x12, 0x24, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52,
-	0x73, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a,
-	0x04, 0x52, 0x65, 0x61, 0x64, 0x12, 0x1a, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33,
-	0x0a, 0x05, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x41, 0x63, 0x6b, 0x12, 0x4f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x6f,
-	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x1a, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x52, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x57,
-	0x72, 0x69, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x52, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x07, 0x52, 0x65, 0x61, 0x64, 0x44,
-	0x69, 0x72, 0x12, 0x1a, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
-	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x52,
-	0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x26,
-	0x5a, 0x24, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6c, 0x69,
-	0x78, 0x78, 0x69, 0x72, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x73, 0x2f, 0x6d, 0x69, 0x78, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x46, 0x61, 0x63, 0x74, 0x12, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0a, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x46, 0x61, 0x63, 0x74, 0x12, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x13, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x12, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x2e, 0x6d, 0x69,
+	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x32, 0xed, 0x01, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x65, 0x12, 0x1b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x1a,
+	0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00,
+	0x12, 0x42, 0x0a, 0x0b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x65, 0x72, 0x74, 0x12,
+	0x22, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41,
+	0x63, 0x6b, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x15, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45,
+	0x41, 0x42, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x21, 0x2e,
+	0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x45, 0x41, 0x42, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x45,
+	0x41, 0x42, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xc3, 0x03, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x54, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x24,
+	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x41,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x52, 0x73, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x04, 0x52,
+	0x65, 0x61, 0x64, 0x12, 0x1a, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73,
+	0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x05,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63,
+	0x6b, 0x12, 0x4f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x12, 0x1a, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52,
+	0x73, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x12, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x52, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x52, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x07, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72,
+	0x12, 0x1a, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52,
+	0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d,
+	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x52, 0x65, 0x61,
+	0x64, 0x44, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x4b, 0x0a, 0x09,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3e, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x1a, 0x1c, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x32, 0x4e, 0x0a, 0x05, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x12, 0x45, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x42, 0x26, 0x5a, 0x24, 0x67, 0x69, 0x74,
+	0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6c, 0x69, 0x78, 0x78, 0x69, 0x72, 0x2f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x73, 0x2f, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -6953,7 +8292,7 @@ func file_mixmessages_proto_rawDescGZIP() []byte {
 	return file_mixmessages_proto_rawDescData
 }
 
-var file_mixmessages_proto_msgTypes = make([]protoimpl.MessageInfo, 88)
+var file_mixmessages_proto_msgTypes = make([]protoimpl.MessageInfo, 101)
 var file_mixmessages_proto_goTypes = []interface{}{
 	(*ClientKeyRequest)(nil),                      // 0: mixmessages.ClientKeyRequest
 	(*SignedClientBatchKeyRequest)(nil),           // 1: mixmessages.SignedClientBatchKeyRequest
@@ -6961,272 +8300,328 @@ var file_mixmessages_proto_goTypes = []interface{}{
 	(*ClientKeyResponse)(nil),                     // 3: mixmessages.ClientKeyResponse
 	(*SignedBatchKeyResponse)(nil),                // 4: mixmessages.SignedBatchKeyResponse
 	(*SignedKeyResponse)(nil),                     // 5: mixmessages.SignedKeyResponse
-	(*PostPrecompResult)(nil),                     // 6: mixmessages.PostPrecompResult
-	(*RoundBufferInfo)(nil),                       // 7: mixmessages.RoundBufferInfo
-	(*RoundPublicKey)(nil),                        // 8: mixmessages.RoundPublicKey
-	(*RoundMetrics)(nil),                          // 9: mixmessages.RoundMetrics
-	(*Batch)(nil),                                 // 10: mixmessages.Batch
-	(*CompletedBatch)(nil),                        // 11: mixmessages.CompletedBatch
-	(*BatchInfo)(nil),                             // 12: mixmessages.BatchInfo
-	(*RoundTripPing)(nil),                         // 13: mixmessages.RoundTripPing
-	(*ServerPoll)(nil),                            // 14: mixmessages.ServerPoll
-	(*ServerPollResponse)(nil),                    // 15: mixmessages.ServerPollResponse
-	(*BatchReady)(nil),                            // 16: mixmessages.BatchReady
-	(*SharePiece)(nil),                            // 17: mixmessages.SharePiece
-	(*RequestGatewayCert)(nil),                    // 18: mixmessages.RequestGatewayCert
-	(*GatewayCertificate)(nil),                    // 19: mixmessages.GatewayCertificate
-	(*StreamChunk)(nil),                           // 20: mixmessages.StreamChunk
-	(*HistoricalRounds)(nil),                      // 21: mixmessages.HistoricalRounds
-	(*HistoricalRoundsResponse)(nil),              // 22: mixmessages.HistoricalRoundsResponse
-	(*GetMessagesBatch)(nil),                      // 23: mixmessages.GetMessagesBatch
-	(*GetMessagesResponseBatch)(nil),              // 24: mixmessages.GetMessagesResponseBatch
-	(*GetMessages)(nil),                           // 25: mixmessages.GetMessages
-	(*GetMessagesResponse)(nil),                   // 26: mixmessages.GetMessagesResponse
-	(*RoundMessages)(nil),                         // 27: mixmessages.RoundMessages
-	(*IDList)(nil),                                // 28: mixmessages.IDList
-	(*Slot)(nil),                                  // 29: mixmessages.Slot
-	(*GatewayPoll)(nil),                           // 30: mixmessages.GatewayPoll
-	(*GatewayPollResponse)(nil),                   // 31: mixmessages.GatewayPollResponse
-	(*ClientBlooms)(nil),                          // 32: mixmessages.ClientBlooms
-	(*ClientBloom)(nil),                           // 33: mixmessages.ClientBloom
-	(*GatewaySlots)(nil),                          // 34: mixmessages.GatewaySlots
-	(*GatewaySlot)(nil),                           // 35: mixmessages.GatewaySlot
-	(*GatewaySlotResponse)(nil),                   // 36: mixmessages.GatewaySlotResponse
-	(*BatchSenders)(nil),                          // 37: mixmessages.BatchSenders
-	(*Recipients)(nil),                            // 38: mixmessages.Recipients
-	(*RegisteredNodeConfirmation)(nil),            // 39: mixmessages.RegisteredNodeConfirmation
-	(*RegisteredNodeCheck)(nil),                   // 40: mixmessages.RegisteredNodeCheck
-	(*NDFHash)(nil),                               // 41: mixmessages.NDFHash
-	(*NDF)(nil),                                   // 42: mixmessages.NDF
-	(*NodeRegistration)(nil),                      // 43: mixmessages.NodeRegistration
-	(*ClientRegistration)(nil),                    // 44: mixmessages.ClientRegistration
-	(*ClientRegistrationConfirmation)(nil),        // 45: mixmessages.ClientRegistrationConfirmation
-	(*SignedRegistrationConfirmation)(nil),        // 46: mixmessages.SignedRegistrationConfirmation
-	(*SignedClientRegistrationConfirmations)(nil), // 47: mixmessages.SignedClientRegistrationConfirmations
-	(*ClientVersion)(nil),                         // 48: mixmessages.ClientVersion
-	(*PermissioningPoll)(nil),                     // 49: mixmessages.PermissioningPoll
-	(*ClientError)(nil),                           // 50: mixmessages.ClientError
-	(*PermissionPollResponse)(nil),                // 51: mixmessages.PermissionPollResponse
-	(*RegisterTokenRequest)(nil),                  // 52: mixmessages.RegisterTokenRequest
-	(*UnregisterTokenRequest)(nil),                // 53: mixmessages.UnregisterTokenRequest
-	(*UnregisterTrackedIdRequest)(nil),            // 54: mixmessages.UnregisterTrackedIdRequest
-	(*RegisterTrackedIdRequest)(nil),              // 55: mixmessages.RegisterTrackedIdRequest
-	(*TrackedIntermediaryIdRequest)(nil),          // 56: mixmessages.TrackedIntermediaryIdRequest
-	(*NotificationRegisterRequest)(nil),           // 57: mixmessages.NotificationRegisterRequest
-	(*NotificationUnregisterRequest)(nil),         // 58: mixmessages.NotificationUnregisterRequest
-	(*UserIdList)(nil),                            // 59: mixmessages.UserIdList
-	(*NotificationBatch)(nil),                     // 60: mixmessages.NotificationBatch
-	(*NotificationData)(nil),                      // 61: mixmessages.NotificationData
-	(*ChannelLeaseRequest)(nil),                   // 62: mixmessages.ChannelLeaseRequest
-	(*ChannelLeaseResponse)(nil),                  // 63: mixmessages.ChannelLeaseResponse
-	(*UsernameValidationRequest)(nil),             // 64: mixmessages.UsernameValidationRequest
-	(*UsernameValidation)(nil),                    // 65: mixmessages.UsernameValidation
-	(*UDBUserRegistration)(nil),                   // 66: mixmessages.UDBUserRegistration
-	(*Identity)(nil),                              // 67: mixmessages.Identity
-	(*FactRegisterRequest)(nil),                   // 68: mixmessages.FactRegisterRequest
-	(*Fact)(nil),                                  // 69: mixmessages.Fact
-	(*FactRegisterResponse)(nil),                  // 70: mixmessages.FactRegisterResponse
-	(*FactConfirmRequest)(nil),                    // 71: mixmessages.FactConfirmRequest
-	(*FactRemovalRequest)(nil),                    // 72: mixmessages.FactRemovalRequest
-	(*StrAddress)(nil),                            // 73: mixmessages.StrAddress
-	(*RoundInfo)(nil),                             // 74: mixmessages.RoundInfo
-	(*RoundError)(nil),                            // 75: mixmessages.RoundError
-	(*EABCredentialRequest)(nil),                  // 76: mixmessages.EABCredentialRequest
-	(*EABCredentialResponse)(nil),                 // 77: mixmessages.EABCredentialResponse
-	(*AuthorizerCertRequest)(nil),                 // 78: mixmessages.AuthorizerCertRequest
-	(*AuthorizerAuth)(nil),                        // 79: mixmessages.AuthorizerAuth
-	(*RsAuthenticationRequest)(nil),               // 80: mixmessages.RsAuthenticationRequest
-	(*RsAuthenticationResponse)(nil),              // 81: mixmessages.RsAuthenticationResponse
-	(*RsReadRequest)(nil),                         // 82: mixmessages.RsReadRequest
-	(*RsLastWriteRequest)(nil),                    // 83: mixmessages.RsLastWriteRequest
-	(*RsReadResponse)(nil),                        // 84: mixmessages.RsReadResponse
-	(*RsWriteRequest)(nil),                        // 85: mixmessages.RsWriteRequest
-	(*RsReadDirResponse)(nil),                     // 86: mixmessages.RsReadDirResponse
-	(*RsTimestampResponse)(nil),                   // 87: mixmessages.RsTimestampResponse
-	(*messages.RSASignature)(nil),                 // 88: messages.RSASignature
-	(*anypb.Any)(nil),                             // 89: google.protobuf.Any
-	(*messages.ECCSignature)(nil),                 // 90: messages.ECCSignature
-	(*messages.AuthenticatedMessage)(nil),         // 91: messages.AuthenticatedMessage
-	(*messages.Ping)(nil),                         // 92: messages.Ping
-	(*messages.Ack)(nil),                          // 93: messages.Ack
+	(*RegistrationReceipt)(nil),                   // 6: mixmessages.RegistrationReceipt
+	(*PostPrecompResult)(nil),                     // 7: mixmessages.PostPrecompResult
+	(*RoundBufferInfo)(nil),                       // 8: mixmessages.RoundBufferInfo
+	(*RoundPublicKey)(nil),                        // 9: mixmessages.RoundPublicKey
+	(*RoundMetrics)(nil),                          // 10: mixmessages.RoundMetrics
+	(*Batch)(nil),                                 // 11: mixmessages.Batch
+	(*CompletedBatch)(nil),                        // 12: mixmessages.CompletedBatch
+	(*BatchInfo)(nil),                             // 13: mixmessages.BatchInfo
+	(*RoundTripPing)(nil),                         // 14: mixmessages.RoundTripPing
+	(*ServerPoll)(nil),                            // 15: mixmessages.ServerPoll
+	(*ServerPollResponse)(nil),                    // 16: mixmessages.ServerPollResponse
+	(*BatchReady)(nil),                            // 17: mixmessages.BatchReady
+	(*SharePiece)(nil),                            // 18: mixmessages.SharePiece
+	(*AddressUpdate)(nil),                         // 19: mixmessages.AddressUpdate
+	(*RequestGatewayCert)(nil),                    // 20: mixmessages.RequestGatewayCert
+	(*GatewayCertificate)(nil),                    // 21: mixmessages.GatewayCertificate
+	(*StreamChunk)(nil),                           // 22: mixmessages.StreamChunk
+	(*HistoricalRounds)(nil),                      // 23: mixmessages.HistoricalRounds
+	(*HistoricalRoundsResponse)(nil),              // 24: mixmessages.HistoricalRoundsResponse
+	(*GetMessagesBatch)(nil),                      // 25: mixmessages.GetMessagesBatch
+	(*GetMessagesResponseBatch)(nil),              // 26: mixmessages.GetMessagesResponseBatch
+	(*GetMessages)(nil),                           // 27: mixmessages.GetMessages
+	(*GetMessagesResponse)(nil),                   // 28: mixmessages.GetMessagesResponse
+	(*MessageRetentionPolicy)(nil),                // 29: mixmessages.MessageRetentionPolicy
+	(*RoundMessages)(nil),                         // 30: mixmessages.RoundMessages
+	(*MirroredMessages)(nil),                      // 31: mixmessages.MirroredMessages
+	(*MirrorManifest)(nil),                        // 32: mixmessages.MirrorManifest
+	(*IDList)(nil),                                // 33: mixmessages.IDList
+	(*Slot)(nil),                                  // 34: mixmessages.Slot
+	(*GatewayPoll)(nil),                           // 35: mixmessages.GatewayPoll
+	(*GatewayPollResponse)(nil),                   // 36: mixmessages.GatewayPollResponse
+	(*ClientBlooms)(nil),                          // 37: mixmessages.ClientBlooms
+	(*ClientBloom)(nil),                           // 38: mixmessages.ClientBloom
+	(*GatewaySlots)(nil),                          // 39: mixmessages.GatewaySlots
+	(*GatewaySlot)(nil),                           // 40: mixmessages.GatewaySlot
+	(*GatewaySlotResponse)(nil),                   // 41: mixmessages.GatewaySlotResponse
+	(*InclusionProof)(nil),                        // 42: mixmessages.InclusionProof
+	(*RelayedMessage)(nil),                        // 43: mixmessages.RelayedMessage
+	(*BatchSenders)(nil),                          // 44: mixmessages.BatchSenders
+	(*Recipients)(nil),                            // 45: mixmessages.Recipients
+	(*RoundMetricsReport)(nil),                    // 46: mixmessages.RoundMetricsReport
+	(*PhaseTiming)(nil),                           // 47: mixmessages.PhaseTiming
+	(*ResourceUsage)(nil),                         // 48: mixmessages.ResourceUsage
+	(*RoundTripPingTiming)(nil),                   // 49: mixmessages.RoundTripPingTiming
+	(*RegisteredNodeConfirmation)(nil),            // 50: mixmessages.RegisteredNodeConfirmation
+	(*RegisteredNodeCheck)(nil),                   // 51: mixmessages.RegisteredNodeCheck
+	(*NDFHash)(nil),                               // 52: mixmessages.NDFHash
+	(*NDF)(nil),                                   // 53: mixmessages.NDF
+	(*NodeRegistration)(nil),                      // 54: mixmessages.NodeRegistration
+	(*ClientRegistration)(nil),                    // 55: mixmessages.ClientRegistration
+	(*ClientRegistrationConfirmation)(nil),        // 56: mixmessages.ClientRegistrationConfirmation
+	(*SignedRegistrationConfirmation)(nil),        // 57: mixmessages.SignedRegistrationConfirmation
+	(*SignedClientRegistrationConfirmations)(nil), // 58: mixmessages.SignedClientRegistrationConfirmations
+	(*ClientVersion)(nil),                         // 59: mixmessages.ClientVersion
+	(*PermissioningPoll)(nil),                     // 60: mixmessages.PermissioningPoll
+	(*ClientError)(nil),                           // 61: mixmessages.ClientError
+	(*PermissionPollResponse)(nil),                // 62: mixmessages.PermissionPollResponse
+	(*RegisterTokenRequest)(nil),                  // 63: mixmessages.RegisterTokenRequest
+	(*UnregisterTokenRequest)(nil),                // 64: mixmessages.UnregisterTokenRequest
+	(*UnregisterTrackedIdRequest)(nil),            // 65: mixmessages.UnregisterTrackedIdRequest
+	(*RegisterTrackedIdRequest)(nil),              // 66: mixmessages.RegisterTrackedIdRequest
+	(*TrackedIntermediaryIdRequest)(nil),          // 67: mixmessages.TrackedIntermediaryIdRequest
+	(*NotificationRegisterRequest)(nil),           // 68: mixmessages.NotificationRegisterRequest
+	(*NotificationUnregisterRequest)(nil),         // 69: mixmessages.NotificationUnregisterRequest
+	(*UserIdList)(nil),                            // 70: mixmessages.UserIdList
+	(*NotificationBatch)(nil),                     // 71: mixmessages.NotificationBatch
+	(*NotificationData)(nil),                      // 72: mixmessages.NotificationData
+	(*ChannelLeaseRequest)(nil),                   // 73: mixmessages.ChannelLeaseRequest
+	(*ChannelLeaseResponse)(nil),                  // 74: mixmessages.ChannelLeaseResponse
+	(*UsernameValidationRequest)(nil),             // 75: mixmessages.UsernameValidationRequest
+	(*UsernameValidation)(nil),                    // 76: mixmessages.UsernameValidation
+	(*UDBUserRegistration)(nil),                   // 77: mixmessages.UDBUserRegistration
+	(*Identity)(nil),                              // 78: mixmessages.Identity
+	(*FactRegisterRequest)(nil),                   // 79: mixmessages.FactRegisterRequest
+	(*Fact)(nil),                                  // 80: mixmessages.Fact
+	(*FactRegisterResponse)(nil),                  // 81: mixmessages.FactRegisterResponse
+	(*FactConfirmRequest)(nil),                    // 82: mixmessages.FactConfirmRequest
+	(*FactRemovalRequest)(nil),                    // 83: mixmessages.FactRemovalRequest
+	(*StrAddress)(nil),                            // 84: mixmessages.StrAddress
+	(*RoundInfo)(nil),                             // 85: mixmessages.RoundInfo
+	(*RoundError)(nil),                            // 86: mixmessages.RoundError
+	(*EABCredentialRequest)(nil),                  // 87: mixmessages.EABCredentialRequest
+	(*EABCredentialResponse)(nil),                 // 88: mixmessages.EABCredentialResponse
+	(*AuthorizerCertRequest)(nil),                 // 89: mixmessages.AuthorizerCertRequest
+	(*AuthorizerAuth)(nil),                        // 90: mixmessages.AuthorizerAuth
+	(*RsAuthenticationRequest)(nil),               // 91: mixmessages.RsAuthenticationRequest
+	(*RsAuthenticationResponse)(nil),              // 92: mixmessages.RsAuthenticationResponse
+	(*RsReadRequest)(nil),                         // 93: mixmessages.RsReadRequest
+	(*RsLastWriteRequest)(nil),                    // 94: mixmessages.RsLastWriteRequest
+	(*RsReadResponse)(nil),                        // 95: mixmessages.RsReadResponse
+	(*RsWriteRequest)(nil),                        // 96: mixmessages.RsWriteRequest
+	(*RsReadDirResponse)(nil),                     // 97: mixmessages.RsReadDirResponse
+	(*RsTimestampResponse)(nil),                   // 98: mixmessages.RsTimestampResponse
+	(*ServerBuildInfo)(nil),                       // 99: mixmessages.ServerBuildInfo
+	(*SetEndpointEnabled)(nil),                    // 100: mixmessages.SetEndpointEnabled
+	(*messages.RSASignature)(nil),                 // 101: messages.RSASignature
+	(*anypb.Any)(nil),                             // 102: google.protobuf.Any
+	(*messages.ECCSignature)(nil),                 // 103: messages.ECCSignature
+	(*messages.AuthenticatedMessage)(nil),         // 104: messages.AuthenticatedMessage
+	(*messages.Ping)(nil),                         // 105: messages.Ping
+	(*messages.Ack)(nil),                          // 106: messages.Ack
+	(*messages.AssignToken)(nil),                  // 107: messages.AssignToken
 }
 var file_mixmessages_proto_depIdxs = []int32{
-	46,  // 0: mixmessages.ClientKeyRequest.ClientTransmissionConfirmation:type_name -> mixmessages.SignedRegistrationConfirmation
-	88,  // 1: mixmessages.SignedClientBatchKeyRequest.ClientKeyRequestSignature:type_name -> messages.RSASignature
-	88,  // 2: mixmessages.SignedClientKeyRequest.ClientKeyRequestSignature:type_name -> messages.RSASignature
+	57,  // 0: mixmessages.ClientKeyRequest.ClientTransmissionConfirmation:type_name -> mixmessages.SignedRegistrationConfirmation
+	101, // 1: mixmessages.SignedClientBatchKeyRequest.ClientKeyRequestSignature:type_name -> messages.RSASignature
+	101, // 2: mixmessages.SignedClientKeyRequest.ClientKeyRequestSignature:type_name -> messages.RSASignature
 	5,   // 3: mixmessages.SignedBatchKeyResponse.SignedKeys:type_name -> mixmessages.SignedKeyResponse
-	88,  // 4: mixmessages.SignedKeyResponse.KeyResponseSignedByGateway:type_name -> messages.RSASignature
-	74,  // 5: mixmessages.RoundPublicKey.Round:type_name -> mixmessages.RoundInfo
-	74,  // 6: mixmessages.Batch.Round:type_name -> mixmessages.RoundInfo
-	29,  // 7: mixmessages.Batch.slots:type_name -> mixmessages.Slot
-	29,  // 8: mixmessages.CompletedBatch.slots:type_name -> mixmessages.Slot
-	74,  // 9: mixmessages.BatchInfo.Round:type_name -> mixmessages.RoundInfo
-	89,  // 10: mixmessages.RoundTripPing.Payload:type_name -> google.protobuf.Any
-	74,  // 11: mixmessages.RoundTripPing.Round:type_name -> mixmessages.RoundInfo
-	41,  // 12: mixmessages.ServerPoll.Full:type_name -> mixmessages.NDFHash
-	41,  // 13: mixmessages.ServerPoll.Partial:type_name -> mixmessages.NDFHash
-	42,  // 14: mixmessages.ServerPollResponse.FullNDF:type_name -> mixmessages.NDF
-	42,  // 15: mixmessages.ServerPollResponse.PartialNDF:type_name -> mixmessages.NDF
-	74,  // 16: mixmessages.ServerPollResponse.Updates:type_name -> mixmessages.RoundInfo
-	74,  // 17: mixmessages.ServerPollResponse.BatchRequest:type_name -> mixmessages.RoundInfo
-	16,  // 18: mixmessages.ServerPollResponse.Batch:type_name -> mixmessages.BatchReady
-	88,  // 19: mixmessages.SharePiece.Signature:type_name -> messages.RSASignature
-	74,  // 20: mixmessages.HistoricalRoundsResponse.Rounds:type_name -> mixmessages.RoundInfo
-	25,  // 21: mixmessages.GetMessagesBatch.Requests:type_name -> mixmessages.GetMessages
-	26,  // 22: mixmessages.GetMessagesResponseBatch.Results:type_name -> mixmessages.GetMessagesResponse
-	29,  // 23: mixmessages.GetMessagesResponse.Messages:type_name -> mixmessages.Slot
-	29,  // 24: mixmessages.RoundMessages.Messages:type_name -> mixmessages.Slot
-	41,  // 25: mixmessages.GatewayPoll.Partial:type_name -> mixmessages.NDFHash
-	42,  // 26: mixmessages.GatewayPollResponse.PartialNDF:type_name -> mixmessages.NDF
-	74,  // 27: mixmessages.GatewayPollResponse.Updates:type_name -> mixmessages.RoundInfo
-	32,  // 28: mixmessages.GatewayPollResponse.Filters:type_name -> mixmessages.ClientBlooms
-	33,  // 29: mixmessages.ClientBlooms.Filters:type_name -> mixmessages.ClientBloom
-	35,  // 30: mixmessages.GatewaySlots.Messages:type_name -> mixmessages.GatewaySlot
-	29,  // 31: mixmessages.GatewaySlot.Message:type_name -> mixmessages.Slot
-	88,  // 32: mixmessages.NDF.Signature:type_name -> messages.RSASignature
-	88,  // 33: mixmessages.SignedRegistrationConfirmation.RegistrarSignature:type_name -> messages.RSASignature
-	46,  // 34: mixmessages.SignedClientRegistrationConfirmations.ClientTransmissionConfirmation:type_name -> mixmessages.SignedRegistrationConfirmation
-	46,  // 35: mixmessages.SignedClientRegistrationConfirmations.ClientReceptionConfirmation:type_name -> mixmessages.SignedRegistrationConfirmation
-	41,  // 36: mixmessages.PermissioningPoll.Full:type_name -> mixmessages.NDFHash
-	41,  // 37: mixmessages.PermissioningPoll.Partial:type_name -> mixmessages.NDFHash
-	75,  // 38: mixmessages.PermissioningPoll.Error:type_name -> mixmessages.RoundError
-	50,  // 39: mixmessages.PermissioningPoll.ClientErrors:type_name -> mixmessages.ClientError
-	42,  // 40: mixmessages.PermissionPollResponse.FullNDF:type_name -> mixmessages.NDF
-	42,  // 41: mixmessages.PermissionPollResponse.PartialNDF:type_name -> mixmessages.NDF
-	74,  // 42: mixmessages.PermissionPollResponse.Updates:type_name -> mixmessages.RoundInfo
-	56,  // 43: mixmessages.UnregisterTrackedIdRequest.Request:type_name -> mixmessages.TrackedIntermediaryIdRequest
-	56,  // 44: mixmessages.RegisterTrackedIdRequest.Request:type_name -> mixmessages.TrackedIntermediaryIdRequest
-	61,  // 45: mixmessages.NotificationBatch.notifications:type_name -> mixmessages.NotificationData
-	67,  // 46: mixmessages.UDBUserRegistration.IdentityRegistration:type_name -> mixmessages.Identity
-	68,  // 47: mixmessages.UDBUserRegistration.frs:type_name -> mixmessages.FactRegisterRequest
-	69,  // 48: mixmessages.FactRegisterRequest.Fact:type_name -> mixmessages.Fact
-	69,  // 49: mixmessages.FactRemovalRequest.RemovalData:type_name -> mixmessages.Fact
-	75,  // 50: mixmessages.RoundInfo.Errors:type_name -> mixmessages.RoundError
-	50,  // 51: mixmessages.RoundInfo.ClientErrors:type_name -> mixmessages.ClientError
-	88,  // 52: mixmessages.RoundInfo.Signature:type_name -> messages.RSASignature
-	90,  // 53: mixmessages.RoundInfo.EccSignature:type_name -> messages.ECCSignature
-	88,  // 54: mixmessages.RoundError.Signature:type_name -> messages.RSASignature
-	91,  // 55: mixmessages.Node.AskOnline:input_type -> messages.AuthenticatedMessage
-	91,  // 56: mixmessages.Node.CreateNewRound:input_type -> messages.AuthenticatedMessage
-	29,  // 57: mixmessages.Node.UploadUnmixedBatch:input_type -> mixmessages.Slot
-	29,  // 58: mixmessages.Node.FinishRealtime:input_type -> mixmessages.Slot
-	29,  // 59: mixmessages.Node.PrecompTestBatch:input_type -> mixmessages.Slot
-	91,  // 60: mixmessages.Node.PostPhase:input_type -> messages.AuthenticatedMessage
-	29,  // 61: mixmessages.Node.StreamPostPhase:input_type -> mixmessages.Slot
-	91,  // 62: mixmessages.Node.GetRoundBufferInfo:input_type -> messages.AuthenticatedMessage
-	91,  // 63: mixmessages.Node.RequestClientKey:input_type -> messages.AuthenticatedMessage
-	91,  // 64: mixmessages.Node.PostPrecompResult:input_type -> messages.AuthenticatedMessage
-	91,  // 65: mixmessages.Node.GetMeasure:input_type -> messages.AuthenticatedMessage
-	91,  // 66: mixmessages.Node.Poll:input_type -> messages.AuthenticatedMessage
-	91,  // 67: mixmessages.Node.DownloadMixedBatch:input_type -> messages.AuthenticatedMessage
-	91,  // 68: mixmessages.Node.SendRoundTripPing:input_type -> messages.AuthenticatedMessage
-	91,  // 69: mixmessages.Node.RoundError:input_type -> messages.AuthenticatedMessage
-	92,  // 70: mixmessages.Node.GetPermissioningAddress:input_type -> messages.Ping
-	91,  // 71: mixmessages.Node.StartSharePhase:input_type -> messages.AuthenticatedMessage
-	91,  // 72: mixmessages.Node.SharePhaseRound:input_type -> messages.AuthenticatedMessage
-	91,  // 73: mixmessages.Node.ShareFinalKey:input_type -> messages.AuthenticatedMessage
-	2,   // 74: mixmessages.Gateway.RequestClientKey:input_type -> mixmessages.SignedClientKeyRequest
-	1,   // 75: mixmessages.Gateway.BatchNodeRegistration:input_type -> mixmessages.SignedClientBatchKeyRequest
-	35,  // 76: mixmessages.Gateway.PutMessage:input_type -> mixmessages.GatewaySlot
-	34,  // 77: mixmessages.Gateway.PutManyMessages:input_type -> mixmessages.GatewaySlots
-	91,  // 78: mixmessages.Gateway.PutMessageProxy:input_type -> messages.AuthenticatedMessage
-	91,  // 79: mixmessages.Gateway.PutManyMessagesProxy:input_type -> messages.AuthenticatedMessage
-	30,  // 80: mixmessages.Gateway.Poll:input_type -> mixmessages.GatewayPoll
-	21,  // 81: mixmessages.Gateway.RequestHistoricalRounds:input_type -> mixmessages.HistoricalRounds
-	25,  // 82: mixmessages.Gateway.RequestMessages:input_type -> mixmessages.GetMessages
-	23,  // 83: mixmessages.Gateway.RequestBatchMessages:input_type -> mixmessages.GetMessagesBatch
-	18,  // 84: mixmessages.Gateway.RequestTlsCert:input_type -> mixmessages.RequestGatewayCert
-	44,  // 85: mixmessages.ClientRegistrar.RegisterUser:input_type -> mixmessages.ClientRegistration
-	43,  // 86: mixmessages.Registration.RegisterNode:input_type -> mixmessages.NodeRegistration
-	41,  // 87: mixmessages.Registration.PollNdf:input_type -> mixmessages.NDFHash
-	91,  // 88: mixmessages.Registration.Poll:input_type -> messages.AuthenticatedMessage
-	40,  // 89: mixmessages.Registration.CheckRegistration:input_type -> mixmessages.RegisteredNodeCheck
-	58,  // 90: mixmessages.NotificationBot.UnregisterForNotifications:input_type -> mixmessages.NotificationUnregisterRequest
-	57,  // 91: mixmessages.NotificationBot.RegisterForNotifications:input_type -> mixmessages.NotificationRegisterRequest
-	91,  // 92: mixmessages.NotificationBot.ReceiveNotificationBatch:input_type -> messages.AuthenticatedMessage
-	52,  // 93: mixmessages.NotificationBot.RegisterToken:input_type -> mixmessages.RegisterTokenRequest
-	53,  // 94: mixmessages.NotificationBot.UnregisterToken:input_type -> mixmessages.UnregisterTokenRequest
-	55,  // 95: mixmessages.NotificationBot.RegisterTrackedID:input_type -> mixmessages.RegisterTrackedIdRequest
-	54,  // 96: mixmessages.NotificationBot.UnregisterTrackedID:input_type -> mixmessages.UnregisterTrackedIdRequest
-	66,  // 97: mixmessages.UDB.RegisterUser:input_type -> mixmessages.UDBUserRegistration
-	72,  // 98: mixmessages.UDB.RemoveUser:input_type -> mixmessages.FactRemovalRequest
-	68,  // 99: mixmessages.UDB.RegisterFact:input_type -> mixmessages.FactRegisterRequest
-	71,  // 100: mixmessages.UDB.ConfirmFact:input_type -> mixmessages.FactConfirmRequest
-	72,  // 101: mixmessages.UDB.RemoveFact:input_type -> mixmessages.FactRemovalRequest
-	62,  // 102: mixmessages.UDB.RequestChannelLease:input_type -> mixmessages.ChannelLeaseRequest
-	64,  // 103: mixmessages.UDB.ValidateUsername:input_type -> mixmessages.UsernameValidationRequest
-	79,  // 104: mixmessages.Authorizer.Authorize:input_type -> mixmessages.AuthorizerAuth
-	78,  // 105: mixmessages.Authorizer.RequestCert:input_type -> mixmessages.AuthorizerCertRequest
-	76,  // 106: mixmessages.Authorizer.RequestEABCredentials:input_type -> mixmessages.EABCredentialRequest
-	80,  // 107: mixmessages.RemoteSync.Login:input_type -> mixmessages.RsAuthenticationRequest
-	82,  // 108: mixmessages.RemoteSync.Read:input_type -> mixmessages.RsReadRequest
-	85,  // 109: mixmessages.RemoteSync.Write:input_type -> mixmessages.RsWriteRequest
-	82,  // 110: mixmessages.RemoteSync.GetLastModified:input_type -> mixmessages.RsReadRequest
-	83,  // 111: mixmessages.RemoteSync.GetLastWrite:input_type -> mixmessages.RsLastWriteRequest
-	82,  // 112: mixmessages.RemoteSync.ReadDir:input_type -> mixmessages.RsReadRequest
-	93,  // 113: mixmessages.Node.AskOnline:output_type -> messages.Ack
-	93,  // 114: mixmessages.Node.CreateNewRound:output_type -> messages.Ack
-	93,  // 115: mixmessages.Node.UploadUnmixedBatch:output_type -> messages.Ack
-	93,  // 116: mixmessages.Node.FinishRealtime:output_type -> messages.Ack
-	93,  // 117: mixmessages.Node.PrecompTestBatch:output_type -> messages.Ack
-	93,  // 118: mixmessages.Node.PostPhase:output_type -> messages.Ack
-	93,  // 119: mixmessages.Node.StreamPostPhase:output_type -> messages.Ack
-	7,   // 120: mixmessages.Node.GetRoundBufferInfo:output_type -> mixmessages.RoundBufferInfo
-	5,   // 121: mixmessages.Node.RequestClientKey:output_type -> mixmessages.SignedKeyResponse
-	93,  // 122: mixmessages.Node.PostPrecompResult:output_type -> messages.Ack
-	9,   // 123: mixmessages.Node.GetMeasure:output_type -> mixmessages.RoundMetrics
-	15,  // 124: mixmessages.Node.Poll:output_type -> mixmessages.ServerPollResponse
-	29,  // 125: mixmessages.Node.DownloadMixedBatch:output_type -> mixmessages.Slot
-	93,  // 126: mixmessages.Node.SendRoundTripPing:output_type -> messages.Ack
-	93,  // 127: mixmessages.Node.RoundError:output_type -> messages.Ack
-	73,  // 128: mixmessages.Node.GetPermissioningAddress:output_type -> mixmessages.StrAddress
-	93,  // 129: mixmessages.Node.StartSharePhase:output_type -> messages.Ack
-	93,  // 130: mixmessages.Node.SharePhaseRound:output_type -> messages.Ack
-	93,  // 131: mixmessages.Node.ShareFinalKey:output_type -> messages.Ack
-	5,   // 132: mixmessages.Gateway.RequestClientKey:output_type -> mixmessages.SignedKeyResponse
-	4,   // 133: mixmessages.Gateway.BatchNodeRegistration:output_type -> mixmessages.SignedBatchKeyResponse
-	36,  // 134: mixmessages.Gateway.PutMessage:output_type -> mixmessages.GatewaySlotResponse
-	36,  // 135: mixmessages.Gateway.PutManyMessages:output_type -> mixmessages.GatewaySlotResponse
-	36,  // 136: mixmessages.Gateway.PutMessageProxy:output_type -> mixmessages.GatewaySlotResponse
-	36,  // 137: mixmessages.Gateway.PutManyMessagesProxy:output_type -> mixmessages.GatewaySlotResponse
-	20,  // 138: mixmessages.Gateway.Poll:output_type -> mixmessages.StreamChunk
-	22,  // 139: mixmessages.Gateway.RequestHistoricalRounds:output_type -> mixmessages.HistoricalRoundsResponse
-	26,  // 140: mixmessages.Gateway.RequestMessages:output_type -> mixmessages.GetMessagesResponse
-	24,  // 141: mixmessages.Gateway.RequestBatchMessages:output_type -> mixmessages.GetMessagesResponseBatch
-	19,  // 142: mixmessages.Gateway.RequestTlsCert:output_type -> mixmessages.GatewayCertificate
-	47,  // 143: mixmessages.ClientRegistrar.RegisterUser:output_type -> mixmessages.SignedClientRegistrationConfirmations
-	93,  // 144: mixmessages.Registration.RegisterNode:output_type -> messages.Ack
-	42,  // 145: mixmessages.Registration.PollNdf:output_type -> mixmessages.NDF
-	51,  // 146: mixmessages.Registration.Poll:output_type -> mixmessages.PermissionPollResponse
-	39,  // 147: mixmessages.Registration.CheckRegistration:output_type -> mixmessages.RegisteredNodeConfirmation
-	93,  // 148: mixmessages.NotificationBot.UnregisterForNotifications:output_type -> messages.Ack
-	93,  // 149: mixmessages.NotificationBot.RegisterForNotifications:output_type -> messages.Ack
-	93,  // 150: mixmessages.NotificationBot.ReceiveNotificationBatch:output_type -> messages.Ack
-	93,  // 151: mixmessages.NotificationBot.RegisterToken:output_type -> messages.Ack
-	93,  // 152: mixmessages.NotificationBot.UnregisterToken:output_type -> messages.Ack
-	93,  // 153: mixmessages.NotificationBot.RegisterTrackedID:output_type -> messages.Ack
-	93,  // 154: mixmessages.NotificationBot.UnregisterTrackedID:output_type -> messages.Ack
-	93,  // 155: mixmessages.UDB.RegisterUser:output_type -> messages.Ack
-	93,  // 156: mixmessages.UDB.RemoveUser:output_type -> messages.Ack
-	70,  // 157: mixmessages.UDB.RegisterFact:output_type -> mixmessages.FactRegisterResponse
-	93,  // 158: mixmessages.UDB.ConfirmFact:output_type -> messages.Ack
-	93,  // 159: mixmessages.UDB.RemoveFact:output_type -> messages.Ack
-	63,  // 160: mixmessages.UDB.RequestChannelLease:output_type -> mixmessages.ChannelLeaseResponse
-	65,  // 161: mixmessages.UDB.ValidateUsername:output_type -> mixmessages.UsernameValidation
-	93,  // 162: mixmessages.Authorizer.Authorize:output_type -> messages.Ack
-	93,  // 163: mixmessages.Authorizer.RequestCert:output_type -> messages.Ack
-	77,  // 164: mixmessages.Authorizer.RequestEABCredentials:output_type -> mixmessages.EABCredentialResponse
-	81,  // 165: mixmessages.RemoteSync.Login:output_type -> mixmessages.RsAuthenticationResponse
-	84,  // 166: mixmessages.RemoteSync.Read:output_type -> mixmessages.RsReadResponse
-	93,  // 167: mixmessages.RemoteSync.Write:output_type -> messages.Ack
-	87,  // 168: mixmessages.RemoteSync.GetLastModified:output_type -> mixmessages.RsTimestampResponse
-	87,  // 169: mixmessages.RemoteSync.GetLastWrite:output_type -> mixmessages.RsTimestampResponse
-	86,  // 170: mixmessages.RemoteSync.ReadDir:output_type -> mixmessages.RsReadDirResponse
-	113, // [113:171] is the sub-list for method output_type
-	55,  // [55:113] is the sub-list for method input_type
-	55,  // [55:55] is the sub-list for extension type_name
-	55,  // [55:55] is the sub-list for extension extendee
-	0,   // [0:55] is the sub-list for field type_name
+	101, // 4: mixmessages.SignedKeyResponse.KeyResponseSignedByGateway:type_name -> messages.RSASignature
+	6,   // 5: mixmessages.SignedKeyResponse.Receipt:type_name -> mixmessages.RegistrationReceipt
+	101, // 6: mixmessages.RegistrationReceipt.Signature:type_name -> messages.RSASignature
+	85,  // 7: mixmessages.RoundPublicKey.Round:type_name -> mixmessages.RoundInfo
+	85,  // 8: mixmessages.Batch.Round:type_name -> mixmessages.RoundInfo
+	34,  // 9: mixmessages.Batch.slots:type_name -> mixmessages.Slot
+	34,  // 10: mixmessages.CompletedBatch.slots:type_name -> mixmessages.Slot
+	85,  // 11: mixmessages.BatchInfo.Round:type_name -> mixmessages.RoundInfo
+	102, // 12: mixmessages.RoundTripPing.Payload:type_name -> google.protobuf.Any
+	85,  // 13: mixmessages.RoundTripPing.Round:type_name -> mixmessages.RoundInfo
+	52,  // 14: mixmessages.ServerPoll.Full:type_name -> mixmessages.NDFHash
+	52,  // 15: mixmessages.ServerPoll.Partial:type_name -> mixmessages.NDFHash
+	53,  // 16: mixmessages.ServerPollResponse.FullNDF:type_name -> mixmessages.NDF
+	53,  // 17: mixmessages.ServerPollResponse.PartialNDF:type_name -> mixmessages.NDF
+	85,  // 18: mixmessages.ServerPollResponse.Updates:type_name -> mixmessages.RoundInfo
+	85,  // 19: mixmessages.ServerPollResponse.BatchRequest:type_name -> mixmessages.RoundInfo
+	17,  // 20: mixmessages.ServerPollResponse.Batch:type_name -> mixmessages.BatchReady
+	101, // 21: mixmessages.SharePiece.Signature:type_name -> messages.RSASignature
+	101, // 22: mixmessages.AddressUpdate.Signature:type_name -> messages.RSASignature
+	85,  // 23: mixmessages.HistoricalRoundsResponse.Rounds:type_name -> mixmessages.RoundInfo
+	27,  // 24: mixmessages.GetMessagesBatch.Requests:type_name -> mixmessages.GetMessages
+	28,  // 25: mixmessages.GetMessagesResponseBatch.Results:type_name -> mixmessages.GetMessagesResponse
+	29,  // 26: mixmessages.GetMessagesResponseBatch.Retention:type_name -> mixmessages.MessageRetentionPolicy
+	34,  // 27: mixmessages.GetMessagesResponse.Messages:type_name -> mixmessages.Slot
+	29,  // 28: mixmessages.GetMessagesResponse.Retention:type_name -> mixmessages.MessageRetentionPolicy
+	34,  // 29: mixmessages.RoundMessages.Messages:type_name -> mixmessages.Slot
+	30,  // 30: mixmessages.MirroredMessages.Messages:type_name -> mixmessages.RoundMessages
+	32,  // 31: mixmessages.MirroredMessages.Manifest:type_name -> mixmessages.MirrorManifest
+	101, // 32: mixmessages.MirrorManifest.Signature:type_name -> messages.RSASignature
+	52,  // 33: mixmessages.GatewayPoll.Partial:type_name -> mixmessages.NDFHash
+	53,  // 34: mixmessages.GatewayPollResponse.PartialNDF:type_name -> mixmessages.NDF
+	85,  // 35: mixmessages.GatewayPollResponse.Updates:type_name -> mixmessages.RoundInfo
+	37,  // 36: mixmessages.GatewayPollResponse.Filters:type_name -> mixmessages.ClientBlooms
+	38,  // 37: mixmessages.ClientBlooms.Filters:type_name -> mixmessages.ClientBloom
+	40,  // 38: mixmessages.GatewaySlots.Messages:type_name -> mixmessages.GatewaySlot
+	34,  // 39: mixmessages.GatewaySlot.Message:type_name -> mixmessages.Slot
+	42,  // 40: mixmessages.GatewaySlotResponse.Proof:type_name -> mixmessages.InclusionProof
+	29,  // 41: mixmessages.GatewaySlotResponse.Retention:type_name -> mixmessages.MessageRetentionPolicy
+	101, // 42: mixmessages.InclusionProof.Signature:type_name -> messages.RSASignature
+	47,  // 43: mixmessages.RoundMetricsReport.Phases:type_name -> mixmessages.PhaseTiming
+	48,  // 44: mixmessages.RoundMetricsReport.Resources:type_name -> mixmessages.ResourceUsage
+	49,  // 45: mixmessages.RoundMetricsReport.RoundTripPings:type_name -> mixmessages.RoundTripPingTiming
+	101, // 46: mixmessages.NDF.Signature:type_name -> messages.RSASignature
+	101, // 47: mixmessages.SignedRegistrationConfirmation.RegistrarSignature:type_name -> messages.RSASignature
+	57,  // 48: mixmessages.SignedClientRegistrationConfirmations.ClientTransmissionConfirmation:type_name -> mixmessages.SignedRegistrationConfirmation
+	57,  // 49: mixmessages.SignedClientRegistrationConfirmations.ClientReceptionConfirmation:type_name -> mixmessages.SignedRegistrationConfirmation
+	52,  // 50: mixmessages.PermissioningPoll.Full:type_name -> mixmessages.NDFHash
+	52,  // 51: mixmessages.PermissioningPoll.Partial:type_name -> mixmessages.NDFHash
+	86,  // 52: mixmessages.PermissioningPoll.Error:type_name -> mixmessages.RoundError
+	61,  // 53: mixmessages.PermissioningPoll.ClientErrors:type_name -> mixmessages.ClientError
+	53,  // 54: mixmessages.PermissionPollResponse.FullNDF:type_name -> mixmessages.NDF
+	53,  // 55: mixmessages.PermissionPollResponse.PartialNDF:type_name -> mixmessages.NDF
+	85,  // 56: mixmessages.PermissionPollResponse.Updates:type_name -> mixmessages.RoundInfo
+	67,  // 57: mixmessages.UnregisterTrackedIdRequest.Request:type_name -> mixmessages.TrackedIntermediaryIdRequest
+	67,  // 58: mixmessages.RegisterTrackedIdRequest.Request:type_name -> mixmessages.TrackedIntermediaryIdRequest
+	72,  // 59: mixmessages.NotificationBatch.notifications:type_name -> mixmessages.NotificationData
+	78,  // 60: mixmessages.UDBUserRegistration.IdentityRegistration:type_name -> mixmessages.Identity
+	79,  // 61: mixmessages.UDBUserRegistration.frs:type_name -> mixmessages.FactRegisterRequest
+	80,  // 62: mixmessages.FactRegisterRequest.Fact:type_name -> mixmessages.Fact
+	80,  // 63: mixmessages.FactRemovalRequest.RemovalData:type_name -> mixmessages.Fact
+	86,  // 64: mixmessages.RoundInfo.Errors:type_name -> mixmessages.RoundError
+	61,  // 65: mixmessages.RoundInfo.ClientErrors:type_name -> mixmessages.ClientError
+	101, // 66: mixmessages.RoundInfo.Signature:type_name -> messages.RSASignature
+	103, // 67: mixmessages.RoundInfo.EccSignature:type_name -> messages.ECCSignature
+	101, // 68: mixmessages.RoundError.Signature:type_name -> messages.RSASignature
+	104, // 69: mixmessages.Node.AskOnline:input_type -> messages.AuthenticatedMessage
+	104, // 70: mixmessages.Node.CreateNewRound:input_type -> messages.AuthenticatedMessage
+	34,  // 71: mixmessages.Node.UploadUnmixedBatch:input_type -> mixmessages.Slot
+	34,  // 72: mixmessages.Node.FinishRealtime:input_type -> mixmessages.Slot
+	34,  // 73: mixmessages.Node.PrecompTestBatch:input_type -> mixmessages.Slot
+	104, // 74: mixmessages.Node.PostPhase:input_type -> messages.AuthenticatedMessage
+	34,  // 75: mixmessages.Node.StreamPostPhase:input_type -> mixmessages.Slot
+	104, // 76: mixmessages.Node.GetRoundBufferInfo:input_type -> messages.AuthenticatedMessage
+	104, // 77: mixmessages.Node.RequestClientKey:input_type -> messages.AuthenticatedMessage
+	104, // 78: mixmessages.Node.PostPrecompResult:input_type -> messages.AuthenticatedMessage
+	104, // 79: mixmessages.Node.GetMeasure:input_type -> messages.AuthenticatedMessage
+	104, // 80: mixmessages.Node.Poll:input_type -> messages.AuthenticatedMessage
+	104, // 81: mixmessages.Node.DownloadMixedBatch:input_type -> messages.AuthenticatedMessage
+	104, // 82: mixmessages.Node.SendRoundTripPing:input_type -> messages.AuthenticatedMessage
+	104, // 83: mixmessages.Node.RoundError:input_type -> messages.AuthenticatedMessage
+	105, // 84: mixmessages.Node.GetPermissioningAddress:input_type -> messages.Ping
+	104, // 85: mixmessages.Node.StartSharePhase:input_type -> messages.AuthenticatedMessage
+	104, // 86: mixmessages.Node.SharePhaseRound:input_type -> messages.AuthenticatedMessage
+	104, // 87: mixmessages.Node.ShareFinalKey:input_type -> messages.AuthenticatedMessage
+	104, // 88: mixmessages.Node.ReservePrecomputation:input_type -> messages.AuthenticatedMessage
+	104, // 89: mixmessages.Node.ConfirmPrecomputation:input_type -> messages.AuthenticatedMessage
+	104, // 90: mixmessages.Node.ReleasePrecomputation:input_type -> messages.AuthenticatedMessage
+	2,   // 91: mixmessages.Gateway.RequestClientKey:input_type -> mixmessages.SignedClientKeyRequest
+	1,   // 92: mixmessages.Gateway.BatchNodeRegistration:input_type -> mixmessages.SignedClientBatchKeyRequest
+	40,  // 93: mixmessages.Gateway.PutMessage:input_type -> mixmessages.GatewaySlot
+	39,  // 94: mixmessages.Gateway.PutManyMessages:input_type -> mixmessages.GatewaySlots
+	104, // 95: mixmessages.Gateway.PutMessageProxy:input_type -> messages.AuthenticatedMessage
+	104, // 96: mixmessages.Gateway.PutManyMessagesProxy:input_type -> messages.AuthenticatedMessage
+	35,  // 97: mixmessages.Gateway.Poll:input_type -> mixmessages.GatewayPoll
+	23,  // 98: mixmessages.Gateway.RequestHistoricalRounds:input_type -> mixmessages.HistoricalRounds
+	27,  // 99: mixmessages.Gateway.RequestMessages:input_type -> mixmessages.GetMessages
+	25,  // 100: mixmessages.Gateway.RequestBatchMessages:input_type -> mixmessages.GetMessagesBatch
+	20,  // 101: mixmessages.Gateway.RequestTlsCert:input_type -> mixmessages.RequestGatewayCert
+	104, // 102: mixmessages.Gateway.NotifyAddressUpdate:input_type -> messages.AuthenticatedMessage
+	104, // 103: mixmessages.Gateway.MirrorMessages:input_type -> messages.AuthenticatedMessage
+	35,  // 104: mixmessages.Gateway.StreamRoundUpdates:input_type -> mixmessages.GatewayPoll
+	43,  // 105: mixmessages.Gateway.RelayMessage:input_type -> mixmessages.RelayedMessage
+	40,  // 106: mixmessages.Gateway.RequestInclusionProof:input_type -> mixmessages.GatewaySlot
+	104, // 107: mixmessages.Gateway.UpdateHostAddress:input_type -> messages.AuthenticatedMessage
+	55,  // 108: mixmessages.ClientRegistrar.RegisterUser:input_type -> mixmessages.ClientRegistration
+	54,  // 109: mixmessages.Registration.RegisterNode:input_type -> mixmessages.NodeRegistration
+	52,  // 110: mixmessages.Registration.PollNdf:input_type -> mixmessages.NDFHash
+	104, // 111: mixmessages.Registration.Poll:input_type -> messages.AuthenticatedMessage
+	51,  // 112: mixmessages.Registration.CheckRegistration:input_type -> mixmessages.RegisteredNodeCheck
+	52,  // 113: mixmessages.Registration.PollNdfStream:input_type -> mixmessages.NDFHash
+	104, // 114: mixmessages.Registration.RequestCapability:input_type -> messages.AuthenticatedMessage
+	104, // 115: mixmessages.Registration.ReportRoundMetrics:input_type -> messages.AuthenticatedMessage
+	69,  // 116: mixmessages.NotificationBot.UnregisterForNotifications:input_type -> mixmessages.NotificationUnregisterRequest
+	68,  // 117: mixmessages.NotificationBot.RegisterForNotifications:input_type -> mixmessages.NotificationRegisterRequest
+	104, // 118: mixmessages.NotificationBot.ReceiveNotificationBatch:input_type -> messages.AuthenticatedMessage
+	63,  // 119: mixmessages.NotificationBot.RegisterToken:input_type -> mixmessages.RegisterTokenRequest
+	64,  // 120: mixmessages.NotificationBot.UnregisterToken:input_type -> mixmessages.UnregisterTokenRequest
+	66,  // 121: mixmessages.NotificationBot.RegisterTrackedID:input_type -> mixmessages.RegisterTrackedIdRequest
+	65,  // 122: mixmessages.NotificationBot.UnregisterTrackedID:input_type -> mixmessages.UnregisterTrackedIdRequest
+	77,  // 123: mixmessages.UDB.RegisterUser:input_type -> mixmessages.UDBUserRegistration
+	83,  // 124: mixmessages.UDB.RemoveUser:input_type -> mixmessages.FactRemovalRequest
+	79,  // 125: mixmessages.UDB.RegisterFact:input_type -> mixmessages.FactRegisterRequest
+	82,  // 126: mixmessages.UDB.ConfirmFact:input_type -> mixmessages.FactConfirmRequest
+	83,  // 127: mixmessages.UDB.RemoveFact:input_type -> mixmessages.FactRemovalRequest
+	73,  // 128: mixmessages.UDB.RequestChannelLease:input_type -> mixmessages.ChannelLeaseRequest
+	75,  // 129: mixmessages.UDB.ValidateUsername:input_type -> mixmessages.UsernameValidationRequest
+	90,  // 130: mixmessages.Authorizer.Authorize:input_type -> mixmessages.AuthorizerAuth
+	89,  // 131: mixmessages.Authorizer.RequestCert:input_type -> mixmessages.AuthorizerCertRequest
+	87,  // 132: mixmessages.Authorizer.RequestEABCredentials:input_type -> mixmessages.EABCredentialRequest
+	91,  // 133: mixmessages.RemoteSync.Login:input_type -> mixmessages.RsAuthenticationRequest
+	93,  // 134: mixmessages.RemoteSync.Read:input_type -> mixmessages.RsReadRequest
+	96,  // 135: mixmessages.RemoteSync.Write:input_type -> mixmessages.RsWriteRequest
+	93,  // 136: mixmessages.RemoteSync.GetLastModified:input_type -> mixmessages.RsReadRequest
+	94,  // 137: mixmessages.RemoteSync.GetLastWrite:input_type -> mixmessages.RsLastWriteRequest
+	93,  // 138: mixmessages.RemoteSync.ReadDir:input_type -> mixmessages.RsReadRequest
+	105, // 139: mixmessages.BuildInfo.GetBuildInfo:input_type -> messages.Ping
+	104, // 140: mixmessages.Admin.SetEndpointEnabled:input_type -> messages.AuthenticatedMessage
+	106, // 141: mixmessages.Node.AskOnline:output_type -> messages.Ack
+	106, // 142: mixmessages.Node.CreateNewRound:output_type -> messages.Ack
+	106, // 143: mixmessages.Node.UploadUnmixedBatch:output_type -> messages.Ack
+	106, // 144: mixmessages.Node.FinishRealtime:output_type -> messages.Ack
+	106, // 145: mixmessages.Node.PrecompTestBatch:output_type -> messages.Ack
+	106, // 146: mixmessages.Node.PostPhase:output_type -> messages.Ack
+	106, // 147: mixmessages.Node.StreamPostPhase:output_type -> messages.Ack
+	8,   // 148: mixmessages.Node.GetRoundBufferInfo:output_type -> mixmessages.RoundBufferInfo
+	5,   // 149: mixmessages.Node.RequestClientKey:output_type -> mixmessages.SignedKeyResponse
+	106, // 150: mixmessages.Node.PostPrecompResult:output_type -> messages.Ack
+	10,  // 151: mixmessages.Node.GetMeasure:output_type -> mixmessages.RoundMetrics
+	16,  // 152: mixmessages.Node.Poll:output_type -> mixmessages.ServerPollResponse
+	34,  // 153: mixmessages.Node.DownloadMixedBatch:output_type -> mixmessages.Slot
+	106, // 154: mixmessages.Node.SendRoundTripPing:output_type -> messages.Ack
+	106, // 155: mixmessages.Node.RoundError:output_type -> messages.Ack
+	84,  // 156: mixmessages.Node.GetPermissioningAddress:output_type -> mixmessages.StrAddress
+	106, // 157: mixmessages.Node.StartSharePhase:output_type -> messages.Ack
+	106, // 158: mixmessages.Node.SharePhaseRound:output_type -> messages.Ack
+	106, // 159: mixmessages.Node.ShareFinalKey:output_type -> messages.Ack
+	85,  // 160: mixmessages.Node.ReservePrecomputation:output_type -> mixmessages.RoundInfo
+	106, // 161: mixmessages.Node.ConfirmPrecomputation:output_type -> messages.Ack
+	106, // 162: mixmessages.Node.ReleasePrecomputation:output_type -> messages.Ack
+	5,   // 163: mixmessages.Gateway.RequestClientKey:output_type -> mixmessages.SignedKeyResponse
+	4,   // 164: mixmessages.Gateway.BatchNodeRegistration:output_type -> mixmessages.SignedBatchKeyResponse
+	41,  // 165: mixmessages.Gateway.PutMessage:output_type -> mixmessages.GatewaySlotResponse
+	41,  // 166: mixmessages.Gateway.PutManyMessages:output_type -> mixmessages.GatewaySlotResponse
+	41,  // 167: mixmessages.Gateway.PutMessageProxy:output_type -> mixmessages.GatewaySlotResponse
+	41,  // 168: mixmessages.Gateway.PutManyMessagesProxy:output_type -> mixmessages.GatewaySlotResponse
+	22,  // 169: mixmessages.Gateway.Poll:output_type -> mixmessages.StreamChunk
+	24,  // 170: mixmessages.Gateway.RequestHistoricalRounds:output_type -> mixmessages.HistoricalRoundsResponse
+	28,  // 171: mixmessages.Gateway.RequestMessages:output_type -> mixmessages.GetMessagesResponse
+	26,  // 172: mixmessages.Gateway.RequestBatchMessages:output_type -> mixmessages.GetMessagesResponseBatch
+	21,  // 173: mixmessages.Gateway.RequestTlsCert:output_type -> mixmessages.GatewayCertificate
+	106, // 174: mixmessages.Gateway.NotifyAddressUpdate:output_type -> messages.Ack
+	106, // 175: mixmessages.Gateway.MirrorMessages:output_type -> messages.Ack
+	85,  // 176: mixmessages.Gateway.StreamRoundUpdates:output_type -> mixmessages.RoundInfo
+	106, // 177: mixmessages.Gateway.RelayMessage:output_type -> messages.Ack
+	41,  // 178: mixmessages.Gateway.RequestInclusionProof:output_type -> mixmessages.GatewaySlotResponse
+	106, // 179: mixmessages.Gateway.UpdateHostAddress:output_type -> messages.Ack
+	58,  // 180: mixmessages.ClientRegistrar.RegisterUser:output_type -> mixmessages.SignedClientRegistrationConfirmations
+	106, // 181: mixmessages.Registration.RegisterNode:output_type -> messages.Ack
+	53,  // 182: mixmessages.Registration.PollNdf:output_type -> mixmessages.NDF
+	62,  // 183: mixmessages.Registration.Poll:output_type -> mixmessages.PermissionPollResponse
+	50,  // 184: mixmessages.Registration.CheckRegistration:output_type -> mixmessages.RegisteredNodeConfirmation
+	22,  // 185: mixmessages.Registration.PollNdfStream:output_type -> mixmessages.StreamChunk
+	107, // 186: mixmessages.Registration.RequestCapability:output_type -> messages.AssignToken
+	106, // 187: mixmessages.Registration.ReportRoundMetrics:output_type -> messages.Ack
+	106, // 188: mixmessages.NotificationBot.UnregisterForNotifications:output_type -> messages.Ack
+	106, // 189: mixmessages.NotificationBot.RegisterForNotifications:output_type -> messages.Ack
+	106, // 190: mixmessages.NotificationBot.ReceiveNotificationBatch:output_type -> messages.Ack
+	106, // 191: mixmessages.NotificationBot.RegisterToken:output_type -> messages.Ack
+	106, // 192: mixmessages.NotificationBot.UnregisterToken:output_type -> messages.Ack
+	106, // 193: mixmessages.NotificationBot.RegisterTrackedID:output_type -> messages.Ack
+	106, // 194: mixmessages.NotificationBot.UnregisterTrackedID:output_type -> messages.Ack
+	106, // 195: mixmessages.UDB.RegisterUser:output_type -> messages.Ack
+	106, // 196: mixmessages.UDB.RemoveUser:output_type -> messages.Ack
+	81,  // 197: mixmessages.UDB.RegisterFact:output_type -> mixmessages.FactRegisterResponse
+	106, // 198: mixmessages.UDB.ConfirmFact:output_type -> messages.Ack
+	106, // 199: mixmessages.UDB.RemoveFact:output_type -> messages.Ack
+	74,  // 200: mixmessages.UDB.RequestChannelLease:output_type -> mixmessages.ChannelLeaseResponse
+	76,  // 201: mixmessages.UDB.ValidateUsername:output_type -> mixmessages.UsernameValidation
+	106, // 202: mixmessages.Authorizer.Authorize:output_type -> messages.Ack
+	106, // 203: mixmessages.Authorizer.RequestCert:output_type -> messages.Ack
+	88,  // 204: mixmessages.Authorizer.RequestEABCredentials:output_type -> mixmessages.EABCredentialResponse
+	92,  // 205: mixmessages.RemoteSync.Login:output_type -> mixmessages.RsAuthenticationResponse
+	95,  // 206: mixmessages.RemoteSync.Read:output_type -> mixmessages.RsReadResponse
+	106, // 207: mixmessages.RemoteSync.Write:output_type -> messages.Ack
+	98,  // 208: mixmessages.RemoteSync.GetLastModified:output_type -> mixmessages.RsTimestampResponse
+	98,  // 209: mixmessages.RemoteSync.GetLastWrite:output_type -> mixmessages.RsTimestampResponse
+	97,  // 210: mixmessages.RemoteSync.ReadDir:output_type -> mixmessages.RsReadDirResponse
+	99,  // 211: mixmessages.BuildInfo.GetBuildInfo:output_type -> mixmessages.ServerBuildInfo
+	106, // 212: mixmessages.Admin.SetEndpointEnabled:output_type -> messages.Ack
+	141, // [141:213] is the sub-list for method output_type
+	69,  // [69:141] is the sub-list for method input_type
+	69,  // [69:69] is the sub-list for extension type_name
+	69,  // [69:69] is the sub-list for extension extendee
+	0,   // [0:69] is the sub-list for field type_name
 }
 
 func init() { file_mixmessages_proto_init() }
@@ -7247,8 +8642,140 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignedClientBatchKeyRequest); i {
+		file_mixmessages_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignedClientBatchKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignedClientKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignedBatchKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignedKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistrationReceipt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostPrecompResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoundBufferInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoundPublicKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoundMetrics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Batch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompletedBatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7259,8 +8786,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignedClientKeyRequest); i {
+		file_mixmessages_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7271,8 +8798,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClientKeyResponse); i {
+		file_mixmessages_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoundTripPing); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7283,8 +8810,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignedBatchKeyResponse); i {
+		file_mixmessages_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerPoll); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7295,8 +8822,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignedKeyResponse); i {
+		file_mixmessages_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerPollResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7307,8 +8834,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PostPrecompResult); i {
+		file_mixmessages_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchReady); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7319,8 +8846,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoundBufferInfo); i {
+		file_mixmessages_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharePiece); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7331,8 +8858,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoundPublicKey); i {
+		file_mixmessages_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7343,8 +8870,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoundMetrics); i {
+		file_mixmessages_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestGatewayCert); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7355,8 +8882,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Batch); i {
+		file_mixmessages_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayCertificate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7367,8 +8894,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CompletedBatch); i {
+		file_mixmessages_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamChunk); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7379,8 +8906,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchInfo); i {
+		file_mixmessages_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HistoricalRounds); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7391,8 +8918,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoundTripPing); i {
+		file_mixmessages_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HistoricalRoundsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7403,8 +8930,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerPoll); i {
+		file_mixmessages_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMessagesBatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7415,8 +8942,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerPollResponse); i {
+		file_mixmessages_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMessagesResponseBatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7427,8 +8954,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchReady); i {
+		file_mixmessages_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMessages); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7439,8 +8966,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SharePiece); i {
+		file_mixmessages_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMessagesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7451,8 +8978,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestGatewayCert); i {
+		file_mixmessages_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageRetentionPolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7463,8 +8990,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GatewayCertificate); i {
+		file_mixmessages_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoundMessages); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7475,8 +9002,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamChunk); i {
+		file_mixmessages_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MirroredMessages); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7487,8 +9014,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HistoricalRounds); i {
+		file_mixmessages_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MirrorManifest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7499,8 +9026,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HistoricalRoundsResponse); i {
+		file_mixmessages_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IDList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7511,8 +9038,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMessagesBatch); i {
+		file_mixmessages_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Slot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7523,8 +9050,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMessagesResponseBatch); i {
+		file_mixmessages_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayPoll); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7535,8 +9062,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMessages); i {
+		file_mixmessages_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayPollResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7547,8 +9074,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMessagesResponse); i {
+		file_mixmessages_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientBlooms); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7559,8 +9086,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoundMessages); i {
+		file_mixmessages_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientBloom); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7571,8 +9098,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IDList); i {
+		file_mixmessages_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewaySlots); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7583,8 +9110,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Slot); i {
+		file_mixmessages_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewaySlot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7595,8 +9122,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GatewayPoll); i {
+		file_mixmessages_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewaySlotResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7607,8 +9134,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GatewayPollResponse); i {
+		file_mixmessages_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InclusionProof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7619,8 +9146,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClientBlooms); i {
+		file_mixmessages_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelayedMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7631,8 +9158,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClientBloom); i {
+		file_mixmessages_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchSenders); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7643,8 +9170,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GatewaySlots); i {
+		file_mixmessages_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Recipients); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7655,8 +9182,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GatewaySlot); i {
+		file_mixmessages_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoundMetricsReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7667,8 +9194,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GatewaySlotResponse); i {
+		file_mixmessages_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PhaseTiming); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7679,8 +9206,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchSenders); i {
+		file_mixmessages_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceUsage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7691,8 +9218,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Recipients); i {
+		file_mixmessages_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoundTripPingTiming); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7703,7 +9230,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RegisteredNodeConfirmation); i {
 			case 0:
 				return &v.state
@@ -7715,7 +9242,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RegisteredNodeCheck); i {
 			case 0:
 				return &v.state
@@ -7727,7 +9254,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NDFHash); i {
 			case 0:
 				return &v.state
@@ -7739,7 +9266,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NDF); i {
 			case 0:
 				return &v.state
@@ -7751,7 +9278,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NodeRegistration); i {
 			case 0:
 				return &v.state
@@ -7763,7 +9290,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ClientRegistration); i {
 			case 0:
 				return &v.state
@@ -7775,7 +9302,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ClientRegistrationConfirmation); i {
 			case 0:
 				return &v.state
@@ -7787,7 +9314,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SignedRegistrationConfirmation); i {
 			case 0:
 				return &v.state
@@ -7799,7 +9326,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SignedClientRegistrationConfirmations); i {
 			case 0:
 				return &v.state
@@ -7811,7 +9338,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ClientVersion); i {
 			case 0:
 				return &v.state
@@ -7823,7 +9350,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PermissioningPoll); i {
 			case 0:
 				return &v.state
@@ -7835,7 +9362,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ClientError); i {
 			case 0:
 				return &v.state
@@ -7847,7 +9374,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PermissionPollResponse); i {
 			case 0:
 				return &v.state
@@ -7859,7 +9386,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RegisterTokenRequest); i {
 			case 0:
 				return &v.state
@@ -7871,7 +9398,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UnregisterTokenRequest); i {
 			case 0:
 				return &v.state
@@ -7883,7 +9410,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UnregisterTrackedIdRequest); i {
 			case 0:
 				return &v.state
@@ -7895,7 +9422,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RegisterTrackedIdRequest); i {
 			case 0:
 				return &v.state
@@ -7907,7 +9434,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrackedIntermediaryIdRequest); i {
 			case 0:
 				return &v.state
@@ -7919,7 +9446,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NotificationRegisterRequest); i {
 			case 0:
 				return &v.state
@@ -7931,7 +9458,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NotificationUnregisterRequest); i {
 			case 0:
 				return &v.state
@@ -7943,7 +9470,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserIdList); i {
 			case 0:
 				return &v.state
@@ -7955,7 +9482,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NotificationBatch); i {
 			case 0:
 				return &v.state
@@ -7967,7 +9494,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NotificationData); i {
 			case 0:
 				return &v.state
@@ -7979,7 +9506,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChannelLeaseRequest); i {
 			case 0:
 				return &v.state
@@ -7991,7 +9518,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChannelLeaseResponse); i {
 			case 0:
 				return &v.state
@@ -8003,7 +9530,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UsernameValidationRequest); i {
 			case 0:
 				return &v.state
@@ -8015,7 +9542,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UsernameValidation); i {
 			case 0:
 				return &v.state
@@ -8027,7 +9554,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UDBUserRegistration); i {
 			case 0:
 				return &v.state
@@ -8039,7 +9566,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Identity); i {
 			case 0:
 				return &v.state
@@ -8051,7 +9578,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FactRegisterRequest); i {
 			case 0:
 				return &v.state
@@ -8063,7 +9590,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Fact); i {
 			case 0:
 				return &v.state
@@ -8075,7 +9602,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FactRegisterResponse); i {
 			case 0:
 				return &v.state
@@ -8087,7 +9614,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FactConfirmRequest); i {
 			case 0:
 				return &v.state
@@ -8099,7 +9626,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FactRemovalRequest); i {
 			case 0:
 				return &v.state
@@ -8111,7 +9638,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StrAddress); i {
 			case 0:
 				return &v.state
@@ -8123,7 +9650,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RoundInfo); i {
 			case 0:
 				return &v.state
@@ -8135,7 +9662,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RoundError); i {
 			case 0:
 				return &v.state
@@ -8147,7 +9674,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EABCredentialRequest); i {
 			case 0:
 				return &v.state
@@ -8159,7 +9686,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EABCredentialResponse); i {
 			case 0:
 				return &v.state
@@ -8171,7 +9698,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AuthorizerCertRequest); i {
 			case 0:
 				return &v.state
@@ -8183,7 +9710,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AuthorizerAuth); i {
 			case 0:
 				return &v.state
@@ -8195,7 +9722,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RsAuthenticationRequest); i {
 			case 0:
 				return &v.state
@@ -8207,7 +9734,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RsAuthenticationResponse); i {
 			case 0:
 				return &v.state
@@ -8219,7 +9746,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RsReadRequest); i {
 			case 0:
 				return &v.state
@@ -8231,7 +9758,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RsLastWriteRequest); i {
 			case 0:
 				return &v.state
@@ -8243,7 +9770,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RsReadResponse); i {
 			case 0:
 				return &v.state
@@ -8255,7 +9782,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RsWriteRequest); i {
 			case 0:
 				return &v.state
@@ -8267,7 +9794,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RsReadDirResponse); i {
 			case 0:
 				return &v.state
@@ -8279,7 +9806,7 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+		file_mixmessages_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RsTimestampResponse); i {
 			case 0:
 				return &v.state
@@ -8291,6 +9818,30 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
+		file_mixmessages_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerBuildInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetEndpointEnabled); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -8298,9 +9849,9 @@ func file_mixmessages_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mixmessages_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   88,
+			NumMessages:   101,
 			NumExtensions: 0,
-			NumServices:   8,
+			NumServices:   10,
 		},
 		GoTypes:           file_mixmessages_proto_goTypes,
 		DependencyIndexes: file_mixmessages_proto_depIdxs,
@@ -65,6 +65,13 @@ type NodeClient interface {
 	SharePhaseRound(ctx context.Context, in *messages.AuthenticatedMessage, opts ...grpc.CallOption) (*messages.Ack, error)
 	// Server -> Server received final key
 	ShareFinalKey(ctx context.Context, in *messages.AuthenticatedMessage, opts ...grpc.CallOption) (*messages.Ack, error)
+	// Gateway -> Server reservation of a precomputed round for the next batch
+	ReservePrecomputation(ctx context.Context, in *messages.AuthenticatedMessage, opts ...grpc.CallOption) (*RoundInfo, error)
+	// Gateway -> Server confirmation that the batch for a reserved round is
+	// being uploaded
+	ConfirmPrecomputation(ctx context.Context, in *messages.AuthenticatedMessage, opts ...grpc.CallOption) (*messages.Ack, error)
+	// Gateway -> Server release of a reserved round which will not be used
+	ReleasePrecomputation(ctx context.Context, in *messages.AuthenticatedMessage, opts ...grpc.CallOption) (*messages.Ack, error)
 }
 
 type nodeClient struct {
@@ -369,6 +376,33 @@ func (c *nodeClient) ShareFinalKey(ctx context.Context, in *messages.Authenticat
 	return out, nil
 }
 
+func (c *nodeClient) ReservePrecomputation(ctx context.Context, in *messages.AuthenticatedMessage, opts ...grpc.CallOption) (*RoundInfo, error) {
+	out := new(RoundInfo)
+	err := c.cc.Invoke(ctx, "/mixmessages.Node/ReservePrecomputation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) ConfirmPrecomputation(ctx context.Context, in *messages.AuthenticatedMessage, opts ...grpc.CallOption) (*messages.Ack, error) {
+	out := new(messages.Ack)
+	err := c.cc.Invoke(ctx, "/mixmessages.Node/ConfirmPrecomputation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) ReleasePrecomputation(ctx context.Context, in *messages.AuthenticatedMessage, opts ...grpc.CallOption) (*messages.Ack, error) {
+	out := new(messages.Ack)
+	err := c.cc.Invoke(ctx, "/mixmessages.Node/ReleasePrecomputation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NodeServer is the server API for Node service.
 // All implementations must embed UnimplementedNodeServer
 // for forward compatibility
@@ -415,6 +449,13 @@ type NodeServer interface {
 	SharePhaseRound(context.Context, *messages.AuthenticatedMessage) (*messages.Ack, error)
 	// Server -> Server received final key
 	ShareFinalKey(context.Context, *messages.AuthenticatedMessage) (*messages.Ack, error)
+	// Gateway -> Server reservation of a precomputed round for the next batch
+	ReservePrecomputation(context.Context, *messages.AuthenticatedMessage) (*RoundInfo, error)
+	// Gateway -> Server confirmation that the batch for a reserved round is
+	// being uploaded
+	ConfirmPrecomputation(context.Context, *messages.AuthenticatedMessage) (*messages.Ack, error)
+	// Gateway -> Server release of a reserved round which will not be used
+	ReleasePrecomputation(context.Context, *messages.AuthenticatedMessage) (*messages.Ack, error)
 	mustEmbedUnimplementedNodeServer()
 }
 
@@ -479,6 +520,15 @@ func (UnimplementedNodeServer) SharePhaseRound(context.Context, *messages.Authen
 func (UnimplementedNodeServer) ShareFinalKey(context.Context, *messages.AuthenticatedMessage) (*messages.Ack, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShareFinalKey not implemented")
 }
+func (UnimplementedNodeServer) ReservePrecomputation(context.Context, *messages.AuthenticatedMessage) (*RoundInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReservePrecomputation not implemented")
+}
+func (UnimplementedNodeServer) ConfirmPrecomputation(context.Context, *messages.AuthenticatedMessage) (*messages.Ack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmPrecomputation not implemented")
+}
+func (UnimplementedNodeServer) ReleasePrecomputation(context.Context, *messages.AuthenticatedMessage) (*messages.Ack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleasePrecomputation not implemented")
+}
 func (UnimplementedNodeServer) mustEmbedUnimplementedNodeServer() {}
 
 // UnsafeNodeServer may be embedded to opt out of forward compatibility for this service.
@@ -869,6 +919,60 @@ func _Node_ShareFinalKey_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Node_ReservePrecomputation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(messages.AuthenticatedMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).ReservePrecomputation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.Node/ReservePrecomputation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).ReservePrecomputation(ctx, req.(*messages.AuthenticatedMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_ConfirmPrecomputation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(messages.AuthenticatedMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).ConfirmPrecomputation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.Node/ConfirmPrecomputation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).ConfirmPrecomputation(ctx, req.(*messages.AuthenticatedMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_ReleasePrecomputation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(messages.AuthenticatedMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).ReleasePrecomputation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.Node/ReleasePrecomputation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).ReleasePrecomputation(ctx, req.(*messages.AuthenticatedMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Node_ServiceDesc is the grpc.ServiceDesc for Node service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -932,6 +1036,18 @@ var Node_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ShareFinalKey",
 			Handler:    _Node_ShareFinalKey_Handler,
 		},
+		{
+			MethodName: "ReservePrecomputation",
+			Handler:    _Node_ReservePrecomputation_Handler,
+		},
+		{
+			MethodName: "ConfirmPrecomputation",
+			Handler:    _Node_ConfirmPrecomputation_Handler,
+		},
+		{
+			MethodName: "ReleasePrecomputation",
+			Handler:    _Node_ReleasePrecomputation_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -988,6 +1104,25 @@ type GatewayClient interface {
 	RequestMessages(ctx context.Context, in *GetMessages, opts ...grpc.CallOption) (*GetMessagesResponse, error)
 	RequestBatchMessages(ctx context.Context, in *GetMessagesBatch, opts ...grpc.CallOption) (*GetMessagesResponseBatch, error)
 	RequestTlsCert(ctx context.Context, in *RequestGatewayCert, opts ...grpc.CallOption) (*GatewayCertificate, error)
+	// Node -> Gateway notification that the addresses of the node's team have
+	// changed, carrying the permissioning-signed NDF the node observed
+	NotifyAddressUpdate(ctx context.Context, in *messages.AuthenticatedMessage, opts ...grpc.CallOption) (*messages.Ack, error)
+	// Gateway -> Gateway replication of the messages stored for a round,
+	// carried as MirroredMessages with a manifest signed by the round's
+	// gateway
+	MirrorMessages(ctx context.Context, in *messages.AuthenticatedMessage, opts ...grpc.CallOption) (*messages.Ack, error)
+	// Client -> Gateway subscription to round updates, pushed as they arrive
+	StreamRoundUpdates(ctx context.Context, in *GatewayPoll, opts ...grpc.CallOption) (Gateway_StreamRoundUpdatesClient, error)
+	// Forwards an opaque payload to its destination without reading it, for
+	// sender-anonymous messages
+	RelayMessage(ctx context.Context, in *RelayedMessage, opts ...grpc.CallOption) (*messages.Ack, error)
+	// Client -> Gateway request for proof that a message it accepted was
+	// included in a round, sent in the Proof of the response
+	RequestInclusionProof(ctx context.Context, in *GatewaySlot, opts ...grpc.CallOption) (*GatewaySlotResponse, error)
+	// Node -> Gateway push of the node's new address, sent as an
+	// AddressUpdate signed by the node so it can be verified against the
+	// node's cert
+	UpdateHostAddress(ctx context.Context, in *messages.AuthenticatedMessage, opts ...grpc.CallOption) (*messages.Ack, error)
 }
 
 type gatewayClient struct {
@@ -1120,6 +1255,83 @@ func (c *gatewayClient) RequestTlsCert(ctx context.Context, in *RequestGatewayCe
 	return out, nil
 }
 
+func (c *gatewayClient) NotifyAddressUpdate(ctx context.Context, in *messages.AuthenticatedMessage, opts ...grpc.CallOption) (*messages.Ack, error) {
+	out := new(messages.Ack)
+	err := c.cc.Invoke(ctx, "/mixmessages.Gateway/NotifyAddressUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) MirrorMessages(ctx context.Context, in *messages.AuthenticatedMessage, opts ...grpc.CallOption) (*messages.Ack, error) {
+	out := new(messages.Ack)
+	err := c.cc.Invoke(ctx, "/mixmessages.Gateway/MirrorMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) StreamRoundUpdates(ctx context.Context, in *GatewayPoll, opts ...grpc.CallOption) (Gateway_StreamRoundUpdatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Gateway_ServiceDesc.Streams[1], "/mixmessages.Gateway/StreamRoundUpdates", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gatewayStreamRoundUpdatesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Gateway_StreamRoundUpdatesClient interface {
+	Recv() (*RoundInfo, error)
+	grpc.ClientStream
+}
+
+type gatewayStreamRoundUpdatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *gatewayStreamRoundUpdatesClient) Recv() (*RoundInfo, error) {
+	m := new(RoundInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gatewayClient) RelayMessage(ctx context.Context, in *RelayedMessage, opts ...grpc.CallOption) (*messages.Ack, error) {
+	out := new(messages.Ack)
+	err := c.cc.Invoke(ctx, "/mixmessages.Gateway/RelayMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) RequestInclusionProof(ctx context.Context, in *GatewaySlot, opts ...grpc.CallOption) (*GatewaySlotResponse, error) {
+	out := new(GatewaySlotResponse)
+	err := c.cc.Invoke(ctx, "/mixmessages.Gateway/RequestInclusionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) UpdateHostAddress(ctx context.Context, in *messages.AuthenticatedMessage, opts ...grpc.CallOption) (*messages.Ack, error) {
+	out := new(messages.Ack)
+	err := c.cc.Invoke(ctx, "/mixmessages.Gateway/UpdateHostAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GatewayServer is the server API for Gateway service.
 // All implementations must embed UnimplementedGatewayServer
 // for forward compatibility
@@ -1145,6 +1357,25 @@ type GatewayServer interface {
 	RequestMessages(context.Context, *GetMessages) (*GetMessagesResponse, error)
 	RequestBatchMessages(context.Context, *GetMessagesBatch) (*GetMessagesResponseBatch, error)
 	RequestTlsCert(context.Context, *RequestGatewayCert) (*GatewayCertificate, error)
+	// Node -> Gateway notification that the addresses of the node's team have
+	// changed, carrying the permissioning-signed NDF the node observed
+	NotifyAddressUpdate(context.Context, *messages.AuthenticatedMessage) (*messages.Ack, error)
+	// Gateway -> Gateway replication of the messages stored for a round,
+	// carried as MirroredMessages with a manifest signed by the round's
+	// gateway
+	MirrorMessages(context.Context, *messages.AuthenticatedMessage) (*messages.Ack, error)
+	// Client -> Gateway subscription to round updates, pushed as they arrive
+	StreamRoundUpdates(*GatewayPoll, Gateway_StreamRoundUpdatesServer) error
+	// Forwards an opaque payload to its destination without reading it, for
+	// sender-anonymous messages
+	RelayMessage(context.Context, *RelayedMessage) (*messages.Ack, error)
+	// Client -> Gateway request for proof that a message it accepted was
+	// included in a round, sent in the Proof of the response
+	RequestInclusionProof(context.Context, *GatewaySlot) (*GatewaySlotResponse, error)
+	// Node -> Gateway push of the node's new address, sent as an
+	// AddressUpdate signed by the node so it can be verified against the
+	// node's cert
+	UpdateHostAddress(context.Context, *messages.AuthenticatedMessage) (*messages.Ack, error)
 	mustEmbedUnimplementedGatewayServer()
 }
 
@@ -1185,6 +1416,24 @@ func (UnimplementedGatewayServer) RequestBatchMessages(context.Context, *GetMess
 func (UnimplementedGatewayServer) RequestTlsCert(context.Context, *RequestGatewayCert) (*GatewayCertificate, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestTlsCert not implemented")
 }
+func (UnimplementedGatewayServer) NotifyAddressUpdate(context.Context, *messages.AuthenticatedMessage) (*messages.Ack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifyAddressUpdate not implemented")
+}
+func (UnimplementedGatewayServer) MirrorMessages(context.Context, *messages.AuthenticatedMessage) (*messages.Ack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MirrorMessages not implemented")
+}
+func (UnimplementedGatewayServer) StreamRoundUpdates(*GatewayPoll, Gateway_StreamRoundUpdatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamRoundUpdates not implemented")
+}
+func (UnimplementedGatewayServer) RelayMessage(context.Context, *RelayedMessage) (*messages.Ack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelayMessage not implemented")
+}
+func (UnimplementedGatewayServer) RequestInclusionProof(context.Context, *GatewaySlot) (*GatewaySlotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestInclusionProof not implemented")
+}
+func (UnimplementedGatewayServer) UpdateHostAddress(context.Context, *messages.AuthenticatedMessage) (*messages.Ack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHostAddress not implemented")
+}
 func (UnimplementedGatewayServer) mustEmbedUnimplementedGatewayServer() {}
 
 // UnsafeGatewayServer may be embedded to opt out of forward compatibility for this service.
@@ -1399,6 +1648,117 @@ func _Gateway_RequestTlsCert_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Gateway_NotifyAddressUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(messages.AuthenticatedMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).NotifyAddressUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.Gateway/NotifyAddressUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).NotifyAddressUpdate(ctx, req.(*messages.AuthenticatedMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_MirrorMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(messages.AuthenticatedMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).MirrorMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.Gateway/MirrorMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).MirrorMessages(ctx, req.(*messages.AuthenticatedMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_StreamRoundUpdates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GatewayPoll)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GatewayServer).StreamRoundUpdates(m, &gatewayStreamRoundUpdatesServer{stream})
+}
+
+type Gateway_StreamRoundUpdatesServer interface {
+	Send(*RoundInfo) error
+	grpc.ServerStream
+}
+
+type gatewayStreamRoundUpdatesServer struct {
+	grpc.ServerStream
+}
+
+func (x *gatewayStreamRoundUpdatesServer) Send(m *RoundInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Gateway_RelayMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelayedMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).RelayMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.Gateway/RelayMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).RelayMessage(ctx, req.(*RelayedMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_RequestInclusionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewaySlot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).RequestInclusionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.Gateway/RequestInclusionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).RequestInclusionProof(ctx, req.(*GatewaySlot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_UpdateHostAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(messages.AuthenticatedMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).UpdateHostAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.Gateway/UpdateHostAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).UpdateHostAddress(ctx, req.(*messages.AuthenticatedMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Gateway_ServiceDesc is the grpc.ServiceDesc for Gateway service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1446,6 +1806,26 @@ var Gateway_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RequestTlsCert",
 			Handler:    _Gateway_RequestTlsCert_Handler,
 		},
+		{
+			MethodName: "NotifyAddressUpdate",
+			Handler:    _Gateway_NotifyAddressUpdate_Handler,
+		},
+		{
+			MethodName: "MirrorMessages",
+			Handler:    _Gateway_MirrorMessages_Handler,
+		},
+		{
+			MethodName: "RelayMessage",
+			Handler:    _Gateway_RelayMessage_Handler,
+		},
+		{
+			MethodName: "RequestInclusionProof",
+			Handler:    _Gateway_RequestInclusionProof_Handler,
+		},
+		{
+			MethodName: "UpdateHostAddress",
+			Handler:    _Gateway_UpdateHostAddress_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1453,6 +1833,11 @@ var Gateway_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Gateway_Poll_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamRoundUpdates",
+			Handler:       _Gateway_StreamRoundUpdates_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "mixmessages.proto",
 }
@@ -1557,6 +1942,15 @@ type RegistrationClient interface {
 	Poll(ctx context.Context, in *messages.AuthenticatedMessage, opts ...grpc.CallOption) (*PermissionPollResponse, error)
 	// Checks if node has been registered
 	CheckRegistration(ctx context.Context, in *RegisteredNodeCheck, opts ...grpc.CallOption) (*RegisteredNodeConfirmation, error)
+	// Obtain NDF from the Registration Server as a stream of chunks, for NDFs
+	// too large to be sent in a single message
+	PollNdfStream(ctx context.Context, in *NDFHash, opts ...grpc.CallOption) (Registration_PollNdfStreamClient, error)
+	// Issues a signed capability token authorizing the sender to call a method
+	// on another host
+	RequestCapability(ctx context.Context, in *messages.AuthenticatedMessage, opts ...grpc.CallOption) (*messages.AssignToken, error)
+	// Server -> Permissioning export of the structured metrics of a round,
+	// carried as a RoundMetricsReport
+	ReportRoundMetrics(ctx context.Context, in *messages.AuthenticatedMessage, opts ...grpc.CallOption) (*messages.Ack, error)
 }
 
 type registrationClient struct {
@@ -1603,6 +1997,56 @@ func (c *registrationClient) CheckRegistration(ctx context.Context, in *Register
 	return out, nil
 }
 
+func (c *registrationClient) PollNdfStream(ctx context.Context, in *NDFHash, opts ...grpc.CallOption) (Registration_PollNdfStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Registration_ServiceDesc.Streams[0], "/mixmessages.Registration/PollNdfStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &registrationPollNdfStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Registration_PollNdfStreamClient interface {
+	Recv() (*StreamChunk, error)
+	grpc.ClientStream
+}
+
+type registrationPollNdfStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *registrationPollNdfStreamClient) Recv() (*StreamChunk, error) {
+	m := new(StreamChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *registrationClient) RequestCapability(ctx context.Context, in *messages.AuthenticatedMessage, opts ...grpc.CallOption) (*messages.AssignToken, error) {
+	out := new(messages.AssignToken)
+	err := c.cc.Invoke(ctx, "/mixmessages.Registration/RequestCapability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registrationClient) ReportRoundMetrics(ctx context.Context, in *messages.AuthenticatedMessage, opts ...grpc.CallOption) (*messages.Ack, error) {
+	out := new(messages.Ack)
+	err := c.cc.Invoke(ctx, "/mixmessages.Registration/ReportRoundMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RegistrationServer is the server API for Registration service.
 // All implementations must embed UnimplementedRegistrationServer
 // for forward compatibility
@@ -1615,6 +2059,15 @@ type RegistrationServer interface {
 	Poll(context.Context, *messages.AuthenticatedMessage) (*PermissionPollResponse, error)
 	// Checks if node has been registered
 	CheckRegistration(context.Context, *RegisteredNodeCheck) (*RegisteredNodeConfirmation, error)
+	// Obtain NDF from the Registration Server as a stream of chunks, for NDFs
+	// too large to be sent in a single message
+	PollNdfStream(*NDFHash, Registration_PollNdfStreamServer) error
+	// Issues a signed capability token authorizing the sender to call a method
+	// on another host
+	RequestCapability(context.Context, *messages.AuthenticatedMessage) (*messages.AssignToken, error)
+	// Server -> Permissioning export of the structured metrics of a round,
+	// carried as a RoundMetricsReport
+	ReportRoundMetrics(context.Context, *messages.AuthenticatedMessage) (*messages.Ack, error)
 	mustEmbedUnimplementedRegistrationServer()
 }
 
@@ -1634,6 +2087,15 @@ func (UnimplementedRegistrationServer) Poll(context.Context, *messages.Authentic
 func (UnimplementedRegistrationServer) CheckRegistration(context.Context, *RegisteredNodeCheck) (*RegisteredNodeConfirmation, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckRegistration not implemented")
 }
+func (UnimplementedRegistrationServer) PollNdfStream(*NDFHash, Registration_PollNdfStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method PollNdfStream not implemented")
+}
+func (UnimplementedRegistrationServer) RequestCapability(context.Context, *messages.AuthenticatedMessage) (*messages.AssignToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestCapability not implemented")
+}
+func (UnimplementedRegistrationServer) ReportRoundMetrics(context.Context, *messages.AuthenticatedMessage) (*messages.Ack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportRoundMetrics not implemented")
+}
 func (UnimplementedRegistrationServer) mustEmbedUnimplementedRegistrationServer() {}
 
 // UnsafeRegistrationServer may be embedded to opt out of forward compatibility for this service.
@@ -1719,6 +2181,63 @@ func _Registration_CheckRegistration_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Registration_PollNdfStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(NDFHash)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RegistrationServer).PollNdfStream(m, &registrationPollNdfStreamServer{stream})
+}
+
+type Registration_PollNdfStreamServer interface {
+	Send(*StreamChunk) error
+	grpc.ServerStream
+}
+
+type registrationPollNdfStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *registrationPollNdfStreamServer) Send(m *StreamChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Registration_RequestCapability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(messages.AuthenticatedMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationServer).RequestCapability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.Registration/RequestCapability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationServer).RequestCapability(ctx, req.(*messages.AuthenticatedMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registration_ReportRoundMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(messages.AuthenticatedMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationServer).ReportRoundMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.Registration/ReportRoundMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationServer).ReportRoundMetrics(ctx, req.(*messages.AuthenticatedMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Registration_ServiceDesc is the grpc.ServiceDesc for Registration service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1742,8 +2261,22 @@ var Registration_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckRegistration",
 			Handler:    _Registration_CheckRegistration_Handler,
 		},
+		{
+			MethodName: "RequestCapability",
+			Handler:    _Registration_RequestCapability_Handler,
+		},
+		{
+			MethodName: "ReportRoundMetrics",
+			Handler:    _Registration_ReportRoundMetrics_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PollNdfStream",
+			Handler:       _Registration_PollNdfStream_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "mixmessages.proto",
 }
 
@@ -2816,3 +3349,179 @@ var RemoteSync_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "mixmessages.proto",
 }
+
+// BuildInfoClient is the client API for BuildInfo service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BuildInfoClient interface {
+	// Returns the version and build of the comms server
+	GetBuildInfo(ctx context.Context, in *messages.Ping, opts ...grpc.CallOption) (*ServerBuildInfo, error)
+}
+
+type buildInfoClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBuildInfoClient(cc grpc.ClientConnInterface) BuildInfoClient {
+	return &buildInfoClient{cc}
+}
+
+func (c *buildInfoClient) GetBuildInfo(ctx context.Context, in *messages.Ping, opts ...grpc.CallOption) (*ServerBuildInfo, error) {
+	out := new(ServerBuildInfo)
+	err := c.cc.Invoke(ctx, "/mixmessages.BuildInfo/GetBuildInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BuildInfoServer is the server API for BuildInfo service.
+// All implementations must embed UnimplementedBuildInfoServer
+// for forward compatibility
+type BuildInfoServer interface {
+	// Returns the version and build of the comms server
+	GetBuildInfo(context.Context, *messages.Ping) (*ServerBuildInfo, error)
+	mustEmbedUnimplementedBuildInfoServer()
+}
+
+// UnimplementedBuildInfoServer must be embedded to have forward compatible implementations.
+type UnimplementedBuildInfoServer struct {
+}
+
+func (UnimplementedBuildInfoServer) GetBuildInfo(context.Context, *messages.Ping) (*ServerBuildInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBuildInfo not implemented")
+}
+func (UnimplementedBuildInfoServer) mustEmbedUnimplementedBuildInfoServer() {}
+
+// UnsafeBuildInfoServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BuildInfoServer will
+// result in compilation errors.
+type UnsafeBuildInfoServer interface {
+	mustEmbedUnimplementedBuildInfoServer()
+}
+
+func RegisterBuildInfoServer(s grpc.ServiceRegistrar, srv BuildInfoServer) {
+	s.RegisterService(&BuildInfo_ServiceDesc, srv)
+}
+
+func _BuildInfo_GetBuildInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(messages.Ping)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BuildInfoServer).GetBuildInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.BuildInfo/GetBuildInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BuildInfoServer).GetBuildInfo(ctx, req.(*messages.Ping))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BuildInfo_ServiceDesc is the grpc.ServiceDesc for BuildInfo service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BuildInfo_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mixmessages.BuildInfo",
+	HandlerType: (*BuildInfoServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBuildInfo",
+			Handler:    _BuildInfo_GetBuildInfo_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mixmessages.proto",
+}
+
+// AdminClient is the client API for Admin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminClient interface {
+	// Enables or disables an endpoint of the server
+	SetEndpointEnabled(ctx context.Context, in *messages.AuthenticatedMessage, opts ...grpc.CallOption) (*messages.Ack, error)
+}
+
+type adminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminClient(cc grpc.ClientConnInterface) AdminClient {
+	return &adminClient{cc}
+}
+
+func (c *adminClient) SetEndpointEnabled(ctx context.Context, in *messages.AuthenticatedMessage, opts ...grpc.CallOption) (*messages.Ack, error) {
+	out := new(messages.Ack)
+	err := c.cc.Invoke(ctx, "/mixmessages.Admin/SetEndpointEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServer is the server API for Admin service.
+// All implementations must embed UnimplementedAdminServer
+// for forward compatibility
+type AdminServer interface {
+	// Enables or disables an endpoint of the server
+	SetEndpointEnabled(context.Context, *messages.AuthenticatedMessage) (*messages.Ack, error)
+	mustEmbedUnimplementedAdminServer()
+}
+
+// UnimplementedAdminServer must be embedded to have forward compatible implementations.
+type UnimplementedAdminServer struct {
+}
+
+func (UnimplementedAdminServer) SetEndpointEnabled(context.Context, *messages.AuthenticatedMessage) (*messages.Ack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEndpointEnabled not implemented")
+}
+func (UnimplementedAdminServer) mustEmbedUnimplementedAdminServer() {}
+
+// UnsafeAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServer will
+// result in compilation errors.
+type UnsafeAdminServer interface {
+	mustEmbedUnimplementedAdminServer()
+}
+
+func RegisterAdminServer(s grpc.ServiceRegistrar, srv AdminServer) {
+	s.RegisterService(&Admin_ServiceDesc, srv)
+}
+
+func _Admin_SetEndpointEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(messages.AuthenticatedMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SetEndpointEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.Admin/SetEndpointEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SetEndpointEnabled(ctx, req.(*messages.AuthenticatedMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Admin_ServiceDesc is the grpc.ServiceDesc for Admin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Admin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mixmessages.Admin",
+	HandlerType: (*AdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetEndpointEnabled",
+			Handler:    _Admin_SetEndpointEnabled_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mixmessages.proto",
+}
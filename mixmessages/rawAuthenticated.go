@@ -0,0 +1,99 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains marshalling of authenticated messages without copying their
+// contents
+
+package mixmessages
+
+import (
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"gitlab.com/xx_network/comms/messages"
+	"google.golang.org/protobuf/encoding/protowire"
+	protoV2 "google.golang.org/protobuf/proto"
+)
+
+const (
+	// authenticatedMessageField is the field number of
+	// AuthenticatedMessage.Message.
+	authenticatedMessageField = 5
+	// Field numbers of Any.TypeUrl and Any.Value
+	anyTypeURLField = 1
+	anyValueField   = 2
+
+	// anyTypeURLPrefix prefixes the message names in the type URLs of Any
+	// messages, as in ptypes.MarshalAny.
+	anyTypeURLPrefix = "type.googleapis.com/"
+)
+
+// rawBuffers holds the buffers of marshalled authenticated messages, so that
+// each large message sent does not allocate a new one.
+var rawBuffers = sync.Pool{New: func() interface{} { return new([]byte) }}
+
+// MarshalAuthenticated returns the wire form of the authenticated message
+// with msg as its contents, to be sent with RawCodec. Packing msg with
+// PackAuthenticatedMessage marshals it into an Any, which gRPC then copies
+// while marshalling the authenticated message; here msg is marshalled once,
+// directly into a pooled buffer. Any Message already held by authMsg is
+// ignored. Call the returned function once the RawMessage is no longer in use
+// to return its buffer to the pool.
+func MarshalAuthenticated(authMsg *messages.AuthenticatedMessage,
+	msg proto.Message) (RawMessage, func(), error) {
+	head := &messages.AuthenticatedMessage{
+		ID:        authMsg.GetID(),
+		Signature: authMsg.GetSignature(),
+		Token:     authMsg.GetToken(),
+		Client:    authMsg.GetClient(),
+	}
+	typeURL := anyTypeURLPrefix + proto.MessageName(msg)
+	headSize := proto.Size(head)
+	msgSize := proto.Size(msg)
+	anySize := protowire.SizeTag(anyTypeURLField) +
+		protowire.SizeBytes(len(typeURL)) +
+		protowire.SizeTag(anyValueField) + protowire.SizeBytes(msgSize)
+	size := headSize + protowire.SizeTag(authenticatedMessageField) +
+		protowire.SizeBytes(anySize)
+
+	buf := rawBuffers.Get().(*[]byte)
+	release := func() { rawBuffers.Put(buf) }
+	if cap(*buf) < size {
+		*buf = make([]byte, 0, size)
+	}
+
+	// The sizes were cached when they were computed above
+	opts := protoV2.MarshalOptions{UseCachedSize: true}
+	b, err := opts.MarshalAppend((*buf)[:0], proto.MessageV2(head))
+	if err != nil {
+		release()
+		return nil, nil, errors.Errorf("Failed to marshal authenticated "+
+			"message: %+v", err)
+	}
+	b = protowire.AppendTag(b, authenticatedMessageField,
+		protowire.BytesType)
+	b = protowire.AppendVarint(b, uint64(anySize))
+	b = protowire.AppendTag(b, anyTypeURLField, protowire.BytesType)
+	b = protowire.AppendString(b, typeURL)
+	b = protowire.AppendTag(b, anyValueField, protowire.BytesType)
+	b = protowire.AppendVarint(b, uint64(msgSize))
+	b, err = opts.MarshalAppend(b, proto.MessageV2(msg))
+	if err != nil {
+		release()
+		return nil, nil, errors.Errorf("Failed to marshal %s: %+v",
+			proto.MessageName(msg), err)
+	}
+	if len(b) != size {
+		release()
+		return nil, nil, errors.Errorf("Marshalled %s changed size "+
+			"while it was marshalled", proto.MessageName(msg))
+	}
+
+	*buf = b
+	return b, release, nil
+}
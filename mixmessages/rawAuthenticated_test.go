@@ -0,0 +1,97 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package mixmessages
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"gitlab.com/xx_network/comms/messages"
+)
+
+// Tests that a marshalled authenticated message unmarshals to the same
+// message as one packed into an Any.
+func TestMarshalAuthenticated(t *testing.T) {
+	batch := &Batch{
+		Round:     &RoundInfo{ID: 42},
+		FromPhase: 3,
+		Slots: []*Slot{
+			{Index: 0, PayloadA: bytes.Repeat([]byte("a"), 4096)},
+			{Index: 1, PayloadB: bytes.Repeat([]byte("b"), 4096)},
+		},
+	}
+	authMsg := &messages.AuthenticatedMessage{
+		ID:     []byte("sender"),
+		Token:  []byte("token"),
+		Client: &messages.ClientID{Salt: []byte{}},
+	}
+
+	raw, release, err := MarshalAuthenticated(authMsg, batch)
+	if err != nil {
+		t.Fatalf("MarshalAuthenticated error: %+v", err)
+	}
+	defer release()
+
+	received := &messages.AuthenticatedMessage{}
+	if err = proto.Unmarshal(raw, received); err != nil {
+		t.Fatalf("Failed to unmarshal authenticated message: %+v", err)
+	}
+	if !bytes.Equal(received.ID, authMsg.ID) ||
+		!bytes.Equal(received.Token, authMsg.Token) {
+		t.Errorf("Unexpected authenticated message.\nexpected: %+v"+
+			"\nreceived: %+v", authMsg, received)
+	}
+
+	expectedAny, err := ptypes.MarshalAny(batch)
+	if err != nil {
+		t.Fatalf("Failed to marshal Any: %+v", err)
+	}
+	if received.Message.GetTypeUrl() != expectedAny.GetTypeUrl() {
+		t.Errorf("Unexpected type URL.\nexpected: %s\nreceived: %s",
+			expectedAny.GetTypeUrl(), received.Message.GetTypeUrl())
+	}
+	receivedBatch := &Batch{}
+	if err = ptypes.UnmarshalAny(received.Message, receivedBatch); err != nil {
+		t.Fatalf("Failed to unmarshal batch: %+v", err)
+	}
+	if !proto.Equal(receivedBatch, batch) {
+		t.Errorf("Unexpected batch.\nexpected: %+v\nreceived: %+v",
+			batch, receivedBatch)
+	}
+}
+
+// Tests that any Message held by the authenticated message is replaced.
+func TestMarshalAuthenticated_ReplacesMessage(t *testing.T) {
+	old, err := ptypes.MarshalAny(&messages.Ack{Error: "old"})
+	if err != nil {
+		t.Fatalf("Failed to marshal Any: %+v", err)
+	}
+	authMsg := &messages.AuthenticatedMessage{ID: []byte("sender"),
+		Message: old}
+
+	raw, release, err := MarshalAuthenticated(authMsg,
+		&messages.Ack{Error: "new"})
+	if err != nil {
+		t.Fatalf("MarshalAuthenticated error: %+v", err)
+	}
+	defer release()
+
+	received := &messages.AuthenticatedMessage{}
+	if err = proto.Unmarshal(raw, received); err != nil {
+		t.Fatalf("Failed to unmarshal authenticated message: %+v", err)
+	}
+	ack := &messages.Ack{}
+	if err = ptypes.UnmarshalAny(received.Message, ack); err != nil {
+		t.Fatalf("Failed to unmarshal ack: %+v", err)
+	}
+	if ack.Error != "new" {
+		t.Errorf("Received message %q, expected \"new\".", ack.Error)
+	}
+}
@@ -0,0 +1,114 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains support for passing already marshalled messages through gRPC
+
+package mixmessages
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// RawMessage is the marshalled wire form of a message. When sent over a
+// stream using RawCodec the bytes are written as-is, so a message which is
+// relayed without being inspected does not need to be unmarshalled and
+// re-marshalled.
+type RawMessage []byte
+
+// RawCodec is a gRPC codec which passes RawMessage through untouched and
+// marshals everything else as protobuf. It reports its name as "proto", so
+// the receiving server decodes the messages with its normal codec. Use it on
+// the sending side with grpc.ForceCodec.
+var RawCodec encoding.Codec = rawCodec{}
+
+type rawCodec struct{}
+
+// Marshal returns the bytes of a RawMessage or the protobuf encoding of any
+// other message.
+func (rawCodec) Marshal(v interface{}) ([]byte, error) {
+	switch m := v.(type) {
+	case RawMessage:
+		return m, nil
+	case *RawMessage:
+		return *m, nil
+	case proto.Message:
+		return proto.Marshal(m)
+	default:
+		return nil, errors.Errorf("Cannot marshal %T: not a RawMessage "+
+			"or proto.Message", v)
+	}
+}
+
+// Unmarshal copies the data into a *RawMessage or decodes it into any other
+// message. The data is copied because gRPC may reuse the buffer.
+func (rawCodec) Unmarshal(data []byte, v interface{}) error {
+	switch m := v.(type) {
+	case *RawMessage:
+		*m = append((*m)[:0], data...)
+		return nil
+	case proto.Message:
+		return proto.Unmarshal(data, m)
+	default:
+		return errors.Errorf("Cannot unmarshal into %T: not a "+
+			"*RawMessage or proto.Message", v)
+	}
+}
+
+// Name returns "proto" so that the content type on the wire is unchanged.
+func (rawCodec) Name() string {
+	return "proto"
+}
+
+// gatewaySlotMessageField is the field number of GatewaySlot.Message.
+const gatewaySlotMessageField = 1
+
+// ExtractRawSlot returns the marshalled Slot contained in a marshalled
+// GatewaySlot without decoding either message. The returned RawMessage
+// references the passed buffer, so the buffer must not be modified while it is
+// in use. An error is returned if the GatewaySlot is malformed or holds no
+// Slot.
+func ExtractRawSlot(gatewaySlot []byte) (RawMessage, error) {
+	b := gatewaySlot
+	var slot RawMessage
+	found := false
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return nil, errors.Errorf("Malformed GatewaySlot: %+v",
+				protowire.ParseError(n))
+		}
+		b = b[n:]
+
+		if num == gatewaySlotMessageField && typ == protowire.BytesType {
+			v, m := protowire.ConsumeBytes(b)
+			if m < 0 {
+				return nil, errors.Errorf("Malformed GatewaySlot: %+v",
+					protowire.ParseError(m))
+			}
+			// Per the protobuf spec, the last occurrence of a field wins
+			slot = v
+			found = true
+			b = b[m:]
+			continue
+		}
+
+		m := protowire.ConsumeFieldValue(num, typ, b)
+		if m < 0 {
+			return nil, errors.Errorf("Malformed GatewaySlot: %+v",
+				protowire.ParseError(m))
+		}
+		b = b[m:]
+	}
+
+	if !found {
+		return nil, errors.New("GatewaySlot contains no Slot")
+	}
+	return slot, nil
+}
@@ -0,0 +1,85 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package mixmessages
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Tests that RawCodec passes RawMessage through and marshals other messages
+// as protobuf.
+func TestRawCodec_Marshal(t *testing.T) {
+	slot := &Slot{Index: 3, PayloadA: []byte("payload")}
+	expected, err := proto.Marshal(slot)
+	if err != nil {
+		t.Fatalf("Failed to marshal slot: %+v", err)
+	}
+
+	data, err := RawCodec.Marshal(RawMessage(expected))
+	if err != nil || !bytes.Equal(data, expected) {
+		t.Errorf("RawMessage was not passed through: %v, %+v", data, err)
+	}
+
+	data, err = RawCodec.Marshal(slot)
+	if err != nil || !bytes.Equal(data, expected) {
+		t.Errorf("Slot was not marshalled as protobuf: %v, %+v", data, err)
+	}
+
+	if _, err = RawCodec.Marshal("string"); err == nil {
+		t.Errorf("Marshal() did not error on an unsupported type.")
+	}
+
+	received := &Slot{}
+	if err = RawCodec.Unmarshal(expected, received); err != nil {
+		t.Fatalf("Unmarshal() returned an error: %+v", err)
+	}
+	if !proto.Equal(slot, received) {
+		t.Errorf("Unexpected slot.\nexpected: %+v\nreceived: %+v",
+			slot, received)
+	}
+}
+
+// Tests that ExtractRawSlot returns the marshalled Slot of a GatewaySlot.
+func TestExtractRawSlot(t *testing.T) {
+	slot := &Slot{Index: 7, SenderID: []byte("sender"), PayloadA: []byte("a")}
+	gwSlot := &GatewaySlot{
+		Message: slot,
+		RoundID: 42,
+		MAC:     []byte("mac"),
+		IpAddr:  "0.0.0.0",
+	}
+	data, err := proto.Marshal(gwSlot)
+	if err != nil {
+		t.Fatalf("Failed to marshal GatewaySlot: %+v", err)
+	}
+
+	raw, err := ExtractRawSlot(data)
+	if err != nil {
+		t.Fatalf("ExtractRawSlot() returned an error: %+v", err)
+	}
+
+	received := &Slot{}
+	if err = proto.Unmarshal(raw, received); err != nil {
+		t.Fatalf("Failed to unmarshal extracted slot: %+v", err)
+	}
+	if !proto.Equal(slot, received) {
+		t.Errorf("Unexpected slot.\nexpected: %+v\nreceived: %+v",
+			slot, received)
+	}
+
+	noSlot, _ := proto.Marshal(&GatewaySlot{RoundID: 1})
+	if _, err = ExtractRawSlot(noSlot); err == nil {
+		t.Errorf("ExtractRawSlot() did not error without a Slot.")
+	}
+	if _, err = ExtractRawSlot([]byte{0xFF}); err == nil {
+		t.Errorf("ExtractRawSlot() did not error on malformed data.")
+	}
+}
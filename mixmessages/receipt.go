@@ -0,0 +1,88 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains registration receipts, which a node signs over a client key
+// response so the client can later prove that registration succeeded
+
+package mixmessages
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/comms/signature"
+	"gitlab.com/xx_network/crypto/signature/rsa"
+)
+
+// HashKeyResponse returns the hash of the KeyResponse a receipt is signed
+// over. The KeyResponse is used rather than the whole SignedKeyResponse
+// because gateways modify the latter as it is relayed.
+func HashKeyResponse(response *SignedKeyResponse) []byte {
+	h := sha256.Sum256(response.GetKeyResponse())
+	return h[:]
+}
+
+// NewRegistrationReceipt creates an unsigned receipt for the response.
+func NewRegistrationReceipt(response *SignedKeyResponse,
+	timestamp time.Time) *RegistrationReceipt {
+	return &RegistrationReceipt{
+		ResponseHash: HashKeyResponse(response),
+		Timestamp:    timestamp.UnixNano(),
+	}
+}
+
+// Sign signs the receipt with the node's private key.
+func (r *RegistrationReceipt) Sign(key *rsa.PrivateKey) error {
+	return signature.SignRsa(r, key)
+}
+
+// Verify checks that the receipt is for the response and was signed by the
+// holder of the public key.
+func (r *RegistrationReceipt) Verify(response *SignedKeyResponse,
+	pubKey *rsa.PublicKey) error {
+	if !bytes.Equal(r.ResponseHash, HashKeyResponse(response)) {
+		return errors.New("Registration receipt is not for the response")
+	}
+	return signature.VerifyRsa(r, pubKey)
+}
+
+// GetTime returns the time the receipt was issued.
+func (r *RegistrationReceipt) GetTime() time.Time {
+	return time.Unix(0, r.Timestamp)
+}
+
+// GetSig returns the RSA signature.
+// IF none exists, it creates it, adds it to the object, then returns it.
+func (r *RegistrationReceipt) GetSig() *messages.RSASignature {
+	if r.Signature != nil {
+		return r.Signature
+	}
+
+	r.Signature = new(messages.RSASignature)
+
+	return r.Signature
+}
+
+// Digest hashes the contents of the receipt in a repeatable manner
+// using the provided cryptographic hash. It includes the nonce in the hash
+func (r *RegistrationReceipt) Digest(nonce []byte, h hash.Hash) []byte {
+	h.Reset()
+
+	timestamp := make([]byte, 8)
+	binary.BigEndian.PutUint64(timestamp, uint64(r.Timestamp))
+
+	h.Write(r.ResponseHash)
+	h.Write(timestamp)
+	h.Write(nonce)
+
+	return h.Sum(nil)
+}
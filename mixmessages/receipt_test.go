@@ -0,0 +1,81 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package mixmessages
+
+import (
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"gitlab.com/xx_network/crypto/signature/rsa"
+	"google.golang.org/protobuf/proto"
+)
+
+// Happy path: a receipt sent in its response verifies against it.
+func TestRegistrationReceipt_SignVerify(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %+v", err)
+	}
+
+	response := &SignedKeyResponse{KeyResponse: []byte("response")}
+	timestamp := time.Unix(0, 42)
+	receipt := NewRegistrationReceipt(response, timestamp)
+	if err = receipt.Sign(privateKey); err != nil {
+		t.Fatalf("Failed to sign receipt: %+v", err)
+	}
+
+	response.Receipt = receipt
+
+	data, err := proto.Marshal(response)
+	if err != nil {
+		t.Fatalf("Failed to marshal response: %+v", err)
+	}
+	unmarshalled := &SignedKeyResponse{}
+	if err = proto.Unmarshal(data, unmarshalled); err != nil {
+		t.Fatalf("Failed to unmarshal response: %+v", err)
+	}
+
+	received := unmarshalled.GetReceipt()
+	if received == nil {
+		t.Fatalf("Receipt was not sent in the response.")
+	}
+	if !received.GetTime().Equal(timestamp) {
+		t.Errorf("Unexpected timestamp.\nexpected: %s\nreceived: %s",
+			timestamp, received.GetTime())
+	}
+	err = received.Verify(unmarshalled, privateKey.GetPublic())
+	if err != nil {
+		t.Errorf("Failed to verify receipt: %+v", err)
+	}
+}
+
+// Error path: a receipt does not verify against another response or after
+// its timestamp is modified.
+func TestRegistrationReceipt_Verify_Error(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %+v", err)
+	}
+
+	response := &SignedKeyResponse{KeyResponse: []byte("response")}
+	receipt := NewRegistrationReceipt(response, time.Now())
+	if err = receipt.Sign(privateKey); err != nil {
+		t.Fatalf("Failed to sign receipt: %+v", err)
+	}
+
+	other := &SignedKeyResponse{KeyResponse: []byte("other")}
+	if err = receipt.Verify(other, privateKey.GetPublic()); err == nil {
+		t.Errorf("Receipt verified against another response.")
+	}
+
+	receipt.Timestamp++
+	if err = receipt.Verify(response, privateKey.GetPublic()); err == nil {
+		t.Errorf("Receipt verified after its timestamp was modified.")
+	}
+}
@@ -0,0 +1,62 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the message retention policy gateways advertise to clients
+
+package mixmessages
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// MessageRetention describes how long a gateway keeps messages.
+type MessageRetention struct {
+	TTL time.Duration
+	// Zero if the response does not refer to specific messages
+	Expiry time.Time
+}
+
+// NewMessageRetention returns the retention of messages stored at the given
+// time under the ttl.
+func NewMessageRetention(ttl time.Duration, stored time.Time) MessageRetention {
+	return MessageRetention{
+		TTL:    ttl,
+		Expiry: stored.Add(ttl),
+	}
+}
+
+// Message returns the retention in the form it is sent in the Retention field
+// of a response.
+func (mr MessageRetention) Message() *MessageRetentionPolicy {
+	policy := &MessageRetentionPolicy{TTL: int64(mr.TTL)}
+	if !mr.Expiry.IsZero() {
+		policy.Expiry = mr.Expiry.UnixNano()
+	}
+	return policy
+}
+
+// MessageRetentionFromMessage returns the retention sent in the Retention
+// field of a response. It returns false if the gateway did not send one.
+func MessageRetentionFromMessage(policy *MessageRetentionPolicy) (
+	MessageRetention, bool, error) {
+	var mr MessageRetention
+	if policy == nil {
+		return mr, false, nil
+	}
+	if policy.GetTTL() < 0 {
+		return mr, false, errors.Errorf("Invalid message TTL %d",
+			policy.GetTTL())
+	}
+
+	mr.TTL = time.Duration(policy.GetTTL())
+	if policy.GetExpiry() != 0 {
+		mr.Expiry = time.Unix(0, policy.GetExpiry())
+	}
+	return mr, true, nil
+}
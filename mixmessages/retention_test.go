@@ -0,0 +1,48 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package mixmessages
+
+import (
+	"testing"
+	"time"
+)
+
+// Happy path: a retention survives being sent in a response.
+func TestMessageRetentionFromMessage(t *testing.T) {
+	expected := NewMessageRetention(72*time.Hour, time.Unix(0, 42))
+
+	received, ok, err := MessageRetentionFromMessage(expected.Message())
+	if err != nil || !ok {
+		t.Fatalf("Failed to read retention: %t %+v", ok, err)
+	}
+	if received.TTL != expected.TTL || !received.Expiry.Equal(expected.Expiry) {
+		t.Errorf("Unexpected retention.\nexpected: %+v\nreceived: %+v",
+			expected, received)
+	}
+
+	// The expiry is optional
+	received, ok, err = MessageRetentionFromMessage(
+		MessageRetention{TTL: time.Hour}.Message())
+	if err != nil || !ok || received.TTL != time.Hour ||
+		!received.Expiry.IsZero() {
+		t.Errorf("Unexpected retention without expiry: %+v %t %+v",
+			received, ok, err)
+	}
+}
+
+// Tests that missing and invalid retentions are reported.
+func TestMessageRetentionFromMessage_Error(t *testing.T) {
+	if _, ok, err := MessageRetentionFromMessage(nil); ok || err != nil {
+		t.Errorf("Retention found in empty response: %t %+v", ok, err)
+	}
+
+	policy := &MessageRetentionPolicy{TTL: -1}
+	if _, ok, err := MessageRetentionFromMessage(policy); ok || err == nil {
+		t.Errorf("Negative TTL was accepted.")
+	}
+}
@@ -41,6 +41,12 @@ func (m *RoundInfo) GetEccSig() *messages.ECCSignature {
 	return m.EccSignature
 }
 
+// HasEccSignature returns true if the round info has been signed with an
+// elliptic curve key. Unlike GetEccSig, it does not modify the message.
+func (m *RoundInfo) HasEccSignature() bool {
+	return m.EccSignature != nil && len(m.EccSignature.Signature) > 0
+}
+
 // Digest hashes the contents of the message in a repeatable manner
 // using the provided cryptographic hash. It includes the nonce in the hash
 func (m *RoundInfo) Digest(nonce []byte, h hash.Hash) []byte {
@@ -0,0 +1,158 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the structured form of round metrics which nodes export to
+// permissioning
+
+package mixmessages
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Duration returns the time spent in the phase.
+func (m *PhaseTiming) Duration() time.Duration {
+	return time.Duration(m.GetEnd() - m.GetStart())
+}
+
+// NewRoundTripPingTiming returns the timing in the form it is sent in a
+// RoundMetricsReport.
+func NewRoundTripPingTiming(rtt RoundTripTiming) *RoundTripPingTiming {
+	return &RoundTripPingTiming{
+		RoundID:         rtt.RoundID,
+		Peer:            rtt.Peer,
+		Sent:            unixNano(rtt.Sent),
+		Received:        unixNano(rtt.Received),
+		Returned:        unixNano(rtt.Returned),
+		PayloadVerified: rtt.PayloadVerified,
+	}
+}
+
+// Timing returns the timing sent in the message.
+func (m *RoundTripPingTiming) Timing() RoundTripTiming {
+	return RoundTripTiming{
+		RoundID:         m.GetRoundID(),
+		Peer:            m.GetPeer(),
+		Sent:            fromUnixNano(m.GetSent()),
+		Received:        fromUnixNano(m.GetReceived()),
+		Returned:        fromUnixNano(m.GetReturned()),
+		PayloadVerified: m.GetPayloadVerified(),
+	}
+}
+
+// unixNano returns the time in Unix nanoseconds, or zero for the zero time.
+func unixNano(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano()
+}
+
+// fromUnixNano returns the time of the Unix nanoseconds, or the zero time
+// for zero.
+func fromUnixNano(ns int64) time.Time {
+	if ns == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, ns)
+}
+
+// legacyRoundMetrics is the layout of the RoundMetricJSON string of
+// RoundMetrics as written by nodes.
+type legacyRoundMetrics struct {
+	NodeID         string
+	NumNodes       int
+	Index          int
+	RoundID        uint64
+	BatchSize      uint32
+	StartTime      time.Time
+	EndTime        time.Time
+	PhaseMetrics   []legacyPhaseMetric
+	ResourceMetric legacyResourceMetric
+	RoundTripPings []RoundTripTiming
+}
+
+type legacyPhaseMetric struct {
+	PhaseName string
+	Events    []legacyEvent
+}
+
+type legacyEvent struct {
+	Tag       string
+	Timestamp time.Time
+}
+
+type legacyResourceMetric struct {
+	MemAllocBytes uint64
+	MemAvailable  uint64
+	NumThreads    int
+	CPUPercentage float64
+}
+
+// NewRoundMetricsReport converts the RoundMetricJSON string of the metrics
+// into a report. A phase runs from its first to its last recorded event;
+// phases without events are skipped. Fields missing from the JSON are left
+// zero.
+func NewRoundMetricsReport(metrics *RoundMetrics) (*RoundMetricsReport, error) {
+	legacy := legacyRoundMetrics{}
+	err := json.Unmarshal([]byte(metrics.GetRoundMetricJSON()), &legacy)
+	if err != nil {
+		return nil, errors.Errorf("Failed to parse round metrics: %+v", err)
+	}
+
+	report := &RoundMetricsReport{
+		RoundID:   legacy.RoundID,
+		NodeID:    legacy.NodeID,
+		Index:     uint32(legacy.Index),
+		NumNodes:  uint32(legacy.NumNodes),
+		BatchSize: legacy.BatchSize,
+		StartTime: unixNano(legacy.StartTime),
+		EndTime:   unixNano(legacy.EndTime),
+		Phases:    make([]*PhaseTiming, 0, len(legacy.PhaseMetrics)),
+		Resources: &ResourceUsage{
+			MemoryAllocated: legacy.ResourceMetric.MemAllocBytes,
+			MemoryAvailable: legacy.ResourceMetric.MemAvailable,
+			NumThreads:      uint32(legacy.ResourceMetric.NumThreads),
+			CPUPercentage:   legacy.ResourceMetric.CPUPercentage,
+		},
+		RoundTripPings: make([]*RoundTripPingTiming, 0,
+			len(legacy.RoundTripPings)),
+	}
+	for _, rtt := range legacy.RoundTripPings {
+		report.RoundTripPings = append(report.RoundTripPings,
+			NewRoundTripPingTiming(rtt))
+	}
+
+	for _, phase := range legacy.PhaseMetrics {
+		if len(phase.Events) == 0 {
+			continue
+		}
+		start, end := phase.Events[0].Timestamp, phase.Events[0].Timestamp
+		for _, event := range phase.Events[1:] {
+			if event.Timestamp.Before(start) {
+				start = event.Timestamp
+			}
+			if event.Timestamp.After(end) {
+				end = event.Timestamp
+			}
+		}
+		report.Phases = append(report.Phases, &PhaseTiming{
+			Phase: phase.PhaseName,
+			Start: unixNano(start),
+			End:   unixNano(end),
+		})
+	}
+	sort.SliceStable(report.Phases, func(i, j int) bool {
+		return report.Phases[i].Start < report.Phases[j].Start
+	})
+
+	return report, nil
+}
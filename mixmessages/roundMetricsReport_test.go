@@ -0,0 +1,114 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package mixmessages
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const testLegacyRoundMetrics = `{
+	"NodeID": "node",
+	"NumNodes": 3,
+	"Index": 1,
+	"RoundID": 18446744073709551615,
+	"BatchSize": 32,
+	"StartTime": "2024-01-01T00:00:00Z",
+	"EndTime": "2024-01-01T00:00:09Z",
+	"PhaseMetrics": [
+		{"PhaseName": "REALTIME", "Events": [
+			{"Tag": "end", "Timestamp": "2024-01-01T00:00:08Z"},
+			{"Tag": "start", "Timestamp": "2024-01-01T00:00:05Z"}]},
+		{"PhaseName": "EMPTY"},
+		{"PhaseName": "PRECOMPUTATION", "Events": [
+			{"Tag": "start", "Timestamp": "2024-01-01T00:00:01Z"},
+			{"Tag": "end", "Timestamp": "2024-01-01T00:00:04Z"}]}
+	],
+	"ResourceMetric": {"MemAllocBytes": 1024, "NumThreads": 8,
+		"CPUPercentage": 12.5},
+	"Unknown": true
+}`
+
+// Tests that legacy metrics are converted into a report.
+func TestNewRoundMetricsReport(t *testing.T) {
+	report, err := NewRoundMetricsReport(
+		&RoundMetrics{RoundMetricJSON: testLegacyRoundMetrics})
+	if err != nil {
+		t.Fatalf("Failed to convert metrics: %+v", err)
+	}
+
+	if report.RoundID != 18446744073709551615 || report.NodeID != "node" ||
+		report.Index != 1 || report.NumNodes != 3 || report.BatchSize != 32 {
+		t.Errorf("Unexpected round fields: %+v", report)
+	}
+	if len(report.Phases) != 2 {
+		t.Fatalf("Unexpected phases: %+v", report.Phases)
+	}
+	if report.Phases[0].Phase != "PRECOMPUTATION" ||
+		report.Phases[1].Phase != "REALTIME" {
+		t.Errorf("Phases not ordered by start: %+v", report.Phases)
+	}
+	if d := report.Phases[1].Duration(); d != 3*time.Second {
+		t.Errorf("Unexpected realtime duration %s.", d)
+	}
+	if report.Resources.GetMemoryAllocated() != 1024 ||
+		report.Resources.GetNumThreads() != 8 ||
+		report.Resources.GetCPUPercentage() != 12.5 {
+		t.Errorf("Unexpected resources: %+v", report.Resources)
+	}
+}
+
+// Error path: metrics which are not JSON are rejected.
+func TestNewRoundMetricsReport_Invalid(t *testing.T) {
+	_, err := NewRoundMetricsReport(&RoundMetrics{RoundMetricJSON: "metrics"})
+	if err == nil {
+		t.Error("No error for invalid metrics.")
+	}
+}
+
+// Tests that a report with round trip pings is unchanged by sending it.
+func TestRoundMetricsReport_Marshal(t *testing.T) {
+	report, err := NewRoundMetricsReport(
+		&RoundMetrics{RoundMetricJSON: testLegacyRoundMetrics})
+	if err != nil {
+		t.Fatalf("Failed to convert metrics: %+v", err)
+	}
+	timing := RoundTripTiming{
+		RoundID:  5,
+		Peer:     "peer",
+		Sent:     time.Unix(0, report.StartTime),
+		Received: time.Unix(0, report.EndTime),
+	}
+	report.RoundTripPings = []*RoundTripPingTiming{
+		NewRoundTripPingTiming(timing)}
+
+	data, err := proto.Marshal(report)
+	if err != nil {
+		t.Fatalf("Failed to marshal report: %+v", err)
+	}
+	received := &RoundMetricsReport{}
+	if err = proto.Unmarshal(data, received); err != nil {
+		t.Fatalf("Failed to unmarshal report: %+v", err)
+	}
+
+	if !proto.Equal(received, report) {
+		t.Errorf("Report changed.\nexpected: %+v\nreceived: %+v",
+			report, received)
+	}
+	pings := received.GetRoundTripPings()
+	if len(pings) != 1 {
+		t.Fatalf("Unexpected pings: %+v", pings)
+	}
+	if rtt := pings[0].Timing(); rtt.Peer != "peer" ||
+		!rtt.Sent.Equal(timing.Sent) || !rtt.Returned.IsZero() {
+		t.Errorf("Ping timing changed.\nexpected: %+v\nreceived: %+v",
+			timing, rtt)
+	}
+}
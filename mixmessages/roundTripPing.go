@@ -0,0 +1,143 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the payload echo and timing of round trip pings
+
+package mixmessages
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+)
+
+// Metadata sent with round trip pings. They are sent as metadata rather than
+// in RoundTripPing so that nodes not aware of them are unaffected.
+const (
+	// When the ping was sent, in the sender's Unix nanoseconds
+	RoundTripPingSentHeader = "ping-sent"
+	// When the ping was received, in the receiver's Unix nanoseconds
+	RoundTripPingReceivedHeader = "ping-received"
+	// The hash of the received payload, echoed back to the sender
+	RoundTripPingHashHeader = "ping-payload-hash"
+)
+
+// RoundMetricsPingsKey is the key of the round trip ping timings added to
+// the RoundMetricJSON object of a round.
+const RoundMetricsPingsKey = "RoundTripPings"
+
+// HashRoundTripPingPayload returns the hash of the payload echoed back by the
+// receiver of a ping.
+func HashRoundTripPingPayload(payload *any.Any) string {
+	h := sha256.New()
+	h.Write([]byte(payload.GetTypeUrl()))
+	h.Write(payload.GetValue())
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+// RoundTripTiming records the times of a round trip ping. Each side records
+// the times it knows: the receiver records when the ping was sent and
+// received, and the sender additionally when the response returned. Times
+// from the other node are taken from its clock, so one-way durations are
+// estimates which include any clock offset between the nodes.
+type RoundTripTiming struct {
+	RoundID uint64
+	// ID of the other node
+	Peer     string
+	Sent     time.Time
+	Received time.Time
+	// Zero on the receiver
+	Returned time.Time
+	// Whether the receiver echoed the hash of the payload as sent; only
+	// meaningful on the sender
+	PayloadVerified bool `json:",omitempty"`
+}
+
+// Outbound returns the estimated time taken by the ping to reach the
+// receiver, or zero if the other node did not send its time.
+func (rtt RoundTripTiming) Outbound() time.Duration {
+	if rtt.Sent.IsZero() || rtt.Received.IsZero() {
+		return 0
+	}
+	return rtt.Received.Sub(rtt.Sent)
+}
+
+// Return returns the estimated time taken by the response to reach the
+// sender, or zero on the receiver or if the receiver did not send its time.
+func (rtt RoundTripTiming) Return() time.Duration {
+	if rtt.Received.IsZero() || rtt.Returned.IsZero() {
+		return 0
+	}
+	return rtt.Returned.Sub(rtt.Received)
+}
+
+// RoundTrip returns the time between sending the ping and receiving the
+// response, which does not depend on the receiver's clock, or zero on the
+// receiver.
+func (rtt RoundTripTiming) RoundTrip() time.Duration {
+	if rtt.Returned.IsZero() {
+		return 0
+	}
+	return rtt.Returned.Sub(rtt.Sent)
+}
+
+// TimeFromHeader returns the time in Unix nanoseconds under the key.
+func TimeFromHeader(md metadata.MD, key string) (time.Time, error) {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return time.Time{}, errors.Errorf("Missing %s header", key)
+	}
+	ns, err := strconv.ParseInt(values[0], 10, 64)
+	if err != nil {
+		return time.Time{}, errors.Errorf("Invalid %s header %q", key,
+			values[0])
+	}
+	return time.Unix(0, ns), nil
+}
+
+// FormatHeaderTime returns the time in the form sent in headers.
+func FormatHeaderTime(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+// AddRoundTripTimings adds the timings to the JSON object in the metrics
+// under RoundMetricsPingsKey. If the metrics are not a JSON object, they are
+// left unchanged and an error is returned.
+func AddRoundTripTimings(metrics *RoundMetrics,
+	timings []RoundTripTiming) error {
+	if metrics == nil || len(timings) == 0 {
+		return nil
+	}
+
+	fields := make(map[string]json.RawMessage)
+	if metrics.RoundMetricJSON != "" {
+		err := json.Unmarshal([]byte(metrics.RoundMetricJSON), &fields)
+		if err != nil {
+			return errors.Errorf("Round metrics are not a JSON object: %+v",
+				err)
+		}
+	}
+
+	data, err := json.Marshal(timings)
+	if err != nil {
+		return err
+	}
+	fields[RoundMetricsPingsKey] = data
+
+	data, err = json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	metrics.RoundMetricJSON = string(data)
+	return nil
+}
@@ -0,0 +1,87 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package mixmessages
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/any"
+)
+
+// Tests that the payload hash depends on both the type and the value.
+func TestHashRoundTripPingPayload(t *testing.T) {
+	payload := &any.Any{TypeUrl: "type", Value: []byte("value")}
+	hash := HashRoundTripPingPayload(payload)
+
+	if hash != HashRoundTripPingPayload(
+		&any.Any{TypeUrl: "type", Value: []byte("value")}) {
+		t.Errorf("Hash of identical payload differs.")
+	}
+	if hash == HashRoundTripPingPayload(
+		&any.Any{TypeUrl: "type", Value: []byte("other")}) {
+		t.Errorf("Hash of altered value is unchanged.")
+	}
+	if hash == HashRoundTripPingPayload(
+		&any.Any{TypeUrl: "other", Value: []byte("value")}) {
+		t.Errorf("Hash of altered type is unchanged.")
+	}
+}
+
+// Tests the durations of a timing recorded by the sender.
+func TestRoundTripTiming_Durations(t *testing.T) {
+	sent := time.Unix(100, 0)
+	rtt := RoundTripTiming{
+		Sent:     sent,
+		Received: sent.Add(3 * time.Millisecond),
+		Returned: sent.Add(5 * time.Millisecond),
+	}
+	if rtt.Outbound() != 3*time.Millisecond || rtt.Return() !=
+		2*time.Millisecond || rtt.RoundTrip() != 5*time.Millisecond {
+		t.Errorf("Unexpected durations: %s %s %s", rtt.Outbound(),
+			rtt.Return(), rtt.RoundTrip())
+	}
+
+	// The receiver cannot know the return time
+	rtt.Returned = time.Time{}
+	if rtt.Return() != 0 || rtt.RoundTrip() != 0 {
+		t.Errorf("Receiver timing has return durations.")
+	}
+}
+
+// Tests that timings are added to the metrics object without changing its
+// other fields and that metrics which are not an object are left unchanged.
+func TestAddRoundTripTimings(t *testing.T) {
+	metrics := &RoundMetrics{RoundMetricJSON: `{"RoundID":5}`}
+	timings := []RoundTripTiming{{RoundID: 5, Peer: "node"}}
+	if err := AddRoundTripTimings(metrics, timings); err != nil {
+		t.Fatalf("Failed to add timings: %+v", err)
+	}
+
+	var fields struct {
+		RoundID        uint64
+		RoundTripPings []RoundTripTiming
+	}
+	err := json.Unmarshal([]byte(metrics.RoundMetricJSON), &fields)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal metrics: %+v", err)
+	}
+	if fields.RoundID != 5 || len(fields.RoundTripPings) != 1 ||
+		fields.RoundTripPings[0].Peer != "node" {
+		t.Errorf("Unexpected metrics: %s", metrics.RoundMetricJSON)
+	}
+
+	metrics = &RoundMetrics{RoundMetricJSON: "[]"}
+	if err = AddRoundTripTimings(metrics, timings); err == nil {
+		t.Errorf("Timings were added to metrics which are not an object.")
+	}
+	if metrics.RoundMetricJSON != "[]" {
+		t.Errorf("Metrics were modified: %s", metrics.RoundMetricJSON)
+	}
+}
@@ -0,0 +1,231 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the comparison of protocol schemas for breaking changes
+
+package mixmessages
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// SchemaChange is a breaking change between two versions of the protocol.
+type SchemaChange struct {
+	// Full name of the changed element, e.g. "mixmessages.Slot.PayloadA"
+	Element     string
+	Description string
+}
+
+func (sc SchemaChange) String() string {
+	return sc.Element + ": " + sc.Description
+}
+
+// IncompatibleSchemaError is returned when a peer's protocol has breaking
+// changes from this one.
+type IncompatibleSchemaError struct {
+	Changes []SchemaChange
+}
+
+func (e *IncompatibleSchemaError) Error() string {
+	changes := make([]string, len(e.Changes))
+	for i, c := range e.Changes {
+		changes[i] = c.String()
+	}
+	return fmt.Sprintf("peer protocol is incompatible: %d breaking "+
+		"changes: %s", len(changes), strings.Join(changes, "; "))
+}
+
+// LocalSchema returns the descriptor set of the protocol this package
+// implements.
+func LocalSchema() *descriptorpb.FileDescriptorSet {
+	return &descriptorpb.FileDescriptorSet{
+		File: []*descriptorpb.FileDescriptorProto{
+			protodesc.ToFileDescriptorProto(File_mixmessages_proto),
+		},
+	}
+}
+
+// CheckPeerSchema returns an IncompatibleSchemaError if the local protocol
+// has breaking changes from the peer's, such that messages the peer sends
+// would be misread. Elements added locally are not breaking.
+func CheckPeerSchema(peer *descriptorpb.FileDescriptorSet) error {
+	changes := CompareSchemas(peer, LocalSchema())
+	if len(changes) > 0 {
+		return &IncompatibleSchemaError{Changes: changes}
+	}
+	return nil
+}
+
+// CompareSchemas returns the breaking changes from one descriptor set to a
+// later one: removed messages, fields, enum values, services and methods,
+// and fields and methods whose types changed. The changes are sorted by
+// element.
+func CompareSchemas(before,
+	after *descriptorpb.FileDescriptorSet) []SchemaChange {
+	oldIndex, newIndex := indexSchema(before), indexSchema(after)
+	var changes []SchemaChange
+	add := func(element, format string, a ...interface{}) {
+		changes = append(changes, SchemaChange{
+			Element:     element,
+			Description: fmt.Sprintf(format, a...),
+		})
+	}
+
+	for name, oldMsg := range oldIndex.messages {
+		newMsg, exists := newIndex.messages[name]
+		if !exists {
+			add(name, "message removed")
+			continue
+		}
+		compareFields(name, oldMsg, newMsg, add)
+	}
+
+	for name, oldEnum := range oldIndex.enums {
+		newEnum, exists := newIndex.enums[name]
+		if !exists {
+			add(name, "enum removed")
+			continue
+		}
+		values := make(map[int32]bool)
+		for _, v := range newEnum.GetValue() {
+			values[v.GetNumber()] = true
+		}
+		for _, v := range oldEnum.GetValue() {
+			if !values[v.GetNumber()] {
+				add(name+"."+v.GetName(), "enum value %d removed",
+					v.GetNumber())
+			}
+		}
+	}
+
+	for name, oldService := range oldIndex.services {
+		newService, exists := newIndex.services[name]
+		if !exists {
+			add(name, "service removed")
+			continue
+		}
+		methods := make(map[string]*descriptorpb.MethodDescriptorProto)
+		for _, m := range newService.GetMethod() {
+			methods[m.GetName()] = m
+		}
+		for _, oldMethod := range oldService.GetMethod() {
+			element := name + "." + oldMethod.GetName()
+			newMethod, exists := methods[oldMethod.GetName()]
+			if !exists {
+				add(element, "method removed")
+				continue
+			}
+			if oldMethod.GetInputType() != newMethod.GetInputType() ||
+				oldMethod.GetOutputType() != newMethod.GetOutputType() {
+				add(element, "type changed from (%s) returns (%s) to "+
+					"(%s) returns (%s)", oldMethod.GetInputType(),
+					oldMethod.GetOutputType(), newMethod.GetInputType(),
+					newMethod.GetOutputType())
+			}
+			if oldMethod.GetClientStreaming() != newMethod.GetClientStreaming() ||
+				oldMethod.GetServerStreaming() != newMethod.GetServerStreaming() {
+				add(element, "streaming changed")
+			}
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Element != changes[j].Element {
+			return changes[i].Element < changes[j].Element
+		}
+		return changes[i].Description < changes[j].Description
+	})
+	return changes
+}
+
+// compareFields reports the breaking changes between the fields of two
+// versions of a message. Fields are matched by number, as that is what is
+// sent on the wire.
+func compareFields(name string, before, after *descriptorpb.DescriptorProto,
+	add func(element, format string, a ...interface{})) {
+	fields := make(map[int32]*descriptorpb.FieldDescriptorProto)
+	for _, f := range after.GetField() {
+		fields[f.GetNumber()] = f
+	}
+
+	for _, oldField := range before.GetField() {
+		element := name + "." + oldField.GetName()
+		newField, exists := fields[oldField.GetNumber()]
+		if !exists {
+			add(element, "field %d removed", oldField.GetNumber())
+			continue
+		}
+		if oldField.GetType() != newField.GetType() ||
+			oldField.GetTypeName() != newField.GetTypeName() {
+			add(element, "field %d type changed from %s to %s",
+				oldField.GetNumber(), fieldType(oldField),
+				fieldType(newField))
+		}
+		if oldField.GetLabel() != newField.GetLabel() {
+			add(element, "field %d label changed from %s to %s",
+				oldField.GetNumber(), oldField.GetLabel(),
+				newField.GetLabel())
+		}
+	}
+}
+
+// fieldType returns the name of the type of the field.
+func fieldType(f *descriptorpb.FieldDescriptorProto) string {
+	if f.GetTypeName() != "" {
+		return f.GetTypeName()
+	}
+	return f.GetType().String()
+}
+
+// schemaIndex holds the elements of a descriptor set by full name.
+type schemaIndex struct {
+	messages map[string]*descriptorpb.DescriptorProto
+	enums    map[string]*descriptorpb.EnumDescriptorProto
+	services map[string]*descriptorpb.ServiceDescriptorProto
+}
+
+// indexSchema indexes the elements of the descriptor set, including nested
+// messages and enums.
+func indexSchema(set *descriptorpb.FileDescriptorSet) schemaIndex {
+	index := schemaIndex{
+		messages: make(map[string]*descriptorpb.DescriptorProto),
+		enums:    make(map[string]*descriptorpb.EnumDescriptorProto),
+		services: make(map[string]*descriptorpb.ServiceDescriptorProto),
+	}
+
+	var addMessages func(prefix string, msgs []*descriptorpb.DescriptorProto,
+		enums []*descriptorpb.EnumDescriptorProto)
+	addMessages = func(prefix string, msgs []*descriptorpb.DescriptorProto,
+		enums []*descriptorpb.EnumDescriptorProto) {
+		for _, e := range enums {
+			index.enums[prefix+e.GetName()] = e
+		}
+		for _, m := range msgs {
+			name := prefix + m.GetName()
+			index.messages[name] = m
+			addMessages(name+".", m.GetNestedType(), m.GetEnumType())
+		}
+	}
+
+	for _, file := range set.GetFile() {
+		prefix := ""
+		if file.GetPackage() != "" {
+			prefix = file.GetPackage() + "."
+		}
+		addMessages(prefix, file.GetMessageType(), file.GetEnumType())
+		for _, s := range file.GetService() {
+			index.services[prefix+s.GetName()] = s
+		}
+	}
+
+	return index
+}
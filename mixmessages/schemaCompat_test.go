@@ -0,0 +1,84 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package mixmessages
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// findMessage returns the message with the name in the first file of the set.
+func findMessage(t *testing.T, set *descriptorpb.FileDescriptorSet,
+	name string) *descriptorpb.DescriptorProto {
+	for _, m := range set.File[0].MessageType {
+		if m.GetName() == name {
+			return m
+		}
+	}
+	t.Fatalf("Message %s not found", name)
+	return nil
+}
+
+// Tests that the local schema is compatible with itself and with a peer
+// which lacks elements added locally.
+func TestCheckPeerSchema(t *testing.T) {
+	if err := CheckPeerSchema(LocalSchema()); err != nil {
+		t.Errorf("Local schema is incompatible with itself: %+v", err)
+	}
+
+	peer := LocalSchema()
+	slot := findMessage(t, peer, "Slot")
+	slot.Field = slot.Field[:len(slot.Field)-1]
+	peer.File[0].Service = peer.File[0].Service[:1]
+	if err := CheckPeerSchema(peer); err != nil {
+		t.Errorf("Local additions were reported as breaking: %+v", err)
+	}
+}
+
+// Tests that removed and retyped fields and removed methods are reported.
+func TestCompareSchemas(t *testing.T) {
+	before := LocalSchema()
+	after := proto.Clone(before).(*descriptorpb.FileDescriptorSet)
+
+	slot := findMessage(t, after, "Slot")
+	for i, f := range slot.Field {
+		if f.GetName() == "PayloadA" {
+			slot.Field = append(slot.Field[:i], slot.Field[i+1:]...)
+			break
+		}
+	}
+	for _, f := range slot.Field {
+		if f.GetName() == "Index" {
+			f.Type = descriptorpb.FieldDescriptorProto_TYPE_UINT64.Enum()
+		}
+	}
+	service := after.File[0].Service[0]
+	removed := service.Method[0].GetName()
+	service.Method = service.Method[1:]
+
+	changes := CompareSchemas(before, after)
+	expected := map[string]bool{
+		"mixmessages.Slot.PayloadA":                        true,
+		"mixmessages.Slot.Index":                           true,
+		"mixmessages." + service.GetName() + "." + removed: true,
+	}
+	if len(changes) != len(expected) {
+		t.Fatalf("Expected %d changes, got %v", len(expected), changes)
+	}
+	for _, c := range changes {
+		if !expected[c.Element] {
+			t.Errorf("Unexpected change %s", c)
+		}
+	}
+
+	if err := (&IncompatibleSchemaError{Changes: changes}); err.Error() == "" {
+		t.Errorf("Error has no message.")
+	}
+}
@@ -0,0 +1,59 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the marker of the encryption scheme of slot payloads
+
+package mixmessages
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// SlotScheme identifies the encryption scheme and version of slot payloads.
+type SlotScheme struct {
+	Name    string
+	Version uint32
+}
+
+// DefaultSlotScheme is the scheme of slots sent without a marker.
+var DefaultSlotScheme = SlotScheme{Name: "cmix", Version: 1}
+
+// String returns the scheme as "name/version".
+func (s SlotScheme) String() string {
+	return s.Name + "/" + strconv.FormatUint(uint64(s.Version), 10)
+}
+
+// IsZero returns true if the scheme is unset.
+func (s SlotScheme) IsZero() bool {
+	return s == SlotScheme{}
+}
+
+// ParseSlotScheme parses a scheme in the form "name/version".
+func ParseSlotScheme(s string) (SlotScheme, error) {
+	i := strings.LastIndex(s, "/")
+	if i <= 0 {
+		return SlotScheme{}, errors.Errorf("Invalid slot scheme %q", s)
+	}
+	version, err := strconv.ParseUint(s[i+1:], 10, 32)
+	if err != nil {
+		return SlotScheme{}, errors.Errorf("Invalid version of slot "+
+			"scheme %q: %+v", s, err)
+	}
+	return SlotScheme{Name: s[:i], Version: uint32(version)}, nil
+}
+
+// MarkedSlotScheme returns the scheme marked in the SlotScheme field of a
+// GatewaySlot or GatewaySlots, or the DefaultSlotScheme if it is not marked.
+func MarkedSlotScheme(marker string) (SlotScheme, error) {
+	if marker == "" {
+		return DefaultSlotScheme, nil
+	}
+	return ParseSlotScheme(marker)
+}
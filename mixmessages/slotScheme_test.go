@@ -0,0 +1,41 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package mixmessages
+
+import (
+	"testing"
+)
+
+// Tests that schemes survive being marked in and read from a message.
+func TestMarkedSlotScheme(t *testing.T) {
+	scheme := SlotScheme{Name: "cmix-pq", Version: 2}
+	msg := &GatewaySlot{SlotScheme: scheme.String()}
+
+	received, err := MarkedSlotScheme(msg.GetSlotScheme())
+	if err != nil {
+		t.Fatalf("Failed to read scheme: %+v", err)
+	}
+	if received != scheme {
+		t.Errorf("Received scheme %s, expected %s", received, scheme)
+	}
+
+	received, err = MarkedSlotScheme("")
+	if err != nil || received != DefaultSlotScheme {
+		t.Errorf("Unmarked request has scheme %s, expected %s: %+v",
+			received, DefaultSlotScheme, err)
+	}
+}
+
+// Tests that malformed schemes are rejected.
+func TestParseSlotScheme_Invalid(t *testing.T) {
+	for _, s := range []string{"", "cmix", "/1", "cmix/", "cmix/v1", "cmix/-1"} {
+		if _, err := ParseSlotScheme(s); err == nil {
+			t.Errorf("No error parsing %q", s)
+		}
+	}
+}
@@ -10,6 +10,10 @@
 package mixmessages
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
 	"github.com/golang/protobuf/proto"
 	jww "github.com/spf13/jwalterweatherman"
 )
@@ -21,6 +25,9 @@ const (
 	MixedBatchHeader       = "mixedBatchInfo"
 	FinishRealtimeHeader   = "finishRealtimeRoundInfo"
 	PrecompTestBatchHeader = "precompTestBatch"
+	// Ephemeral IDs a round update subscriber is interested in, one per
+	// value. Binary headers must end in "-bin".
+	RoundUpdateInterestHeader = "round-update-interest-bin"
 )
 
 const NoStreamingHeaderErr = "Streaming header has no information from %s"
@@ -33,6 +40,10 @@ const ChunkSize = 1250
 // the amount of chunks the response has been split into.
 const ChunkHeader = "totalChunks"
 
+// ChunkHashTrailer is the trailer used to send the hash of all streamed chunks
+// (see HashChunks) so the receiver can check the reassembled message.
+const ChunkHashTrailer = "chunksHash"
+
 // SplitResponseIntoChunks is a function which takes in a message and splits
 // the serialized message into ChunkSize chunks. .
 func SplitResponseIntoChunks(message proto.Message) ([]*StreamChunk, error) {
@@ -76,6 +87,16 @@ func AssembleChunksIntoResponse(chunks []*StreamChunk, response proto.Message) e
 	return proto.Unmarshal(data, response)
 }
 
+// HashChunks returns the SHA-256 hash of the data of all chunks in order,
+// which is the hash of the message they were split from.
+func HashChunks(chunks []*StreamChunk) []byte {
+	h := sha256.New()
+	for _, chunk := range chunks {
+		h.Write(chunk.Datum)
+	}
+	return h.Sum(nil)
+}
+
 func DebugMode() {
 	jww.SetLogThreshold(jww.LevelDebug)
 	jww.SetStdoutThreshold(jww.LevelDebug)
@@ -85,3 +106,19 @@ func TraceMode() {
 	jww.SetLogThreshold(jww.LevelTrace)
 	jww.SetStdoutThreshold(jww.LevelTrace)
 }
+
+// UnmixedBatchIdempotencyKey returns the idempotency key of the upload of the
+// batch described by the info, whose slots marshal to the given data. Retries
+// of an upload send the same slots, so they share the key, while other
+// batches uploaded for the same round phase do not.
+func UnmixedBatchIdempotencyKey(info *BatchInfo, slots [][]byte) string {
+	h := sha256.New()
+	length := make([]byte, 8)
+	for _, slot := range slots {
+		binary.BigEndian.PutUint64(length, uint64(len(slot)))
+		h.Write(length)
+		h.Write(slot)
+	}
+	return fmt.Sprintf("%d/%d/%d/%d/%x", info.GetRound().GetID(),
+		info.GetFromPhase(), info.GetBatchSize(), len(slots), h.Sum(nil))
+}
@@ -0,0 +1,243 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Cache of signed gateway and node certificates for certificate pinning
+
+package dataStructures
+
+import (
+	"bytes"
+	"crypto"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/crypto/signature/rsa"
+	"gitlab.com/xx_network/crypto/tls"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/netTime"
+)
+
+// CertificateCallback is called when the certificate held for a gateway or
+// node changes. old is nil when a certificate is first added and new is nil
+// when one is removed or expires.
+type CertificateCallback func(hostID *id.ID, old, new *pb.GatewayCertificate)
+
+// cachedCertificate is a certificate held by CertificateCache.
+type cachedCertificate struct {
+	cert     *pb.GatewayCertificate
+	notAfter time.Time
+}
+
+// certificateChange is a change to report to the callbacks once the lock is
+// released.
+type certificateChange struct {
+	hostID   *id.ID
+	old, new *pb.GatewayCertificate
+}
+
+// CertificateCache holds the TLS certificates of gateways and nodes, as
+// delivered by RequestTlsCert, after checking that permissioning signed them
+// and that they have not expired. Clients can pin the certificates they
+// connect with to those in the cache.
+type CertificateCache struct {
+	certs            map[id.ID]*cachedCertificate
+	permissioningKey *rsa.PublicKey
+	callbacks        []CertificateCallback
+	mux              sync.RWMutex
+}
+
+// NewCertificateCache creates an empty CertificateCache accepting
+// certificates signed with the permissioning key.
+func NewCertificateCache(permissioningKey *rsa.PublicKey) *CertificateCache {
+	return &CertificateCache{
+		certs:            make(map[id.ID]*cachedCertificate),
+		permissioningKey: permissioningKey,
+	}
+}
+
+// SignCertificate signs the certificate in the form verified by
+// CertificateCache, an RSA-PSS signature over its SHA-256 hash.
+func SignCertificate(rng io.Reader, certificate []byte,
+	key *rsa.PrivateKey) (*pb.GatewayCertificate, error) {
+	h := crypto.SHA256.New()
+	h.Write(certificate)
+	sig, err := rsa.Sign(rng, key, crypto.SHA256, h.Sum(nil), nil)
+	if err != nil {
+		return nil, errors.WithMessage(err, "Failed to sign certificate")
+	}
+	return &pb.GatewayCertificate{
+		Certificate: copyBytes(certificate),
+		Signature:   sig,
+	}, nil
+}
+
+// AddCallback registers a callback to be called, outside the cache's lock,
+// each time a certificate is added, replaced, removed or expires.
+func (cc *CertificateCache) AddCallback(cb CertificateCallback) {
+	cc.mux.Lock()
+	defer cc.mux.Unlock()
+
+	cc.callbacks = append(cc.callbacks, cb)
+}
+
+// Add verifies the certificate for the gateway or node and stores a copy of
+// it, replacing any certificate already held. Returns an error if the
+// signature is not valid or the certificate cannot be parsed or has expired.
+// Adding the certificate already held does nothing.
+func (cc *CertificateCache) Add(hostID *id.ID,
+	cert *pb.GatewayCertificate) error {
+	if err := cc.verify(cert); err != nil {
+		return errors.WithMessagef(err, "Failed to verify certificate of %s",
+			hostID)
+	}
+
+	x509Cert, err := tls.LoadCertificate(string(cert.GetCertificate()))
+	if err != nil {
+		return errors.WithMessagef(err, "Failed to parse certificate of %s",
+			hostID)
+	}
+	if netTime.Now().After(x509Cert.NotAfter) {
+		return errors.Errorf("Certificate of %s expired at %s", hostID,
+			x509Cert.NotAfter)
+	}
+
+	newCert := &pb.GatewayCertificate{
+		Certificate: copyBytes(cert.GetCertificate()),
+		Signature:   copyBytes(cert.GetSignature()),
+	}
+
+	cc.mux.Lock()
+	existing, exists := cc.certs[*hostID]
+	if exists && bytes.Equal(existing.cert.Certificate, newCert.Certificate) {
+		cc.mux.Unlock()
+		return nil
+	}
+	cc.certs[*hostID] = &cachedCertificate{newCert, x509Cert.NotAfter}
+	callbacks := cc.callbacks
+	cc.mux.Unlock()
+
+	change := certificateChange{hostID: hostID.DeepCopy(), new: newCert}
+	if exists {
+		change.old = existing.cert
+	}
+	notifyCertificateChanges(callbacks, change)
+	return nil
+}
+
+// Get returns a copy of the certificate held for the gateway or node. Returns
+// an error if there is none or it has expired.
+func (cc *CertificateCache) Get(hostID *id.ID) (*pb.GatewayCertificate,
+	error) {
+	cc.mux.RLock()
+	defer cc.mux.RUnlock()
+
+	cached, exists := cc.certs[*hostID]
+	if !exists {
+		return nil, errors.Errorf("No certificate held for %s", hostID)
+	}
+	if netTime.Now().After(cached.notAfter) {
+		return nil, errors.Errorf("Certificate of %s expired at %s", hostID,
+			cached.notAfter)
+	}
+	return &pb.GatewayCertificate{
+		Certificate: copyBytes(cached.cert.Certificate),
+		Signature:   copyBytes(cached.cert.Signature),
+	}, nil
+}
+
+// GetExpiry returns when the certificate held for the gateway or node
+// expires, or false if none is held.
+func (cc *CertificateCache) GetExpiry(hostID *id.ID) (time.Time, bool) {
+	cc.mux.RLock()
+	defer cc.mux.RUnlock()
+
+	cached, exists := cc.certs[*hostID]
+	if !exists {
+		return time.Time{}, false
+	}
+	return cached.notAfter, true
+}
+
+// Remove removes the certificate held for the gateway or node. Returns false
+// if none was held.
+func (cc *CertificateCache) Remove(hostID *id.ID) bool {
+	cc.mux.Lock()
+	existing, exists := cc.certs[*hostID]
+	delete(cc.certs, *hostID)
+	callbacks := cc.callbacks
+	cc.mux.Unlock()
+
+	if exists {
+		notifyCertificateChanges(callbacks,
+			certificateChange{hostID: hostID.DeepCopy(), old: existing.cert})
+	}
+	return exists
+}
+
+// PruneExpired removes the certificates which have expired and returns the
+// IDs of their gateways or nodes.
+func (cc *CertificateCache) PruneExpired() []*id.ID {
+	now := netTime.Now()
+
+	cc.mux.Lock()
+	var changes []certificateChange
+	for hostID, cached := range cc.certs {
+		if now.After(cached.notAfter) {
+			delete(cc.certs, hostID)
+			changes = append(changes,
+				certificateChange{hostID: hostID.DeepCopy(), old: cached.cert})
+		}
+	}
+	callbacks := cc.callbacks
+	cc.mux.Unlock()
+
+	notifyCertificateChanges(callbacks, changes...)
+
+	expired := make([]*id.ID, len(changes))
+	for i, change := range changes {
+		expired[i] = change.hostID
+	}
+	return expired
+}
+
+// Len returns the number of certificates held, including expired ones not yet
+// pruned.
+func (cc *CertificateCache) Len() int {
+	cc.mux.RLock()
+	defer cc.mux.RUnlock()
+
+	return len(cc.certs)
+}
+
+// verify checks that the certificate is signed with the permissioning key.
+func (cc *CertificateCache) verify(cert *pb.GatewayCertificate) error {
+	if cc.permissioningKey == nil {
+		return errors.New("No permissioning key set")
+	}
+	if len(cert.GetCertificate()) == 0 || len(cert.GetSignature()) == 0 {
+		return errors.New("Certificate or signature is missing")
+	}
+
+	h := crypto.SHA256.New()
+	h.Write(cert.GetCertificate())
+	return rsa.Verify(cc.permissioningKey, crypto.SHA256, h.Sum(nil),
+		cert.GetSignature(), nil)
+}
+
+// notifyCertificateChanges calls each callback with each change. Callers
+// receive the cache's own copies, which must not be modified.
+func notifyCertificateChanges(callbacks []CertificateCallback,
+	changes ...certificateChange) {
+	for _, change := range changes {
+		for _, cb := range callbacks {
+			cb(change.hostID, change.old, change.new)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package dataStructures
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/testkeys"
+	"gitlab.com/elixxir/comms/testutils"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// Tests that CertificateCache.Add stores only certificates signed by
+// permissioning and reports changes to the callbacks.
+func TestCertificateCache_Add(t *testing.T) {
+	privKey, err := testutils.LoadPrivateKeyTesting(t)
+	if err != nil {
+		t.Fatalf("Failed to load private key: %+v", err)
+	}
+	cc := NewCertificateCache(privKey.GetPublic())
+
+	var changes []*pb.GatewayCertificate
+	cc.AddCallback(func(hostID *id.ID, old, new *pb.GatewayCertificate) {
+		changes = append(changes, old, new)
+	})
+
+	gwID := id.NewIdFromString("gateway", id.Gateway, t)
+	gwCert, err := SignCertificate(rand.Reader, testkeys.GetGatewayCert(),
+		privKey)
+	if err != nil {
+		t.Fatalf("Failed to sign certificate: %+v", err)
+	}
+
+	// A certificate with a bad signature is rejected
+	badCert := &pb.GatewayCertificate{
+		Certificate: gwCert.Certificate,
+		Signature:   append([]byte{}, gwCert.Signature...),
+	}
+	badCert.Signature[0] ^= 0xFF
+	if err = cc.Add(gwID, badCert); err == nil {
+		t.Error("Add() accepted a certificate with an invalid signature.")
+	}
+
+	if err = cc.Add(gwID, gwCert); err != nil {
+		t.Fatalf("Add() returned an error: %+v", err)
+	}
+	// Adding the same certificate again is not a change
+	if err = cc.Add(gwID, gwCert); err != nil {
+		t.Fatalf("Add() returned an error: %+v", err)
+	}
+	if len(changes) != 2 || changes[0] != nil ||
+		!bytes.Equal(changes[1].Certificate, gwCert.Certificate) {
+		t.Errorf("Unexpected changes reported: %v", changes)
+	}
+
+	received, err := cc.Get(gwID)
+	if err != nil {
+		t.Fatalf("Get() returned an error: %+v", err)
+	}
+	if !bytes.Equal(received.Certificate, gwCert.Certificate) {
+		t.Errorf("Get() returned the wrong certificate.")
+	}
+	received.Certificate[0] ^= 0xFF
+	if again, _ := cc.Get(gwID); !bytes.Equal(again.Certificate,
+		gwCert.Certificate) {
+		t.Errorf("Modifying the certificate returned by Get() changed the " +
+			"cache.")
+	}
+
+	// Replacing the certificate reports the old and new certificates
+	nodeCert, err := SignCertificate(rand.Reader, testkeys.GetNodeCert(),
+		privKey)
+	if err != nil {
+		t.Fatalf("Failed to sign certificate: %+v", err)
+	}
+	if err = cc.Add(gwID, nodeCert); err != nil {
+		t.Fatalf("Add() returned an error: %+v", err)
+	}
+	if len(changes) != 4 ||
+		!bytes.Equal(changes[2].Certificate, gwCert.Certificate) ||
+		!bytes.Equal(changes[3].Certificate, nodeCert.Certificate) {
+		t.Errorf("Unexpected changes reported: %v", changes)
+	}
+
+	if _, exists := cc.GetExpiry(gwID); !exists {
+		t.Errorf("GetExpiry() did not find the certificate.")
+	}
+	if expired := cc.PruneExpired(); len(expired) != 0 {
+		t.Errorf("PruneExpired() removed valid certificates: %v", expired)
+	}
+
+	if !cc.Remove(gwID) || cc.Len() != 0 {
+		t.Errorf("Remove() did not remove the certificate.")
+	}
+	if len(changes) != 6 || changes[5] != nil {
+		t.Errorf("Removal not reported: %v", changes)
+	}
+	if _, err = cc.Get(gwID); err == nil {
+		t.Errorf("Get() did not error for a removed certificate.")
+	}
+}
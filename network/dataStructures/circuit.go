@@ -8,9 +8,13 @@
 package dataStructures
 
 import (
+	"github.com/pkg/errors"
 	jww "github.com/spf13/jwalterweatherman"
 	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/messages"
 	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/ndf"
+	"time"
 )
 
 type Circuit struct {
@@ -49,6 +53,59 @@ func NewCircuit(list []*id.ID) *Circuit {
 	return &c
 }
 
+// NewCircuitFromNDF builds a Circuit of the nodes in the NDF, in the order
+// they are listed, with the host of each node added in the same order. Hosts
+// already in the manager are reused; missing ones are added with the node's
+// address and certificate. Returns an error instead of panicking if the NDF
+// has no nodes, lists a node twice or has a node without an address.
+func NewCircuitFromNDF(def *ndf.NetworkDefinition, manager *connect.Manager) (
+	*Circuit, error) {
+	if len(def.Nodes) == 0 {
+		return nil, errors.New("Cannot build a Circuit from an NDF without " +
+			"nodes")
+	}
+
+	list := make([]*id.ID, len(def.Nodes))
+	seen := make(map[id.ID]bool, len(def.Nodes))
+	for index, node := range def.Nodes {
+		nid, err := id.Unmarshal(node.ID)
+		if err != nil {
+			return nil, errors.Errorf("Invalid ID of node %d: %+v", index,
+				err)
+		}
+		if seen[*nid] {
+			return nil, errors.Errorf("Node %s is listed multiple times in "+
+				"the NDF", nid)
+		}
+		if node.Address == "" {
+			return nil, errors.Errorf("Node %s has no address in the NDF",
+				nid)
+		}
+		seen[*nid] = true
+		list[index] = nid
+	}
+
+	c := NewCircuit(list)
+	for index, nid := range list {
+		host, exists := manager.GetHost(nid)
+		if !exists {
+			var err error
+			host, err = manager.AddHost(nid, def.Nodes[index].Address,
+				[]byte(def.Nodes[index].TlsCertificate),
+				connect.GetDefaultHostParams())
+			if err != nil {
+				return nil, errors.WithMessagef(err, "Could not add host "+
+					"of node %s", nid)
+			}
+			// 10k batch size * 8192 packet size * 2
+			host.SetWindowSize(connect.MaxWindowSize)
+		}
+		c.AddHost(host)
+	}
+
+	return c, nil
+}
+
 // GetNodeLocation returns the location of the passed node in the list.
 // Returns -1 if the node is not in the list
 func (c *Circuit) GetNodeLocation(node *id.ID) int {
@@ -157,6 +214,98 @@ func (c *Circuit) AddHost(newHost *connect.Host) {
 	c.hosts = append(c.hosts, newHost)
 }
 
+// ReplaceHost replaces the node at the index with the node of the new host,
+// so a failed node can be swapped out without rebuilding the circuit. Panics
+// if the index has no host or the new node is elsewhere in the circuit.
+func (c *Circuit) ReplaceHost(index int, newHost *connect.Host) {
+	if index < 0 || index >= len(c.hosts) {
+		jww.FATAL.Panicf("Cannot replace the host at index %v which is "+
+			"outside the Circut (len=%v)", index, len(c.hosts))
+	}
+
+	nid := newHost.GetId()
+	if loc, ok := c.nodeIndexes[*nid]; ok && loc != index {
+		jww.FATAL.Panicf("NodeIDs must be unique for the circuit.Circuit, "+
+			"%s is already at index %v", nid, loc)
+	}
+
+	delete(c.nodeIndexes, *c.nodes[index])
+	c.nodes[index] = nid.DeepCopy()
+	c.nodeIndexes[*nid] = index
+	c.hosts[index] = newHost
+}
+
+// OnlineChecker asks a host whether it is online. It is implemented by
+// node.Comms.
+type OnlineChecker interface {
+	SendAskOnline(host *connect.Host) (*messages.Ack, error)
+}
+
+// UnreachableNode describes a node which did not respond to an online check.
+type UnreachableNode struct {
+	Index int
+	Node  *id.ID
+	Err   error
+}
+
+// OnlineReport is the result of checking every node in a circuit.
+type OnlineReport struct {
+	Unreachable []UnreachableNode
+}
+
+// AllOnline returns true if every node in the circuit responded.
+func (r *OnlineReport) AllOnline() bool {
+	return len(r.Unreachable) == 0
+}
+
+// CheckAllOnline concurrently asks every host in the circuit whether it is
+// online, reporting the nodes which returned an error or did not respond
+// within the timeout, in circuit order.
+func (c *Circuit) CheckAllOnline(checker OnlineChecker,
+	timeout time.Duration) *OnlineReport {
+	type result struct {
+		index int
+		err   error
+	}
+	results := make(chan result, len(c.hosts))
+	for index, host := range c.hosts {
+		go func(index int, host *connect.Host) {
+			_, err := checker.SendAskOnline(host)
+			results <- result{index, err}
+		}(index, host)
+	}
+
+	errs := make([]error, len(c.hosts))
+	responded := make([]bool, len(c.hosts))
+	deadline := time.After(timeout)
+collect:
+	for range c.hosts {
+		select {
+		case r := <-results:
+			responded[r.index] = true
+			errs[r.index] = r.err
+		case <-deadline:
+			break collect
+		}
+	}
+
+	report := &OnlineReport{}
+	for index, host := range c.hosts {
+		err := errs[index]
+		if !responded[index] {
+			err = errors.Errorf("No response within %s", timeout)
+		}
+		if err != nil {
+			report.Unreachable = append(report.Unreachable, UnreachableNode{
+				Index: index,
+				Node:  host.GetId(),
+				Err:   err,
+			})
+		}
+	}
+	return report
+}
+
 // shiftLeft rotates the node IDs in a slice to the left the specified number of
 // times.
 func shiftLeft(list []*id.ID, rotation int) []*id.ID {
@@ -8,12 +8,17 @@
 package dataStructures
 
 import (
+	"errors"
 	"gitlab.com/elixxir/comms/testkeys"
 	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/messages"
 	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/ndf"
 	"gitlab.com/xx_network/primitives/utils"
 	"reflect"
+	"strconv"
 	"testing"
+	"time"
 )
 
 // Tests the happy path of NewCircuit
@@ -229,6 +234,160 @@ func TestCircuit_GetHostAtIndex(t *testing.T) {
 
 }
 
+// Tests that NewCircuitFromNDF orders the circuit as the NDF, reuses hosts
+// already in the manager and adds missing ones.
+func TestNewCircuitFromNDF(t *testing.T) {
+	nodeIdList := makeTestingNodeIdList(3, t)
+	cert, _ := utils.ReadFile(testkeys.GetNodeCertPath())
+	def := &ndf.NetworkDefinition{}
+	for i, nid := range nodeIdList {
+		def.Nodes = append(def.Nodes, ndf.Node{
+			ID:             nid.Marshal(),
+			Address:        "0.0.0.0:" + strconv.Itoa(7000+i),
+			TlsCertificate: string(cert),
+		})
+	}
+
+	manager := connect.NewManagerTesting(t)
+	existing, err := manager.AddHost(nodeIdList[1], "0.0.0.0:8000", cert,
+		connect.GetDefaultHostParams())
+	if err != nil {
+		t.Fatalf("Failed to add host: %+v", err)
+	}
+
+	circuit, err := NewCircuitFromNDF(def, manager)
+	if err != nil {
+		t.Fatalf("NewCircuitFromNDF returned an error: %+v", err)
+	}
+	for i, nid := range nodeIdList {
+		if circuit.GetNodeLocation(nid) != i {
+			t.Errorf("Node %d at location %d", i, circuit.GetNodeLocation(nid))
+		}
+		if !circuit.GetHostAtIndex(i).GetId().Cmp(nid) {
+			t.Errorf("Host %d has ID %s, expected %s", i,
+				circuit.GetHostAtIndex(i).GetId(), nid)
+		}
+	}
+	if circuit.GetHostAtIndex(1) != existing {
+		t.Errorf("Existing host was not reused.")
+	}
+	if _, exists := manager.GetHost(nodeIdList[2]); !exists {
+		t.Errorf("Missing host was not added to the manager.")
+	}
+}
+
+// Tests that NewCircuitFromNDF returns errors for NDFs it cannot build a
+// circuit from.
+func TestNewCircuitFromNDF_Invalid(t *testing.T) {
+	nid := makeNodeId(1, t)
+	invalid := map[string][]ndf.Node{
+		"empty":      nil,
+		"duplicate":  {{ID: nid.Marshal(), Address: "a"}, {ID: nid.Marshal(), Address: "b"}},
+		"no address": {{ID: nid.Marshal()}},
+		"invalid ID": {{ID: []byte{1}, Address: "a"}},
+	}
+	for name, nodes := range invalid {
+		def := &ndf.NetworkDefinition{Nodes: nodes}
+		if _, err := NewCircuitFromNDF(def, connect.NewManagerTesting(t)); err == nil {
+			t.Errorf("No error for %s NDF.", name)
+		}
+	}
+}
+
+// makeTestingCircuit returns a circuit of the nodes with a host for each.
+func makeTestingCircuit(nodeIdList []*id.ID, t *testing.T) *Circuit {
+	cert, _ := utils.ReadFile(testkeys.GetNodeCertPath())
+	circuit := NewCircuit(nodeIdList)
+	for _, nid := range nodeIdList {
+		host, err := connect.NewHost(nid, "test", cert, connect.GetDefaultHostParams())
+		if err != nil {
+			t.Fatalf("Failed to create host: %+v", err)
+		}
+		circuit.AddHost(host)
+	}
+	return circuit
+}
+
+// mockOnlineChecker fails the hosts in fail and never responds for the
+// hosts in hang.
+type mockOnlineChecker struct {
+	fail, hang *id.ID
+	block      chan struct{}
+}
+
+func (m *mockOnlineChecker) SendAskOnline(host *connect.Host) (*messages.Ack, error) {
+	if m.fail != nil && host.GetId().Cmp(m.fail) {
+		return nil, errors.New("offline")
+	}
+	if m.hang != nil && host.GetId().Cmp(m.hang) {
+		<-m.block
+	}
+	return &messages.Ack{}, nil
+}
+
+// Tests that CheckAllOnline reports nodes which fail or time out.
+func TestCircuit_CheckAllOnline(t *testing.T) {
+	nodeIdList := makeTestingNodeIdList(4, t)
+	circuit := makeTestingCircuit(nodeIdList, t)
+	checker := &mockOnlineChecker{
+		fail:  nodeIdList[1],
+		hang:  nodeIdList[3],
+		block: make(chan struct{}),
+	}
+	defer close(checker.block)
+
+	report := circuit.CheckAllOnline(checker, 50*time.Millisecond)
+	if report.AllOnline() || len(report.Unreachable) != 2 {
+		t.Fatalf("Unexpected report: %+v", report)
+	}
+	for i, index := range []int{1, 3} {
+		unreachable := report.Unreachable[i]
+		if unreachable.Index != index ||
+			!unreachable.Node.Cmp(nodeIdList[index]) ||
+			unreachable.Err == nil {
+			t.Errorf("Unexpected unreachable node %d: %+v", i, unreachable)
+		}
+	}
+
+	if !circuit.CheckAllOnline(&mockOnlineChecker{}, time.Second).AllOnline() {
+		t.Errorf("Nodes reported unreachable when all are online.")
+	}
+}
+
+// Tests that ReplaceHost swaps the node and host at the index.
+func TestCircuit_ReplaceHost(t *testing.T) {
+	nodeIdList := makeTestingNodeIdList(3, t)
+	circuit := makeTestingCircuit(nodeIdList, t)
+	cert, _ := utils.ReadFile(testkeys.GetNodeCertPath())
+	newID := makeNodeId(10, t)
+	newHost, err := connect.NewHost(newID, "test", cert, connect.GetDefaultHostParams())
+	if err != nil {
+		t.Fatalf("Failed to create host: %+v", err)
+	}
+
+	circuit.ReplaceHost(1, newHost)
+	if circuit.GetHostAtIndex(1) != newHost {
+		t.Errorf("Host was not replaced.")
+	}
+	if circuit.GetNodeLocation(newID) != 1 {
+		t.Errorf("New node at location %d", circuit.GetNodeLocation(newID))
+	}
+	if circuit.GetNodeLocation(nodeIdList[1]) != -1 {
+		t.Errorf("Replaced node still in the circuit.")
+	}
+	if !circuit.GetNextNode(nodeIdList[0]).Cmp(newID) {
+		t.Errorf("New node is not next after the first node.")
+	}
+
+	// Replacing with a node already in the circuit panics
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("No panic for a duplicate node.")
+		}
+	}()
+	circuit.ReplaceHost(0, newHost)
+}
+
 // Tests to see if node retrieved is in fact the last node
 func TestCircuit_GetLastNode(t *testing.T) {
 	nodeIdList := makeTestingNodeIdList(23, t)
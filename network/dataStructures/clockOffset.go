@@ -0,0 +1,299 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Round-trip based estimation of the local clock's offset and drift from the
+// network
+
+package dataStructures
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/netTime"
+)
+
+// DefaultClockOffsetWindow is the number of samples kept per host when a
+// window size of zero is passed to NewClockOffsets.
+const DefaultClockOffsetWindow = 10
+
+// clockSkewHistory is the number of global offset estimates the skew is
+// fitted to.
+const clockSkewHistory = 64
+
+// minClockSkewSpan is the shortest span of local time the offset estimates
+// must cover before a skew is estimated. Over shorter spans, jitter in the
+// round trips dominates any drift.
+const minClockSkewSpan = time.Minute
+
+// ClockOffsets estimates the offset of the network clock from the local clock
+// (remote time minus local time) from round-trip samples. Samples are kept per
+// host and the estimate for each host is the median of its samples. The global
+// estimate is the median of the per-host estimates, so a single host with a
+// bad clock cannot move it far.
+//
+// The global estimate is also tracked over time to estimate the rate at which
+// the network clock drifts from the local clock. Adjust and Now extrapolate
+// the offset with the drift, so they stay accurate between samples on clients
+// whose clocks run fast or slow.
+type ClockOffsets struct {
+	windowSize int
+	hosts      map[id.ID]*offsetSamples
+	global     time.Duration
+
+	// Ring buffer of the global estimate after each sample, oldest first from
+	// next once full
+	history []skewSample
+	next    int
+
+	// Fitted offset at fitTime and the drift in nanoseconds of offset per
+	// second of local time; valid is false until the history covers
+	// minClockSkewSpan
+	fitOffset float64
+	fitTime   time.Time
+	drift     float64
+	valid     bool
+
+	// Optional WaitingRounds to keep up to date with the global estimate
+	waitingRounds *WaitingRounds
+
+	mux sync.RWMutex
+}
+
+// offsetSamples is a ring buffer of offset samples for a single host.
+type offsetSamples struct {
+	samples []time.Duration
+	next    int
+	median  time.Duration
+}
+
+// skewSample is the global offset estimate at a local time.
+type skewSample struct {
+	local  time.Time
+	offset time.Duration
+}
+
+// NewClockOffsets creates an estimator keeping windowSize samples per host. If
+// wr is not nil, its clock offset is updated every time the global estimate
+// changes.
+func NewClockOffsets(windowSize int, wr *WaitingRounds) *ClockOffsets {
+	if windowSize <= 0 {
+		windowSize = DefaultClockOffsetWindow
+	}
+
+	return &ClockOffsets{
+		windowSize:    windowSize,
+		hosts:         make(map[id.ID]*offsetSamples),
+		history:       make([]skewSample, 0, clockSkewHistory),
+		waitingRounds: wr,
+	}
+}
+
+// AddSample adds a round-trip sample for the host and returns the updated
+// global estimate. sent is the local time the request was sent and rtt is the
+// local round trip time. remoteReceived is the time the host reports it
+// received the request and remoteDelay is how long the host reports it took to
+// respond; the remainder of the round trip is assumed to be split evenly
+// between the two directions.
+func (co *ClockOffsets) AddSample(hostID *id.ID, sent time.Time,
+	rtt time.Duration, remoteReceived time.Time,
+	remoteDelay time.Duration) time.Duration {
+	transit := rtt - remoteDelay
+	if transit < 0 {
+		transit = 0
+	}
+	offset := remoteReceived.Sub(sent.Add(transit / 2))
+
+	co.mux.Lock()
+	defer co.mux.Unlock()
+
+	hs, exists := co.hosts[*hostID]
+	if !exists {
+		hs = &offsetSamples{samples: make([]time.Duration, 0, co.windowSize)}
+		co.hosts[*hostID] = hs
+	}
+
+	if len(hs.samples) < co.windowSize {
+		hs.samples = append(hs.samples, offset)
+	} else {
+		hs.samples[hs.next] = offset
+	}
+	hs.next = (hs.next + 1) % co.windowSize
+	hs.median = median(hs.samples)
+
+	co.updateGlobal()
+	co.addHistory(sent.Add(rtt / 2))
+	return co.global
+}
+
+// AddRoundTripPing adds a sample from the timing of a round trip ping sent to
+// the host, as returned by the sender. Returns an error if the timing is
+// missing any of its times.
+func (co *ClockOffsets) AddRoundTripPing(hostID *id.ID,
+	timing pb.RoundTripTiming) error {
+	if timing.Sent.IsZero() || timing.Received.IsZero() ||
+		timing.Returned.IsZero() {
+		return errors.Errorf("Round trip ping to %s in round %d is missing "+
+			"its times", hostID, timing.RoundID)
+	}
+
+	// The receiver records a single time, so it takes no time to respond
+	co.AddSample(hostID, timing.Sent, timing.RoundTrip(), timing.Received, 0)
+	return nil
+}
+
+// GetHostOffset returns the estimated offset for the host.
+func (co *ClockOffsets) GetHostOffset(hostID *id.ID) (time.Duration, bool) {
+	co.mux.RLock()
+	defer co.mux.RUnlock()
+
+	hs, exists := co.hosts[*hostID]
+	if !exists {
+		return 0, false
+	}
+	return hs.median, true
+}
+
+// GetOffset returns the global offset estimate. It is zero if no samples have
+// been added.
+func (co *ClockOffsets) GetOffset() time.Duration {
+	co.mux.RLock()
+	defer co.mux.RUnlock()
+	return co.global
+}
+
+// Adjust converts a local time to the estimated network time. Once a skew has
+// been estimated, the offset is extrapolated to the local time with it.
+func (co *ClockOffsets) Adjust(local time.Time) time.Time {
+	co.mux.RLock()
+	defer co.mux.RUnlock()
+
+	if !co.valid {
+		return local.Add(co.global)
+	}
+	return local.Add(time.Duration(co.fitOffset +
+		co.drift*local.Sub(co.fitTime).Seconds()))
+}
+
+// Now returns the estimated network time. It should be used in place of the
+// local time in time-based checks such as WaitingRounds.NumValidRounds.
+func (co *ClockOffsets) Now() time.Time {
+	return co.Adjust(netTime.Now())
+}
+
+// Skew returns the estimated rate at which the network clock gains on the
+// local clock, in seconds per second; it is negative if the local clock runs
+// fast. It is zero until the samples span at least a minute.
+func (co *ClockOffsets) Skew() float64 {
+	co.mux.RLock()
+	defer co.mux.RUnlock()
+
+	if !co.valid {
+		return 0
+	}
+	return co.drift / float64(time.Second)
+}
+
+// RemoveHost drops all samples for the host, for use when it leaves the
+// network.
+func (co *ClockOffsets) RemoveHost(hostID *id.ID) {
+	co.mux.Lock()
+	defer co.mux.Unlock()
+
+	delete(co.hosts, *hostID)
+	co.updateGlobal()
+}
+
+// updateGlobal recomputes the global estimate and passes it on to
+// WaitingRounds. This is assumed to be called under the lock.
+func (co *ClockOffsets) updateGlobal() {
+	hostOffsets := make([]time.Duration, 0, len(co.hosts))
+	for _, hs := range co.hosts {
+		hostOffsets = append(hostOffsets, hs.median)
+	}
+	co.global = median(hostOffsets)
+
+	if co.waitingRounds != nil {
+		co.waitingRounds.SetClockOffset(co.global)
+	}
+}
+
+// addHistory records the global estimate at the local time and refits the
+// skew. This is assumed to be called under the lock.
+func (co *ClockOffsets) addHistory(local time.Time) {
+	sample := skewSample{local, co.global}
+	if len(co.history) < clockSkewHistory {
+		co.history = append(co.history, sample)
+	} else {
+		co.history[co.next] = sample
+	}
+	co.next = (co.next + 1) % clockSkewHistory
+	co.fit()
+}
+
+// fit fits a line to the offset history by least squares. This is assumed to
+// be called under the lock.
+func (co *ClockOffsets) fit() {
+	co.valid = false
+
+	earliest, latest := co.history[0].local, co.history[0].local
+	for _, s := range co.history {
+		if s.local.Before(earliest) {
+			earliest = s.local
+		}
+		if s.local.After(latest) {
+			latest = s.local
+		}
+	}
+	if latest.Sub(earliest) < minClockSkewSpan {
+		return
+	}
+
+	// Times are taken relative to the earliest sample, in seconds, to keep
+	// the sums well within float64 precision
+	n := float64(len(co.history))
+	var meanX, meanY float64
+	for _, s := range co.history {
+		meanX += s.local.Sub(earliest).Seconds()
+		meanY += float64(s.offset)
+	}
+	meanX, meanY = meanX/n, meanY/n
+
+	var sxy, sxx float64
+	for _, s := range co.history {
+		dx := s.local.Sub(earliest).Seconds() - meanX
+		sxy += dx * (float64(s.offset) - meanY)
+		sxx += dx * dx
+	}
+
+	co.drift = sxy / sxx
+	co.fitOffset = meanY
+	co.fitTime = earliest.Add(time.Duration(meanX * float64(time.Second)))
+	co.valid = true
+}
+
+// median returns the median of the durations without modifying the slice. The
+// median of an empty slice is zero.
+func median(durations []time.Duration) time.Duration {
+	if len(durations) == 0 {
+		return 0
+	}
+
+	sorted := make([]time.Duration, len(durations))
+	copy(sorted, durations)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
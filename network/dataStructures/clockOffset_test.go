@@ -0,0 +1,153 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package dataStructures
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/netTime"
+)
+
+// Tests that the offset is derived from the round trip and that the per-host
+// and global estimates use the median.
+func TestClockOffsets_AddSample(t *testing.T) {
+	co := NewClockOffsets(3, nil)
+	gw1 := id.NewIdFromString("gw1", id.Gateway, t)
+	gw2 := id.NewIdFromString("gw2", id.Gateway, t)
+
+	sent := netTime.Now()
+	// 100ms transit, 20ms remote delay, remote clock 5s ahead
+	remoteReceived := sent.Add(5*time.Second + 50*time.Millisecond)
+	offset := co.AddSample(gw1, sent, 120*time.Millisecond, remoteReceived,
+		20*time.Millisecond)
+	if offset != 5*time.Second {
+		t.Errorf("Unexpected offset.\nexpected: %s\nreceived: %s",
+			5*time.Second, offset)
+	}
+
+	// An outlier sample does not move the host median
+	co.AddSample(gw1, sent, 0, sent.Add(time.Hour), 0)
+	co.AddSample(gw1, sent, 0, sent.Add(5*time.Second), 0)
+	if o, _ := co.GetHostOffset(gw1); o != 5*time.Second {
+		t.Errorf("Unexpected host offset: %s", o)
+	}
+
+	co.AddSample(gw2, sent, 0, sent.Add(3*time.Second), 0)
+	if o := co.GetOffset(); o != 4*time.Second {
+		t.Errorf("Unexpected global offset.\nexpected: %s\nreceived: %s",
+			4*time.Second, o)
+	}
+
+	co.RemoveHost(gw2)
+	if o := co.GetOffset(); o != 5*time.Second {
+		t.Errorf("Global offset not updated after RemoveHost: %s", o)
+	}
+}
+
+// Tests that a client whose clock is ahead of the network still sees upcoming
+// rounds once the offset is applied to WaitingRounds.
+func TestClockOffsets_WaitingRounds(t *testing.T) {
+	wr := NewWaitingRounds()
+	co := NewClockOffsets(0, wr)
+
+	// The local clock is a minute ahead of the network, so rounds starting in
+	// 30s network time look like they started 30s ago
+	rounds, _ := createTestRoundInfos(4, netTime.Now().Add(-30*time.Second), t)
+	wr.Insert(rounds, nil)
+	if wr.Len() != 0 {
+		t.Fatalf("Rounds in the past were inserted without an offset.")
+	}
+
+	sent := netTime.Now()
+	co.AddSample(id.NewIdFromString("gw", id.Gateway, t), sent, 0,
+		sent.Add(-time.Minute), 0)
+	if wr.GetClockOffset() != -time.Minute {
+		t.Fatalf("WaitingRounds offset not set: %s", wr.GetClockOffset())
+	}
+
+	wr.Insert(rounds, nil)
+	if wr.Len() != len(rounds) {
+		t.Errorf("Rounds were treated as expired.\nexpected: %d\nreceived: %d",
+			len(rounds), wr.Len())
+	}
+}
+
+// Tests that ClockOffsets estimates the drift of a network clock gaining half a
+// millisecond every 30 seconds and extrapolates the offset to now.
+func TestClockOffsets_Skew(t *testing.T) {
+	co := NewClockOffsets(1, nil)
+	gwID := id.NewIdFromString("gateway", id.Gateway, t)
+
+	const rtt = 10 * time.Millisecond
+	start := netTime.Now().Add(-5 * time.Minute)
+	offset := func(local time.Time) time.Duration {
+		return 100*time.Millisecond + local.Sub(start)/60000
+	}
+
+	for i := 0; i < 11; i++ {
+		sent := start.Add(time.Duration(i) * 30 * time.Second)
+		received := sent.Add(rtt / 2)
+		co.AddSample(gwID, sent, rtt, received.Add(offset(received)), 0)
+
+		// Samples covering less than a minute do not give a skew
+		if i < 2 {
+			now := netTime.Now()
+			if co.Skew() != 0 || co.Adjust(now) != now.Add(co.GetOffset()) {
+				t.Errorf("Skew estimated from %d samples: %g", i+1, co.Skew())
+			}
+		}
+	}
+
+	if skew := co.Skew(); math.Abs(skew-1.0/60000) > 1e-9 {
+		t.Errorf("Unexpected skew.\nexpected: %g\nreceived: %g",
+			1.0/60000, skew)
+	}
+
+	now := netTime.Now()
+	expected := offset(now)
+	if diff := co.Adjust(now).Sub(now) - expected; diff > time.Millisecond/10 ||
+		diff < -time.Millisecond/10 {
+		t.Errorf("Unexpected offset.\nexpected: %s\nreceived: %s",
+			expected, co.Adjust(now).Sub(now))
+	}
+	if diff := co.Now().Sub(now.Add(expected)); diff < 0 ||
+		diff > time.Second {
+		t.Errorf("Now() is %s from the expected network time.", diff)
+	}
+}
+
+// Tests that ClockOffsets.AddRoundTripPing adds complete timings and rejects
+// timings missing a time.
+func TestClockOffsets_AddRoundTripPing(t *testing.T) {
+	co := NewClockOffsets(0, nil)
+	nodeID := id.NewIdFromString("node", id.Node, t)
+
+	sent := netTime.Now()
+	timing := pb.RoundTripTiming{
+		RoundID:  5,
+		Sent:     sent,
+		Received: sent.Add(time.Second + 10*time.Millisecond),
+		Returned: sent.Add(20 * time.Millisecond),
+	}
+	if err := co.AddRoundTripPing(nodeID, timing); err != nil {
+		t.Fatalf("AddRoundTripPing() returned an error: %+v", err)
+	}
+	if offset := co.GetOffset(); offset != time.Second {
+		t.Errorf("Unexpected offset.\nexpected: %s\nreceived: %s",
+			time.Second, offset)
+	}
+
+	timing.Returned = time.Time{}
+	if err := co.AddRoundTripPing(nodeID, timing); err == nil {
+		t.Error("AddRoundTripPing() accepted a timing without a return time.")
+	}
+}
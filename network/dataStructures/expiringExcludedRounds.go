@@ -0,0 +1,147 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Round exclusion list whose entries expire once the round can no longer be
+// sent on
+
+package dataStructures
+
+import (
+	"sync"
+	"time"
+
+	"gitlab.com/elixxir/primitives/excludedRounds"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/netTime"
+)
+
+// ExpiringExcludedRounds is an excludedRounds.ExcludedRounds whose entries
+// are only kept until the excluded round's realtime starts, after which the
+// round can no longer be returned by GetUpcomingRealtime anyway. This keeps
+// the list from growing for the life of the client.
+type ExpiringExcludedRounds struct {
+	// Maps each excluded round to when its exclusion expires
+	rounds        map[id.Round]time.Time
+	waitingRounds *WaitingRounds
+	defaultTTL    time.Duration
+	mux           sync.Mutex
+}
+
+var _ excludedRounds.ExcludedRounds = &ExpiringExcludedRounds{}
+
+// NewExpiringExcludedRounds creates an empty exclusion list. Inserted rounds
+// expire at their realtime start as recorded in wr. Rounds which are not in
+// wr expire after defaultTTL. wr may be nil.
+func NewExpiringExcludedRounds(wr *WaitingRounds,
+	defaultTTL time.Duration) *ExpiringExcludedRounds {
+	return &ExpiringExcludedRounds{
+		rounds:        make(map[id.Round]time.Time),
+		waitingRounds: wr,
+		defaultTTL:    defaultTTL,
+	}
+}
+
+// Has returns true if the round is excluded and the exclusion has not
+// expired.
+func (eer *ExpiringExcludedRounds) Has(rid id.Round) bool {
+	eer.mux.Lock()
+	defer eer.mux.Unlock()
+
+	expiry, exists := eer.rounds[rid]
+	if !exists {
+		return false
+	}
+
+	if !eer.now().Before(expiry) {
+		delete(eer.rounds, rid)
+		return false
+	}
+	return true
+}
+
+// Insert excludes the round until its realtime starts, or for the default TTL
+// if its start time is unknown. Returns true if the round was not already
+// excluded.
+func (eer *ExpiringExcludedRounds) Insert(rid id.Round) bool {
+	expiry, exists := eer.startTime(rid)
+	if !exists {
+		expiry = eer.now().Add(eer.defaultTTL)
+	}
+
+	return eer.InsertUntil(rid, expiry)
+}
+
+// InsertUntil excludes the round until the given time. Returns true if the
+// round was not already excluded. If it was, the later of the two expiry
+// times is kept.
+func (eer *ExpiringExcludedRounds) InsertUntil(rid id.Round,
+	expiry time.Time) bool {
+	eer.mux.Lock()
+	defer eer.mux.Unlock()
+
+	old, exists := eer.rounds[rid]
+	if exists && eer.now().Before(old) {
+		if expiry.After(old) {
+			eer.rounds[rid] = expiry
+		}
+		return false
+	}
+
+	eer.rounds[rid] = expiry
+	return true
+}
+
+// Remove removes the round from the list.
+func (eer *ExpiringExcludedRounds) Remove(rid id.Round) {
+	eer.mux.Lock()
+	delete(eer.rounds, rid)
+	eer.mux.Unlock()
+}
+
+// Len returns the number of rounds with unexpired exclusions. Expired entries
+// are pruned.
+func (eer *ExpiringExcludedRounds) Len() int {
+	eer.mux.Lock()
+	defer eer.mux.Unlock()
+
+	eer.prune(eer.now())
+	return len(eer.rounds)
+}
+
+// prune deletes all expired entries. This is assumed to be called under the
+// lock.
+func (eer *ExpiringExcludedRounds) prune(now time.Time) {
+	for rid, expiry := range eer.rounds {
+		if !now.Before(expiry) {
+			delete(eer.rounds, rid)
+		}
+	}
+}
+
+// now returns the current time, corrected by the WaitingRounds clock offset
+// if there is one.
+func (eer *ExpiringExcludedRounds) now() time.Time {
+	if eer.waitingRounds == nil {
+		return netTime.Now()
+	}
+	return eer.waitingRounds.now()
+}
+
+// startTime looks up the realtime start of the round in WaitingRounds.
+func (eer *ExpiringExcludedRounds) startTime(rid id.Round) (time.Time, bool) {
+	if eer.waitingRounds == nil {
+		return time.Time{}, false
+	}
+
+	rounds := eer.waitingRounds.readRounds.Load().([]*Round)
+	for _, r := range rounds {
+		if id.Round(r.info.ID) == rid {
+			return r.StartTime(), true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,103 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package dataStructures
+
+import (
+	"testing"
+	"time"
+
+	"gitlab.com/elixxir/comms/testutils"
+	"gitlab.com/elixxir/primitives/excludedRounds"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/netTime"
+)
+
+// Tests that inserted rounds are excluded until they expire.
+func TestExpiringExcludedRounds_InsertUntil(t *testing.T) {
+	eer := NewExpiringExcludedRounds(nil, time.Minute)
+
+	if !eer.InsertUntil(1, netTime.Now().Add(time.Minute)) {
+		t.Errorf("InsertUntil() reported a new round as existing.")
+	}
+	if eer.InsertUntil(1, netTime.Now().Add(time.Minute)) {
+		t.Errorf("InsertUntil() reported an existing round as new.")
+	}
+	if !eer.InsertUntil(2, netTime.Now().Add(-time.Second)) {
+		t.Errorf("InsertUntil() reported a new round as existing.")
+	}
+
+	if !eer.Has(1) {
+		t.Errorf("Has() did not find an unexpired round.")
+	}
+	if eer.Has(2) {
+		t.Errorf("Has() found an expired round.")
+	}
+	if eer.Len() != 1 {
+		t.Errorf("Unexpected length.\nexpected: %d\nreceived: %d", 1, eer.Len())
+	}
+
+	eer.Remove(1)
+	if eer.Has(1) {
+		t.Errorf("Has() found a removed round.")
+	}
+}
+
+// Tests that Insert uses the start time from WaitingRounds and falls back to
+// the default TTL for unknown rounds.
+func TestExpiringExcludedRounds_Insert(t *testing.T) {
+	rounds, _ := createTestRoundInfos(2, netTime.Now().Add(time.Hour), t)
+	wr := NewWaitingRounds()
+	wr.Insert(rounds, nil)
+
+	eer := NewExpiringExcludedRounds(wr, time.Millisecond)
+	rid := id.Round(rounds[0].info.ID)
+	eer.Insert(rid)
+	eer.Insert(rid + 100)
+
+	if expiry := eer.rounds[rid]; !expiry.Equal(rounds[0].StartTime()) {
+		t.Errorf("Round does not expire at its start time."+
+			"\nexpected: %s\nreceived: %s", rounds[0].StartTime(), expiry)
+	}
+
+	time.Sleep(5 * time.Millisecond)
+	if eer.Has(rid + 100) {
+		t.Errorf("Unknown round did not expire after the default TTL.")
+	}
+	if !eer.Has(rid) {
+		t.Errorf("Round expired before its start time.")
+	}
+}
+
+// Tests that GetUpcomingRealtime inserts into and respects the list.
+func TestExpiringExcludedRounds_GetUpcomingRealtime(t *testing.T) {
+	rounds, _ := createTestRoundInfos(4, netTime.Now().Add(5*time.Second), t)
+	for i, r := range rounds {
+		if err := testutils.SignRoundInfoRsa(r.info, t); err != nil {
+			t.Fatalf("Failed to sign round info #%d: %+v", i, err)
+		}
+	}
+	wr := NewWaitingRounds()
+	wr.Insert(rounds, nil)
+
+	var exclude excludedRounds.ExcludedRounds = NewExpiringExcludedRounds(wr, time.Minute)
+	for i := range rounds {
+		ri, _, err := wr.GetUpcomingRealtime(time.Second, exclude, 0, 0)
+		if err != nil {
+			t.Fatalf("GetUpcomingRealtime() returned an error: %+v", err)
+		}
+		if ri != rounds[i].info {
+			t.Errorf("Unexpected round returned.\nexpected: %d\nreceived: %d",
+				rounds[i].info.ID, ri.ID)
+		}
+	}
+
+	if exclude.Len() != len(rounds) {
+		t.Errorf("Unexpected length.\nexpected: %d\nreceived: %d",
+			len(rounds), exclude.Len())
+	}
+}
@@ -12,6 +12,7 @@ package dataStructures
 import (
 	"encoding/json"
 	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
 	"gitlab.com/elixxir/crypto/cyclic"
 	"gitlab.com/xx_network/crypto/large"
 	"gitlab.com/xx_network/primitives/ndf"
@@ -19,30 +20,65 @@ import (
 	"testing"
 )
 
+// GroupUpdate describes a version of a Group. It is sent to subscribers each
+// time the group changes.
+type GroupUpdate struct {
+	Group   *cyclic.Group
+	String  string
+	Version uint64
+}
+
 // Struct that handles and updates cyclic.Groups
 type Group struct {
 	groupString string
 	cyclicGroup *cyclic.Group
-	*sync.RWMutex
+	// Incremented each time the group changes; 0 while it is unset
+	version uint64
+
+	subscribers map[uint64]chan GroupUpdate
+	nextSub     uint64
+	sync.RWMutex
 }
 
 // NewGroup creates a ds.Group with a cyclic.Group and a mutex
 func NewGroup() *Group {
-	return &Group{
-		RWMutex: &sync.RWMutex{},
-	}
+	return &Group{}
 }
 
 // Get returns the ds.Groups's cyclic group
 func (g *Group) Get() *cyclic.Group {
+	g.RLock()
+	defer g.RUnlock()
 	return g.cyclicGroup
 }
 
 // Get returns the ds.Groups's cyclic group string
 func (g *Group) GetString() string {
+	g.RLock()
+	defer g.RUnlock()
 	return g.groupString
 }
 
+// GetVersioned returns the cyclic group, its string and its version read
+// together, so they always describe the same group.
+func (g *Group) GetVersioned() GroupUpdate {
+	g.RLock()
+	defer g.RUnlock()
+	return GroupUpdate{
+		Group:   g.cyclicGroup,
+		String:  g.groupString,
+		Version: g.version,
+	}
+}
+
+// Version returns the number of times the group has been set, or 0 if it has
+// not been set yet.
+func (g *Group) Version() uint64 {
+	g.RLock()
+	defer g.RUnlock()
+	return g.version
+}
+
 // Update sets the group's string and cyclic.Group object
 // If these values have not been set yet, we set these two values
 // If these values are set and the newGroup is different, it errors
@@ -53,16 +89,7 @@ func (g *Group) Update(newGroup string) error {
 	defer g.Unlock()
 	// Check if groupString has not been set
 	if g.groupString == "" {
-		// If so initialize these values
-		g.groupString = newGroup
-
-		// Create cyclic.Group object
-		grp, err := toGroup(newGroup)
-		if err != nil {
-			return errors.Errorf("Unable to update group: %+v", err)
-		}
-		// Set value
-		g.cyclicGroup = grp
+		return g.set(newGroup)
 	} else if g.groupString != newGroup {
 		// If they have already been set and the newGroup is a different value,
 		return errors.Errorf("Attempt to modify an already initialized group")
@@ -71,9 +98,75 @@ func (g *Group) Update(newGroup string) error {
 	return nil
 }
 
+// Set sets the group to newGroup, replacing a previously set group, e.g. when
+// a new NDF changes it. Subscribers are notified if the group changed.
+// Returns true if the group changed. An invalid group leaves the current
+// group in place.
+func (g *Group) Set(newGroup string) (bool, error) {
+	g.Lock()
+	defer g.Unlock()
+	if g.groupString == newGroup {
+		return false, nil
+	}
+	if err := g.set(newGroup); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// set parses newGroup and, if valid, replaces the group with it, increments
+// the version and notifies subscribers. Must be called with the lock held.
+func (g *Group) set(newGroup string) error {
+	grp, err := toGroup(newGroup)
+	if err != nil {
+		return errors.Errorf("Unable to update group: %+v", err)
+	}
+
+	g.groupString = newGroup
+	g.cyclicGroup = grp
+	g.version++
+
+	update := GroupUpdate{
+		Group:   grp,
+		String:  newGroup,
+		Version: g.version,
+	}
+	for _, c := range g.subscribers {
+		select {
+		case c <- update:
+		default:
+			jww.WARN.Printf("Unable to send group update to a subscriber, "+
+				"version %d dropped", update.Version)
+		}
+	}
+	return nil
+}
+
+// Subscribe returns a channel receiving each change to the group and a
+// function ending the subscription. Sends do not block; changes are dropped
+// for subscribers whose buffer is full, which can read GetVersioned to catch
+// up.
+func (g *Group) Subscribe(buffer int) (<-chan GroupUpdate, func()) {
+	g.Lock()
+	defer g.Unlock()
+	if g.subscribers == nil {
+		g.subscribers = make(map[uint64]chan GroupUpdate)
+	}
+	key := g.nextSub
+	g.nextSub++
+	c := make(chan GroupUpdate, buffer)
+	g.subscribers[key] = c
+
+	return c, func() {
+		g.Lock()
+		defer g.Unlock()
+		delete(g.subscribers, key)
+	}
+}
+
 // Utility function for NewInstanceTesting that directly sets cyclic.Group object
 // USED FOR TESTING PURPOSED ONLY
-func (g Group) UpdateCyclicGroupTesting(group *cyclic.Group, i interface{}) {
+func (g *Group) UpdateCyclicGroupTesting(group *cyclic.Group, i interface{}) {
 	switch i.(type) {
 	case *testing.T:
 		break
@@ -85,6 +178,8 @@ func (g Group) UpdateCyclicGroupTesting(group *cyclic.Group, i interface{}) {
 		panic("Should not be able to directly set cyclic group outside of testing purposes")
 	}
 
+	g.Lock()
+	defer g.Unlock()
 	g.cyclicGroup = group
 }
 
@@ -195,3 +195,74 @@ func TestGroup_Update_DoubleUpdate_Error(t *testing.T) {
 	t.Errorf("Expected error case: Should error when trying to modify values in group!")
 
 }
+
+// Tests that Set replaces the group, increments the version and notifies
+// subscribers until they unsubscribe.
+func TestGroup_Set(t *testing.T) {
+	g := NewGroup()
+	updates, unsubscribe := g.Subscribe(2)
+
+	first, _ := (&ndf.Group{Prime: "123", SmallPrime: "456", Generator: "2"}).String()
+	second, _ := (&ndf.Group{Prime: "69", SmallPrime: "420", Generator: "98"}).String()
+
+	if changed, err := g.Set(first); err != nil || !changed {
+		t.Fatalf("Failed to set the group (changed %t): %+v", changed, err)
+	}
+	if changed, err := g.Set(first); err != nil || changed {
+		t.Errorf("Setting the same group changed it (%t): %+v", changed, err)
+	}
+	if changed, err := g.Set(second); err != nil || !changed {
+		t.Fatalf("Failed to replace the group (changed %t): %+v", changed,
+			err)
+	}
+
+	for i, expected := range []string{first, second} {
+		select {
+		case u := <-updates:
+			if u.String != expected || u.Version != uint64(i+1) {
+				t.Errorf("Unexpected update %d: version %d, group %s", i,
+					u.Version, u.String)
+			}
+		default:
+			t.Fatalf("Update %d not sent.", i)
+		}
+	}
+
+	v := g.GetVersioned()
+	expectedCyclic := cyclic.NewGroup(large.NewIntFromString("69", 16),
+		large.NewIntFromString("98", 16))
+	if v.Version != 2 || v.String != second ||
+		!reflect.DeepEqual(v.Group, expectedCyclic) {
+		t.Errorf("Unexpected versioned group: %+v", v)
+	}
+
+	unsubscribe()
+	if _, err := g.Set(first); err != nil {
+		t.Fatalf("Failed to set the group: %+v", err)
+	}
+	select {
+	case u := <-updates:
+		t.Errorf("Received update after unsubscribing: %+v", u)
+	default:
+	}
+	if g.Version() != 3 {
+		t.Errorf("Expected version 3, got %d.", g.Version())
+	}
+}
+
+// Tests that an invalid group leaves the current group in place.
+func TestGroup_Set_Invalid(t *testing.T) {
+	g := NewGroup()
+	gstr, _ := (&ndf.Group{Prime: "123", SmallPrime: "456", Generator: "2"}).String()
+	if _, err := g.Set(gstr); err != nil {
+		t.Fatalf("Failed to set the group: %+v", err)
+	}
+
+	if _, err := g.Set("invalid"); err == nil {
+		t.Errorf("Set an invalid group.")
+	}
+	if g.GetString() != gstr || g.Version() != 1 {
+		t.Errorf("Invalid group replaced the group: version %d, group %s",
+			g.Version(), g.GetString())
+	}
+}
@@ -0,0 +1,254 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Aggregates round outcomes, poll latencies and waiting round depth into a
+// rolling network health score
+
+package dataStructures
+
+import (
+	"sync"
+	"time"
+
+	"gitlab.com/xx_network/primitives/netTime"
+)
+
+// HealthParams configures how the HealthScorer weighs its inputs.
+type HealthParams struct {
+	// Number of most recent round outcomes and poll latencies kept
+	WindowSize int
+
+	// Average poll latency at or below which the latency score is full, and
+	// at or above which it is zero
+	TargetLatency time.Duration
+	MaxLatency    time.Duration
+
+	// Number of valid waiting rounds at or above which the depth score is full
+	TargetWaitingRounds int
+
+	// Relative weights of the round outcome, latency and depth scores
+	RoundWeight   float64
+	LatencyWeight float64
+	DepthWeight   float64
+
+	// The network is reported as degraded when the score drops below
+	// DegradedThreshold and as recovered once it rises to RecoveredThreshold.
+	// RecoveredThreshold should be at least DegradedThreshold to avoid
+	// flapping.
+	DegradedThreshold  float64
+	RecoveredThreshold float64
+}
+
+// GetDefaultHealthParams returns a HealthParams object with default values.
+func GetDefaultHealthParams() HealthParams {
+	return HealthParams{
+		WindowSize:          100,
+		TargetLatency:       500 * time.Millisecond,
+		MaxLatency:          5 * time.Second,
+		TargetWaitingRounds: 3,
+		RoundWeight:         0.5,
+		LatencyWeight:       0.25,
+		DepthWeight:         0.25,
+		DegradedThreshold:   0.5,
+		RecoveredThreshold:  0.7,
+	}
+}
+
+// HealthCallback is called when the network becomes degraded or recovers.
+type HealthCallback func(degraded bool, score float64)
+
+// HealthScorer keeps a rolling window of network observations and scores the
+// health of the network between 0 (unusable) and 1 (fully healthy).
+type HealthScorer struct {
+	params        HealthParams
+	waitingRounds *WaitingRounds
+
+	outcomes    []bool
+	outcomeNext int
+	latencies   []time.Duration
+	latencyNext int
+
+	degraded  bool
+	callbacks []HealthCallback
+	mux       sync.Mutex
+}
+
+// NewHealthScorer creates a HealthScorer. waitingRounds may be nil, in which
+// case the depth score is not included.
+func NewHealthScorer(waitingRounds *WaitingRounds,
+	params HealthParams) *HealthScorer {
+	if params.WindowSize <= 0 {
+		params.WindowSize = 1
+	}
+
+	return &HealthScorer{
+		params:        params,
+		waitingRounds: waitingRounds,
+		outcomes:      make([]bool, 0, params.WindowSize),
+		latencies:     make([]time.Duration, 0, params.WindowSize),
+	}
+}
+
+// AddHealthCallback registers a callback to be called whenever the network
+// changes between healthy and degraded.
+func (hs *HealthScorer) AddHealthCallback(cb HealthCallback) {
+	hs.mux.Lock()
+	hs.callbacks = append(hs.callbacks, cb)
+	hs.mux.Unlock()
+}
+
+// ReportRoundOutcome records whether a round the client took part in
+// completed successfully.
+func (hs *HealthScorer) ReportRoundOutcome(success bool) {
+	hs.mux.Lock()
+	if len(hs.outcomes) < hs.params.WindowSize {
+		hs.outcomes = append(hs.outcomes, success)
+	} else {
+		hs.outcomes[hs.outcomeNext] = success
+	}
+	hs.outcomeNext = (hs.outcomeNext + 1) % hs.params.WindowSize
+	hs.mux.Unlock()
+
+	hs.evaluate()
+}
+
+// ReportAnomaly records a round anomaly as a failed round outcome. It matches
+// RoundAnomalyCallback so it can be registered on a RoundStateTracker.
+func (hs *HealthScorer) ReportAnomaly(RoundAnomaly) {
+	hs.ReportRoundOutcome(false)
+}
+
+// ReportPollLatency records how long a gateway poll took.
+func (hs *HealthScorer) ReportPollLatency(latency time.Duration) {
+	hs.mux.Lock()
+	if len(hs.latencies) < hs.params.WindowSize {
+		hs.latencies = append(hs.latencies, latency)
+	} else {
+		hs.latencies[hs.latencyNext] = latency
+	}
+	hs.latencyNext = (hs.latencyNext + 1) % hs.params.WindowSize
+	hs.mux.Unlock()
+
+	hs.evaluate()
+}
+
+// Score returns the current health score between 0 and 1.
+func (hs *HealthScorer) Score() float64 {
+	hs.mux.Lock()
+	defer hs.mux.Unlock()
+	return hs.score(netTime.Now())
+}
+
+// IsDegraded returns true if the network is currently considered degraded.
+// The state is only re-evaluated when new observations are reported or
+// Evaluate is called.
+func (hs *HealthScorer) IsDegraded() bool {
+	hs.mux.Lock()
+	defer hs.mux.Unlock()
+	return hs.degraded
+}
+
+// Evaluate recomputes the score, updating the degraded state and calling the
+// callbacks if it changed. It should be called periodically since the depth
+// of WaitingRounds changes without observations being reported.
+func (hs *HealthScorer) Evaluate() float64 {
+	return hs.evaluate()
+}
+
+// evaluate recomputes the score and calls the callbacks outside the lock if
+// the degraded state changed.
+func (hs *HealthScorer) evaluate() float64 {
+	hs.mux.Lock()
+	score := hs.score(netTime.Now())
+
+	changed := false
+	if !hs.degraded && score < hs.params.DegradedThreshold {
+		hs.degraded, changed = true, true
+	} else if hs.degraded && score >= hs.params.RecoveredThreshold {
+		hs.degraded, changed = false, true
+	}
+	degraded := hs.degraded
+	callbacks := hs.callbacks
+	hs.mux.Unlock()
+
+	if changed {
+		for _, cb := range callbacks {
+			cb(degraded, score)
+		}
+	}
+
+	return score
+}
+
+// score computes the weighted score. Components without any observations
+// score fully. This is assumed to be called under the lock.
+func (hs *HealthScorer) score(now time.Time) float64 {
+	total := hs.params.RoundWeight*hs.roundScore() +
+		hs.params.LatencyWeight*hs.latencyScore()
+	weights := hs.params.RoundWeight + hs.params.LatencyWeight
+
+	if hs.waitingRounds != nil {
+		total += hs.params.DepthWeight * hs.depthScore(now)
+		weights += hs.params.DepthWeight
+	}
+
+	if weights <= 0 {
+		return 1
+	}
+	return total / weights
+}
+
+// roundScore returns the fraction of successful rounds in the window.
+func (hs *HealthScorer) roundScore() float64 {
+	if len(hs.outcomes) == 0 {
+		return 1
+	}
+
+	successes := 0
+	for _, success := range hs.outcomes {
+		if success {
+			successes++
+		}
+	}
+	return float64(successes) / float64(len(hs.outcomes))
+}
+
+// latencyScore scales the average latency in the window linearly between the
+// target latency (1) and the maximum latency (0).
+func (hs *HealthScorer) latencyScore() float64 {
+	if len(hs.latencies) == 0 {
+		return 1
+	}
+
+	var sum time.Duration
+	for _, latency := range hs.latencies {
+		sum += latency
+	}
+	avg := sum / time.Duration(len(hs.latencies))
+
+	if avg <= hs.params.TargetLatency {
+		return 1
+	} else if avg >= hs.params.MaxLatency {
+		return 0
+	}
+	return float64(hs.params.MaxLatency-avg) /
+		float64(hs.params.MaxLatency-hs.params.TargetLatency)
+}
+
+// depthScore returns the fraction of the target number of valid waiting
+// rounds currently available.
+func (hs *HealthScorer) depthScore(now time.Time) float64 {
+	if hs.params.TargetWaitingRounds <= 0 {
+		return 1
+	}
+
+	numValid := hs.waitingRounds.NumValidRounds(now)
+	if numValid >= hs.params.TargetWaitingRounds {
+		return 1
+	}
+	return float64(numValid) / float64(hs.params.TargetWaitingRounds)
+}
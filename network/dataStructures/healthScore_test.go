@@ -0,0 +1,111 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package dataStructures
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// Tests that a HealthScorer with no observations is fully healthy.
+func TestNewHealthScorer(t *testing.T) {
+	hs := NewHealthScorer(nil, GetDefaultHealthParams())
+
+	if score := hs.Score(); score != 1 {
+		t.Errorf("Unexpected initial score.\nexpected: %f\nreceived: %f",
+			1.0, score)
+	}
+	if hs.IsDegraded() {
+		t.Errorf("New HealthScorer is degraded.")
+	}
+}
+
+// Tests that the score combines round outcomes and latencies by weight and
+// that the rolling window drops old observations.
+func TestHealthScorer_Score(t *testing.T) {
+	params := GetDefaultHealthParams()
+	params.WindowSize = 4
+	params.RoundWeight = 1
+	params.LatencyWeight = 1
+	params.TargetLatency = time.Second
+	params.MaxLatency = 3 * time.Second
+	hs := NewHealthScorer(nil, params)
+
+	hs.ReportRoundOutcome(true)
+	hs.ReportRoundOutcome(false)
+	// Average latency of 2s is half way between the target and max
+	hs.ReportPollLatency(2 * time.Second)
+
+	expected := (0.5 + 0.5) / 2
+	if score := hs.Score(); math.Abs(score-expected) > 1e-9 {
+		t.Errorf("Unexpected score.\nexpected: %f\nreceived: %f",
+			expected, score)
+	}
+
+	// Fill the window with successes to push out the failure
+	for i := 0; i < params.WindowSize; i++ {
+		hs.ReportRoundOutcome(true)
+	}
+	expected = (1 + 0.5) / 2
+	if score := hs.Score(); math.Abs(score-expected) > 1e-9 {
+		t.Errorf("Unexpected score after window rolled."+
+			"\nexpected: %f\nreceived: %f", expected, score)
+	}
+}
+
+// Tests that the depth of WaitingRounds is scored.
+func TestHealthScorer_Score_Depth(t *testing.T) {
+	params := GetDefaultHealthParams()
+	params.TargetWaitingRounds = 2
+	params.RoundWeight = 0
+	params.LatencyWeight = 0
+	params.DepthWeight = 1
+
+	wr := NewWaitingRounds()
+	hs := NewHealthScorer(wr, params)
+	if score := hs.Score(); score != 0 {
+		t.Errorf("Unexpected score with no waiting rounds: %f", score)
+	}
+
+	rounds, _ := createTestRoundInfos(2, time.Now().Add(5*time.Second), t)
+	wr.Insert(rounds, nil)
+	if score := hs.Score(); score != 0.5 {
+		t.Errorf("Unexpected score with one waiting round: %f", score)
+	}
+}
+
+// Tests that the callbacks are called when the network becomes degraded and
+// when it recovers, and not when the state does not change.
+func TestHealthScorer_Callbacks(t *testing.T) {
+	params := GetDefaultHealthParams()
+	params.WindowSize = 2
+	params.LatencyWeight = 0
+	hs := NewHealthScorer(nil, params)
+
+	var changes []bool
+	hs.AddHealthCallback(func(degraded bool, score float64) {
+		changes = append(changes, degraded)
+	})
+
+	hs.ReportAnomaly(RoundAnomaly{})
+	hs.ReportRoundOutcome(false)
+	if !hs.IsDegraded() {
+		t.Errorf("HealthScorer is not degraded after only failures.")
+	}
+
+	hs.ReportRoundOutcome(true)
+	hs.ReportRoundOutcome(true)
+	if hs.IsDegraded() {
+		t.Errorf("HealthScorer did not recover after only successes.")
+	}
+
+	if len(changes) != 2 || !changes[0] || changes[1] {
+		t.Errorf("Unexpected callback calls: %v", changes)
+	}
+}
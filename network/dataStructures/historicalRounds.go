@@ -0,0 +1,168 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Store of recently finished rounds for answering historical round requests
+
+package dataStructures
+
+import (
+	"container/list"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/primitives/states"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/netTime"
+)
+
+// historicalRound is a round held by HistoricalRounds.
+type historicalRound struct {
+	info *pb.RoundInfo
+	// When the round completed or failed
+	finished time.Time
+}
+
+// HistoricalRounds holds the completed and failed rounds most recently added,
+// up to a maximum number and age, so that a gateway can answer historical
+// round requests for them without a database lookup. Rounds are pruned in the
+// order they were added as new rounds are added and when ranges are read.
+type HistoricalRounds struct {
+	rounds map[id.Round]*list.Element
+	// Rounds in the order they were added, oldest first
+	order *list.List
+
+	maxCount int
+	maxAge   time.Duration
+
+	mux sync.RWMutex
+}
+
+// NewHistoricalRounds creates an empty HistoricalRounds keeping up to
+// maxCount rounds for up to maxAge after they finish. A maximum of zero means
+// there is no limit.
+func NewHistoricalRounds(maxCount int,
+	maxAge time.Duration) *HistoricalRounds {
+	return &HistoricalRounds{
+		rounds:   make(map[id.Round]*list.Element),
+		order:    list.New(),
+		maxCount: maxCount,
+		maxAge:   maxAge,
+	}
+}
+
+// Add stores a round which has completed or failed, replacing any round
+// already stored with its ID. Returns an error for rounds in other states.
+// The round's age is measured from the timestamp of its final state, or from
+// when it is added if that is not set.
+func (hr *HistoricalRounds) Add(ri *pb.RoundInfo) error {
+	state := states.Round(ri.GetState())
+	if state != states.COMPLETED && state != states.FAILED {
+		return errors.Errorf("Cannot store round %d in state %s as a "+
+			"historical round", ri.GetID(), state)
+	}
+
+	now := netTime.Now()
+	finished := now
+	if int(state) < len(ri.GetTimestamps()) && ri.Timestamps[state] != 0 {
+		finished = time.Unix(0, int64(ri.Timestamps[state]))
+	}
+
+	hr.mux.Lock()
+	defer hr.mux.Unlock()
+
+	rid := id.Round(ri.GetID())
+	if e, exists := hr.rounds[rid]; exists {
+		hr.order.Remove(e)
+	}
+	hr.rounds[rid] = hr.order.PushBack(&historicalRound{ri, finished})
+	hr.prune(now)
+	return nil
+}
+
+// Get returns the stored round with the ID, if there is one.
+func (hr *HistoricalRounds) Get(rid id.Round) (*pb.RoundInfo, bool) {
+	hr.mux.RLock()
+	defer hr.mux.RUnlock()
+
+	e, exists := hr.rounds[rid]
+	if !exists || hr.expired(e.Value.(*historicalRound), netTime.Now()) {
+		return nil, false
+	}
+	return e.Value.(*historicalRound).info, true
+}
+
+// GetRange returns the stored rounds with IDs from first to last inclusive,
+// in order of ID. Rounds in the range which are not stored are left out.
+func (hr *HistoricalRounds) GetRange(first, last id.Round) []*pb.RoundInfo {
+	if last < first {
+		return nil
+	}
+
+	hr.mux.Lock()
+	defer hr.mux.Unlock()
+
+	now := netTime.Now()
+	hr.prune(now)
+
+	var rounds []*pb.RoundInfo
+	if uint64(last-first) < uint64(len(hr.rounds)) {
+		for rid := first; ; rid++ {
+			e, exists := hr.rounds[rid]
+			if exists && !hr.expired(e.Value.(*historicalRound), now) {
+				rounds = append(rounds, e.Value.(*historicalRound).info)
+			}
+			if rid == last {
+				break
+			}
+		}
+		return rounds
+	}
+
+	// The range is larger than the store, so search the store instead
+	for rid, e := range hr.rounds {
+		hRound := e.Value.(*historicalRound)
+		if rid >= first && rid <= last && !hr.expired(hRound, now) {
+			rounds = append(rounds, hRound.info)
+		}
+	}
+	sort.Slice(rounds, func(i, j int) bool {
+		return rounds[i].GetID() < rounds[j].GetID()
+	})
+	return rounds
+}
+
+// Len returns the number of rounds stored.
+func (hr *HistoricalRounds) Len() int {
+	hr.mux.RLock()
+	defer hr.mux.RUnlock()
+
+	return len(hr.rounds)
+}
+
+// prune removes rounds, oldest added first, until there are no more than the
+// maximum count and the oldest has not expired. This is assumed to be called
+// under the lock.
+func (hr *HistoricalRounds) prune(now time.Time) {
+	for e := hr.order.Front(); e != nil; e = hr.order.Front() {
+		hRound := e.Value.(*historicalRound)
+		if (hr.maxCount == 0 || hr.order.Len() <= hr.maxCount) &&
+			!hr.expired(hRound, now) {
+			return
+		}
+		hr.order.Remove(e)
+		delete(hr.rounds, id.Round(hRound.info.GetID()))
+	}
+}
+
+// expired returns true if the round is older than the maximum age.
+func (hr *HistoricalRounds) expired(hRound *historicalRound,
+	now time.Time) bool {
+	return hr.maxAge != 0 && now.Sub(hRound.finished) > hr.maxAge
+}
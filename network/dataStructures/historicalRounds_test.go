@@ -0,0 +1,102 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package dataStructures
+
+import (
+	"testing"
+	"time"
+
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/primitives/states"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/netTime"
+)
+
+// newHistoricalTestRound returns a round which finished in the state at the
+// given time.
+func newHistoricalTestRound(rid uint64, state states.Round,
+	finished time.Time) *pb.RoundInfo {
+	ri := &pb.RoundInfo{
+		ID:         rid,
+		State:      uint32(state),
+		Timestamps: make([]uint64, states.NUM_STATES),
+	}
+	ri.Timestamps[state] = uint64(finished.UnixNano())
+	return ri
+}
+
+// roundIDs returns the IDs of the rounds.
+func roundIDs(rounds []*pb.RoundInfo) []uint64 {
+	ids := make([]uint64, len(rounds))
+	for i, ri := range rounds {
+		ids[i] = ri.GetID()
+	}
+	return ids
+}
+
+// Tests that HistoricalRounds.GetRange returns the stored rounds in the
+// range in order, for ranges smaller and larger than the store.
+func TestHistoricalRounds_GetRange(t *testing.T) {
+	hr := NewHistoricalRounds(0, 0)
+	now := netTime.Now()
+	for _, rid := range []uint64{7, 3, 5, 10} {
+		err := hr.Add(newHistoricalTestRound(rid, states.COMPLETED, now))
+		if err != nil {
+			t.Fatalf("Failed to add round %d: %+v", rid, err)
+		}
+	}
+
+	if ids := roundIDs(hr.GetRange(4, 7)); len(ids) != 2 ||
+		ids[0] != 5 || ids[1] != 7 {
+		t.Errorf("Unexpected rounds in small range: %v", ids)
+	}
+	if ids := roundIDs(hr.GetRange(0, 1000)); len(ids) != 4 ||
+		ids[0] != 3 || ids[3] != 10 {
+		t.Errorf("Unexpected rounds in large range: %v", ids)
+	}
+	if rounds := hr.GetRange(7, 3); len(rounds) != 0 {
+		t.Errorf("Reversed range returned rounds: %v", roundIDs(rounds))
+	}
+	if _, exists := hr.Get(5); !exists {
+		t.Error("Get did not return a stored round.")
+	}
+}
+
+// Error path: Tests that HistoricalRounds.Add rejects rounds which have not
+// finished.
+func TestHistoricalRounds_Add_Unfinished(t *testing.T) {
+	hr := NewHistoricalRounds(0, 0)
+	ri := newHistoricalTestRound(1, states.REALTIME, netTime.Now())
+	if err := hr.Add(ri); err == nil {
+		t.Error("Add accepted a round which has not finished.")
+	}
+}
+
+// Tests that HistoricalRounds prunes the oldest rounds beyond the maximum
+// count and rounds older than the maximum age.
+func TestHistoricalRounds_Prune(t *testing.T) {
+	now := netTime.Now()
+
+	hr := NewHistoricalRounds(2, 0)
+	for rid := uint64(1); rid <= 3; rid++ {
+		_ = hr.Add(newHistoricalTestRound(rid, states.FAILED, now))
+	}
+	if _, exists := hr.Get(1); exists || hr.Len() != 2 {
+		t.Errorf("Oldest round was not pruned beyond the maximum count: "+
+			"%d rounds.", hr.Len())
+	}
+
+	hr = NewHistoricalRounds(0, time.Minute)
+	_ = hr.Add(newHistoricalTestRound(1, states.COMPLETED,
+		now.Add(-time.Hour)))
+	_ = hr.Add(newHistoricalTestRound(2, states.COMPLETED, now))
+	if ids := roundIDs(hr.GetRange(0, id.Round(10))); len(ids) != 1 ||
+		ids[0] != 2 {
+		t.Errorf("Expired round was not pruned: %v", ids)
+	}
+}
@@ -11,8 +11,11 @@ package dataStructures
 
 import (
 	"bytes"
+	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
 	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/signature"
+	"gitlab.com/xx_network/crypto/signature/rsa"
 	"gitlab.com/xx_network/primitives/ndf"
 	"golang.org/x/crypto/blake2b"
 	"sync"
@@ -20,12 +23,27 @@ import (
 
 // Ndf encapsulates all data from an NDF.
 type Ndf struct {
-	f    *ndf.NetworkDefinition
-	pb   *pb.NDF
-	hash []byte
+	f          *ndf.NetworkDefinition
+	pb         *pb.NDF
+	hash       []byte
+	validation NdfValidation
 	sync.RWMutex
 }
 
+// NdfValidation configures the checks an NDF must pass to be accepted by
+// Ndf.Update. The zero value performs no checks.
+type NdfValidation struct {
+	// Key of the permissioning server the NDF message must be signed by
+	PublicKey *rsa.PublicKey
+
+	// Groups the NDF must contain
+	CmixGroup *ndf.Group
+	E2eGroup  *ndf.Group
+
+	// Reject NDFs with an earlier timestamp than the stored one
+	RejectStale bool
+}
+
 // NewNdf initializes a Ndf object from a primitives ndf.NetworkDefinition.
 func NewNdf(definition *ndf.NetworkDefinition) (*Ndf, error) {
 	h, err := GenerateNDFHash(nil)
@@ -40,8 +58,31 @@ func NewNdf(definition *ndf.NetworkDefinition) (*Ndf, error) {
 	}, nil
 }
 
-// Update to a new NDF if the passed NDF is valid.
+// SetValidation sets the checks an NDF must pass to be accepted by Update.
+func (file *Ndf) SetValidation(v NdfValidation) {
+	file.Lock()
+	defer file.Unlock()
+
+	file.validation = v
+}
+
+// Update to a new NDF if the passed NDF is valid and passes the checks set by
+// SetValidation.
 func (file *Ndf) Update(m *pb.NDF) error {
+	file.RLock()
+	key := file.validation.PublicKey
+	file.RUnlock()
+
+	// Verify the signature before decoding anything from the message.
+	// VerifyRsa cannot handle a missing signature or a short nonce.
+	if key != nil {
+		if len(m.GetSignature().GetNonce()) < 8 {
+			return errors.New("Could not validate NDF: NDF is not signed")
+		}
+		if err := signature.VerifyRsa(m, key); err != nil {
+			return errors.WithMessage(err, "Could not validate NDF")
+		}
+	}
 
 	// Build the ndf object
 	decoded, err := ndf.Unmarshal(m.Ndf)
@@ -52,6 +93,10 @@ func (file *Ndf) Update(m *pb.NDF) error {
 	file.Lock()
 	defer file.Unlock()
 
+	if err = file.validate(decoded); err != nil {
+		return err
+	}
+
 	file.pb = m
 	file.f = decoded
 
@@ -60,9 +105,32 @@ func (file *Ndf) Update(m *pb.NDF) error {
 	return err
 }
 
-// Get returns the NDF object.
-// FIXME: return a copy instead to ensure edits to not impact the original version
+// validate checks the decoded NDF against the groups and stored timestamp
+// required by the validation. This is assumed to be called under the lock.
+func (file *Ndf) validate(decoded *ndf.NetworkDefinition) error {
+	v := file.validation
+	if v.CmixGroup != nil && decoded.CMIX != *v.CmixGroup {
+		return errors.New("NDF cMix group does not match the expected group")
+	}
+	if v.E2eGroup != nil && decoded.E2E != *v.E2eGroup {
+		return errors.New("NDF E2E group does not match the expected group")
+	}
+	if v.RejectStale && file.f != nil &&
+		decoded.Timestamp.Before(file.f.Timestamp) {
+		return errors.Errorf("NDF from %s is older than the stored NDF "+
+			"from %s", decoded.Timestamp, file.f.Timestamp)
+	}
+	return nil
+}
+
+// Get returns a copy of the NDF object, so edits to it do not impact the
+// stored version.
 func (file *Ndf) Get() *ndf.NetworkDefinition {
+	return copyNetworkDefinition(file.get())
+}
+
+// get returns the stored NDF object, which must not be edited.
+func (file *Ndf) get() *ndf.NetworkDefinition {
 	file.RLock()
 	defer file.RUnlock()
 
@@ -79,13 +147,16 @@ func (file *Ndf) GetHash() []byte {
 	return rtn
 }
 
-// GetPb returns the NDF message.
-// FIXME: return a copy instead to ensure edits to not impact the original version
+// GetPb returns a copy of the NDF message, so edits to it do not impact the
+// stored version.
 func (file *Ndf) GetPb() *pb.NDF {
 	file.RLock()
 	defer file.RUnlock()
 
-	return file.pb
+	if file.pb == nil {
+		return nil
+	}
+	return proto.Clone(file.pb).(*pb.NDF)
 }
 
 // CompareHash evaluates if the passed NDF hash is the same as the stored one.
@@ -113,3 +184,37 @@ func GenerateNDFHash(msg *pb.NDF) ([]byte, error) {
 
 	return hash.Sum(nil), nil
 }
+
+// copyNetworkDefinition returns a deep copy of the definition. The copy made by
+// ndf.NetworkDefinition.DeepCopy shares the byte slices of the nodes and
+// gateways and the whitelists with the original, so they are copied here.
+func copyNetworkDefinition(def *ndf.NetworkDefinition) *ndf.NetworkDefinition {
+	if def == nil {
+		return nil
+	}
+
+	cp := def.DeepCopy()
+	for i := range cp.Nodes {
+		cp.Nodes[i].ID = copyBytes(cp.Nodes[i].ID)
+		cp.Nodes[i].Ed25519 = copyBytes(cp.Nodes[i].Ed25519)
+	}
+	for i := range cp.Gateways {
+		cp.Gateways[i].ID = copyBytes(cp.Gateways[i].ID)
+	}
+	if def.WhitelistedIds != nil {
+		cp.WhitelistedIds = append([]string{}, def.WhitelistedIds...)
+	}
+	if def.WhitelistedIpAddresses != nil {
+		cp.WhitelistedIpAddresses =
+			append([]string{}, def.WhitelistedIpAddresses...)
+	}
+	return cp
+}
+
+// copyBytes returns a copy of b, which is nil if b is.
+func copyBytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+	return append([]byte{}, b...)
+}
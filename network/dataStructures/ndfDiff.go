@@ -0,0 +1,115 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the differences between two network definitions
+
+package dataStructures
+
+import (
+	"gitlab.com/xx_network/primitives/ndf"
+)
+
+// NdfDiff lists the nodes and gateways which differ between two NDFs, so that
+// a consumer can update only the hosts which changed.
+type NdfDiff struct {
+	AddedNodes   []ndf.Node
+	RemovedNodes []ndf.Node
+	// Nodes whose address or TLS certificate changed
+	ChangedNodes []NodeChange
+
+	AddedGateways   []ndf.Gateway
+	RemovedGateways []ndf.Gateway
+	// Gateways whose address or TLS certificate changed
+	ChangedGateways []GatewayChange
+}
+
+// NodeChange holds a node as it is in the old and new NDFs.
+type NodeChange struct {
+	Old, New ndf.Node
+}
+
+// GatewayChange holds a gateway as it is in the old and new NDFs.
+type GatewayChange struct {
+	Old, New ndf.Gateway
+}
+
+// IsEmpty returns true if no node or gateway differs.
+func (d NdfDiff) IsEmpty() bool {
+	return len(d.AddedNodes) == 0 && len(d.RemovedNodes) == 0 &&
+		len(d.ChangedNodes) == 0 && len(d.AddedGateways) == 0 &&
+		len(d.RemovedGateways) == 0 && len(d.ChangedGateways) == 0
+}
+
+// Diff returns the nodes and gateways which differ from those in this NDF
+// in the newer one.
+func (file *Ndf) Diff(newer *Ndf) NdfDiff {
+	// Each NDF is read under its own lock in turn; the definitions are
+	// replaced rather than edited by Update, so they can be compared after
+	return DiffNetworkDefinitions(file.get(), newer.get())
+}
+
+// DiffNetworkDefinitions returns the nodes and gateways which differ from
+// those in the old definition in the new one. Nodes and gateways are matched
+// by ID. A nil definition has no nodes or gateways. The entries returned are
+// copies.
+func DiffNetworkDefinitions(oldDef, newDef *ndf.NetworkDefinition) NdfDiff {
+	oldDef = copyNetworkDefinition(oldDef)
+	newDef = copyNetworkDefinition(newDef)
+	if oldDef == nil {
+		oldDef = &ndf.NetworkDefinition{}
+	}
+	if newDef == nil {
+		newDef = &ndf.NetworkDefinition{}
+	}
+
+	var diff NdfDiff
+
+	oldNodes := make(map[string]ndf.Node, len(oldDef.Nodes))
+	for _, n := range oldDef.Nodes {
+		oldNodes[string(n.ID)] = n
+	}
+	for _, n := range newDef.Nodes {
+		o, exists := oldNodes[string(n.ID)]
+		if !exists {
+			diff.AddedNodes = append(diff.AddedNodes, n)
+			continue
+		}
+		delete(oldNodes, string(n.ID))
+		if o.Address != n.Address || o.TlsCertificate != n.TlsCertificate {
+			diff.ChangedNodes = append(diff.ChangedNodes, NodeChange{o, n})
+		}
+	}
+	for _, n := range oldDef.Nodes {
+		if _, removed := oldNodes[string(n.ID)]; removed {
+			diff.RemovedNodes = append(diff.RemovedNodes, n)
+		}
+	}
+
+	oldGateways := make(map[string]ndf.Gateway, len(oldDef.Gateways))
+	for _, g := range oldDef.Gateways {
+		oldGateways[string(g.ID)] = g
+	}
+	for _, g := range newDef.Gateways {
+		o, exists := oldGateways[string(g.ID)]
+		if !exists {
+			diff.AddedGateways = append(diff.AddedGateways, g)
+			continue
+		}
+		delete(oldGateways, string(g.ID))
+		if o.Address != g.Address || o.TlsCertificate != g.TlsCertificate {
+			diff.ChangedGateways = append(diff.ChangedGateways,
+				GatewayChange{o, g})
+		}
+	}
+	for _, g := range oldDef.Gateways {
+		if _, removed := oldGateways[string(g.ID)]; removed {
+			diff.RemovedGateways = append(diff.RemovedGateways, g)
+		}
+	}
+
+	return diff
+}
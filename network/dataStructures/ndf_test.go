@@ -8,9 +8,14 @@
 package dataStructures
 
 import (
+	"bytes"
 	"gitlab.com/elixxir/comms/mixmessages"
 	"gitlab.com/elixxir/comms/testutils"
+	"gitlab.com/xx_network/comms/signature"
+	"gitlab.com/xx_network/primitives/ndf"
+	"reflect"
 	"testing"
+	"time"
 )
 
 func setup() *Ndf {
@@ -85,3 +90,130 @@ func TestNdf_CompareHash(t *testing.T) {
 		t.Error("Should return false when hashes are different")
 	}
 }
+
+// Tests that editing the NDF returned by Ndf.Get does not change the stored
+// NDF.
+func TestNdf_Get_Copy(t *testing.T) {
+	ndf := setup()
+
+	def := ndf.Get()
+	if !reflect.DeepEqual(ndf.f.Nodes, def.Nodes) {
+		t.Error("Returned NDF does not match the stored NDF.")
+	}
+	nodeID := append([]byte{}, def.Nodes[0].ID...)
+	def.Nodes[0].ID[0] ^= 0xFF
+	def.Gateways[0].Address = "changed"
+
+	stored := ndf.Get()
+	if !bytes.Equal(stored.Nodes[0].ID, nodeID) ||
+		stored.Gateways[0].Address == "changed" {
+		t.Error("Edits to the returned NDF changed the stored NDF.")
+	}
+
+	msg := ndf.GetPb()
+	msg.Ndf = nil
+	if ndf.GetPb().Ndf == nil {
+		t.Error("Edits to the returned message changed the stored message.")
+	}
+}
+
+// Tests that Ndf.Diff reports added, removed and changed nodes and gateways.
+func TestNdf_Diff(t *testing.T) {
+	oldNdf := setup()
+	def := oldNdf.Get()
+
+	removedNode, removedGateway := def.Nodes[0], def.Gateways[0]
+	def.Nodes = def.Nodes[1:]
+	def.Gateways = def.Gateways[1:]
+	def.Nodes[0].Address = "changed.node:11420"
+	def.Gateways[0].TlsCertificate = "changed"
+	addedNode := ndf.Node{ID: []byte("added node"), Address: "0.0.0.0:1"}
+	addedGateway := ndf.Gateway{ID: []byte("added gateway")}
+	def.Nodes = append(def.Nodes, addedNode)
+	def.Gateways = append(def.Gateways, addedGateway)
+
+	newNdf, err := NewNdf(def)
+	if err != nil {
+		t.Fatalf("Failed to create NDF: %+v", err)
+	}
+	diff := oldNdf.Diff(newNdf)
+
+	if !reflect.DeepEqual(diff.AddedNodes, []ndf.Node{addedNode}) ||
+		!reflect.DeepEqual(diff.RemovedNodes, []ndf.Node{removedNode}) {
+		t.Errorf("Unexpected added or removed nodes: %+v", diff)
+	}
+	if !reflect.DeepEqual(diff.AddedGateways, []ndf.Gateway{addedGateway}) ||
+		!reflect.DeepEqual(diff.RemovedGateways,
+			[]ndf.Gateway{removedGateway}) {
+		t.Errorf("Unexpected added or removed gateways: %+v", diff)
+	}
+	if len(diff.ChangedNodes) != 1 ||
+		diff.ChangedNodes[0].New.Address != "changed.node:11420" {
+		t.Errorf("Unexpected changed nodes: %+v", diff.ChangedNodes)
+	}
+	if len(diff.ChangedGateways) != 1 ||
+		diff.ChangedGateways[0].New.TlsCertificate != "changed" {
+		t.Errorf("Unexpected changed gateways: %+v", diff.ChangedGateways)
+	}
+
+	if !oldNdf.Diff(oldNdf).IsEmpty() {
+		t.Error("Diff of an NDF with itself is not empty.")
+	}
+}
+
+// Tests that Ndf.Update rejects NDFs which are unsigned, have unexpected
+// groups or are older than the stored NDF when validation is set.
+func TestNdf_Update_Validation(t *testing.T) {
+	privKey, err := testutils.LoadPrivateKeyTesting(t)
+	if err != nil {
+		t.Fatalf("Failed to load private key: %+v", err)
+	}
+	def, err := ndf.Unmarshal(testutils.ExampleNDF)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal NDF: %+v", err)
+	}
+	newMsg := func(def *ndf.NetworkDefinition) *mixmessages.NDF {
+		data, err := def.Marshal()
+		if err != nil {
+			t.Fatalf("Failed to marshal NDF: %+v", err)
+		}
+		msg := &mixmessages.NDF{Ndf: data}
+		if err = signature.SignRsa(msg, privKey); err != nil {
+			t.Fatalf("Failed to sign NDF: %+v", err)
+		}
+		return msg
+	}
+
+	netDef := setup()
+	cmix := def.CMIX
+	netDef.SetValidation(NdfValidation{
+		PublicKey:   privKey.GetPublic(),
+		CmixGroup:   &cmix,
+		RejectStale: true,
+	})
+
+	if err = netDef.Update(newMsg(def)); err != nil {
+		t.Errorf("Failed to update with a valid NDF: %+v", err)
+	}
+
+	if err = netDef.Update(&mixmessages.NDF{Ndf: testutils.ExampleNDF}); err == nil {
+		t.Error("Update accepted an unsigned NDF.")
+	}
+
+	stale := def.DeepCopy()
+	stale.Timestamp = def.Timestamp.Add(-time.Hour)
+	if err = netDef.Update(newMsg(stale)); err == nil {
+		t.Error("Update accepted an older NDF.")
+	}
+
+	wrongGroup := def.DeepCopy()
+	wrongGroup.CMIX.Generator = "2"
+	wrongGroup.Timestamp = def.Timestamp.Add(time.Hour)
+	if err = netDef.Update(newMsg(wrongGroup)); err == nil {
+		t.Error("Update accepted an NDF with an unexpected group.")
+	}
+
+	if netDef.Get().Timestamp != def.Timestamp {
+		t.Error("Rejected NDF replaced the stored NDF.")
+	}
+}
@@ -8,6 +8,8 @@
 package dataStructures
 
 import (
+	"fmt"
+	"github.com/pkg/errors"
 	jww "github.com/spf13/jwalterweatherman"
 	pb "gitlab.com/elixxir/comms/mixmessages"
 	"gitlab.com/elixxir/primitives/states"
@@ -18,6 +20,38 @@ import (
 	"time"
 )
 
+// SignaturePolicy selects which of the signatures on a round info must verify
+// for the round to be accepted.
+type SignaturePolicy uint8
+
+const (
+	// EitherSignature accepts the Ed25519 signature if an elliptic key is set
+	// and the round info carries one, and the RSA signature otherwise.
+	EitherSignature SignaturePolicy = iota
+	// RsaSignatureOnly accepts only the RSA signature.
+	RsaSignatureOnly
+	// EccSignatureOnly accepts only the Ed25519 signature.
+	EccSignatureOnly
+	// BothSignatures requires both the RSA and Ed25519 signatures.
+	BothSignatures
+)
+
+// String returns a human-readable name for the policy.
+func (p SignaturePolicy) String() string {
+	switch p {
+	case EitherSignature:
+		return "either"
+	case RsaSignatureOnly:
+		return "RSA only"
+	case EccSignatureOnly:
+		return "ECC only"
+	case BothSignatures:
+		return "both"
+	default:
+		return fmt.Sprintf("SignaturePolicy(%d)", uint8(p))
+	}
+}
+
 // Structure wraps a round info object with the
 // key to verify the protobuff's signature
 // and a state track for verifying
@@ -26,17 +60,28 @@ type Round struct {
 	needsValidation *uint32
 	rsaPubKey       *rsa.PublicKey
 	ecPubKey        *ec.PublicKey
+	policy          SignaturePolicy
 	startTime       time.Time
 }
 
-// Constructor of a Round object.
+// Constructor of a Round object. Either key may be nil. When both are set the
+// round is treated as dual-signed: the cheaper Ed25519 signature is checked if
+// the round info carries one, otherwise the RSA signature is checked.
 func NewRound(ri *pb.RoundInfo, rsaPubKey *rsa.PublicKey, ecPubKey *ec.PublicKey) *Round {
-	validationDefault := uint32(0)
+	return NewRoundWithPolicy(ri, rsaPubKey, ecPubKey, EitherSignature)
+}
+
+// Constructor of a Round object whose signatures are checked according to the
+// policy. The keys the policy requires must be set.
+func NewRoundWithPolicy(ri *pb.RoundInfo, rsaPubKey *rsa.PublicKey,
+	ecPubKey *ec.PublicKey, policy SignaturePolicy) *Round {
+	validationDefault := roundUnverified
 	return &Round{
 		info:            ri,
 		needsValidation: &validationDefault,
 		rsaPubKey:       rsaPubKey,
 		ecPubKey:        ecPubKey,
+		policy:          policy,
 		startTime:       time.Unix(0, int64(ri.Timestamps[states.QUEUED])),
 	}
 }
@@ -45,7 +90,7 @@ func NewRound(ri *pb.RoundInfo, rsaPubKey *rsa.PublicKey, ecPubKey *ec.PublicKey
 // Intended for use by round creator.
 func NewVerifiedRound(ri *pb.RoundInfo, pubkey *rsa.PublicKey) *Round {
 	// Set validation to done on creation
-	validationDefault := uint32(1)
+	validationDefault := roundVerified
 	return &Round{
 		info:            ri,
 		needsValidation: &validationDefault,
@@ -54,32 +99,107 @@ func NewVerifiedRound(ri *pb.RoundInfo, pubkey *rsa.PublicKey) *Round {
 	}
 }
 
+// Values of Round.needsValidation
+const (
+	roundUnverified uint32 = iota
+	roundVerified
+	roundInvalid
+)
+
 // Get returns the round info object. If we have not
 // validated the signature before, we then verify.
 // Later calls will not need validation
 func (r *Round) Get() *pb.RoundInfo {
-	if atomic.LoadUint32(r.needsValidation) == 0 {
-		if r.rsaPubKey != nil {
-			// Check the sig, panic if failure
-			err := signature.VerifyRsa(r.info, r.rsaPubKey)
-			if err != nil {
-				jww.FATAL.Panicf("Could not validate "+
-					"the roundInfo signature: %+v: %v", r.info, err)
-			}
-		} else {
-			// Check the sig, panic if failure
-			err := signature.VerifyEddsa(r.info, r.ecPubKey)
-			if err != nil {
-				jww.FATAL.Panicf("Could not validate "+
-					"the roundInfo signature: %+v: %v", r.info, err)
-			}
+	if atomic.LoadUint32(r.needsValidation) != roundVerified {
+		// Check the sig, panic if failure
+		err := r.Verify()
+		if err != nil {
+			jww.FATAL.Panicf("Could not validate "+
+				"the roundInfo signature: %+v: %v", r.info, err)
 		}
 
-		atomic.StoreUint32(r.needsValidation, 1)
+		atomic.StoreUint32(r.needsValidation, roundVerified)
 	}
 	return r.info
 }
 
+// GetVerified returns the round info object, verifying its signature on the
+// first call. Unlike Get, an invalid signature is returned as an error, and
+// the result of the verification is cached whether or not it succeeds.
+func (r *Round) GetVerified() (*pb.RoundInfo, error) {
+	switch atomic.LoadUint32(r.needsValidation) {
+	case roundVerified:
+		return r.info, nil
+	case roundInvalid:
+		return nil, errors.Errorf("Round %d failed signature verification",
+			r.info.ID)
+	}
+
+	if err := r.Verify(); err != nil {
+		atomic.StoreUint32(r.needsValidation, roundInvalid)
+		return nil, errors.WithMessagef(err, "Round %d failed signature "+
+			"verification", r.info.ID)
+	}
+	atomic.StoreUint32(r.needsValidation, roundVerified)
+	return r.info, nil
+}
+
+// Verify checks the signatures on the round info required by the round's
+// SignaturePolicy without caching the result. By default, the Ed25519
+// signature is preferred when an elliptic key is set and the round info has
+// been signed with it, falling back to the RSA signature otherwise.
+func (r *Round) Verify() error {
+	switch r.policy {
+	case EitherSignature:
+		if r.ecPubKey != nil && (r.rsaPubKey == nil || r.info.HasEccSignature()) {
+			return signature.VerifyEddsa(r.info, r.ecPubKey)
+		}
+
+		if r.rsaPubKey != nil {
+			return signature.VerifyRsa(r.info, r.rsaPubKey)
+		}
+
+		return errors.Errorf("No key set to verify round %d", r.info.ID)
+	case RsaSignatureOnly:
+		return r.verifyRsa()
+	case EccSignatureOnly:
+		return r.verifyEcc()
+	case BothSignatures:
+		if err := r.verifyRsa(); err != nil {
+			return err
+		}
+		return r.verifyEcc()
+	default:
+		return errors.Errorf("Unknown signature policy %s for round %d",
+			r.policy, r.info.ID)
+	}
+}
+
+// verifyRsa checks the RSA signature on the round info.
+func (r *Round) verifyRsa() error {
+	if r.rsaPubKey == nil {
+		return errors.Errorf("No RSA key set to verify round %d", r.info.ID)
+	}
+	// VerifyRsa cannot handle a missing signature or a short nonce
+	if len(r.info.GetSignature().GetNonce()) < 8 {
+		return errors.Errorf("Round %d has no RSA signature", r.info.ID)
+	}
+	return signature.VerifyRsa(r.info, r.rsaPubKey)
+}
+
+// verifyEcc checks the Ed25519 signature on the round info.
+func (r *Round) verifyEcc() error {
+	if r.ecPubKey == nil {
+		return errors.Errorf("No elliptic key set to verify round %d",
+			r.info.ID)
+	}
+	if !r.info.HasEccSignature() ||
+		len(r.info.GetEccSignature().GetNonce()) < 8 {
+		return errors.Errorf("Round %d has no Ed25519 signature", r.info.ID)
+	}
+	return signature.VerifyEddsa(r.info, r.ecPubKey)
+}
+
 func (r *Round) StartTime() time.Time {
 	return r.startTime
 }
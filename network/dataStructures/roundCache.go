@@ -0,0 +1,148 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Cache of the most recent round updates, verified as they are read
+
+package dataStructures
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/crypto/signature/ec"
+	"gitlab.com/xx_network/crypto/signature/rsa"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/ring"
+)
+
+// RoundCache stores the most recent update of each recent round by round ID,
+// and the history of recent updates by update ID, as served in gateway poll
+// responses. An update is verified when it is added if it would advance either
+// buffer, which clears the entries it moves past, or replace an update already
+// stored, so that a forged update can neither evict nor displace valid ones.
+// Other updates only fill gaps in the history; their signature is verified the
+// first time they are read and the result is kept.
+type RoundCache struct {
+	rounds  *ring.Buff
+	updates *ring.Buff
+
+	rsaPubKey *rsa.PublicKey
+	ecPubKey  *ec.PublicKey
+
+	mux sync.Mutex
+}
+
+// NewRoundCache creates a RoundCache holding up to size rounds and size
+// updates. Round signatures are verified with the keys as in NewRound; either
+// may be nil.
+func NewRoundCache(size int, rsaPubKey *rsa.PublicKey,
+	ecPubKey *ec.PublicKey) *RoundCache {
+	return &RoundCache{
+		rounds:    ring.NewBuff(size),
+		updates:   ring.NewBuff(size),
+		rsaPubKey: rsaPubKey,
+		ecPubKey:  ecPubKey,
+	}
+}
+
+// Add stores a round update. An update older than the one already stored for
+// the round is stored only in the update history. Returns an error if the
+// round or update is older than those tracked, or if it had to be verified
+// and its signature is invalid.
+func (rc *RoundCache) Add(ri *pb.RoundInfo) error {
+	rnd := NewRound(ri, rc.rsaPubKey, rc.ecPubKey)
+
+	rc.mux.Lock()
+	defer rc.mux.Unlock()
+
+	if rc.displaces(ri) {
+		if _, err := rnd.GetVerified(); err != nil {
+			return errors.WithMessagef(err, "Failed to add update %d of "+
+				"round %d", ri.UpdateID, ri.ID)
+		}
+	}
+
+	if err := rc.updates.UpsertById(int(ri.UpdateID), rnd); err != nil {
+		return errors.WithMessagef(err, "Failed to add update %d of round "+
+			"%d", ri.UpdateID, ri.ID)
+	}
+
+	if existing, err := rc.rounds.GetById(int(ri.ID)); err == nil &&
+		existing != nil && existing.(*Round).info.UpdateID >= ri.UpdateID {
+		return nil
+	}
+	if err := rc.rounds.UpsertById(int(ri.ID), rnd); err != nil {
+		return errors.WithMessagef(err, "Failed to add round %d", ri.ID)
+	}
+	return nil
+}
+
+// displaces returns true if storing the update would advance either buffer
+// or replace an update already stored. This is assumed to be called under the
+// lock.
+func (rc *RoundCache) displaces(ri *pb.RoundInfo) bool {
+	if int(ri.UpdateID) > rc.updates.GetNewestId() ||
+		int(ri.ID) > rc.rounds.GetNewestId() {
+		return true
+	}
+	if existing, err := rc.updates.GetById(int(ri.UpdateID)); err == nil &&
+		existing != nil {
+		return true
+	}
+	existing, err := rc.rounds.GetById(int(ri.ID))
+	return err == nil && existing != nil &&
+		existing.(*Round).info.UpdateID < ri.UpdateID
+}
+
+// GetRound returns the most recent update of the round, verifying its
+// signature if it has not been read before.
+func (rc *RoundCache) GetRound(rid id.Round) (*pb.RoundInfo, error) {
+	val, err := rc.rounds.GetById(int(rid))
+	if err != nil {
+		return nil, errors.WithMessagef(err, "Failed to get round %d", rid)
+	}
+	if val == nil {
+		return nil, errors.Errorf("Round %d is not in the cache", rid)
+	}
+	return val.(*Round).GetVerified()
+}
+
+// GetUpdates returns the updates newer than lastUpdateID, oldest first, for
+// a poll response. Updates whose signature fails to verify are left out.
+func (rc *RoundCache) GetUpdates(lastUpdateID int) []*pb.RoundInfo {
+	vals, err := rc.updates.GetNewerById(lastUpdateID)
+	if err != nil {
+		return nil
+	}
+
+	updates := make([]*pb.RoundInfo, 0, len(vals))
+	for _, val := range vals {
+		if val == nil {
+			continue
+		}
+		ri, err := val.(*Round).GetVerified()
+		if err != nil {
+			jww.WARN.Printf("Leaving update out of round updates: %+v", err)
+			continue
+		}
+		updates = append(updates, ri)
+	}
+	return updates
+}
+
+// GetLastUpdateID returns the ID of the newest update in the cache, or -1 if
+// it is empty.
+func (rc *RoundCache) GetLastUpdateID() int {
+	return rc.updates.GetNewestId()
+}
+
+// GetLastRoundID returns the ID of the newest round in the cache.
+func (rc *RoundCache) GetLastRoundID() id.Round {
+	return id.Round(rc.rounds.GetNewestId())
+}
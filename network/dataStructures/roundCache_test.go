@@ -0,0 +1,145 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package dataStructures
+
+import (
+	"testing"
+
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/testutils"
+	"gitlab.com/elixxir/primitives/states"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// newCacheTestRound returns a signed update of a round.
+func newCacheTestRound(rid, updateID uint64, state states.Round,
+	t *testing.T) *pb.RoundInfo {
+	ri := &pb.RoundInfo{
+		ID:         rid,
+		UpdateID:   updateID,
+		State:      uint32(state),
+		Timestamps: make([]uint64, states.NUM_STATES),
+	}
+	if err := testutils.SignRoundInfoRsa(ri, t); err != nil {
+		t.Fatalf("Failed to sign round info: %+v", err)
+	}
+	return ri
+}
+
+// Tests that RoundCache.GetRound returns the newest update of each round and
+// RoundCache.GetUpdates returns the update history in order.
+func TestRoundCache_GetRound_GetUpdates(t *testing.T) {
+	pubKey, err := testutils.LoadPublicKeyTesting(t)
+	if err != nil {
+		t.Fatalf("Failed to load public key: %+v", err)
+	}
+	rc := NewRoundCache(10, pubKey, nil)
+
+	queued := newCacheTestRound(1, 1, states.QUEUED, t)
+	completed := newCacheTestRound(1, 3, states.COMPLETED, t)
+	other := newCacheTestRound(2, 2, states.QUEUED, t)
+	for _, ri := range []*pb.RoundInfo{queued, completed, other} {
+		if err = rc.Add(ri); err != nil {
+			t.Fatalf("Failed to add round %d: %+v", ri.ID, err)
+		}
+	}
+
+	ri, err := rc.GetRound(1)
+	if err != nil {
+		t.Fatalf("Failed to get round: %+v", err)
+	}
+	if ri != completed {
+		t.Errorf("Unexpected round.\nexpected: %+v\nreceived: %+v",
+			completed, ri)
+	}
+	if _, err = rc.GetRound(3); err == nil {
+		t.Error("GetRound did not error for a round not in the cache.")
+	}
+
+	if rc.GetLastUpdateID() != 3 || rc.GetLastRoundID() != id.Round(2) {
+		t.Errorf("Unexpected newest IDs: update %d, round %d.",
+			rc.GetLastUpdateID(), rc.GetLastRoundID())
+	}
+
+	updates := rc.GetUpdates(1)
+	if len(updates) != 2 || updates[0] != other || updates[1] != completed {
+		t.Errorf("Unexpected updates: %+v", updates)
+	}
+}
+
+// Tests that a round filling a gap whose signature does not verify is not
+// returned, and that the result is cached.
+func TestRoundCache_GetRound_Invalid(t *testing.T) {
+	pubKey, err := testutils.LoadPublicKeyTesting(t)
+	if err != nil {
+		t.Fatalf("Failed to load public key: %+v", err)
+	}
+	rc := NewRoundCache(10, pubKey, nil)
+	if err = rc.Add(newCacheTestRound(2, 2, states.QUEUED, t)); err != nil {
+		t.Fatalf("Failed to add round: %+v", err)
+	}
+
+	ri := newCacheTestRound(1, 1, states.QUEUED, t)
+	ri.Signature.Signature[0] ^= 0xFF
+	if err = rc.Add(ri); err != nil {
+		t.Fatalf("Failed to add round: %+v", err)
+	}
+
+	if _, err = rc.GetRound(1); err == nil {
+		t.Error("GetRound returned a round with an invalid signature.")
+	}
+
+	// Fixing the signature does not change the cached result
+	ri.Signature.Signature[0] ^= 0xFF
+	if _, err = rc.GetRound(1); err == nil {
+		t.Error("GetRound did not cache the failed verification.")
+	}
+	if updates := rc.GetUpdates(-1); len(updates) != 1 {
+		t.Errorf("GetUpdates returned invalid updates: %+v", updates)
+	}
+}
+
+// Tests that forged updates which would advance the cache or replace a stored
+// update are rejected without changing it.
+func TestRoundCache_Add_Forged(t *testing.T) {
+	pubKey, err := testutils.LoadPublicKeyTesting(t)
+	if err != nil {
+		t.Fatalf("Failed to load public key: %+v", err)
+	}
+	rc := NewRoundCache(10, pubKey, nil)
+	valid := newCacheTestRound(1, 1, states.QUEUED, t)
+	if err = rc.Add(valid); err != nil {
+		t.Fatalf("Failed to add round: %+v", err)
+	}
+
+	forged := []*pb.RoundInfo{
+		// Far ahead, which would clear both buffers
+		newCacheTestRound(1<<40, 1<<40, states.QUEUED, t),
+		// A newer update of the stored round
+		newCacheTestRound(1, 2, states.FAILED, t),
+		// The update ID already stored
+		newCacheTestRound(5, 1, states.QUEUED, t),
+	}
+	for i, ri := range forged {
+		ri.Signature.Signature[0] ^= 0xFF
+		if err = rc.Add(ri); err == nil {
+			t.Errorf("Added forged update %d.", i)
+		}
+	}
+
+	if rc.GetLastUpdateID() != 1 || rc.GetLastRoundID() != 1 {
+		t.Errorf("Forged updates advanced the cache to update %d, round "+
+			"%d.", rc.GetLastUpdateID(), rc.GetLastRoundID())
+	}
+	if ri, err := rc.GetRound(1); err != nil || ri != valid {
+		t.Errorf("Valid round was replaced: %+v", err)
+	}
+	if err = rc.Add(newCacheTestRound(2, 2, states.QUEUED, t)); err != nil {
+		t.Errorf("Failed to add a valid update after forged ones: %+v", err)
+	}
+}
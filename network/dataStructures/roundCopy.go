@@ -0,0 +1,79 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains copying and redaction of rounds for logging and forwarding
+
+package dataStructures
+
+import (
+	"sync/atomic"
+
+	"github.com/golang/protobuf/proto"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+)
+
+// Copy returns a round holding a deep copy of the round info, so that the
+// copy can be handed out without exposing the cached protobuf. The copy keeps
+// the keys, signature policy and verification state of the round; verifying
+// one afterwards does not affect the other.
+func (r *Round) Copy() *Round {
+	validation := atomic.LoadUint32(r.needsValidation)
+	return &Round{
+		info:            CopyRoundInfo(r.info),
+		needsValidation: &validation,
+		rsaPubKey:       r.rsaPubKey,
+		ecPubKey:        r.ecPubKey,
+		policy:          r.policy,
+		startTime:       r.startTime,
+	}
+}
+
+// CopyRoundInfo returns a deep copy of the round info, or nil if it is nil.
+func CopyRoundInfo(ri *pb.RoundInfo) *pb.RoundInfo {
+	if ri == nil {
+		return nil
+	}
+	return proto.Clone(ri).(*pb.RoundInfo)
+}
+
+// StripSignatures returns a copy of the round info without its RSA and
+// Ed25519 signatures or the signatures on its round errors, so that it can be
+// logged without leaking signature nonces. The copy no longer verifies.
+func StripSignatures(ri *pb.RoundInfo) *pb.RoundInfo {
+	stripped := CopyRoundInfo(ri)
+	if stripped == nil {
+		return nil
+	}
+	stripped.Signature = nil
+	stripped.EccSignature = nil
+	for _, roundErr := range stripped.Errors {
+		roundErr.Signature = nil
+	}
+	return stripped
+}
+
+// StripClientErrors returns a copy of the round info without its client
+// errors, which name the clients they concern. The copy no longer verifies.
+func StripClientErrors(ri *pb.RoundInfo) *pb.RoundInfo {
+	stripped := CopyRoundInfo(ri)
+	if stripped == nil {
+		return nil
+	}
+	stripped.ClientErrors = nil
+	return stripped
+}
+
+// RedactRoundInfo returns a copy of the round info with both its signatures
+// and its client errors removed, suitable for logs.
+func RedactRoundInfo(ri *pb.RoundInfo) *pb.RoundInfo {
+	redacted := StripSignatures(ri)
+	if redacted == nil {
+		return nil
+	}
+	redacted.ClientErrors = nil
+	return redacted
+}
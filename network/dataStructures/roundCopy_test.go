@@ -0,0 +1,105 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package dataStructures
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/testutils"
+	"gitlab.com/elixxir/primitives/states"
+	"gitlab.com/xx_network/comms/messages"
+)
+
+// newRedactionTestRoundInfo returns a signed round info with round and client
+// errors.
+func newRedactionTestRoundInfo(t *testing.T) *pb.RoundInfo {
+	ri := &pb.RoundInfo{
+		ID:         7,
+		State:      uint32(states.FAILED),
+		Timestamps: make([]uint64, states.NUM_STATES),
+		Errors: []*pb.RoundError{{
+			Id:        7,
+			Error:     "round error",
+			Signature: &messages.RSASignature{Nonce: []byte("nonce")},
+		}},
+		ClientErrors: []*pb.ClientError{{
+			ClientId: []byte("client"),
+			Error:    "client error",
+		}},
+	}
+	if err := testutils.SignRoundInfoRsa(ri, t); err != nil {
+		t.Fatalf("Failed to sign round info: %+v", err)
+	}
+	return ri
+}
+
+// Tests that Round.Copy returns a round with an independent round info and
+// verification state.
+func TestRound_Copy(t *testing.T) {
+	ri := newRedactionTestRoundInfo(t)
+	pubKey, err := testutils.LoadPublicKeyTesting(t)
+	if err != nil {
+		t.Fatalf("Failed to load public key: %+v", err)
+	}
+	r := NewRound(ri, pubKey, nil)
+
+	c := r.Copy()
+	if c.info == ri || !proto.Equal(c.info, ri) {
+		t.Errorf("Copied round info is not an equal deep copy.")
+	}
+	if !c.StartTime().Equal(r.StartTime()) || c.policy != r.policy {
+		t.Errorf("Copy did not keep the round's fields.")
+	}
+
+	if _, err = c.GetVerified(); err != nil {
+		t.Fatalf("Copy failed to verify: %+v", err)
+	}
+	if *r.needsValidation != roundUnverified {
+		t.Errorf("Verifying the copy changed the original's state.")
+	}
+	if r.Copy().info.Errors[0] == ri.Errors[0] {
+		t.Errorf("Copy shares nested messages with the original.")
+	}
+}
+
+// Tests that StripSignatures, StripClientErrors and RedactRoundInfo remove
+// only their fields and leave the original unchanged.
+func TestRedactRoundInfo(t *testing.T) {
+	ri := newRedactionTestRoundInfo(t)
+	original := CopyRoundInfo(ri)
+
+	noSigs := StripSignatures(ri)
+	if noSigs.Signature != nil || noSigs.EccSignature != nil ||
+		noSigs.Errors[0].Signature != nil {
+		t.Errorf("StripSignatures left a signature: %v", noSigs)
+	}
+	if len(noSigs.ClientErrors) != 1 || noSigs.Errors[0].Error != "round error" {
+		t.Errorf("StripSignatures removed other fields: %v", noSigs)
+	}
+
+	noClientErrs := StripClientErrors(ri)
+	if noClientErrs.ClientErrors != nil || noClientErrs.Signature == nil {
+		t.Errorf("StripClientErrors did not remove only client errors: %v",
+			noClientErrs)
+	}
+
+	redacted := RedactRoundInfo(ri)
+	if redacted.Signature != nil || redacted.ClientErrors != nil ||
+		redacted.ID != ri.ID {
+		t.Errorf("RedactRoundInfo did not redact the round: %v", redacted)
+	}
+
+	if !proto.Equal(ri, original) {
+		t.Errorf("Redaction modified the original round info.")
+	}
+	if StripSignatures(nil) != nil || RedactRoundInfo(nil) != nil {
+		t.Errorf("Redacting nil did not return nil.")
+	}
+}
@@ -14,19 +14,38 @@ import (
 	"gitlab.com/elixxir/primitives/states"
 	"gitlab.com/xx_network/primitives/id"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
+// DefaultRoundEventWorkers is the number of goroutines NewRoundEvents runs
+// callbacks on.
+const DefaultRoundEventWorkers = 32
+
 // RoundEventCallback is the callbacks called on trigger.
 type RoundEventCallback func(ri *pb.RoundInfo, timedOut bool)
 
 // EventCallback contains one callback and associated data.
 type EventCallback struct {
+	rid id.Round
+
 	// Round states where this function can be called
 	states []states.Round
 
-	// Send on this channel to cause the relevant callbacks
-	signal chan *pb.RoundInfo
+	callback RoundEventCallback
+
+	// Set once the callback has been queued, so it is only called once
+	done uint32
+
+	// Slot of the timeout in the timer wheel
+	slot int
+}
+
+// eventCall is a callback queued to be called by a worker.
+type eventCall struct {
+	callback RoundEventCallback
+	ri       *pb.RoundInfo
+	timedOut bool
 }
 
 // RoundEvents holds the callbacks for a round.
@@ -35,17 +54,51 @@ type RoundEvents struct {
 	// for each of the round's states
 	callbacks map[id.Round][states.NUM_STATES]map[*EventCallback]*EventCallback
 	mux       sync.RWMutex
+
+	timeouts *timerWheel
+
+	// Callbacks waiting to be called, and the workers calling them. The
+	// queue is not bounded so that triggering events never blocks, including
+	// from within a callback.
+	queue      []eventCall
+	maxWorkers int
+	workers    int
+	idle       int
+	closed     bool
+	queueMux   sync.Mutex
+	queueCond  *sync.Cond
+
+	// Closed by Close to stop the goroutines forwarding events to channels
+	quit chan struct{}
 }
 
-// NewRoundEvents initialize a new RoundEvents object.
+// NewRoundEvents initialize a new RoundEvents object, which calls callbacks
+// on up to DefaultRoundEventWorkers goroutines.
 func NewRoundEvents() *RoundEvents {
-	return &RoundEvents{
+	return NewRoundEventsWithWorkers(DefaultRoundEventWorkers)
+}
+
+// NewRoundEventsWithWorkers initialize a new RoundEvents object, which calls
+// callbacks on up to the given number of goroutines. Workers are started as
+// callbacks are queued, and a callback which blocks holds up its worker, so
+// callbacks should return quickly.
+func NewRoundEventsWithWorkers(workers int) *RoundEvents {
+	if workers < 1 {
+		workers = 1
+	}
+	r := &RoundEvents{
 		callbacks: make(
 			map[id.Round][states.NUM_STATES]map[*EventCallback]*EventCallback),
+		maxWorkers: workers,
+		quit:       make(chan struct{}),
 	}
+	r.queueCond = sync.NewCond(&r.queueMux)
+	r.timeouts = newTimerWheel(r.timeout)
+	return r
 }
 
-// Remove wraps non-exported remove with mutex.
+// Remove wraps non-exported remove with mutex. The event can no longer be
+// triggered, but its callback is still called once it times out.
 func (r *RoundEvents) Remove(rid id.Round, e *EventCallback) {
 	r.mux.Lock()
 	r.remove(rid, e)
@@ -72,18 +125,77 @@ func (r *RoundEvents) remove(rid id.Round, e *EventCallback) {
 	}
 }
 
-// signal calls or timeout a round event. Removes round events when they are
-// called or timed out to allow them to get garbage collected.
-func (r *RoundEvents) signal(rid id.Round, event *EventCallback,
-	callback RoundEventCallback, timeout time.Duration) {
-	ri := &pb.RoundInfo{ID: uint64(rid)}
-	select {
-	case <-time.After(timeout):
-		go r.Remove(rid, event)
-		callback(ri, true)
-	case ri = <-event.signal:
-		go r.Remove(rid, event)
-		callback(ri, false)
+// timeout is called by the timer wheel when a round event times out. The
+// event is removed so it can be garbage collected and its callback queued
+// unless it has already been triggered.
+func (r *RoundEvents) timeout(e *EventCallback) {
+	if !atomic.CompareAndSwapUint32(&e.done, 0, 1) {
+		return
+	}
+	r.mux.Lock()
+	r.remove(e.rid, e)
+	r.mux.Unlock()
+	r.enqueue(eventCall{e.callback, &pb.RoundInfo{ID: uint64(e.rid)}, true})
+}
+
+// Close stops the timeouts of the round events and the workers calling
+// callbacks. Callbacks already running finish, but those queued or triggered
+// afterwards are not called, and events added afterwards never time out.
+func (r *RoundEvents) Close() {
+	r.timeouts.close()
+
+	r.queueMux.Lock()
+	if !r.closed {
+		close(r.quit)
+	}
+	r.closed = true
+	r.queue = nil
+	r.queueCond.Broadcast()
+	r.queueMux.Unlock()
+}
+
+// enqueue queues a callback, starting a worker to call it if none is idle and
+// fewer than the maximum are running. Nothing is queued once closed.
+func (r *RoundEvents) enqueue(calls ...eventCall) {
+	r.queueMux.Lock()
+	defer r.queueMux.Unlock()
+	if r.closed {
+		return
+	}
+	for _, call := range calls {
+		r.queue = append(r.queue, call)
+		if r.idle > 0 {
+			r.queueCond.Signal()
+		} else if r.workers < r.maxWorkers {
+			r.workers++
+			go r.work()
+		}
+	}
+}
+
+// work calls queued callbacks, waiting for more when the queue is empty,
+// until closed.
+func (r *RoundEvents) work() {
+	r.queueMux.Lock()
+	for {
+		for len(r.queue) == 0 && !r.closed {
+			r.idle++
+			r.queueCond.Wait()
+			r.idle--
+		}
+		if r.closed {
+			r.workers--
+			r.queueMux.Unlock()
+			return
+		}
+		call := r.queue[0]
+		r.queue[0] = eventCall{}
+		r.queue = r.queue[1:]
+		r.queueMux.Unlock()
+
+		call.callback(call.ri, call.timedOut)
+
+		r.queueMux.Lock()
 	}
 }
 
@@ -93,13 +205,24 @@ type EventReturn struct {
 }
 
 // AddRoundEventChan puts the round event on a channel instead of using a
-// callback.
+// callback. The event is sent from a goroutine started for the registration,
+// so a slow reader does not hold up the workers calling other callbacks.
 func (r *RoundEvents) AddRoundEventChan(rid id.Round,
 	eventChan chan EventReturn, timeout time.Duration,
 	validStates ...states.Round) *EventCallback {
 
+	// The callback is only called once, so it never blocks on this buffer
+	forward := make(chan EventReturn, 1)
+	go func() {
+		select {
+		case event := <-forward:
+			eventChan <- event
+		case <-r.quit:
+		}
+	}()
+
 	callback := func(ri *pb.RoundInfo, timedOut bool) {
-		eventChan <- EventReturn{ri, timedOut}
+		forward <- EventReturn{ri, timedOut}
 	}
 
 	return r.AddRoundEvent(rid, callback, timeout, validStates...)
@@ -111,12 +234,11 @@ func (r *RoundEvents) AddRoundEvent(rid id.Round, callback RoundEventCallback,
 	timeout time.Duration, validStates ...states.Round) *EventCallback {
 	// Add the specific event to the round
 	thisEvent := &EventCallback{
-		states: validStates,
-		signal: make(chan *pb.RoundInfo, 1),
+		rid:      rid,
+		states:   validStates,
+		callback: callback,
 	}
 
-	go r.signal(rid, thisEvent, callback, timeout)
-
 	r.mux.Lock()
 	callbacks, ok := r.callbacks[rid]
 	if !ok {
@@ -132,56 +254,71 @@ func (r *RoundEvents) AddRoundEvent(rid id.Round, callback RoundEventCallback,
 		callbacks[s][thisEvent] = thisEvent
 	}
 	r.mux.Unlock()
+
+	r.timeouts.add(thisEvent, timeout)
 	return thisEvent
 }
 
 // TriggerRoundEvent signals all round events matching the passed RoundInfo
 // according to its ID and state.
 func (r *RoundEvents) TriggerRoundEvent(rnd *Round) {
-	r.mux.RLock()
-	defer r.mux.RUnlock()
-
-	// Try to find callbacks
-	callbacks, ok := r.callbacks[id.Round(rnd.info.ID)]
-	if !ok || len(callbacks[rnd.info.State]) == 0 {
-		return
-	}
-
-	// Retrieve and validate the round info
-	roundInfo := rnd.Get()
-
-	// Send round info to every event in the list
-	for _, event := range callbacks[rnd.info.State] {
-		select {
-		case event.signal <- roundInfo:
-		default:
-		}
-	}
+	r.TriggerRoundEvents(rnd)
 }
 
 // TriggerRoundEvents signals all round events matching the passed RoundInfos
 // according to its ID and state.
 func (r *RoundEvents) TriggerRoundEvents(rounds ...*Round) {
-	r.mux.RLock()
-	defer r.mux.RUnlock()
+	r.trigger(rounds, func(ri *pb.RoundInfo) []states.Round {
+		return []states.Round{states.Round(ri.State)}
+	})
+}
 
+// trigger signals all round events for the rounds which are registered for
+// one of the states returned by reached.
+func (r *RoundEvents) trigger(rounds []*Round,
+	reached func(ri *pb.RoundInfo) []states.Round) {
+	var calls []eventCall
 	for _, rnd := range rounds {
+		rid := id.Round(rnd.info.ID)
+		roundStates := reached(rnd.info)
 
 		// Try to find callbacks
-		callbacks, ok := r.callbacks[id.Round(rnd.info.ID)]
-		if !ok || len(callbacks[rnd.info.State]) == 0 {
+		r.mux.RLock()
+		callbacks, found := r.callbacks[rid]
+		registered := false
+		for _, s := range roundStates {
+			registered = registered || (found && len(callbacks[s]) > 0)
+		}
+		r.mux.RUnlock()
+		if !registered {
 			continue
 		}
 
 		// Retrieve and validate the round info
 		roundInfo := rnd.Get()
 
-		// Send round info to every event in the list
-		for _, event := range callbacks[rnd.info.State] {
-			select {
-			case event.signal <- roundInfo:
-			default:
+		// Remove every event in the list, so later triggers and timeouts
+		// do not call it again
+		var triggered []*EventCallback
+		r.mux.Lock()
+		for _, s := range roundStates {
+			for _, event := range r.callbacks[rid][s] {
+				if atomic.CompareAndSwapUint32(&event.done, 0, 1) {
+					r.remove(rid, event)
+					triggered = append(triggered, event)
+					calls = append(calls,
+						eventCall{event.callback, roundInfo, false})
+				}
 			}
 		}
+		r.mux.Unlock()
+
+		for _, event := range triggered {
+			r.timeouts.remove(event)
+		}
+	}
+
+	if len(calls) > 0 {
+		r.enqueue(calls...)
 	}
 }
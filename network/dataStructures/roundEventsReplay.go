@@ -0,0 +1,92 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the recovery of round events after a client reconnects
+
+package dataStructures
+
+import (
+	"sort"
+
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/primitives/states"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// EventRegistration describes a round event which has been neither triggered
+// nor timed out.
+type EventRegistration struct {
+	Round  id.Round
+	States []states.Round
+}
+
+// Outstanding returns the round events which have yet to be triggered or time
+// out, ordered by round. A client can use it after reconnecting to find the
+// rounds whose history it needs to pass to Replay.
+func (r *RoundEvents) Outstanding() []EventRegistration {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+
+	seen := make(map[*EventCallback]struct{})
+	var registrations []EventRegistration
+	for rid, callbacks := range r.callbacks {
+		for _, events := range callbacks {
+			for _, event := range events {
+				if _, exists := seen[event]; exists {
+					continue
+				}
+				seen[event] = struct{}{}
+				registrations = append(registrations, EventRegistration{
+					Round:  rid,
+					States: append([]states.Round(nil), event.states...),
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(registrations, func(i, j int) bool {
+		return registrations[i].Round < registrations[j].Round
+	})
+	return registrations
+}
+
+// Replay signals the round events which would have been triggered by updates
+// missed while disconnected, e.g. those of the rounds returned by a gateway
+// poll after reconnecting. Unlike TriggerRoundEvents, which only signals the
+// events for each round's current state, an event is signalled for every
+// state the round info records a timestamp for, so a client is told a round
+// completed even if it missed the update for the state it waited on.
+func (r *RoundEvents) Replay(history ...*Round) {
+	r.trigger(history, reachedStates)
+}
+
+// PauseTimeouts stops round events from timing out, e.g. while a client is
+// disconnected and cannot receive the updates which would trigger them.
+// Pending timeouts are extended by the time until ResumeTimeouts is called.
+func (r *RoundEvents) PauseTimeouts() {
+	r.timeouts.setPaused(true)
+}
+
+// ResumeTimeouts lets round events time out again after PauseTimeouts. Call
+// Replay first so that events for rounds which have since progressed are
+// triggered rather than timing out.
+func (r *RoundEvents) ResumeTimeouts() {
+	r.timeouts.setPaused(false)
+}
+
+// reachedStates returns the states the round info records the round as having
+// reached, along with its current state.
+func reachedStates(ri *pb.RoundInfo) []states.Round {
+	reached := []states.Round{states.Round(ri.State)}
+	for s, timestamp := range ri.Timestamps {
+		if timestamp != 0 && s < int(states.NUM_STATES) &&
+			states.Round(s) != states.Round(ri.State) {
+			reached = append(reached, states.Round(s))
+		}
+	}
+	return reached
+}
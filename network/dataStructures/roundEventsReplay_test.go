@@ -0,0 +1,111 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package dataStructures
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/testutils"
+	"gitlab.com/elixxir/primitives/states"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// Tests that RoundEvents.Outstanding lists the events which have not been
+// triggered, once each.
+func TestRoundEvents_Outstanding(t *testing.T) {
+	events := NewRoundEvents()
+	events.AddRoundEvent(2, func(*pb.RoundInfo, bool) {}, time.Minute,
+		states.COMPLETED, states.FAILED)
+	events.AddRoundEvent(1, func(*pb.RoundInfo, bool) {}, time.Minute,
+		states.QUEUED)
+	e := events.AddRoundEvent(3, func(*pb.RoundInfo, bool) {}, time.Minute,
+		states.QUEUED)
+	events.Remove(3, e)
+
+	expected := []EventRegistration{
+		{Round: 1, States: []states.Round{states.QUEUED}},
+		{Round: 2, States: []states.Round{states.COMPLETED, states.FAILED}},
+	}
+	if received := events.Outstanding(); !reflect.DeepEqual(received, expected) {
+		t.Errorf("Unexpected registrations.\nexpected: %+v\nreceived: %+v",
+			expected, received)
+	}
+}
+
+// Tests that RoundEvents.Replay triggers the events of states the round
+// passed through but is no longer in.
+func TestRoundEvents_Replay(t *testing.T) {
+	events := NewRoundEvents()
+	rid := id.Round(1)
+	queued := make(chan EventReturn, 1)
+	failed := make(chan EventReturn, 1)
+	events.AddRoundEventChan(rid, queued, time.Minute, states.QUEUED)
+	events.AddRoundEventChan(rid, failed, time.Minute, states.FAILED)
+
+	ri := &pb.RoundInfo{
+		ID:         uint64(rid),
+		State:      uint32(states.COMPLETED),
+		Timestamps: make([]uint64, states.NUM_STATES),
+	}
+	ri.Timestamps[states.QUEUED] = 1
+	ri.Timestamps[states.COMPLETED] = 2
+	if err := testutils.SignRoundInfoRsa(ri, t); err != nil {
+		t.Fatalf("Failed to sign mock round info: %v", err)
+	}
+	pubKey, err := testutils.LoadPublicKeyTesting(t)
+	if err != nil {
+		t.Fatalf("Failed to load public key: %v", err)
+	}
+	events.Replay(NewRound(ri, pubKey, nil))
+
+	select {
+	case ret := <-queued:
+		if ret.TimedOut || ret.RoundInfo != ri {
+			t.Errorf("Unexpected event: %+v", ret)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Event for a passed state was not triggered.")
+	}
+
+	select {
+	case ret := <-failed:
+		t.Errorf("Event for an unreached state was triggered: %+v", ret)
+	case <-time.After(20 * time.Millisecond):
+	}
+	if len(events.Outstanding()) != 1 {
+		t.Errorf("Unexpected registrations: %+v", events.Outstanding())
+	}
+}
+
+// Tests that round events do not time out while timeouts are paused.
+func TestRoundEvents_PauseTimeouts(t *testing.T) {
+	events := NewRoundEvents()
+	eventChan := make(chan EventReturn, 1)
+	events.AddRoundEventChan(1, eventChan, 20*time.Millisecond,
+		states.COMPLETED)
+
+	events.PauseTimeouts()
+	select {
+	case ret := <-eventChan:
+		t.Fatalf("Event timed out while paused: %+v", ret)
+	case <-time.After(60 * time.Millisecond):
+	}
+
+	events.ResumeTimeouts()
+	select {
+	case ret := <-eventChan:
+		if !ret.TimedOut {
+			t.Errorf("Event did not time out: %+v", ret)
+		}
+	case <-time.After(time.Second):
+		t.Error("Event did not time out after resuming.")
+	}
+}
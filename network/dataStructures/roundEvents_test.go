@@ -12,6 +12,9 @@ import (
 	"gitlab.com/elixxir/comms/testutils"
 	"gitlab.com/elixxir/primitives/states"
 	"gitlab.com/xx_network/primitives/id"
+	"runtime"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -228,3 +231,149 @@ func TestRoundEvents_AddRoundEventChan(t *testing.T) {
 		t.Error("callback should have been removed after calling")
 	}
 }
+
+// Tests that adding many round events does not start a goroutine for each,
+// and that their callbacks are run on no more than the maximum number of
+// workers.
+func TestRoundEvents_Workers(t *testing.T) {
+	const numEvents = 1000
+	const workers = 4
+	before := runtime.NumGoroutine()
+	events := NewRoundEventsWithWorkers(workers)
+
+	var running, maxRunning int32
+	var wg sync.WaitGroup
+	wg.Add(numEvents)
+	for i := 0; i < numEvents; i++ {
+		events.AddRoundEvent(id.Round(i), func(_ *pb.RoundInfo, timedOut bool) {
+			n := atomic.AddInt32(&running, 1)
+			for {
+				m := atomic.LoadInt32(&maxRunning)
+				if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			wg.Done()
+		}, 20*time.Millisecond, states.PENDING)
+	}
+
+	// One goroutine runs the timer wheel
+	if n := runtime.NumGoroutine(); n > before+1 {
+		t.Errorf("%d goroutines started for %d events.", n-before, numEvents)
+	}
+
+	wg.Wait()
+	if maxRunning > workers {
+		t.Errorf("%d callbacks ran at once, expected at most %d.",
+			maxRunning, workers)
+	}
+}
+
+// Tests that a removed round event still times out.
+func TestRoundEvents_Remove_TimesOut(t *testing.T) {
+	events := NewRoundEvents()
+	var called uint32
+	e := events.AddRoundEvent(id.Round(1), func(_ *pb.RoundInfo, timedOut bool) {
+		if timedOut {
+			atomic.StoreUint32(&called, 1)
+		}
+	}, 20*time.Millisecond, states.PENDING)
+	events.Remove(id.Round(1), e)
+
+	time.Sleep(60 * time.Millisecond)
+	if atomic.LoadUint32(&called) != 1 {
+		t.Error("Callback of removed event did not time out.")
+	}
+}
+
+// Tests that a triggered round event is not called again when it would have
+// timed out.
+func TestRoundEvents_TriggerRoundEvent_NoTimeout(t *testing.T) {
+	events := NewRoundEvents()
+	calls := make(chan bool, 2)
+	events.AddRoundEvent(id.Round(1), func(_ *pb.RoundInfo, timedOut bool) {
+		calls <- timedOut
+	}, 20*time.Millisecond, states.PENDING)
+
+	ri := &pb.RoundInfo{
+		ID:         1,
+		State:      uint32(states.PENDING),
+		Timestamps: make([]uint64, states.NUM_STATES),
+	}
+	if err := testutils.SignRoundInfoRsa(ri, t); err != nil {
+		t.Fatalf("Failed to sign mock round info: %v", err)
+	}
+	pubKey, err := testutils.LoadPublicKeyTesting(t)
+	if err != nil {
+		t.Fatalf("Failed to load public key: %v", err)
+	}
+	events.TriggerRoundEvent(NewRound(ri, pubKey, nil))
+
+	time.Sleep(60 * time.Millisecond)
+	if len(calls) != 1 {
+		t.Fatalf("Callback called %d times, expected once.", len(calls))
+	}
+	if <-calls {
+		t.Error("Callback was called as timed out.")
+	}
+}
+
+// Tests that events sent to channels nobody is reading do not hold up the
+// workers calling other callbacks and are delivered once read.
+func TestRoundEvents_AddRoundEventChan_Unread(t *testing.T) {
+	events := NewRoundEventsWithWorkers(1)
+	defer events.Close()
+
+	unread := make(chan EventReturn)
+	for i := 0; i < 3; i++ {
+		events.AddRoundEventChan(id.Round(i), unread, time.Millisecond,
+			states.PENDING)
+	}
+	called := make(chan struct{})
+	events.AddRoundEvent(id.Round(10), func(*pb.RoundInfo, bool) {
+		close(called)
+	}, 20*time.Millisecond, states.PENDING)
+
+	select {
+	case <-called:
+	case <-time.After(time.Second):
+		t.Fatal("Callback held up by events sent to an unread channel.")
+	}
+	for i := 0; i < 3; i++ {
+		if event := <-unread; !event.TimedOut {
+			t.Errorf("Event %d did not time out.", i)
+		}
+	}
+}
+
+// Tests that Close stops the timeouts and workers of the round events.
+func TestRoundEvents_Close(t *testing.T) {
+	events := NewRoundEventsWithWorkers(2)
+	var called uint32
+	events.AddRoundEvent(id.Round(1), func(*pb.RoundInfo, bool) {
+		atomic.StoreUint32(&called, 1)
+	}, time.Millisecond, states.PENDING)
+	time.Sleep(50 * time.Millisecond)
+	if atomic.LoadUint32(&called) != 1 {
+		t.Fatal("Event did not time out before closing.")
+	}
+
+	events.Close()
+	time.Sleep(10 * time.Millisecond)
+	events.queueMux.Lock()
+	workers := events.workers
+	events.queueMux.Unlock()
+	if workers != 0 {
+		t.Errorf("%d workers still running after closing.", workers)
+	}
+
+	events.AddRoundEvent(id.Round(2), func(*pb.RoundInfo, bool) {
+		atomic.StoreUint32(&called, 2)
+	}, time.Millisecond, states.PENDING)
+	time.Sleep(50 * time.Millisecond)
+	if atomic.LoadUint32(&called) != 1 {
+		t.Errorf("Event timed out after closing.")
+	}
+}
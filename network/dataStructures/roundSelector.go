@@ -0,0 +1,126 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains pluggable selection of the round returned by GetUpcomingRealtime
+
+package dataStructures
+
+import (
+	"time"
+
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/primitives/excludedRounds"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// RoundSelector chooses which of the waiting rounds GetUpcomingRealtime
+// returns, e.g. by weighting rounds by their team or by the past reliability
+// of their nodes.
+type RoundSelector interface {
+	// Select returns the index in candidates of the round to send on, or a
+	// negative index if none is acceptable. The candidates are sorted soonest
+	// first, and all are inside the lead time window and not excluded. Their
+	// signatures have not yet been verified; only the selected round is, so
+	// the round infos must not be modified or kept.
+	Select(candidates []*pb.RoundInfo) int
+}
+
+// RoundSelectorFunc adapts a function to a RoundSelector.
+type RoundSelectorFunc func(candidates []*pb.RoundInfo) int
+
+// Select calls f(candidates).
+func (f RoundSelectorFunc) Select(candidates []*pb.RoundInfo) int {
+	return f(candidates)
+}
+
+// WeightedRoundSelector returns a RoundSelector choosing the candidate with
+// the greatest weight, preferring the soonest among equal weights. Rounds
+// with a negative weight are never chosen.
+func WeightedRoundSelector(weight func(ri *pb.RoundInfo) float64) RoundSelector {
+	return RoundSelectorFunc(func(candidates []*pb.RoundInfo) int {
+		selected, best := -1, 0.0
+		for i, ri := range candidates {
+			w := weight(ri)
+			if w >= 0 && (selected < 0 || w > best) {
+				selected, best = i, w
+			}
+		}
+		return selected
+	})
+}
+
+// roundSelector wraps the RoundSelector stored by SetRoundSelector, since an
+// atomic.Value must always hold the same concrete type.
+type roundSelector struct {
+	RoundSelector
+}
+
+// SetRoundSelector sets the RoundSelector GetUpcomingRealtime chooses rounds
+// with. By default, or if selector is nil, the soonest round is chosen.
+func (wr *WaitingRounds) SetRoundSelector(selector RoundSelector) {
+	wr.selector.Store(roundSelector{selector})
+}
+
+// getRoundSelector returns the RoundSelector set by SetRoundSelector, or nil
+// if none is set.
+func (wr *WaitingRounds) getRoundSelector() RoundSelector {
+	rs, _ := wr.selector.Load().(roundSelector)
+	return rs.RoundSelector
+}
+
+// selectRound returns the round chosen by the selector from the rounds inside
+// the lead time window which are not excluded and are accepted by the filter,
+// adding it to the exclusion list. Returns nil if there are no such rounds or
+// the selector chooses none.
+func (wr *WaitingRounds) selectRound(selector RoundSelector,
+	exclude excludedRounds.ExcludedRounds, minRoundAge time.Duration,
+	filter TopologyFilter) *Round {
+	earliestStart, latestStart := wr.leadTimeBounds(wr.now(), minRoundAge)
+
+	roundsList, exists := wr.readRounds.Load().([]*Round)
+	if !exists {
+		return nil
+	}
+
+	var rounds []*Round
+	var candidates []*pb.RoundInfo
+	for i, r := range roundsList {
+		// The list is sorted soonest first, so no later round can be within
+		// the window once one starts after it
+		if !latestStart.IsZero() && r.StartTime().After(latestStart) {
+			wr.stats.skip(&wr.stats.skippedCutoff, len(roundsList)-i)
+			break
+		}
+		if !withinLeadTime(r.StartTime(), earliestStart, latestStart) {
+			wr.stats.skip(&wr.stats.skippedCutoff, 1)
+			continue
+		}
+		if !filter.accepts(r) {
+			wr.stats.skip(&wr.stats.skippedFiltered, 1)
+			continue
+		}
+		if exclude != nil && exclude.Has(id.Round(r.info.ID)) {
+			wr.stats.skip(&wr.stats.skippedExcluded, 1)
+			continue
+		}
+		rounds = append(rounds, r)
+		candidates = append(candidates, r.info)
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	i := selector.Select(candidates)
+	if i < 0 || i >= len(rounds) {
+		return nil
+	}
+	if exclude != nil && !exclude.Insert(id.Round(rounds[i].info.ID)) {
+		// Excluded by another caller since the candidates were gathered
+		return nil
+	}
+	return rounds[i]
+}
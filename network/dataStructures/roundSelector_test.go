@@ -0,0 +1,123 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package dataStructures
+
+import (
+	"testing"
+	"time"
+
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/testutils"
+	"gitlab.com/elixxir/primitives/excludedRounds"
+	"gitlab.com/elixxir/primitives/states"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/netTime"
+)
+
+// newSelectorTestRounds returns a WaitingRounds holding num signed queued
+// rounds, with IDs in order of their start.
+func newSelectorTestRounds(num int, t *testing.T) *WaitingRounds {
+	pubKey, err := testutils.LoadPublicKeyTesting(t)
+	if err != nil {
+		t.Fatalf("Failed to load public key: %+v", err)
+	}
+	start := netTime.Now().Add(time.Minute)
+
+	rounds := make([]*Round, num)
+	for i := range rounds {
+		ri := &pb.RoundInfo{
+			ID:         uint64(i),
+			State:      uint32(states.QUEUED),
+			Timestamps: make([]uint64, states.NUM_STATES),
+		}
+		ri.Timestamps[states.QUEUED] =
+			uint64(start.Add(time.Duration(i) * time.Second).UnixNano())
+		if err = testutils.SignRoundInfoRsa(ri, t); err != nil {
+			t.Fatalf("Failed to sign round info: %+v", err)
+		}
+		rounds[i] = NewRound(ri, pubKey, nil)
+	}
+
+	wr := NewWaitingRounds()
+	wr.Insert(rounds, nil)
+	return wr
+}
+
+// Tests that GetUpcomingRealtime returns the round chosen by the set
+// RoundSelector from the rounds which are not excluded.
+func TestWaitingRounds_SetRoundSelector(t *testing.T) {
+	wr := newSelectorTestRounds(5, t)
+	exclude := excludedRounds.NewSet()
+	exclude.Insert(id.Round(4))
+
+	var received []uint64
+	wr.SetRoundSelector(RoundSelectorFunc(func(candidates []*pb.RoundInfo) int {
+		received = received[:0]
+		for _, ri := range candidates {
+			received = append(received, ri.GetID())
+		}
+		return len(candidates) - 1
+	}))
+
+	ri, _, err := wr.GetUpcomingRealtime(time.Second, exclude, 0, 0)
+	if err != nil {
+		t.Fatalf("GetUpcomingRealtime error: %+v", err)
+	}
+	if ri.GetID() != 3 {
+		t.Errorf("Received round %d, expected 3.", ri.GetID())
+	}
+	if len(received) != 4 {
+		t.Errorf("Selector received rounds %v, expected 0 to 3.", received)
+	}
+	if !exclude.Has(id.Round(3)) {
+		t.Error("Selected round was not excluded.")
+	}
+
+	// Resetting the selector returns to choosing the soonest round
+	wr.SetRoundSelector(nil)
+	ri, _, err = wr.GetUpcomingRealtime(time.Second, exclude, 0, 0)
+	if err != nil {
+		t.Fatalf("GetUpcomingRealtime error: %+v", err)
+	}
+	if ri.GetID() != 0 {
+		t.Errorf("Received round %d, expected 0.", ri.GetID())
+	}
+}
+
+// Error path: Tests that GetUpcomingRealtime times out when the selector
+// chooses no round.
+func TestWaitingRounds_SetRoundSelector_NoneSelected(t *testing.T) {
+	wr := newSelectorTestRounds(3, t)
+	wr.SetRoundSelector(RoundSelectorFunc(func([]*pb.RoundInfo) int {
+		return -1
+	}))
+
+	_, _, err := wr.GetUpcomingRealtime(50*time.Millisecond,
+		excludedRounds.NewSet(), 0, 0)
+	if err != timeOutError {
+		t.Errorf("Unexpected error.\nexpected: %v\nreceived: %v",
+			timeOutError, err)
+	}
+}
+
+// Tests that WeightedRoundSelector chooses the soonest round of the greatest
+// weight and never a round with a negative weight.
+func TestWeightedRoundSelector(t *testing.T) {
+	weights := map[uint64]float64{0: 1, 1: 3, 2: 3, 3: -1}
+	selector := WeightedRoundSelector(func(ri *pb.RoundInfo) float64 {
+		return weights[ri.GetID()]
+	})
+
+	candidates := []*pb.RoundInfo{{ID: 0}, {ID: 1}, {ID: 2}, {ID: 3}}
+	if i := selector.Select(candidates); i != 1 {
+		t.Errorf("Selected candidate %d, expected 1.", i)
+	}
+	if i := selector.Select(candidates[3:]); i >= 0 {
+		t.Errorf("Selected candidate %d with a negative weight.", i)
+	}
+}
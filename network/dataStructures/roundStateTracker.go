@@ -0,0 +1,276 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Tracks the observed state transitions of rounds and detects anomalies
+
+package dataStructures
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/elixxir/primitives/states"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/netTime"
+)
+
+// AnomalyType describes how a round misbehaved.
+type AnomalyType uint8
+
+const (
+	// IllegalTransition is reported when a round moves backwards or out of a
+	// terminal state.
+	IllegalTransition AnomalyType = iota
+	// Stall is reported when a round stays in a non-terminal state longer
+	// than the threshold set for that state.
+	Stall
+)
+
+// Stringer for AnomalyType
+func (a AnomalyType) String() string {
+	switch a {
+	case IllegalTransition:
+		return "IllegalTransition"
+	case Stall:
+		return "Stall"
+	default:
+		return fmt.Sprintf("UNKNOWN ANOMALY: %d", a)
+	}
+}
+
+// StateTransition records a round being observed moving between states.
+type StateTransition struct {
+	From      states.Round
+	To        states.Round
+	Timestamp time.Time
+}
+
+// RoundAnomaly describes an illegal transition or stall of a round.
+type RoundAnomaly struct {
+	RoundID id.Round
+	Type    AnomalyType
+
+	// For IllegalTransition, the offending transition. For Stall, From and To
+	// are both the stalled state and Timestamp is when it was entered.
+	Transition StateTransition
+
+	// For Stall, how long the round has been in the stalled state
+	Duration time.Duration
+}
+
+// RoundAnomalyCallback is called when the tracker detects an anomaly.
+type RoundAnomalyCallback func(anomaly RoundAnomaly)
+
+// roundHistory holds the transitions observed for a single round.
+type roundHistory struct {
+	transitions []StateTransition
+	state       states.Round
+	since       time.Time
+
+	// Set once a stall in the current state has been reported so it is only
+	// reported once
+	stallReported bool
+}
+
+// RoundStateTracker tracks the state transitions observed for each round,
+// forwarding legal transitions to RoundEvents and reporting illegal
+// transitions and stalls to registered callbacks.
+type RoundStateTracker struct {
+	rounds          map[id.Round]*roundHistory
+	stallThresholds map[states.Round]time.Duration
+	retention       time.Duration
+	events          *RoundEvents
+	callbacks       []RoundAnomalyCallback
+	mux             sync.Mutex
+}
+
+// NewRoundStateTracker creates a tracker which triggers the passed
+// RoundEvents on every legal transition. events may be nil. stallThresholds
+// sets how long a round may stay in each state before it is reported as
+// stalled; states without a threshold are never reported. Rounds in COMPLETED
+// or FAILED are forgotten once they have been there for longer than retention.
+func NewRoundStateTracker(events *RoundEvents,
+	stallThresholds map[states.Round]time.Duration,
+	retention time.Duration) *RoundStateTracker {
+	thresholds := make(map[states.Round]time.Duration, len(stallThresholds))
+	for s, threshold := range stallThresholds {
+		thresholds[s] = threshold
+	}
+
+	return &RoundStateTracker{
+		rounds:          make(map[id.Round]*roundHistory),
+		stallThresholds: thresholds,
+		retention:       retention,
+		events:          events,
+	}
+}
+
+// AddAnomalyCallback registers a callback to be called on every anomaly.
+func (rst *RoundStateTracker) AddAnomalyCallback(cb RoundAnomalyCallback) {
+	rst.mux.Lock()
+	rst.callbacks = append(rst.callbacks, cb)
+	rst.mux.Unlock()
+}
+
+// Observe records the state of the passed round. Observing the state a round
+// is already in does nothing. Moving forwards is legal even if states were
+// skipped, since updates may be missed. Moving backwards or out of COMPLETED
+// or FAILED is illegal; it is reported to the anomaly callbacks, returned as
+// an error and not recorded.
+func (rst *RoundStateTracker) Observe(rnd *Round) error {
+	rid := id.Round(rnd.info.ID)
+	newState := states.Round(rnd.info.State)
+	now := netTime.Now()
+
+	rst.mux.Lock()
+	h, exists := rst.rounds[rid]
+	if !exists {
+		rst.rounds[rid] = &roundHistory{
+			transitions: []StateTransition{{newState, newState, now}},
+			state:       newState,
+			since:       now,
+		}
+		rst.mux.Unlock()
+		rst.trigger(rnd)
+		return nil
+	}
+
+	if h.state == newState {
+		rst.mux.Unlock()
+		return nil
+	}
+
+	transition := StateTransition{From: h.state, To: newState, Timestamp: now}
+	if !isLegalTransition(h.state, newState) {
+		anomaly := RoundAnomaly{
+			RoundID:    rid,
+			Type:       IllegalTransition,
+			Transition: transition,
+		}
+		callbacks := rst.callbacks
+		rst.mux.Unlock()
+
+		report(callbacks, anomaly)
+		return errors.Errorf("Illegal transition for round %d from %s to %s",
+			rid, transition.From, transition.To)
+	}
+
+	h.transitions = append(h.transitions, transition)
+	h.state = newState
+	h.since = now
+	h.stallReported = false
+	rst.mux.Unlock()
+
+	rst.trigger(rnd)
+	return nil
+}
+
+// GetState returns the last observed state of the round and whether the
+// round is being tracked.
+func (rst *RoundStateTracker) GetState(rid id.Round) (states.Round, bool) {
+	rst.mux.Lock()
+	defer rst.mux.Unlock()
+
+	h, exists := rst.rounds[rid]
+	if !exists {
+		return 0, false
+	}
+	return h.state, true
+}
+
+// GetTransitions returns a copy of the transitions observed for the round.
+// The first entry is the state the round was first observed in.
+func (rst *RoundStateTracker) GetTransitions(rid id.Round) []StateTransition {
+	rst.mux.Lock()
+	defer rst.mux.Unlock()
+
+	h, exists := rst.rounds[rid]
+	if !exists {
+		return nil
+	}
+
+	transitions := make([]StateTransition, len(h.transitions))
+	copy(transitions, h.transitions)
+	return transitions
+}
+
+// Len returns the number of rounds being tracked.
+func (rst *RoundStateTracker) Len() int {
+	rst.mux.Lock()
+	defer rst.mux.Unlock()
+	return len(rst.rounds)
+}
+
+// CheckStalls reports every round which has been in a non-terminal state for
+// longer than that state's threshold as of now. Each stall is only reported
+// once per state. Rounds which finished longer than the retention period ago
+// are forgotten. The detected stalls are also returned.
+func (rst *RoundStateTracker) CheckStalls(now time.Time) []RoundAnomaly {
+	var stalls []RoundAnomaly
+
+	rst.mux.Lock()
+	for rid, h := range rst.rounds {
+		elapsed := now.Sub(h.since)
+		if isTerminal(h.state) {
+			if elapsed > rst.retention {
+				delete(rst.rounds, rid)
+			}
+			continue
+		}
+
+		threshold, exists := rst.stallThresholds[h.state]
+		if !exists || h.stallReported || elapsed <= threshold {
+			continue
+		}
+
+		h.stallReported = true
+		stalls = append(stalls, RoundAnomaly{
+			RoundID:    rid,
+			Type:       Stall,
+			Transition: StateTransition{h.state, h.state, h.since},
+			Duration:   elapsed,
+		})
+	}
+	callbacks := rst.callbacks
+	rst.mux.Unlock()
+
+	for _, stall := range stalls {
+		report(callbacks, stall)
+	}
+
+	return stalls
+}
+
+// trigger forwards a round to the RoundEvents, if set.
+func (rst *RoundStateTracker) trigger(rnd *Round) {
+	if rst.events != nil {
+		rst.events.TriggerRoundEvent(rnd)
+	}
+}
+
+// report calls every callback with the anomaly.
+func report(callbacks []RoundAnomalyCallback, anomaly RoundAnomaly) {
+	for _, cb := range callbacks {
+		cb(anomaly)
+	}
+}
+
+// isTerminal returns true if a round can never leave the state.
+func isTerminal(s states.Round) bool {
+	return s == states.COMPLETED || s == states.FAILED
+}
+
+// isLegalTransition returns true if a round may be observed moving from one
+// state to the other.
+func isLegalTransition(from, to states.Round) bool {
+	if isTerminal(from) || to >= states.NUM_STATES {
+		return false
+	}
+	return to > from
+}
@@ -0,0 +1,147 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package dataStructures
+
+import (
+	"testing"
+	"time"
+
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/primitives/states"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/netTime"
+)
+
+// newTrackerTestRound creates an unverified round in the given state.
+func newTrackerTestRound(rid uint64, s states.Round) *Round {
+	ri := &pb.RoundInfo{ID: rid, State: uint32(s),
+		Timestamps: make([]uint64, states.NUM_STATES)}
+	rnd := NewRound(ri, nil, nil)
+	// Mark as verified so triggering round events does not check signatures
+	*rnd.needsValidation = 1
+	return rnd
+}
+
+// Tests that forward transitions, including skipped states, are recorded and
+// that repeated observations of the same state are ignored.
+func TestRoundStateTracker_Observe(t *testing.T) {
+	rst := NewRoundStateTracker(NewRoundEvents(), nil, time.Minute)
+
+	observed := []states.Round{states.PENDING, states.PRECOMPUTING,
+		states.PRECOMPUTING, states.QUEUED, states.COMPLETED}
+	for _, s := range observed {
+		if err := rst.Observe(newTrackerTestRound(5, s)); err != nil {
+			t.Errorf("Observe() returned an error for state %s: %+v", s, err)
+		}
+	}
+
+	transitions := rst.GetTransitions(5)
+	expected := []states.Round{states.PENDING, states.PRECOMPUTING,
+		states.QUEUED, states.COMPLETED}
+	if len(transitions) != len(expected) {
+		t.Fatalf("Unexpected number of transitions."+
+			"\nexpected: %d\nreceived: %d", len(expected), len(transitions))
+	}
+	for i, s := range expected {
+		if transitions[i].To != s {
+			t.Errorf("Transition %d did not end in the expected state."+
+				"\nexpected: %s\nreceived: %s", i, s, transitions[i].To)
+		}
+	}
+
+	s, exists := rst.GetState(5)
+	if !exists || s != states.COMPLETED {
+		t.Errorf("GetState() returned unexpected state %s (exists: %t)",
+			s, exists)
+	}
+}
+
+// Tests that backwards transitions and transitions out of terminal states are
+// reported and not recorded.
+func TestRoundStateTracker_Observe_Illegal(t *testing.T) {
+	rst := NewRoundStateTracker(nil, nil, time.Minute)
+
+	var anomalies []RoundAnomaly
+	rst.AddAnomalyCallback(func(a RoundAnomaly) {
+		anomalies = append(anomalies, a)
+	})
+
+	_ = rst.Observe(newTrackerTestRound(1, states.REALTIME))
+	if err := rst.Observe(newTrackerTestRound(1, states.PRECOMPUTING)); err == nil {
+		t.Errorf("Observe() did not error on a backwards transition.")
+	}
+
+	_ = rst.Observe(newTrackerTestRound(2, states.FAILED))
+	if err := rst.Observe(newTrackerTestRound(2, states.COMPLETED)); err == nil {
+		t.Errorf("Observe() did not error on a transition out of FAILED.")
+	}
+
+	if len(anomalies) != 2 {
+		t.Fatalf("Unexpected number of anomalies reported."+
+			"\nexpected: %d\nreceived: %d", 2, len(anomalies))
+	}
+	if anomalies[0].Type != IllegalTransition || anomalies[0].RoundID != 1 {
+		t.Errorf("Unexpected anomaly: %+v", anomalies[0])
+	}
+
+	if s, _ := rst.GetState(1); s != states.REALTIME {
+		t.Errorf("Illegal transition was recorded, state is %s", s)
+	}
+}
+
+// Tests that CheckStalls reports a stall once, only for states with a
+// threshold, and prunes old finished rounds.
+func TestRoundStateTracker_CheckStalls(t *testing.T) {
+	thresholds := map[states.Round]time.Duration{
+		states.PRECOMPUTING: time.Second,
+	}
+	rst := NewRoundStateTracker(nil, thresholds, time.Second)
+
+	_ = rst.Observe(newTrackerTestRound(1, states.PRECOMPUTING))
+	_ = rst.Observe(newTrackerTestRound(2, states.REALTIME))
+	_ = rst.Observe(newTrackerTestRound(3, states.COMPLETED))
+
+	later := netTime.Now().Add(2 * time.Second)
+	stalls := rst.CheckStalls(later)
+	if len(stalls) != 1 || stalls[0].RoundID != id.Round(1) ||
+		stalls[0].Type != Stall {
+		t.Fatalf("CheckStalls() returned unexpected stalls: %+v", stalls)
+	}
+
+	if stalls = rst.CheckStalls(later); len(stalls) != 0 {
+		t.Errorf("CheckStalls() reported the same stall twice: %+v", stalls)
+	}
+
+	if _, exists := rst.GetState(3); exists {
+		t.Errorf("CheckStalls() did not prune the completed round.")
+	}
+	if rst.Len() != 2 {
+		t.Errorf("Unexpected number of tracked rounds."+
+			"\nexpected: %d\nreceived: %d", 2, rst.Len())
+	}
+}
+
+// Tests that legal transitions trigger the registered round events.
+func TestRoundStateTracker_Observe_TriggersEvents(t *testing.T) {
+	events := NewRoundEvents()
+	rst := NewRoundStateTracker(events, nil, time.Minute)
+
+	eventChan := make(chan EventReturn, 1)
+	events.AddRoundEventChan(7, eventChan, 5*time.Second, states.QUEUED)
+
+	_ = rst.Observe(newTrackerTestRound(7, states.QUEUED))
+
+	select {
+	case e := <-eventChan:
+		if e.TimedOut || e.RoundInfo.ID != 7 {
+			t.Errorf("Unexpected event: %+v", e)
+		}
+	case <-time.After(5 * time.Second):
+		t.Errorf("Round event was not triggered.")
+	}
+}
@@ -0,0 +1,93 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Verification of the signatures of many rounds at once
+
+package dataStructures
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// BatchVerifier verifies the signatures of several rounds at once. The
+// returned slice has one entry per passed round, in the same order, holding
+// nil if the round's signature is valid or the verification error otherwise.
+// Implementations may use batched signature verification algorithms in the
+// future; the concurrent verifier checks each round individually.
+type BatchVerifier interface {
+	VerifyRounds(rounds []*Round) []error
+}
+
+// concurrentVerifier verifies rounds individually across a pool of workers.
+type concurrentVerifier struct {
+	numWorkers int
+}
+
+// NewConcurrentVerifier creates a BatchVerifier which checks rounds on
+// numWorkers goroutines. If numWorkers is not positive, one worker is used
+// per CPU.
+func NewConcurrentVerifier(numWorkers int) BatchVerifier {
+	if numWorkers <= 0 {
+		numWorkers = runtime.NumCPU()
+	}
+	return &concurrentVerifier{numWorkers: numWorkers}
+}
+
+// VerifyRounds verifies the passed rounds concurrently. Rounds which have
+// already been validated are skipped, and rounds which pass are marked as
+// validated so later calls to Round.Get do not check them again.
+func (cv *concurrentVerifier) VerifyRounds(rounds []*Round) []error {
+	results := make([]error, len(rounds))
+
+	numWorkers := cv.numWorkers
+	if numWorkers > len(rounds) {
+		numWorkers = len(rounds)
+	}
+
+	indexes := make(chan int, len(rounds))
+	for i := range rounds {
+		indexes <- i
+	}
+	close(indexes)
+
+	wg := sync.WaitGroup{}
+	wg.Add(numWorkers)
+	for w := 0; w < numWorkers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = verifyAndMark(rounds[i])
+			}
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
+
+// VerifyRounds verifies the passed rounds concurrently using one worker per
+// CPU. See BatchVerifier for the format of the results.
+func VerifyRounds(rounds []*Round) []error {
+	return NewConcurrentVerifier(0).VerifyRounds(rounds)
+}
+
+// verifyAndMark verifies a single round if it has not been validated yet and
+// marks it as validated on success.
+func verifyAndMark(r *Round) error {
+	if atomic.LoadUint32(r.needsValidation) == roundVerified {
+		return nil
+	}
+
+	if err := r.Verify(); err != nil {
+		return err
+	}
+
+	atomic.StoreUint32(r.needsValidation, roundVerified)
+	return nil
+}
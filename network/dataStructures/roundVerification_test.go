@@ -0,0 +1,88 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package dataStructures
+
+import (
+	"runtime"
+	"testing"
+
+	"gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/testutils"
+	"gitlab.com/elixxir/primitives/states"
+)
+
+// Tests that NewConcurrentVerifier defaults to one worker per CPU.
+func TestNewConcurrentVerifier(t *testing.T) {
+	cv := NewConcurrentVerifier(0).(*concurrentVerifier)
+	if cv.numWorkers != runtime.NumCPU() {
+		t.Errorf("Unexpected number of workers.\nexpected: %d\nreceived: %d",
+			runtime.NumCPU(), cv.numWorkers)
+	}
+
+	cv = NewConcurrentVerifier(3).(*concurrentVerifier)
+	if cv.numWorkers != 3 {
+		t.Errorf("Unexpected number of workers.\nexpected: %d\nreceived: %d",
+			3, cv.numWorkers)
+	}
+}
+
+// Tests that VerifyRounds returns a result for each round in order, reporting
+// only the rounds with bad signatures, and marks valid rounds as validated.
+func TestVerifyRounds(t *testing.T) {
+	pubKey, _ := testutils.LoadPublicKeyTesting(t)
+	ecKey, _ := testutils.LoadEllipticPublicKey(t)
+
+	const numRounds = 20
+	rounds := make([]*Round, numRounds)
+	for i := range rounds {
+		ri := &mixmessages.RoundInfo{ID: uint64(i), UpdateID: uint64(i),
+			Timestamps: make([]uint64, states.NUM_STATES)}
+		if err := testutils.SignRoundInfoDual(ri, ecKey, t); err != nil {
+			t.Fatalf("Failed to sign round info: %+v", err)
+		}
+
+		// Corrupt every third round
+		if i%3 == 0 {
+			ri.EccSignature.Signature[0] ^= 0xFF
+		}
+
+		rounds[i] = NewRound(ri, pubKey, ecKey.GetPublic())
+	}
+
+	results := NewConcurrentVerifier(4).VerifyRounds(rounds)
+	if len(results) != numRounds {
+		t.Fatalf("Unexpected number of results.\nexpected: %d\nreceived: %d",
+			numRounds, len(results))
+	}
+
+	for i, err := range results {
+		if i%3 == 0 {
+			if err == nil {
+				t.Errorf("Round %d with a bad signature was not reported.", i)
+			}
+			if *rounds[i].needsValidation != 0 {
+				t.Errorf("Round %d with a bad signature was marked valid.", i)
+			}
+		} else {
+			if err != nil {
+				t.Errorf("Round %d failed verification: %+v", i, err)
+			}
+			if *rounds[i].needsValidation != 1 {
+				t.Errorf("Round %d was not marked as validated.", i)
+			}
+		}
+	}
+}
+
+// Tests that VerifyRounds handles an empty list.
+func TestVerifyRounds_Empty(t *testing.T) {
+	results := VerifyRounds(nil)
+	if len(results) != 0 {
+		t.Errorf("Expected no results, received %d", len(results))
+	}
+}
@@ -95,3 +95,121 @@ func TestNewRound_Get(t *testing.T) {
 	}
 
 }
+
+// Tests that a dual-signed round is verified using the elliptic key when one
+// is set, even if the RSA signature is invalid.
+func TestRound_Verify_DualPrefersEcc(t *testing.T) {
+	pubKey, _ := testutils.LoadPublicKeyTesting(t)
+	ecKey, _ := testutils.LoadEllipticPublicKey(t)
+	ri := &mixmessages.RoundInfo{ID: uint64(1), UpdateID: uint64(1), Timestamps: make([]uint64, states.NUM_STATES)}
+
+	err := testutils.SignRoundInfoDual(ri, ecKey, t)
+	if err != nil {
+		t.Fatalf("Failed to sign round info: %+v", err)
+	}
+
+	// Corrupt the RSA signature so that only the ECC path can succeed
+	ri.Signature.Signature[0] ^= 0xFF
+
+	rnd := NewRound(ri, pubKey, ecKey.GetPublic())
+	if err = rnd.Verify(); err != nil {
+		t.Errorf("Verify() failed for a dual-signed round: %+v", err)
+	}
+}
+
+// Tests that a round with only an RSA signature falls back to RSA
+// verification when both keys are set.
+func TestRound_Verify_DualFallbackRsa(t *testing.T) {
+	pubKey, _ := testutils.LoadPublicKeyTesting(t)
+	ecKey, _ := testutils.LoadEllipticPublicKey(t)
+	ri := &mixmessages.RoundInfo{ID: uint64(1), UpdateID: uint64(1), Timestamps: make([]uint64, states.NUM_STATES)}
+
+	err := testutils.SignRoundInfoRsa(ri, t)
+	if err != nil {
+		t.Fatalf("Failed to sign round info: %+v", err)
+	}
+
+	rnd := NewRound(ri, pubKey, ecKey.GetPublic())
+	if err = rnd.Verify(); err != nil {
+		t.Errorf("Verify() failed to fall back to RSA: %+v", err)
+	}
+
+	ri.Signature.Signature[0] ^= 0xFF
+	if err = rnd.Verify(); err == nil {
+		t.Errorf("Verify() did not error on an invalid RSA signature")
+	}
+}
+
+// Error path: Tests that Verify errors when the round has no keys.
+func TestRound_Verify_NoKeys(t *testing.T) {
+	ri := &mixmessages.RoundInfo{ID: uint64(1), UpdateID: uint64(1), Timestamps: make([]uint64, states.NUM_STATES)}
+
+	rnd := NewRound(ri, nil, nil)
+	if err := rnd.Verify(); err == nil {
+		t.Errorf("Verify() did not error with no keys set")
+	}
+}
+
+// Tests that Verify checks the signatures required by each SignaturePolicy.
+func TestRound_Verify_Policy(t *testing.T) {
+	pubKey, _ := testutils.LoadPublicKeyTesting(t)
+	ecKey, _ := testutils.LoadEllipticPublicKey(t)
+	newInfo := func(rsaSigned, eccSigned bool) *mixmessages.RoundInfo {
+		ri := &mixmessages.RoundInfo{ID: uint64(1), UpdateID: uint64(1), Timestamps: make([]uint64, states.NUM_STATES)}
+		if rsaSigned {
+			if err := testutils.SignRoundInfoRsa(ri, t); err != nil {
+				t.Fatalf("Failed to sign round info: %+v", err)
+			}
+		}
+		if eccSigned {
+			if err := testutils.SignRoundInfoEddsa(ri, ecKey, t); err != nil {
+				t.Fatalf("Failed to sign round info: %+v", err)
+			}
+		}
+		return ri
+	}
+
+	tests := []struct {
+		policy               SignaturePolicy
+		rsaSigned, eccSigned bool
+		valid                bool
+	}{
+		{RsaSignatureOnly, true, false, true},
+		{RsaSignatureOnly, false, true, false},
+		{EccSignatureOnly, false, true, true},
+		{EccSignatureOnly, true, false, false},
+		{BothSignatures, true, true, true},
+		{BothSignatures, true, false, false},
+		{BothSignatures, false, true, false},
+		{EitherSignature, true, false, true},
+		{EitherSignature, false, true, true},
+	}
+
+	for i, tt := range tests {
+		ri := newInfo(tt.rsaSigned, tt.eccSigned)
+		rnd := NewRoundWithPolicy(ri, pubKey, ecKey.GetPublic(), tt.policy)
+		err := rnd.Verify()
+		if tt.valid && err != nil {
+			t.Errorf("Verify() failed with policy %s (%d): %+v",
+				tt.policy, i, err)
+		} else if !tt.valid && err == nil {
+			t.Errorf("Verify() did not error with policy %s (%d).",
+				tt.policy, i)
+		}
+	}
+}
+
+// Error path: Tests that Verify errors when the key required by the policy
+// is not set.
+func TestRound_Verify_PolicyMissingKey(t *testing.T) {
+	ecKey, _ := testutils.LoadEllipticPublicKey(t)
+	ri := &mixmessages.RoundInfo{ID: uint64(1), UpdateID: uint64(1), Timestamps: make([]uint64, states.NUM_STATES)}
+	if err := testutils.SignRoundInfoDual(ri, ecKey, t); err != nil {
+		t.Fatalf("Failed to sign round info: %+v", err)
+	}
+
+	rnd := NewRoundWithPolicy(ri, nil, ecKey.GetPublic(), BothSignatures)
+	if err := rnd.Verify(); err == nil {
+		t.Errorf("Verify() did not error without the RSA key")
+	}
+}
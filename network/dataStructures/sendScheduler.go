@@ -0,0 +1,153 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Assigns batches of outgoing messages to distinct upcoming rounds
+
+package dataStructures
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/primitives/excludedRounds"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// SendScheduler reserves upcoming rounds from a WaitingRounds for outgoing
+// messages. Messages are spread across as many rounds as possible, soonest
+// first, so concurrent sends do not all pile onto the same round. Each round
+// is assumed to accept at most perRoundCapacity messages from this client.
+type SendScheduler struct {
+	waitingRounds    *WaitingRounds
+	perRoundCapacity uint
+
+	// Number of messages reserved on each round
+	reserved map[id.Round]uint
+	mux      sync.Mutex
+}
+
+// NewSendScheduler creates a scheduler on top of the passed WaitingRounds.
+// A perRoundCapacity of zero is treated as one.
+func NewSendScheduler(wr *WaitingRounds, perRoundCapacity uint) *SendScheduler {
+	if perRoundCapacity == 0 {
+		perRoundCapacity = 1
+	}
+
+	return &SendScheduler{
+		waitingRounds:    wr,
+		perRoundCapacity: perRoundCapacity,
+		reserved:         make(map[id.Round]uint),
+	}
+}
+
+// Schedule reserves rounds for numMessages messages and returns the round
+// assigned to each message, in order. Rounds on the exclusion list, outside
+// the WaitingRounds lead time window or starting within minRoundAge are not
+// used; exclude may be nil and is not modified. Either every message is
+// assigned or, if there is not enough capacity, none are and an error is
+// returned.
+func (ss *SendScheduler) Schedule(numMessages int,
+	exclude excludedRounds.ExcludedRounds,
+	minRoundAge time.Duration) ([]*pb.RoundInfo, error) {
+	if numMessages <= 0 {
+		return nil, nil
+	}
+
+	rounds := ss.waitingRounds.readRounds.Load().([]*Round)
+	earliestStart, latestStart := ss.waitingRounds.leadTimeBounds(
+		ss.waitingRounds.now(), minRoundAge)
+
+	ss.mux.Lock()
+	defer ss.mux.Unlock()
+
+	ss.prune(rounds)
+
+	// Find every usable round and how much capacity it has left
+	var candidates []*Round
+	var free []uint
+	var totalFree int
+	for _, r := range rounds {
+		rid := id.Round(r.info.ID)
+		if !withinLeadTime(r.StartTime(), earliestStart, latestStart) ||
+			(exclude != nil && exclude.Has(rid)) ||
+			ss.reserved[rid] >= ss.perRoundCapacity {
+			continue
+		}
+
+		remaining := ss.perRoundCapacity - ss.reserved[rid]
+		candidates = append(candidates, r)
+		free = append(free, remaining)
+		totalFree += int(remaining)
+	}
+
+	if totalFree < numMessages {
+		return nil, errors.Errorf("Not enough capacity to schedule %d "+
+			"messages: %d slots free across %d rounds", numMessages,
+			totalFree, len(candidates))
+	}
+
+	// Assign messages one round at a time, soonest first, so that each round
+	// receives a message before any receives a second
+	assignments := make([]*pb.RoundInfo, 0, numMessages)
+	for len(assignments) < numMessages {
+		for i, r := range candidates {
+			if len(assignments) == numMessages {
+				break
+			}
+			if free[i] == 0 {
+				continue
+			}
+
+			free[i]--
+			ss.reserved[id.Round(r.info.ID)]++
+			assignments = append(assignments, r.Get())
+		}
+	}
+
+	return assignments, nil
+}
+
+// Release returns the reservation for a single message on the round, for use
+// when a send is abandoned before the round starts.
+func (ss *SendScheduler) Release(rid id.Round) {
+	ss.mux.Lock()
+	defer ss.mux.Unlock()
+
+	if ss.reserved[rid] <= 1 {
+		delete(ss.reserved, rid)
+	} else {
+		ss.reserved[rid]--
+	}
+}
+
+// GetReserved returns the number of messages reserved on the round.
+func (ss *SendScheduler) GetReserved(rid id.Round) uint {
+	ss.mux.Lock()
+	defer ss.mux.Unlock()
+	return ss.reserved[rid]
+}
+
+// prune drops reservations for rounds no longer waiting. This is assumed to
+// be called under the lock.
+func (ss *SendScheduler) prune(rounds []*Round) {
+	if len(ss.reserved) == 0 {
+		return
+	}
+
+	waiting := make(map[id.Round]struct{}, len(rounds))
+	for _, r := range rounds {
+		waiting[id.Round(r.info.ID)] = struct{}{}
+	}
+
+	for rid := range ss.reserved {
+		if _, exists := waiting[rid]; !exists {
+			delete(ss.reserved, rid)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package dataStructures
+
+import (
+	"testing"
+	"time"
+
+	"gitlab.com/elixxir/comms/testutils"
+	"gitlab.com/elixxir/primitives/excludedRounds"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/netTime"
+)
+
+// newSchedulerTestRounds returns a WaitingRounds holding num signed rounds.
+func newSchedulerTestRounds(num int, t *testing.T) (*WaitingRounds, []*Round) {
+	rounds, _ := createTestRoundInfos(2*num, netTime.Now().Add(5*time.Second), t)
+	for i, r := range rounds {
+		if err := testutils.SignRoundInfoRsa(r.info, t); err != nil {
+			t.Fatalf("Failed to sign round info #%d: %+v", i, err)
+		}
+	}
+
+	wr := NewWaitingRounds()
+	wr.Insert(rounds, nil)
+	return wr, rounds
+}
+
+// Tests that messages are spread across distinct rounds before any round is
+// assigned a second message.
+func TestSendScheduler_Schedule(t *testing.T) {
+	wr, rounds := newSchedulerTestRounds(3, t)
+	ss := NewSendScheduler(wr, 2)
+
+	assignments, err := ss.Schedule(4, nil, 0)
+	if err != nil {
+		t.Fatalf("Schedule() returned an error: %+v", err)
+	}
+
+	expected := []*Round{rounds[0], rounds[1], rounds[2], rounds[0]}
+	for i, ri := range assignments {
+		if ri != expected[i].info {
+			t.Errorf("Message %d assigned to unexpected round."+
+				"\nexpected: %d\nreceived: %d", i, expected[i].info.ID, ri.ID)
+		}
+	}
+
+	if n := ss.GetReserved(id.Round(rounds[0].info.ID)); n != 2 {
+		t.Errorf("Unexpected reservations on first round: %d", n)
+	}
+}
+
+// Tests that scheduling is all or nothing when capacity runs out.
+func TestSendScheduler_Schedule_NotEnoughCapacity(t *testing.T) {
+	wr, rounds := newSchedulerTestRounds(2, t)
+	ss := NewSendScheduler(wr, 1)
+
+	if _, err := ss.Schedule(3, nil, 0); err == nil {
+		t.Fatalf("Schedule() did not error without enough capacity.")
+	}
+
+	for _, r := range rounds {
+		if n := ss.GetReserved(id.Round(r.info.ID)); n != 0 {
+			t.Errorf("Round %d was reserved by a failed Schedule().",
+				r.info.ID)
+		}
+	}
+
+	// Once full, further messages cannot be scheduled until released
+	if _, err := ss.Schedule(2, nil, 0); err != nil {
+		t.Fatalf("Schedule() returned an error: %+v", err)
+	}
+	if _, err := ss.Schedule(1, nil, 0); err == nil {
+		t.Errorf("Schedule() did not error with all rounds reserved.")
+	}
+
+	ss.Release(id.Round(rounds[1].info.ID))
+	assignments, err := ss.Schedule(1, nil, 0)
+	if err != nil {
+		t.Fatalf("Schedule() returned an error after release: %+v", err)
+	}
+	if assignments[0] != rounds[1].info {
+		t.Errorf("Schedule() did not use the released round.")
+	}
+}
+
+// Tests that excluded rounds are not used.
+func TestSendScheduler_Schedule_Exclude(t *testing.T) {
+	wr, rounds := newSchedulerTestRounds(2, t)
+	ss := NewSendScheduler(wr, 1)
+
+	exclude := excludedRounds.NewSet()
+	exclude.Insert(id.Round(rounds[0].info.ID))
+
+	assignments, err := ss.Schedule(1, exclude, 0)
+	if err != nil {
+		t.Fatalf("Schedule() returned an error: %+v", err)
+	}
+	if assignments[0] != rounds[1].info {
+		t.Errorf("Schedule() assigned an excluded round.")
+	}
+}
@@ -0,0 +1,156 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Timer wheel expiring the timeouts of round events from a single goroutine
+
+package dataStructures
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// Granularity with which round event timeouts expire
+	timerWheelTick = 10 * time.Millisecond
+
+	// Number of slots in the wheel, so timeouts of up to timerWheelSlots
+	// ticks expire in the first rotation
+	timerWheelSlots = 1024
+)
+
+// timerWheel is a hashed timer wheel. Each timeout is placed in the slot
+// reached after its number of ticks, along with the number of further
+// rotations it must wait, so adding and removing a timeout costs the same
+// however many are pending. A single goroutine advances the wheel, and only
+// while timeouts are pending.
+type timerWheel struct {
+	// Maps each timeout in a slot to the rotations left before it expires
+	slots  [timerWheelSlots]map[*EventCallback]uint
+	pos    int
+	count  int
+	paused bool
+	expire func(e *EventCallback)
+	wake   chan struct{}
+	stop   chan struct{}
+	once   sync.Once
+	mux    sync.Mutex
+}
+
+// newTimerWheel creates a timerWheel calling expire with each timeout which
+// expires and starts the goroutine advancing it.
+func newTimerWheel(expire func(e *EventCallback)) *timerWheel {
+	w := &timerWheel{
+		expire: expire,
+		wake:   make(chan struct{}, 1),
+		stop:   make(chan struct{}),
+	}
+	for i := range w.slots {
+		w.slots[i] = make(map[*EventCallback]uint)
+	}
+	go w.run()
+	return w
+}
+
+// add schedules e to expire after the timeout, rounded up to the next tick.
+func (w *timerWheel) add(e *EventCallback, timeout time.Duration) {
+	ticks := int((timeout + timerWheelTick - 1) / timerWheelTick)
+	if ticks < 1 {
+		ticks = 1
+	}
+
+	w.mux.Lock()
+	e.slot = (w.pos + ticks) % timerWheelSlots
+	w.slots[e.slot][e] = uint((ticks - 1) / timerWheelSlots)
+	w.count++
+	w.mux.Unlock()
+
+	select {
+	case w.wake <- struct{}{}:
+	default:
+	}
+}
+
+// remove cancels the timeout of e, if it is pending.
+func (w *timerWheel) remove(e *EventCallback) {
+	w.mux.Lock()
+	if _, exists := w.slots[e.slot][e]; exists {
+		delete(w.slots[e.slot], e)
+		w.count--
+	}
+	w.mux.Unlock()
+}
+
+// setPaused stops or restarts the wheel. While it is paused no timeouts
+// expire, so those pending are extended by the time it was paused for.
+func (w *timerWheel) setPaused(paused bool) {
+	w.mux.Lock()
+	w.paused = paused
+	w.mux.Unlock()
+}
+
+// close stops the goroutine advancing the wheel. Timeouts pending or added
+// afterwards never expire.
+func (w *timerWheel) close() {
+	w.once.Do(func() { close(w.stop) })
+}
+
+// run advances the wheel each tick while timeouts are pending, sleeping until
+// the next is added otherwise, until the wheel is closed.
+func (w *timerWheel) run() {
+	for {
+		select {
+		case <-w.stop:
+			return
+		case <-w.wake:
+		}
+
+		ticker := time.NewTicker(timerWheelTick)
+		for pending := true; pending; {
+			select {
+			case <-w.stop:
+				ticker.Stop()
+				return
+			case <-ticker.C:
+				pending = w.advance()
+			}
+		}
+		ticker.Stop()
+	}
+}
+
+// advance moves the wheel on one tick, expiring the timeouts in the slot
+// reached which have no rotations left. The expired timeouts are passed to
+// expire outside the lock. Returns true if timeouts are still pending. A
+// paused wheel is not moved.
+func (w *timerWheel) advance() bool {
+	w.mux.Lock()
+	if w.paused {
+		pending := w.count > 0
+		w.mux.Unlock()
+		return pending
+	}
+	w.pos = (w.pos + 1) % timerWheelSlots
+	slot := w.slots[w.pos]
+	var expired []*EventCallback
+	for e, rotations := range slot {
+		if rotations > 0 {
+			slot[e] = rotations - 1
+			continue
+		}
+		delete(slot, e)
+		expired = append(expired, e)
+	}
+	w.count -= len(expired)
+	pending := w.count > 0
+	w.mux.Unlock()
+
+	for _, e := range expired {
+		w.expire(e)
+	}
+	return pending
+}
@@ -0,0 +1,67 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package dataStructures
+
+import (
+	"testing"
+)
+
+// newTestTimerWheel returns a timerWheel which is only advanced by the test.
+func newTestTimerWheel(expire func(e *EventCallback)) *timerWheel {
+	w := &timerWheel{expire: expire, wake: make(chan struct{}, 1)}
+	for i := range w.slots {
+		w.slots[i] = make(map[*EventCallback]uint)
+	}
+	return w
+}
+
+// Tests that timeouts expire on the tick they are due, including those longer
+// than a rotation of the wheel, and that removed timeouts do not expire.
+func Test_timerWheel_advance(t *testing.T) {
+	expired := make(map[*EventCallback]int)
+	ticks := 0
+	w := newTestTimerWheel(func(e *EventCallback) { expired[e] = ticks })
+
+	short, long, removed := &EventCallback{}, &EventCallback{}, &EventCallback{}
+	w.add(short, 3*timerWheelTick)
+	w.add(long, (timerWheelSlots+5)*timerWheelTick)
+	w.add(removed, 3*timerWheelTick)
+	w.remove(removed)
+
+	for pending := true; pending; {
+		ticks++
+		pending = w.advance()
+	}
+
+	if expired[short] != 3 {
+		t.Errorf("Short timeout expired after %d ticks, expected 3.",
+			expired[short])
+	}
+	if expired[long] != timerWheelSlots+5 {
+		t.Errorf("Long timeout expired after %d ticks, expected %d.",
+			expired[long], timerWheelSlots+5)
+	}
+	if _, exists := expired[removed]; exists {
+		t.Error("Removed timeout expired.")
+	}
+}
+
+// Tests that a timeout of zero expires on the next tick.
+func Test_timerWheel_add_Zero(t *testing.T) {
+	var expired []*EventCallback
+	w := newTestTimerWheel(func(e *EventCallback) {
+		expired = append(expired, e)
+	})
+
+	e := &EventCallback{}
+	w.add(e, 0)
+	if w.advance() || len(expired) != 1 || expired[0] != e {
+		t.Errorf("Zero timeout did not expire on the first tick: %v",
+			expired)
+	}
+}
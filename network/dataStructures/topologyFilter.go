@@ -0,0 +1,43 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains filtering of waiting rounds by the nodes in their team
+
+package dataStructures
+
+import (
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// TopologyFilter decides from the marshalled node IDs of a round's topology
+// whether GetUpcomingRealtimeFiltered may return the round. It returns true if
+// the round is acceptable. The topology must not be modified or kept.
+type TopologyFilter func(topology [][]byte) bool
+
+// ExcludeNodes returns a TopologyFilter rejecting every round whose team
+// contains any of the nodes.
+func ExcludeNodes(nodes ...*id.ID) TopologyFilter {
+	excluded := make(map[string]struct{}, len(nodes))
+	for _, nid := range nodes {
+		excluded[string(nid.Marshal())] = struct{}{}
+	}
+
+	return func(topology [][]byte) bool {
+		for _, nid := range topology {
+			if _, exists := excluded[string(nid)]; exists {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// accepts returns true if the filter accepts the round's topology. A nil
+// filter accepts every round.
+func (f TopologyFilter) accepts(r *Round) bool {
+	return f == nil || f(r.info.GetTopology())
+}
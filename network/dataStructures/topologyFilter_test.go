@@ -0,0 +1,43 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package dataStructures
+
+import (
+	"testing"
+
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// Tests that ExcludeNodes rejects exactly the topologies containing one of
+// the excluded nodes.
+func TestExcludeNodes(t *testing.T) {
+	a := id.NewIdFromString("a", id.Node, t)
+	b := id.NewIdFromString("b", id.Node, t)
+	c := id.NewIdFromString("c", id.Node, t)
+	filter := ExcludeNodes(a, b)
+
+	tests := []struct {
+		topology [][]byte
+		expected bool
+	}{
+		{nil, true},
+		{[][]byte{c.Marshal()}, true},
+		{[][]byte{c.Marshal(), a.Marshal()}, false},
+		{[][]byte{b.Marshal()}, false},
+	}
+	for i, tt := range tests {
+		if accepted := filter(tt.topology); accepted != tt.expected {
+			t.Errorf("Unexpected result for topology %d.\nexpected: %t"+
+				"\nreceived: %t", i, tt.expected, accepted)
+		}
+	}
+
+	if !TopologyFilter(nil).accepts(&Round{info: nil}) {
+		t.Error("A nil filter did not accept the round.")
+	}
+}
@@ -34,10 +34,32 @@ const maxGetClosestTries = 2
 // WaitingRounds contains a list of all queued rounds ordered by which occurs
 // furthest in the future with the furthest in the back.
 type WaitingRounds struct {
+	// Bounds on how long before its realtime start a round must be to be
+	// returned by GetUpcomingRealtime. Accessed atomically; kept first in the
+	// struct to guarantee 64-bit alignment.
+	minLeadTime int64
+	maxLeadTime int64
+
+	// Estimated offset of the network clock from the local clock, added to
+	// netTime.Now() when comparing against round timestamps. Accessed
+	// atomically.
+	clockOffset int64
+
+	// Maximum number of rounds kept, or zero for no limit, and the number of
+	// rounds evicted to stay within it. Accessed atomically.
+	maxSize   int64
+	evictions uint64
+
+	// Statistics returned by GetStats
+	stats waitStats
+
 	readRounds  *atomic.Value
 	writeRounds *orderedmap.OrderedMap
 	mux         sync.Mutex
 	signal      chan struct{}
+
+	// Holds the roundSelector set by SetRoundSelector
+	selector atomic.Value
 }
 
 // NewWaitingRounds generates a new WaitingRounds with an empty round list.
@@ -94,14 +116,110 @@ func (wr *WaitingRounds) HasValidRounds(now time.Time) bool {
 	return false
 }
 
+// SetLeadTimeWindow sets the minimum and maximum amount of time before its
+// realtime start that a round must be for GetUpcomingRealtime to return it.
+// Rounds starting sooner than the minimum may not leave enough time for a
+// message to reach the gateway. A maximum of zero means there is no upper
+// bound, which is the default.
+func (wr *WaitingRounds) SetLeadTimeWindow(min, max time.Duration) error {
+	if min < 0 || max < 0 {
+		return errors.Errorf("Lead time window cannot be negative: "+
+			"[%s, %s]", min, max)
+	}
+	if max != 0 && max < min {
+		return errors.Errorf("Maximum lead time %s is less than the "+
+			"minimum lead time %s", max, min)
+	}
+
+	atomic.StoreInt64(&wr.minLeadTime, int64(min))
+	atomic.StoreInt64(&wr.maxLeadTime, int64(max))
+	return nil
+}
+
+// GetLeadTimeWindow returns the minimum and maximum lead times set by
+// SetLeadTimeWindow.
+func (wr *WaitingRounds) GetLeadTimeWindow() (min, max time.Duration) {
+	return time.Duration(atomic.LoadInt64(&wr.minLeadTime)),
+		time.Duration(atomic.LoadInt64(&wr.maxLeadTime))
+}
+
+// leadTimeBounds returns the start times a round must fall after and not after
+// respectively to be returned at time now. The lower bound is the larger of
+// the minimum lead time and minRoundAge. A zero upper bound means there is no
+// limit.
+func (wr *WaitingRounds) leadTimeBounds(now time.Time,
+	minRoundAge time.Duration) (earliestStart, latestStart time.Time) {
+	minLead, maxLead := wr.GetLeadTimeWindow()
+	if minRoundAge > minLead {
+		minLead = minRoundAge
+	}
+
+	earliestStart = now.Add(minLead)
+	if maxLead != 0 {
+		latestStart = now.Add(maxLead)
+	}
+	return earliestStart, latestStart
+}
+
+// withinLeadTime returns true if the start time falls inside the bounds
+// returned by leadTimeBounds.
+func withinLeadTime(start, earliestStart, latestStart time.Time) bool {
+	return start.After(earliestStart) &&
+		(latestStart.IsZero() || !start.After(latestStart))
+}
+
+// SetClockOffset sets the estimated offset of the network clock from the local
+// clock (network time minus local time). It is applied whenever round start
+// times are compared against the current time so that a client with a skewed
+// clock does not treat every round as expired.
+func (wr *WaitingRounds) SetClockOffset(offset time.Duration) {
+	atomic.StoreInt64(&wr.clockOffset, int64(offset))
+}
+
+// GetClockOffset returns the clock offset set by SetClockOffset.
+func (wr *WaitingRounds) GetClockOffset() time.Duration {
+	return time.Duration(atomic.LoadInt64(&wr.clockOffset))
+}
+
+// SetMaxSize bounds the number of rounds kept in the list, so that it does
+// not grow without bound when rounds are inserted faster than they are used.
+// When an insert exceeds the bound, rounds which have already started are
+// removed, followed by the rounds inserted longest ago. A size of zero, the
+// default, means there is no limit.
+func (wr *WaitingRounds) SetMaxSize(size int) error {
+	if size < 0 {
+		return errors.Errorf("Maximum size cannot be negative: %d", size)
+	}
+	atomic.StoreInt64(&wr.maxSize, int64(size))
+	return nil
+}
+
+// GetMaxSize returns the maximum size set by SetMaxSize.
+func (wr *WaitingRounds) GetMaxSize() int {
+	return int(atomic.LoadInt64(&wr.maxSize))
+}
+
+// GetEvictions returns the number of rounds which had yet to start that were
+// removed to keep the list within the size set by SetMaxSize.
+func (wr *WaitingRounds) GetEvictions() uint64 {
+	return atomic.LoadUint64(&wr.evictions)
+}
+
+// now returns the current time corrected by the clock offset.
+func (wr *WaitingRounds) now() time.Time {
+	return netTime.Now().Add(wr.GetClockOffset())
+}
+
 // Insert inserts a queued round into the list in order of its timestamp, from
 // smallest to greatest. If the new round is not in a QUEUED state, then it is
 // not inserted. If the new round already exists in the list but is no longer
-// queued, then it is removed.
+// queued, then it is removed. The list is rebuilt and waiting callers are
+// signalled at most once per call, so updates from a poll response should be
+// inserted together, e.g. with a RoundBatch.
 func (wr *WaitingRounds) Insert(added, removed []*Round) {
 	wr.mux.Lock()
 	defer wr.mux.Unlock()
-	now := netTime.Now()
+	now := wr.now()
 	// Add any round which should be added
 	var addedRounds uint
 	for i := range added {
@@ -112,14 +230,20 @@ func (wr *WaitingRounds) Insert(added, removed []*Round) {
 		}
 	}
 
-	// Remove any round which should be removed
+	// Remove any round which should be removed. Most rounds removed by a poll
+	// response were never in the list, so only those which were are counted.
+	var removedRounds uint
 	for i := range removed {
 		toRemove := removed[i]
-		wr.writeRounds.Delete(toRemove.info.ID)
+		if wr.writeRounds.Delete(toRemove.info.ID) {
+			removedRounds++
+		}
 	}
 
+	evicted := wr.evict(now)
+
 	// If changes occurred, update the atomic
-	if len(removed) > 0 || addedRounds > 0 {
+	if removedRounds > 0 || addedRounds > 0 || evicted {
 		wr.storeReadRounds()
 	}
 
@@ -142,11 +266,43 @@ func (wr *WaitingRounds) Insert(added, removed []*Round) {
 	}
 }
 
+// evict removes rounds until the list is within the size set by SetMaxSize,
+// first those which started before now and then those inserted longest ago.
+// Returns true if any round was removed. This is assumed to be called under
+// the lock.
+func (wr *WaitingRounds) evict(now time.Time) bool {
+	maxSize := wr.GetMaxSize()
+	if maxSize == 0 || wr.writeRounds.Len() <= maxSize {
+		return false
+	}
+
+	for e := wr.writeRounds.Front(); e != nil; {
+		next := e.Next()
+		if !e.Value.(*Round).StartTime().After(now) {
+			wr.writeRounds.Delete(e.Key)
+		}
+		e = next
+	}
+
+	var evictions uint64
+	for wr.writeRounds.Len() > maxSize {
+		oldest := wr.writeRounds.Front()
+		wr.writeRounds.Delete(oldest.Key)
+		evictions++
+	}
+	if evictions > 0 {
+		atomic.AddUint64(&wr.evictions, evictions)
+		jww.DEBUG.Printf("Evicted %d waiting rounds to stay within the "+
+			"maximum of %d", evictions, maxSize)
+	}
+	return true
+}
+
 func (wr *WaitingRounds) storeReadRounds() {
 	roundsList := make([]*Round, 0, wr.writeRounds.Len())
 	toDelete := make([]*Round, 0, wr.writeRounds.Len())
 
-	now := netTime.Now()
+	now := wr.now()
 
 	//filter rounds which should not be included
 	for e := wr.writeRounds.Front(); e != nil; e = e.Next() {
@@ -167,7 +323,7 @@ func (wr *WaitingRounds) storeReadRounds() {
 		var rprint string
 		for _, r := range roundsList {
 			rprint += fmt.Sprintf("\n\tround: %d, startTime: %s, time to start: %s",
-				r.info.ID, r.StartTime(), netTime.Until(r.StartTime()))
+				r.info.ID, r.StartTime(), r.StartTime().Sub(now))
 		}
 		jww.TRACE.Printf("Rounds Order: %s", rprint)
 	}
@@ -185,11 +341,11 @@ func (wr *WaitingRounds) storeReadRounds() {
 // getFurthest returns the round that will occur furthest in the future. If the
 // list is empty, then nil is returned. If the round is on the exclusion list,
 // then the next round is checked. If it is not on the exclusion list, it is
-// added.
+// added. Rounds rejected by the filter, which may be nil, are skipped.
 // This is assumed to be called on an operation already under the cond's lock.
 func (wr *WaitingRounds) getFurthest(exclude excludedRounds.ExcludedRounds,
-	cutoffDelta time.Duration) *Round {
-	earliestStart := netTime.Now().Add(cutoffDelta)
+	cutoffDelta time.Duration, filter TopologyFilter) *Round {
+	earliestStart, latestStart := wr.leadTimeBounds(wr.now(), cutoffDelta)
 
 	roundsList, exists := wr.readRounds.Load().([]*Round)
 	if !exists {
@@ -200,21 +356,29 @@ func (wr *WaitingRounds) getFurthest(exclude excludedRounds.ExcludedRounds,
 	for i := len(roundsList) - 1; i >= 0; i-- {
 		r := roundsList[i]
 
-		// Cannot guarantee that the round object's pointers will be exact match
-		// of value in set
-		if r.StartTime().After(earliestStart) {
-			// If no excluded list has been passed in, do not check
-			if exclude == nil {
-				return r
-			}
+		if !withinLeadTime(r.StartTime(), earliestStart, latestStart) {
+			wr.stats.skip(&wr.stats.skippedCutoff, 1)
+			continue
+		}
+		if !filter.accepts(r) {
+			wr.stats.skip(&wr.stats.skippedFiltered, 1)
+			continue
+		}
 
-			// If the exclusion list exists, attempt and insert and return true
-			// if it was a new insert, otherwise skip
-			newInsertion := exclude.Insert(id.Round(r.info.ID))
-			if newInsertion {
-				return r
-			}
+		// If no excluded list has been passed in, do not check
+		if exclude == nil {
+			return r
 		}
+
+		// Cannot guarantee that the round object's pointers will be exact
+		// match of value in set.
+		// If the exclusion list exists, attempt and insert and return true
+		// if it was a new insert, otherwise skip
+		newInsertion := exclude.Insert(id.Round(r.info.ID))
+		if newInsertion {
+			return r
+		}
+		wr.stats.skip(&wr.stats.skippedExcluded, 1)
 	}
 
 	// If all the rounds in the list are excluded, then return nil
@@ -224,11 +388,11 @@ func (wr *WaitingRounds) getFurthest(exclude excludedRounds.ExcludedRounds,
 // getClosest returns the round that will occur soonest in the future. If the
 // list is empty, then nil is returned. If the round is on the exclusion list,
 // then the next round is checked. If it is not on the exclusion list, it is
-// added.
+// added. Rounds rejected by the filter, which may be nil, are skipped.
 // This is assumed to be called on an operation already under the cond's lock.
 func (wr *WaitingRounds) getClosest(exclude excludedRounds.ExcludedRounds,
-	minRoundAge time.Duration) *Round {
-	earliestStart := netTime.Now().Add(minRoundAge)
+	minRoundAge time.Duration, filter TopologyFilter) *Round {
+	earliestStart, latestStart := wr.leadTimeBounds(wr.now(), minRoundAge)
 
 	roundsList, exists := wr.readRounds.Load().([]*Round)
 	if !exists {
@@ -239,21 +403,36 @@ func (wr *WaitingRounds) getClosest(exclude excludedRounds.ExcludedRounds,
 	for i := 0; i < len(roundsList); i++ {
 		r := roundsList[i]
 
-		// Cannot guarantee that the round object's pointers will be exact match
-		// of value in set
-		if r.StartTime().After(earliestStart) {
-			// If no excluded list has been passed in, do not check
-			if exclude == nil {
-				return r
-			}
+		// The list is sorted soonest first, so no later round can be within
+		// the window once one starts after it
+		if !latestStart.IsZero() && r.StartTime().After(latestStart) {
+			wr.stats.skip(&wr.stats.skippedCutoff, len(roundsList)-i)
+			break
+		}
 
-			// If the exclusion list exists, then attempt an insert and return
-			// if it was a new insert, otherwise skip
-			newInsertion := exclude.Insert(id.Round(r.info.ID))
-			if newInsertion {
-				return r
-			}
+		if !withinLeadTime(r.StartTime(), earliestStart, latestStart) {
+			wr.stats.skip(&wr.stats.skippedCutoff, 1)
+			continue
+		}
+		if !filter.accepts(r) {
+			wr.stats.skip(&wr.stats.skippedFiltered, 1)
+			continue
+		}
+
+		// If no excluded list has been passed in, do not check
+		if exclude == nil {
+			return r
 		}
+
+		// Cannot guarantee that the round object's pointers will be exact
+		// match of value in set.
+		// If the exclusion list exists, then attempt an insert and return
+		// if it was a new insert, otherwise skip
+		newInsertion := exclude.Insert(id.Round(r.info.ID))
+		if newInsertion {
+			return r
+		}
+		wr.stats.skip(&wr.stats.skippedExcluded, 1)
 	}
 
 	// If all the rounds in the list are excluded, then return nil
@@ -270,7 +449,7 @@ func (wr *WaitingRounds) GetSlice() []*pb.RoundInfo {
 		return roundInfos
 	}
 
-	timeNow := netTime.Now()
+	timeNow := wr.now()
 	for i := 0; i < len(roundsList); i++ {
 		if roundsList[i].StartTime().After(timeNow) {
 			roundInfos = append(roundInfos, roundsList[i].info)
@@ -285,23 +464,47 @@ func (wr *WaitingRounds) GetSlice() []*pb.RoundInfo {
 // If the list is empty, then it waits for a round to be added for the
 // specified duration. If no round is added, then an error is returned.
 //
+// Only rounds inside the window set by SetLeadTimeWindow are returned, where
+// the minimum lead time is raised to the delay derived from minRoundAge if
+// that is larger.
+//
 // The length of the excluded set indicates how many times the client has
 // called GetUpcomingRealtime trying to retrieve a round to send on.
 // GetUpcomingRealtime defaults to retrieving the closest non-excluded round
 // from WaitingRounds. If the length of the excluded set exceeds the maximum
 // attempts at pulling the closest round, GetUpcomingRealtime will retrieve
 // the furthest non-excluded round from WaitingRounds.
+//
+// If a RoundSelector is set with SetRoundSelector, it chooses the round
+// instead.
 func (wr *WaitingRounds) GetUpcomingRealtime(timeout time.Duration,
 	exclude excludedRounds.ExcludedRounds, numAttempts int, minRoundAge time.Duration) (*pb.RoundInfo, time.Duration, error) {
+	return wr.GetUpcomingRealtimeFiltered(timeout, exclude, numAttempts,
+		minRoundAge, nil)
+}
+
+// GetUpcomingRealtimeFiltered behaves as GetUpcomingRealtime, but only returns
+// rounds whose topology is accepted by the filter, e.g. to skip rounds
+// containing blacklisted nodes without fetching them one at a time. Rounds
+// rejected by the filter are not added to the excluded set. A nil filter
+// accepts every round.
+func (wr *WaitingRounds) GetUpcomingRealtimeFiltered(timeout time.Duration,
+	exclude excludedRounds.ExcludedRounds, numAttempts int,
+	minRoundAge time.Duration, filter TopologyFilter) (*pb.RoundInfo,
+	time.Duration, error) {
+
+	start := time.Now()
 
 	// Start timeout timer
 	timer := time.NewTimer(timeout)
+	defer timer.Stop()
 
 	delay := multiply(numAttempts, minRoundAge)
 
 	// Start seeing if an acceptable round exists
-	round := wr.get(exclude, delay)
+	round := wr.get(exclude, delay, filter)
 	if round != nil {
+		wr.stats.recordWait(start, false)
 		return round, delay, nil
 	}
 
@@ -310,19 +513,29 @@ func (wr *WaitingRounds) GetUpcomingRealtime(timeout time.Duration,
 	for {
 		select {
 		case <-timer.C:
+			wr.stats.recordWait(start, true)
+			jww.DEBUG.Printf("Timed out waiting for a round to send on; "+
+				"waiting rounds %s", wr.GetStats())
 			return nil, 0, timeOutError
 		case <-wr.signal:
-			round = wr.get(exclude, 0)
+			round = wr.get(exclude, 0, filter)
 			if round != nil {
+				wr.stats.recordWait(start, false)
 				return round, 0, nil
 			}
 		}
 	}
 }
 
-func (wr *WaitingRounds) get(exclude excludedRounds.ExcludedRounds, delay time.Duration) *pb.RoundInfo {
+func (wr *WaitingRounds) get(exclude excludedRounds.ExcludedRounds, delay time.Duration,
+	filter TopologyFilter) *pb.RoundInfo {
 
-	round := wr.getClosest(exclude, delay)
+	var round *Round
+	if selector := wr.getRoundSelector(); selector != nil {
+		round = wr.selectRound(selector, exclude, delay, filter)
+	} else {
+		round = wr.getClosest(exclude, delay, filter)
+	}
 	if round != nil {
 		return round.Get()
 	}
@@ -0,0 +1,103 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains batched updates to WaitingRounds
+
+package dataStructures
+
+import (
+	"gitlab.com/elixxir/primitives/states"
+)
+
+// batchedRound is the latest change to a round in a RoundBatch.
+type batchedRound struct {
+	round *Round
+	add   bool
+}
+
+// RoundBatch collects the rounds added to and removed from WaitingRounds by a
+// whole gateway poll response so that they are applied together, with one
+// rebuild of the list and one signal to waiting callers. Only the latest
+// update of each round is applied. A RoundBatch is not safe for concurrent
+// use.
+type RoundBatch struct {
+	wr      *WaitingRounds
+	rounds  map[uint64]batchedRound
+	ordered []uint64
+}
+
+// NewBatch creates an empty RoundBatch for the WaitingRounds, with room for
+// sizeHint rounds.
+func (wr *WaitingRounds) NewBatch(sizeHint int) *RoundBatch {
+	return &RoundBatch{
+		wr:      wr,
+		rounds:  make(map[uint64]batchedRound, sizeHint),
+		ordered: make([]uint64, 0, sizeHint),
+	}
+}
+
+// Add adds the round to the batch to be inserted.
+func (b *RoundBatch) Add(r *Round) {
+	b.set(r, true)
+}
+
+// Remove adds the round to the batch to be removed.
+func (b *RoundBatch) Remove(r *Round) {
+	b.set(r, false)
+}
+
+// Update adds the round to the batch to be inserted if it is QUEUED or
+// removed if it has progressed past QUEUED, as for a round in a poll
+// response. Rounds in earlier states are ignored.
+func (b *RoundBatch) Update(r *Round) {
+	state := states.Round(r.info.State)
+	if state == states.QUEUED {
+		b.Add(r)
+	} else if state > states.QUEUED {
+		b.Remove(r)
+	}
+}
+
+// Len returns the number of distinct rounds in the batch.
+func (b *RoundBatch) Len() int {
+	return len(b.ordered)
+}
+
+// Commit applies the batch to the WaitingRounds and empties it.
+func (b *RoundBatch) Commit() {
+	if len(b.ordered) == 0 {
+		return
+	}
+
+	// Split the changes outside the lock, so that Insert holds it only to
+	// apply them
+	var added, removed []*Round
+	for _, rid := range b.ordered {
+		if br := b.rounds[rid]; br.add {
+			added = append(added, br.round)
+		} else {
+			removed = append(removed, br.round)
+		}
+	}
+	b.rounds = make(map[uint64]batchedRound, len(b.ordered))
+	b.ordered = b.ordered[:0]
+
+	b.wr.Insert(added, removed)
+}
+
+// set records the change to the round unless the batch already holds a newer
+// update of it.
+func (b *RoundBatch) set(r *Round, add bool) {
+	rid := r.info.ID
+	existing, exists := b.rounds[rid]
+	if !exists {
+		b.ordered = append(b.ordered, rid)
+	} else if existing.round.info.UpdateID > r.info.UpdateID {
+		return
+	}
+	b.rounds[rid] = batchedRound{r, add}
+}
@@ -0,0 +1,72 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package dataStructures
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/primitives/states"
+	"gitlab.com/xx_network/primitives/netTime"
+)
+
+// Tests that RoundBatch.Commit applies only the latest update of each round
+// and empties the batch.
+func TestRoundBatch_Commit(t *testing.T) {
+	expectedRounds, _ := createTestRoundInfos(6, netTime.Now().Add(5*time.Second), t)
+	testWR := NewWaitingRounds()
+	testWR.Insert(expectedRounds[:1], nil)
+
+	expectedRounds[1].info.UpdateID = 1
+	batch := testWR.NewBatch(len(expectedRounds))
+	for _, r := range expectedRounds {
+		batch.Update(r)
+	}
+
+	// A newer update of the first round removes it, and an older update of
+	// the second round is ignored
+	first := proto.Clone(expectedRounds[0].info).(*pb.RoundInfo)
+	first.UpdateID++
+	first.State = uint32(states.REALTIME)
+	batch.Update(NewRound(first, nil, nil))
+	second := proto.Clone(expectedRounds[1].info).(*pb.RoundInfo)
+	second.UpdateID = 0
+	second.State = uint32(states.FAILED)
+	batch.Update(NewRound(second, nil, nil))
+
+	// Rounds before QUEUED are ignored
+	batch.Update(NewRound(&pb.RoundInfo{
+		ID:         100,
+		State:      uint32(states.PRECOMPUTING),
+		Timestamps: make([]uint64, states.NUM_STATES),
+	}, nil, nil))
+
+	if batch.Len() != len(expectedRounds) {
+		t.Errorf("Unexpected batch length.\nexpected: %d\nreceived: %d",
+			len(expectedRounds), batch.Len())
+	}
+
+	batch.Commit()
+	if batch.Len() != 0 {
+		t.Errorf("Batch not empty after commit: %d", batch.Len())
+	}
+
+	slice := testWR.GetSlice()
+	if len(slice) != len(expectedRounds)-1 {
+		t.Fatalf("Unexpected number of waiting rounds."+
+			"\nexpected: %d\nreceived: %d", len(expectedRounds)-1, len(slice))
+	}
+	for i, ri := range slice {
+		if ri != expectedRounds[i+1].info {
+			t.Errorf("Unexpected round %d.\nexpected: %v\nreceived: %v",
+				i, expectedRounds[i+1].info, ri)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains statistics on waiting for rounds in GetUpcomingRealtime
+
+package dataStructures
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// WaitingRoundsStats describes the calls made to GetUpcomingRealtime, to help
+// diagnose clients which cannot find rounds to send on.
+type WaitingRoundsStats struct {
+	// Number of calls to GetUpcomingRealtime and how many returned a round
+	// or timed out
+	Calls    uint64
+	Returned uint64
+	Timeouts uint64
+
+	// Total and average time spent in GetUpcomingRealtime, including calls
+	// which timed out
+	TotalWait   time.Duration
+	AverageWait time.Duration
+
+	// Number of times a round was passed over because it started too soon or
+	// too late for the lead time window, because it was already in the
+	// excluded set, or because the topology filter rejected it
+	SkippedCutoff   uint64
+	SkippedExcluded uint64
+	SkippedFiltered uint64
+}
+
+// String returns a one line summary of the statistics for logging.
+func (s WaitingRoundsStats) String() string {
+	return fmt.Sprintf("calls: %d, returned: %d, timeouts: %d, "+
+		"average wait: %s, skipped (cutoff: %d, excluded: %d, filtered: %d)",
+		s.Calls, s.Returned, s.Timeouts, s.AverageWait, s.SkippedCutoff,
+		s.SkippedExcluded, s.SkippedFiltered)
+}
+
+// waitStats holds the counters behind WaitingRoundsStats. All fields are
+// accessed atomically.
+type waitStats struct {
+	calls           uint64
+	returned        uint64
+	timeouts        uint64
+	totalWait       int64
+	skippedCutoff   uint64
+	skippedExcluded uint64
+	skippedFiltered uint64
+}
+
+// GetStats returns the statistics on calls to GetUpcomingRealtime since the
+// WaitingRounds was created. Calls still waiting are not included.
+func (wr *WaitingRounds) GetStats() WaitingRoundsStats {
+	s := WaitingRoundsStats{
+		Calls:           atomic.LoadUint64(&wr.stats.calls),
+		Returned:        atomic.LoadUint64(&wr.stats.returned),
+		Timeouts:        atomic.LoadUint64(&wr.stats.timeouts),
+		TotalWait:       time.Duration(atomic.LoadInt64(&wr.stats.totalWait)),
+		SkippedCutoff:   atomic.LoadUint64(&wr.stats.skippedCutoff),
+		SkippedExcluded: atomic.LoadUint64(&wr.stats.skippedExcluded),
+		SkippedFiltered: atomic.LoadUint64(&wr.stats.skippedFiltered),
+	}
+	if s.Calls > 0 {
+		s.AverageWait = s.TotalWait / time.Duration(s.Calls)
+	}
+	return s
+}
+
+// recordWait records a finished call to GetUpcomingRealtime which started at
+// the given time.
+func (ws *waitStats) recordWait(start time.Time, timedOut bool) {
+	atomic.AddInt64(&ws.totalWait, int64(time.Since(start)))
+	if timedOut {
+		atomic.AddUint64(&ws.timeouts, 1)
+	} else {
+		atomic.AddUint64(&ws.returned, 1)
+	}
+	atomic.AddUint64(&ws.calls, 1)
+}
+
+// skip records n rounds passed over for the reason counted by counter.
+func (ws *waitStats) skip(counter *uint64, n int) {
+	if n > 0 {
+		atomic.AddUint64(counter, uint64(n))
+	}
+}
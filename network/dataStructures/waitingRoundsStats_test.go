@@ -0,0 +1,85 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package dataStructures
+
+import (
+	"testing"
+	"time"
+
+	"gitlab.com/elixxir/comms/testutils"
+	"gitlab.com/elixxir/primitives/excludedRounds"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/netTime"
+)
+
+// Tests that WaitingRounds.GetStats() counts returned and timed out calls and
+// the rounds skipped for each reason.
+func TestWaitingRounds_GetStats(t *testing.T) {
+	expectedRounds, _ := createTestRoundInfos(6, netTime.Now().Add(5*time.Second), t)
+	testWR := NewWaitingRounds()
+
+	if stats := testWR.GetStats(); stats != (WaitingRoundsStats{}) {
+		t.Errorf("New WaitingRounds has statistics: %s", stats)
+	}
+
+	blocked := id.NewIdFromString("blocked", id.Node, t)
+	for i, round := range expectedRounds {
+		if i == 1 {
+			round.info.Topology = [][]byte{blocked.Marshal()}
+		}
+		err := testutils.SignRoundInfoRsa(round.info, t)
+		if err != nil {
+			t.Errorf("Failed to sign round info #%d: %+v", i, err)
+		}
+	}
+	testWR.Insert(expectedRounds, nil)
+
+	// Skips round 0 as excluded and round 1 as filtered, returning round 2
+	exclude := excludedRounds.NewSet()
+	exclude.Insert(id.Round(expectedRounds[0].info.ID))
+	ri, _, err := testWR.GetUpcomingRealtimeFiltered(time.Second, exclude, 0, 0,
+		ExcludeNodes(blocked))
+	if err != nil || ri != expectedRounds[2].info {
+		t.Fatalf("Unexpected round %v: %+v", ri, err)
+	}
+
+	// Every round starts too soon for the lead time window
+	if err = testWR.SetLeadTimeWindow(time.Hour, 0); err != nil {
+		t.Fatalf("Failed to set lead time window: %+v", err)
+	}
+	_, _, err = testWR.GetUpcomingRealtime(20*time.Millisecond, nil, 0, 0)
+	if err != timeOutError {
+		t.Fatalf("Did not time out: %+v", err)
+	}
+
+	stats := testWR.GetStats()
+	expected := WaitingRoundsStats{
+		Calls:           2,
+		Returned:        1,
+		Timeouts:        1,
+		TotalWait:       stats.TotalWait,
+		AverageWait:     stats.TotalWait / 2,
+		SkippedCutoff:   stats.SkippedCutoff,
+		SkippedExcluded: 1,
+		SkippedFiltered: 1,
+	}
+	if stats != expected {
+		t.Errorf("Unexpected statistics.\nexpected: %s\nreceived: %s",
+			expected, stats)
+	}
+	// The rounds are checked again each time a lingering insertion signal is
+	// received while waiting
+	if stats.SkippedCutoff == 0 ||
+		stats.SkippedCutoff%uint64(len(expectedRounds)) != 0 {
+		t.Errorf("Unexpected number of rounds skipped for the cutoff: %d",
+			stats.SkippedCutoff)
+	}
+	if stats.TotalWait < 20*time.Millisecond {
+		t.Errorf("Total wait %s is less than the timeout.", stats.TotalWait)
+	}
+}
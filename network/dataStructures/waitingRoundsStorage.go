@@ -0,0 +1,107 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains persistence of WaitingRounds across restarts
+
+package dataStructures
+
+import (
+	"encoding/json"
+	"sync/atomic"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/primitives/states"
+	"gitlab.com/xx_network/crypto/signature/ec"
+	"gitlab.com/xx_network/crypto/signature/rsa"
+)
+
+// waitingRoundsVersion is the version of the format written by
+// WaitingRounds.Marshal.
+const waitingRoundsVersion = 0
+
+// waitingRoundsDisk is the stored form of WaitingRounds.
+type waitingRoundsDisk struct {
+	Version int
+	// Marshalled pb.RoundInfo of each round, soonest first
+	Rounds [][]byte
+}
+
+// Marshal serialises the rounds in the list which have yet to start, so a
+// client can store them and restore them with Unmarshal after a restart
+// rather than waiting for its next poll.
+func (wr *WaitingRounds) Marshal() ([]byte, error) {
+	infos := wr.GetSlice()
+	disk := waitingRoundsDisk{
+		Version: waitingRoundsVersion,
+		Rounds:  make([][]byte, len(infos)),
+	}
+	for i, ri := range infos {
+		data, err := proto.Marshal(ri)
+		if err != nil {
+			return nil, errors.Errorf("Failed to marshal round %d: %+v",
+				ri.GetID(), err)
+		}
+		disk.Rounds[i] = data
+	}
+	return json.Marshal(disk)
+}
+
+// Unmarshal inserts the rounds serialised by Marshal into the list,
+// returning how many were restored. Each round is checked again as it is
+// loaded: rounds whose signature does not verify against the keys, which are
+// no longer queued, or which have started since they were stored are
+// dropped. Either key may be nil, as in NewRound. Set the clock offset
+// before restoring so rounds are checked against the network clock.
+func (wr *WaitingRounds) Unmarshal(data []byte, rsaPubKey *rsa.PublicKey,
+	ecPubKey *ec.PublicKey) (int, error) {
+	var disk waitingRoundsDisk
+	if err := json.Unmarshal(data, &disk); err != nil {
+		return 0, errors.Errorf("Failed to unmarshal waiting rounds: %+v",
+			err)
+	}
+	if disk.Version != waitingRoundsVersion {
+		return 0, errors.Errorf("Unsupported version %d of waiting rounds, "+
+			"expected %d", disk.Version, waitingRoundsVersion)
+	}
+
+	now := wr.now()
+	rounds := make([]*Round, 0, len(disk.Rounds))
+	for i, data := range disk.Rounds {
+		ri := &pb.RoundInfo{}
+		if err := proto.Unmarshal(data, ri); err != nil {
+			jww.WARN.Printf("Dropping stored waiting round %d of %d: failed "+
+				"to unmarshal: %+v", i, len(disk.Rounds), err)
+			continue
+		}
+		if states.Round(ri.GetState()) != states.QUEUED ||
+			len(ri.GetTimestamps()) <= int(states.QUEUED) {
+			jww.DEBUG.Printf("Dropping stored waiting round %d: no longer "+
+				"queued", ri.GetID())
+			continue
+		}
+
+		r := NewRound(ri, rsaPubKey, ecPubKey)
+		if !r.StartTime().After(now) {
+			jww.DEBUG.Printf("Dropping stored waiting round %d: started at "+
+				"%s", ri.GetID(), r.StartTime())
+			continue
+		}
+		if err := r.Verify(); err != nil {
+			jww.WARN.Printf("Dropping stored waiting round %d: %+v",
+				ri.GetID(), err)
+			continue
+		}
+		atomic.StoreUint32(r.needsValidation, roundVerified)
+		rounds = append(rounds, r)
+	}
+
+	wr.Insert(rounds, nil)
+	return len(rounds), nil
+}
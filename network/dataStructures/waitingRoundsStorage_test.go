@@ -0,0 +1,133 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package dataStructures
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/testutils"
+	"gitlab.com/elixxir/primitives/states"
+	"gitlab.com/xx_network/primitives/netTime"
+)
+
+// newStoredTestRound returns a signed round in the given state, queued to
+// start at the given time.
+func newStoredTestRound(id uint64, state states.Round, start time.Time,
+	t *testing.T) *pb.RoundInfo {
+	ri := &pb.RoundInfo{
+		ID:         id,
+		UpdateID:   id,
+		State:      uint32(state),
+		Timestamps: make([]uint64, states.NUM_STATES),
+	}
+	ri.Timestamps[states.QUEUED] = uint64(start.UnixNano())
+	if err := testutils.SignRoundInfoRsa(ri, t); err != nil {
+		t.Fatalf("Failed to sign round info: %+v", err)
+	}
+	return ri
+}
+
+// Tests that the rounds marshalled by WaitingRounds.Marshal are restored by
+// WaitingRounds.Unmarshal in order.
+func TestWaitingRounds_Marshal_Unmarshal(t *testing.T) {
+	pubKey, err := testutils.LoadPublicKeyTesting(t)
+	if err != nil {
+		t.Fatalf("Failed to load public key: %+v", err)
+	}
+	start := netTime.Now().Add(time.Minute)
+
+	wr := NewWaitingRounds()
+	var rounds []*Round
+	for i := uint64(0); i < 5; i++ {
+		ri := newStoredTestRound(i, states.QUEUED,
+			start.Add(time.Duration(i)*time.Second), t)
+		rounds = append(rounds, NewVerifiedRound(ri, pubKey))
+	}
+	wr.Insert(rounds, nil)
+
+	data, err := wr.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal error: %+v", err)
+	}
+
+	restored := NewWaitingRounds()
+	n, err := restored.Unmarshal(data, pubKey, nil)
+	if err != nil {
+		t.Fatalf("Unmarshal error: %+v", err)
+	}
+	if n != len(rounds) || restored.Len() != len(rounds) {
+		t.Fatalf("Restored %d rounds (%d in list), expected %d.",
+			n, restored.Len(), len(rounds))
+	}
+	for i, ri := range restored.GetSlice() {
+		if ri.GetID() != uint64(i) {
+			t.Errorf("Round %d has ID %d, expected %d.", i, ri.GetID(), i)
+		}
+	}
+}
+
+// Tests that WaitingRounds.Unmarshal drops rounds which have started, are no
+// longer queued, or are not signed by the key.
+func TestWaitingRounds_Unmarshal_DropsInvalid(t *testing.T) {
+	pubKey, err := testutils.LoadPublicKeyTesting(t)
+	if err != nil {
+		t.Fatalf("Failed to load public key: %+v", err)
+	}
+	now := netTime.Now()
+
+	valid := newStoredTestRound(1, states.QUEUED, now.Add(time.Minute), t)
+	started := newStoredTestRound(2, states.QUEUED, now.Add(-time.Minute), t)
+	realtime := newStoredTestRound(3, states.REALTIME, now.Add(time.Minute), t)
+	badSig := newStoredTestRound(4, states.QUEUED, now.Add(time.Minute), t)
+	badSig.Signature.Signature[0] ^= 0xFF
+
+	var disk waitingRoundsDisk
+	for _, ri := range []*pb.RoundInfo{valid, started, realtime, badSig} {
+		data, err := proto.Marshal(ri)
+		if err != nil {
+			t.Fatalf("Failed to marshal round %d: %+v", ri.GetID(), err)
+		}
+		disk.Rounds = append(disk.Rounds, data)
+	}
+	disk.Rounds = append(disk.Rounds, []byte("invalid"))
+	data, err := json.Marshal(disk)
+	if err != nil {
+		t.Fatalf("Failed to marshal waiting rounds: %+v", err)
+	}
+
+	wr := NewWaitingRounds()
+	n, err := wr.Unmarshal(data, pubKey, nil)
+	if err != nil {
+		t.Fatalf("Unmarshal error: %+v", err)
+	}
+	if n != 1 || wr.Len() != 1 {
+		t.Fatalf("Restored %d rounds (%d in list), expected 1.", n, wr.Len())
+	}
+	if id := wr.GetSlice()[0].GetID(); id != valid.GetID() {
+		t.Errorf("Restored round %d, expected %d.", id, valid.GetID())
+	}
+}
+
+// Error path: Tests that WaitingRounds.Unmarshal rejects data of another
+// version.
+func TestWaitingRounds_Unmarshal_Version(t *testing.T) {
+	data, err := json.Marshal(waitingRoundsDisk{
+		Version: waitingRoundsVersion + 1})
+	if err != nil {
+		t.Fatalf("Failed to marshal waiting rounds: %+v", err)
+	}
+
+	wr := NewWaitingRounds()
+	if _, err = wr.Unmarshal(data, nil, nil); err == nil {
+		t.Error("Unmarshal did not error on an unsupported version.")
+	}
+}
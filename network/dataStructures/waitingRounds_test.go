@@ -22,6 +22,7 @@ import (
 	"gitlab.com/elixxir/primitives/current"
 	"gitlab.com/elixxir/primitives/excludedRounds"
 	"gitlab.com/elixxir/primitives/states"
+	"gitlab.com/xx_network/primitives/id"
 	"gitlab.com/xx_network/primitives/netTime"
 )
 
@@ -92,17 +93,17 @@ func TestWaitingRounds_getFurthest(t *testing.T) {
 	testWR.storeReadRounds()
 
 	for i := len(expectedRounds) - 1; i >= 0; i-- {
-		if !reflect.DeepEqual(expectedRounds[i], testWR.getFurthest(nil, 0)) {
+		if !reflect.DeepEqual(expectedRounds[i], testWR.getFurthest(nil, 0, nil)) {
 			t.Errorf("getFurthest() did not return the expected round for %d."+
 				"\nexpected: %+v\nrecieved: %+v", i,
-				expectedRounds[i].info, testWR.getFurthest(nil, 0).info)
+				expectedRounds[i].info, testWR.getFurthest(nil, 0, nil).info)
 		}
 		// testWR.remove(expectedRounds[i])
 		testWR.Insert(nil, []*Round{expectedRounds[i]})
 		testWR.storeReadRounds()
 	}
 
-	if testWR.getFurthest(nil, 0) != nil {
+	if testWR.getFurthest(nil, 0, nil) != nil {
 		t.Errorf("getFurthest() did not return nil on empty list.")
 	}
 }
@@ -126,7 +127,7 @@ func TestWaitingRounds_getFurthest_Exclude(t *testing.T) {
 
 	for i := len(expectedRounds) - 1; i >= 0; i-- {
 		if i%2 == 1 {
-			received := testWR.getFurthest(exclude, 0)
+			received := testWR.getFurthest(exclude, 0, nil)
 			if !reflect.DeepEqual(expectedRounds[i], received) {
 				t.Errorf("getFurthest() did not return the expected round for %d."+
 					"\nexpected: %v\nrecieved: %v", i, expectedRounds[i], received)
@@ -135,7 +136,7 @@ func TestWaitingRounds_getFurthest_Exclude(t *testing.T) {
 			testWR.storeReadRounds()
 		}
 	}
-	if testWR.getFurthest(exclude, 0) != nil {
+	if testWR.getFurthest(exclude, 0, nil) != nil {
 		t.Errorf("getFurthest() did not return nil on empty list.")
 	}
 }
@@ -150,16 +151,16 @@ func TestWaitingRounds_getClosest(t *testing.T) {
 	testWR.Insert(expectedRounds, nil)
 
 	for i := 0; i < len(expectedRounds); i++ {
-		if !reflect.DeepEqual(expectedRounds[i], testWR.getClosest(nil, 0)) {
+		if !reflect.DeepEqual(expectedRounds[i], testWR.getClosest(nil, 0, nil)) {
 			t.Errorf("getClosest() did not return the expected round for %d."+
 				"\nexpected: %+v\nrecieved: %+v", i,
-				expectedRounds[i].info, testWR.getClosest(nil, 0).info)
+				expectedRounds[i].info, testWR.getClosest(nil, 0, nil).info)
 		}
 		testWR.Insert(nil, []*Round{expectedRounds[i]})
 		testWR.storeReadRounds()
 	}
 
-	if testWR.getClosest(nil, 0) != nil {
+	if testWR.getClosest(nil, 0, nil) != nil {
 		t.Errorf("getFurthest() did not return nil on empty list: %+v",
 			testWR.writeRounds)
 	}
@@ -479,3 +480,198 @@ func TestWaitingRounds_HasValidRounds_halfValid(t *testing.T) {
 		t.Errorf("returned that the rounds are invlaid whene there are valid rounds")
 	}
 }
+
+// Tests that WaitingRounds.SetLeadTimeWindow() stores valid windows and
+// rejects invalid ones.
+func TestWaitingRounds_SetLeadTimeWindow(t *testing.T) {
+	testWR := NewWaitingRounds()
+
+	err := testWR.SetLeadTimeWindow(2*time.Second, 10*time.Second)
+	if err != nil {
+		t.Fatalf("SetLeadTimeWindow() returned an error: %+v", err)
+	}
+
+	minLead, maxLead := testWR.GetLeadTimeWindow()
+	if minLead != 2*time.Second || maxLead != 10*time.Second {
+		t.Errorf("GetLeadTimeWindow() returned an unexpected window."+
+			"\nexpected: [%s, %s]\nreceived: [%s, %s]",
+			2*time.Second, 10*time.Second, minLead, maxLead)
+	}
+
+	if err = testWR.SetLeadTimeWindow(2*time.Second, time.Second); err == nil {
+		t.Errorf("SetLeadTimeWindow() did not error when max < min.")
+	}
+
+	if err = testWR.SetLeadTimeWindow(-time.Second, 0); err == nil {
+		t.Errorf("SetLeadTimeWindow() did not error on a negative window.")
+	}
+
+	if err = testWR.SetLeadTimeWindow(time.Second, 0); err != nil {
+		t.Errorf("SetLeadTimeWindow() errored for an unbounded window: %+v", err)
+	}
+}
+
+// Tests that getClosest and getFurthest only return rounds inside the lead
+// time window.
+func TestWaitingRounds_LeadTimeWindow(t *testing.T) {
+	pubKey, _ := testutils.LoadPublicKeyTesting(t)
+	now := netTime.Now()
+
+	// Rounds starting in 1s, 3s and 5s
+	rounds := make([]*Round, 3)
+	for i := range rounds {
+		ri := &pb.RoundInfo{
+			ID:         uint64(i),
+			State:      uint32(states.QUEUED),
+			Timestamps: make([]uint64, current.NUM_STATES),
+		}
+		ri.Timestamps[states.QUEUED] =
+			uint64(now.Add(time.Duration(2*i+1) * time.Second).UnixNano())
+		rounds[i] = NewRound(ri, pubKey, nil)
+	}
+
+	testWR := NewWaitingRounds()
+	testWR.Insert(rounds, nil)
+
+	err := testWR.SetLeadTimeWindow(2*time.Second, 4*time.Second)
+	if err != nil {
+		t.Fatalf("SetLeadTimeWindow() returned an error: %+v", err)
+	}
+
+	if r := testWR.getClosest(nil, 0, nil); r != rounds[1] {
+		t.Errorf("getClosest() did not return the round inside the window."+
+			"\nexpected: %v\nreceived: %v", rounds[1], r)
+	}
+	if r := testWR.getFurthest(nil, 0, nil); r != rounds[1] {
+		t.Errorf("getFurthest() did not return the round inside the window."+
+			"\nexpected: %v\nreceived: %v", rounds[1], r)
+	}
+
+	// A larger minimum round age overrides the minimum lead time
+	if r := testWR.getClosest(nil, 4*time.Second, nil); r != nil {
+		t.Errorf("getClosest() returned a round outside the window: %v", r)
+	}
+
+	// Removing the upper bound allows the furthest round to be returned
+	err = testWR.SetLeadTimeWindow(2*time.Second, 0)
+	if err != nil {
+		t.Fatalf("SetLeadTimeWindow() returned an error: %+v", err)
+	}
+	if r := testWR.getFurthest(nil, 0, nil); r != rounds[2] {
+		t.Errorf("getFurthest() did not return the furthest round."+
+			"\nexpected: %v\nreceived: %v", rounds[2], r)
+	}
+}
+
+// Tests that WaitingRounds.Insert keeps the list within the size set by
+// WaitingRounds.SetMaxSize, removing started rounds before evicting the
+// rounds inserted longest ago.
+func TestWaitingRounds_SetMaxSize(t *testing.T) {
+	pubKey, err := testutils.LoadPublicKeyTesting(t)
+	if err != nil {
+		t.Fatalf("Failed to load public key: %+v", err)
+	}
+	now := netTime.Now()
+	newRound := func(rid uint64, start time.Time) *Round {
+		ri := &pb.RoundInfo{
+			ID:         rid,
+			State:      uint32(states.QUEUED),
+			Timestamps: make([]uint64, current.NUM_STATES),
+		}
+		ri.Timestamps[states.QUEUED] = uint64(start.UnixNano())
+		return NewRound(ri, pubKey, nil)
+	}
+
+	testWR := NewWaitingRounds()
+	if err = testWR.SetMaxSize(-1); err == nil {
+		t.Error("SetMaxSize() did not error for a negative size.")
+	}
+	if err = testWR.SetMaxSize(3); err != nil {
+		t.Fatalf("SetMaxSize() returned an error: %+v", err)
+	}
+
+	testWR.Insert([]*Round{newRound(0, now.Add(50*time.Millisecond))}, nil)
+	time.Sleep(100 * time.Millisecond)
+	testWR.Insert([]*Round{newRound(1, now.Add(time.Minute)),
+		newRound(2, now.Add(2*time.Minute)),
+		newRound(3, now.Add(3*time.Minute))}, nil)
+	if testWR.writeRounds.Len() != 3 || testWR.GetEvictions() != 0 {
+		t.Errorf("Started round was not removed first: %d rounds, %d "+
+			"evictions.", testWR.writeRounds.Len(), testWR.GetEvictions())
+	}
+
+	testWR.Insert([]*Round{newRound(4, now.Add(30*time.Second))}, nil)
+	if testWR.GetEvictions() != 1 {
+		t.Errorf("Unexpected evictions.\nexpected: %d\nreceived: %d",
+			1, testWR.GetEvictions())
+	}
+	var ids []uint64
+	for _, r := range testWR.readRounds.Load().([]*Round) {
+		ids = append(ids, r.info.ID)
+	}
+	if !reflect.DeepEqual(ids, []uint64{4, 2, 3}) {
+		t.Errorf("Unexpected rounds after eviction.\nexpected: %v"+
+			"\nreceived: %v", []uint64{4, 2, 3}, ids)
+	}
+}
+
+// Tests that WaitingRounds.GetUpcomingRealtimeFiltered() skips rounds rejected
+// by the filter without adding them to the excluded rounds.
+func TestWaitingRounds_GetUpcomingRealtimeFiltered(t *testing.T) {
+	expectedRounds, _ := createTestRoundInfos(10, netTime.Now().Add(5*time.Second), t)
+	testWR := NewWaitingRounds()
+
+	blocked := id.NewIdFromString("blocked", id.Node, t)
+	allowed := id.NewIdFromString("allowed", id.Node, t)
+	for i, round := range expectedRounds {
+		nid := allowed
+		if i%2 == 0 {
+			nid = blocked
+		}
+		round.info.Topology = [][]byte{nid.Marshal()}
+		err := testutils.SignRoundInfoRsa(round.info, t)
+		if err != nil {
+			t.Errorf("Failed to sign round info #%d: %+v", i, err)
+		}
+	}
+	testWR.Insert(expectedRounds, nil)
+
+	exclude := excludedRounds.NewSet()
+	filter := ExcludeNodes(blocked)
+	for i := 1; i < len(expectedRounds); i += 2 {
+		ri, _, err := testWR.GetUpcomingRealtimeFiltered(
+			300*time.Millisecond, exclude, 0, 0, filter)
+		if err != nil {
+			t.Fatalf("GetUpcomingRealtimeFiltered() returned an unexpected "+
+				"error: %+v", err)
+		}
+		if ri != expectedRounds[i].info {
+			t.Errorf("GetUpcomingRealtimeFiltered() did not return the "+
+				"expected round (%d).\nexpected: %v\nreceived: %v",
+				i, expectedRounds[i].info, ri)
+		}
+	}
+
+	_, _, err := testWR.GetUpcomingRealtimeFiltered(
+		50*time.Millisecond, exclude, 0, 0, filter)
+	if err != timeOutError {
+		t.Errorf("GetUpcomingRealtimeFiltered() did not time out when all "+
+			"accepted rounds are excluded.\nexpected: %v\nreceived: %v",
+			timeOutError, err)
+	}
+
+	for i := 0; i < len(expectedRounds); i += 2 {
+		if exclude.Has(id.Round(expectedRounds[i].info.ID)) {
+			t.Errorf("Round %d rejected by the filter was excluded.",
+				expectedRounds[i].info.ID)
+		}
+	}
+
+	ri, _, err := testWR.GetUpcomingRealtime(
+		300*time.Millisecond, exclude, 0, 0)
+	if err != nil || ri != expectedRounds[0].info {
+		t.Errorf("GetUpcomingRealtime() did not return the first round "+
+			"without a filter.\nexpected: %v\nreceived: %v\nerror: %+v",
+			expectedRounds[0].info, ri, err)
+	}
+}
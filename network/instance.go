@@ -14,16 +14,19 @@ import (
 	"fmt"
 	"github.com/pkg/errors"
 	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/elixxir/comms/certLog"
+	"gitlab.com/elixxir/comms/hostCache"
 	pb "gitlab.com/elixxir/comms/mixmessages"
 	ds "gitlab.com/elixxir/comms/network/dataStructures"
 	"gitlab.com/elixxir/crypto/cyclic"
 	"gitlab.com/elixxir/primitives/states"
 	"gitlab.com/xx_network/comms/connect"
-	"gitlab.com/xx_network/comms/signature"
 	"gitlab.com/xx_network/crypto/signature/ec"
 	"gitlab.com/xx_network/primitives/id"
 	"gitlab.com/xx_network/primitives/ndf"
 	"gitlab.com/xx_network/primitives/netTime"
+	"sync"
+	"sync/atomic"
 	"testing"
 )
 
@@ -41,6 +44,12 @@ type Instance struct {
 
 	ipOverride *ds.IpOverrideList
 
+	// Reconciles the hosts in the manager with the NDF; created on first use
+	hosts    *hostCache.Reconciler
+	hostsMux sync.Mutex
+	// Optional log every host certificate seen is submitted to
+	certLog *certLog.Log
+
 	// Determines whether auth is enabled
 	// on communication with gateways
 	gatewayAuth bool
@@ -53,6 +62,10 @@ type Instance struct {
 	// Set to true, they shall use elliptic, set to false they shall use RSA
 	useElliptic bool
 	ecPublicKey *ec.PublicKey
+	// Signatures required on round infos when not the default of either; a
+	// ds.SignaturePolicy accessed atomically, as it may be set while rounds
+	// are being updated
+	signaturePolicy uint32
 	// Waiting Rounds
 	waitingRounds *ds.WaitingRounds
 
@@ -98,6 +111,14 @@ func (i *Instance) SetAddGatewayChan(c chan NodeGateway) {
 	i.addGateway = c
 }
 
+// SetCertificateLog sets the log the certificate of every host in the NDF is
+// submitted to. Passing nil disables it.
+func (i *Instance) SetCertificateLog(l *certLog.Log) {
+	i.hostsMux.Lock()
+	i.certLog = l
+	i.hostsMux.Unlock()
+}
+
 // Return AddGateway channel from Instance
 func (i *Instance) GetAddGatewayChan() chan NodeGateway {
 	return i.addGateway
@@ -147,6 +168,7 @@ func NewInstance(c *connect.ProtoComms, partial, full *ndf.NetworkDefinition, er
 		if err != nil {
 			return nil, errors.WithMessage(err, "Could not create secured partial ndf")
 		}
+		partialNdf.setValidation(ds.NdfValidation{RejectStale: true})
 	}
 
 	if full != nil {
@@ -154,6 +176,7 @@ func NewInstance(c *connect.ProtoComms, partial, full *ndf.NetworkDefinition, er
 		if err != nil {
 			return nil, errors.WithMessage(err, "Could not create secured full ndf")
 		}
+		fullNdf.setValidation(ds.NdfValidation{RejectStale: true})
 	}
 
 	i := &Instance{
@@ -273,12 +296,14 @@ func (i *Instance) UpdatePartialNdf(m *pb.NDF) error {
 	}
 
 	// Get list of removed nodes and remove them from the host map
-	rmNodes, err := getBannedNodes(oldNodeList, i.partial.Get().Nodes)
+	newNdf := i.partial.Get()
+	rmNodes, err := getBannedNodes(oldNodeList, newNdf.Nodes)
 	if err != nil {
 		return err
 	}
+	hosts, _ := i.reconciler()
 	for _, nid := range rmNodes {
-		i.comm.RemoveHost(nid)
+		hosts.RemoveHost(nid)
 
 		// Send events into Node Listener
 		if i.removeNode != nil && i.removeGateway != nil {
@@ -298,15 +323,15 @@ func (i *Instance) UpdatePartialNdf(m *pb.NDF) error {
 	}
 
 	// update the cmix group object
-	cmixGrp, _ := i.partial.Get().CMIX.String()
-	err = i.cmixGroup.Update(cmixGrp)
+	cmixGrp, _ := newNdf.CMIX.String()
+	_, err = i.cmixGroup.Set(cmixGrp)
 	if err != nil {
 		return errors.WithMessage(err, "Unable to update cmix group")
 	}
 
 	// update the e2e group object
-	e2eGrp, _ := i.partial.Get().E2E.String()
-	err = i.e2eGroup.Update(e2eGrp)
+	e2eGrp, _ := newNdf.E2E.String()
+	_, err = i.e2eGroup.Set(e2eGrp)
 	if err != nil {
 		return errors.WithMessage(err, "Unable to update e2e group")
 	}
@@ -328,19 +353,20 @@ func (i *Instance) GetNodeAndGateway(ngid *id.ID) (NodeGateway, error) {
 		def = i.GetPartialNdf()
 	}
 
+	netDef := def.Get()
 	idBytes := ngid.Bytes()
 
 	// depending on if the passed id is a node or gateway ID, look it up in the
 	// correct list
 	if ngid.GetType() == id.Node {
-		for iter, n := range def.Get().Nodes {
+		for iter, n := range netDef.Nodes {
 			if bytes.Compare(n.ID, idBytes) == 0 {
 				index = iter
 				break
 			}
 		}
 	} else if ngid.GetType() == id.Gateway {
-		for iter, g := range def.Get().Gateways {
+		for iter, g := range netDef.Gateways {
 			if bytes.Compare(g.ID, idBytes) == 0 {
 				index = iter
 				break
@@ -359,8 +385,8 @@ func (i *Instance) GetNodeAndGateway(ngid *id.ID) (NodeGateway, error) {
 
 	//return the found node and gateway
 	return NodeGateway{
-		Node:    def.Get().Nodes[index],
-		Gateway: def.Get().Gateways[index],
+		Node:    netDef.Nodes[index],
+		Gateway: netDef.Gateways[index],
 	}, nil
 }
 
@@ -385,12 +411,14 @@ func (i *Instance) UpdateFullNdf(m *pb.NDF) error {
 	if err != nil {
 		return err
 	}
-	rmNodes, err := getBannedNodes(oldNodeList, i.full.Get().Nodes)
+	newNdf := i.full.Get()
+	rmNodes, err := getBannedNodes(oldNodeList, newNdf.Nodes)
 	if err != nil {
 		return err
 	}
+	hosts, _ := i.reconciler()
 	for _, nid := range rmNodes {
-		i.comm.RemoveHost(nid)
+		hosts.RemoveHost(nid)
 
 		// Send events into Node Listener
 		if i.removeNode != nil {
@@ -413,15 +441,15 @@ func (i *Instance) UpdateFullNdf(m *pb.NDF) error {
 	}
 
 	// update the cmix group object
-	cmixGrp, _ := i.full.Get().CMIX.String()
-	err = i.cmixGroup.Update(cmixGrp)
+	cmixGrp, _ := newNdf.CMIX.String()
+	_, err = i.cmixGroup.Set(cmixGrp)
 	if err != nil {
 		return errors.WithMessage(err, "Unable to update cmix group")
 	}
 
 	// update the e2e group object
-	e2eGrp, _ := i.full.Get().E2E.String()
-	err = i.e2eGroup.Update(e2eGrp)
+	e2eGrp, _ := newNdf.E2E.String()
+	_, err = i.e2eGroup.Set(e2eGrp)
 	if err != nil {
 		return errors.WithMessage(err, "Unable to update e2e group")
 	}
@@ -461,8 +489,8 @@ func getBannedNodes(old []ndf.Node, new []ndf.Node) ([]*id.ID, error) {
 func (i *Instance) RoundUpdates(rounds []*pb.RoundInfo) error {
 	// Keep track of whether one of the rounds is completed
 	isRoundComplete := false
-	addedRounds := make([]*ds.Round, 0, len(rounds))
-	removedRounds := make([]*ds.Round, 0, len(rounds))
+	// Apply the whole response to the waiting rounds at once
+	batch := i.waitingRounds.NewBatch(len(rounds))
 	roundsToTrigger := make([]*ds.Round, 0, len(rounds))
 	for _, round := range rounds {
 		if states.Round(round.State) == states.COMPLETED {
@@ -474,19 +502,14 @@ func (i *Instance) RoundUpdates(rounds []*pb.RoundInfo) error {
 		if err != nil {
 			return err
 		}
-		state := states.Round(round.State)
-		if state == states.QUEUED {
-			addedRounds = append(addedRounds, rnd)
-		} else if state > states.QUEUED {
-			addedRounds = append(removedRounds, rnd)
-		}
+		batch.Update(rnd)
 
 		roundsToTrigger = append(roundsToTrigger, rnd)
 	}
 
 	go i.events.TriggerRoundEvents(roundsToTrigger...)
 
-	i.waitingRounds.Insert(addedRounds, removedRounds)
+	batch.Commit()
 
 	// Send a Heartbeat over the networkHealth channel
 	if i.networkHealth != nil {
@@ -503,6 +526,13 @@ func (i *Instance) RoundUpdates(rounds []*pb.RoundInfo) error {
 	return nil
 }
 
+// SetSignaturePolicy sets the signatures a round info must carry to be
+// accepted, overriding the choice of key made by useElliptic. Requiring the
+// elliptic signature needs the NDF to contain the elliptic key.
+func (i *Instance) SetSignaturePolicy(policy ds.SignaturePolicy) {
+	atomic.StoreUint32(&i.signaturePolicy, uint32(policy))
+}
+
 // Add a round to the round and update buffer
 func (i *Instance) RoundUpdate(info *pb.RoundInfo) (*ds.Round, error) {
 	perm, success := i.comm.GetHost(&id.Permissioning)
@@ -513,16 +543,22 @@ func (i *Instance) RoundUpdate(info *pb.RoundInfo) (*ds.Round, error) {
 	}
 
 	var rnd *ds.Round
-	if i.useElliptic {
-		// Use the elliptic key only
-		rnd = ds.NewRound(info, nil, i.ecPublicKey)
+	policy := ds.SignaturePolicy(atomic.LoadUint32(&i.signaturePolicy))
+	if policy != ds.EitherSignature {
+		// The policy determines which keys are used
+		rnd = ds.NewRoundWithPolicy(info, perm.GetPubKey(), i.ecPublicKey,
+			policy)
+	} else if i.useElliptic {
+		// Prefer the elliptic key, falling back to the rsa key for rounds
+		// which have not been dual-signed
+		rnd = ds.NewRound(info, perm.GetPubKey(), i.ecPublicKey)
 	} else {
 		// Use the rsa key only
 		rnd = ds.NewRound(info, perm.GetPubKey(), nil)
 	}
 
 	if i.validationLevel == Strict {
-		err := signature.VerifyRsa(info, perm.GetPubKey())
+		err := rnd.Verify()
 		if err != nil {
 			return nil, errors.WithMessage(err, fmt.Sprintf("Could not validate "+
 				"the roundInfo signature: %+v", info))
@@ -560,6 +596,32 @@ func (i *Instance) GetCmixGroup() *cyclic.Group {
 	return i.cmixGroup.Get()
 }
 
+// GetVersionedCmixGroup returns the cmix group with its version, which
+// changes each time an NDF replaces the group.
+func (i *Instance) GetVersionedCmixGroup() ds.GroupUpdate {
+	return i.cmixGroup.GetVersioned()
+}
+
+// GetVersionedE2EGroup returns the e2e group with its version, which changes
+// each time an NDF replaces the group.
+func (i *Instance) GetVersionedE2EGroup() ds.GroupUpdate {
+	return i.e2eGroup.GetVersioned()
+}
+
+// SubscribeCmixGroup returns a channel receiving each change of the cmix group
+// made by a new NDF and a function ending the subscription.
+func (i *Instance) SubscribeCmixGroup(buffer int) (<-chan ds.GroupUpdate,
+	func()) {
+	return i.cmixGroup.Subscribe(buffer)
+}
+
+// SubscribeE2EGroup returns a channel receiving each change of the e2e group
+// made by a new NDF and a function ending the subscription.
+func (i *Instance) SubscribeE2EGroup(buffer int) (<-chan ds.GroupUpdate,
+	func()) {
+	return i.e2eGroup.Subscribe(buffer)
+}
+
 // Get the round of a given ID as a roundInfo (protobuff)
 func (i *Instance) GetRound(id id.Round) (*pb.RoundInfo, error) {
 	return i.roundData.GetRound(int(id))
@@ -676,107 +738,103 @@ func (i *Instance) GetPermissioningId() *id.ID {
 	return &id.Permissioning
 }
 
+// GetHosts returns the reconciler of the hosts of the NDF, which also tracks
+// hosts added through it, e.g. for a hostRegistry.Registry, or to route them
+// through a proxy with SetRouter.
+func (i *Instance) GetHosts() *hostCache.Reconciler {
+	hosts, _ := i.reconciler()
+	return hosts
+}
+
+// reconciler returns the reconciler of the NDF hosts, creating it if needed,
+// and the certificate log.
+func (i *Instance) reconciler() (*hostCache.Reconciler, *certLog.Log) {
+	i.hostsMux.Lock()
+	defer i.hostsMux.Unlock()
+	if i.hosts == nil {
+		i.hosts = hostCache.NewReconciler(i.comm.Manager)
+	}
+	return i.hosts, i.certLog
+}
+
 // Update host helper
 func (i *Instance) updateConns(def *ndf.NetworkDefinition, isGateway, isNode bool) error {
-	if isGateway {
-		for index, gateway := range def.Gateways {
-			gwid, err := id.Unmarshal(def.Nodes[index].ID)
-			if err != nil {
-				return err
+	// If this entity is a gateway, other gateway hosts
+	// should have auth enabled. Otherwise, disable auth
+	gwParams := connect.GetDefaultHostParams()
+	gwParams.MaxRetries = 3
+	gwParams.EnableCoolOff = true
+	gwParams.AuthEnabled = i.gatewayAuth
+
+	opts := hostCache.NdfOptions{
+		Gateways:      isGateway,
+		Nodes:         isNode,
+		GatewayParams: gwParams,
+		NodeParams:    connect.GetDefaultHostParams(),
+		//check if an ip override is registered
+		Address: i.ipOverride.CheckOverride,
+		OnCreate: func(host *connect.Host) {
+			if host.GetId().GetType() == id.Node {
+				// 10k batch size * 8192 packet size * 2
+				host.SetWindowSize(connect.MaxWindowSize)
 			}
-			gwid.SetType(id.Gateway)
-			//check if an ip override is registered
-			addr := i.ipOverride.CheckOverride(gwid, gateway.Address)
-
-			//check if the host exists
-			host, ok := i.comm.GetHost(gwid)
-			if !ok {
-
-				// Check if gateway ID collides with an existing hard coded ID
-				if id.CollidesWithHardCodedID(gwid) {
-					return errors.Errorf("Gateway ID invalid, collides with a "+
-						"hard coded ID. Invalid ID: %v", gwid.Marshal())
-				}
+		},
+	}
 
-				// If this entity is a gateway, other gateway hosts
-				// should have auth enabled. Otherwise, disable auth
-				gwParams := connect.GetDefaultHostParams()
-				gwParams.MaxRetries = 3
-				gwParams.EnableCoolOff = true
-				gwParams.AuthEnabled = i.gatewayAuth
-				_, err := i.comm.AddHost(gwid, addr, []byte(gateway.TlsCertificate), gwParams)
-				if err != nil {
-					return errors.WithMessagef(err, "Could not add gateway host %s", gwid)
-				}
+	hosts, l := i.reconciler()
+	report, err := hosts.ApplyNdf(def, opts)
+	if err != nil {
+		return err
+	}
 
-				// Send events into Node Listener
-				if i.addGateway != nil {
-					ng := NodeGateway{
-						Node:    def.Nodes[index],
-						Gateway: gateway,
-					}
-
-					select {
-					case i.addGateway <- ng:
-					default:
-						jww.WARN.Printf("Unable to send AddGateway event for id %s", gwid.String())
-					}
+	// Send events into Node Listener for the hosts added, which share the
+	// index of their node and gateway in the NDF
+	added := make(map[id.ID]bool, len(report.Added))
+	for _, hid := range report.Added {
+		added[*hid] = true
+	}
+	for index, node := range def.Nodes {
+		nid, err := id.Unmarshal(node.ID)
+		if err != nil {
+			return err
+		}
+		if isNode {
+			if added[*nid] && i.addNode != nil {
+				select {
+				case i.addNode <- NodeGateway{Node: node, Gateway: def.Gateways[index]}:
+				default:
+					jww.WARN.Printf("Unable to send AddNode event for id %s", nid.String())
 				}
-
-			} else if host.GetAddress() != addr {
-				host.UpdateAddress(addr)
 			}
+			observeCert(l, nid, opts.Address(nid, node.Address),
+				node.TlsCertificate)
 		}
-	}
-	if isNode {
-		for index, node := range def.Nodes {
-			nid, err := id.Unmarshal(node.ID)
-			if err != nil {
-				return err
-			}
-			//check if an ip override is registered
-			addr := i.ipOverride.CheckOverride(nid, node.Address)
-
-			//check if the host exists
-			host, ok := i.comm.GetHost(nid)
-			if !ok {
-
-				// Check if isNode ID collides with an existing hard coded ID
-				if id.CollidesWithHardCodedID(nid) {
-					return errors.Errorf("Node ID invalid, collides with a "+
-						"hard coded ID. Invalid ID: %v", nid.Marshal())
-				}
-
-				host, err := i.comm.AddHost(nid, addr, []byte(node.TlsCertificate), connect.GetDefaultHostParams())
-				if err != nil {
-					return errors.WithMessagef(err, "Could not add isNode host %s", nid)
-				}
-
-				// 10k batch size * 8192 packet size * 2
-				host.SetWindowSize(connect.MaxWindowSize)
-
-				// Send events into Node Listener
-				if i.addNode != nil {
-					ng := NodeGateway{
-						Node:    node,
-						Gateway: def.Gateways[index],
-					}
-
-					select {
-					case i.addNode <- ng:
-					default:
-						jww.WARN.Printf("Unable to send AddNode event for id %s", nid.String())
-					}
+		if isGateway && index < len(def.Gateways) {
+			gateway := def.Gateways[index]
+			gwid := nid.DeepCopy()
+			gwid.SetType(id.Gateway)
+			if added[*gwid] && i.addGateway != nil {
+				select {
+				case i.addGateway <- NodeGateway{Node: node, Gateway: gateway}:
+				default:
+					jww.WARN.Printf("Unable to send AddGateway event for id %s", gwid.String())
 				}
-
-			} else if host.GetAddress() != addr {
-				host.UpdateAddress(addr)
 			}
+			observeCert(l, gwid, opts.Address(gwid, gateway.Address),
+				gateway.TlsCertificate)
 		}
 	}
 	return nil
 }
 
+// observeCert submits the certificate of the host to the certificate log,
+// if set.
+func observeCert(l *certLog.Log, hid *id.ID, address, cert string) {
+	if _, err := l.Observe(hid, address, cert); err != nil {
+		jww.WARN.Printf("%+v", err)
+	}
+}
+
 // SetGatewayAuth will force authentication on all communications with gateways
 // intended for use between Gateway <-> Gateway communications
 func (i *Instance) SetGatewayAuthentication() {
@@ -45,6 +45,12 @@ func (sndf *SecuredNdf) update(m *pb.NDF, key *rsa.PublicKey) error {
 	return sndf.f.Update(m)
 }
 
+// set the checks an ndf must pass, in addition to its signature, to be
+// accepted by update
+func (sndf *SecuredNdf) setValidation(v ds.NdfValidation) {
+	sndf.f.SetValidation(v)
+}
+
 // Get the primitives object for an ndf
 func (sndf *SecuredNdf) Get() *ndf.NetworkDefinition {
 	return sndf.f.Get()
@@ -64,3 +70,8 @@ func (sndf *SecuredNdf) GetPb() *pb.NDF {
 func (sndf *SecuredNdf) CompareHash(h []byte) bool {
 	return sndf.f.CompareHash(h)
 }
+
+// Get the nodes and gateways which differ in a newer ndf
+func (sndf *SecuredNdf) Diff(newer *SecuredNdf) ds.NdfDiff {
+	return sndf.f.Diff(newer.f)
+}
@@ -0,0 +1,106 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains server -> gateway address update functionality
+
+package node
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/primitives/netTime"
+)
+
+// SendAddressUpdate notifies the gateway that the addresses of the node's
+// team have changed mid-round, e.g. because a node was replaced, so it can
+// update its hosts without waiting for the next permissioning poll. The NDF
+// passed should be the one signed by permissioning so the gateway can verify
+// it independently of the node.
+func (s *Comms) SendAddressUpdate(host *connect.Host,
+	update *pb.NDF) (*messages.Ack, error) {
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+
+		// Pack the message as an authenticated message
+		authMsg, err := s.PackAuthenticatedMessage(update, host, false)
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+
+		// Send the message
+		resultMsg, err := pb.NewGatewayClient(s.Interceptors.ClientConn(conn.GetGrpcConn())).
+			NotifyAddressUpdate(ctx, authMsg)
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	// Execute the Send function
+	jww.TRACE.Printf("Sending Address Update message to %s", host.GetId())
+	resultMsg, err := s.Send(host, f)
+	if err != nil {
+		return nil, err
+	}
+
+	// Marshall the result
+	result := &messages.Ack{}
+	return result, ptypes.UnmarshalAny(resultMsg, result)
+}
+
+// SendHostAddressUpdate tells the gateway that this node's address has
+// changed, so it can reach the node before the next NDF lists the new
+// address. The update is signed by this node so the gateway can verify it
+// against the node's cert.
+func (s *Comms) SendHostAddressUpdate(host *connect.Host,
+	address string) (*messages.Ack, error) {
+	update := pb.NewAddressUpdate(s.GetId(), address, netTime.Now())
+	if err := update.Sign(s.GetPrivateKey()); err != nil {
+		return nil, errors.Errorf("Failed to sign address update: %+v", err)
+	}
+
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+
+		// Pack the message as an authenticated message
+		authMsg, err := s.PackAuthenticatedMessage(update, host, false)
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+
+		// Send the message
+		resultMsg, err := pb.NewGatewayClient(s.Interceptors.ClientConn(conn.GetGrpcConn())).
+			UpdateHostAddress(ctx, authMsg)
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	// Execute the Send function
+	jww.TRACE.Printf("Sending Host Address Update message to %s",
+		host.GetId())
+	resultMsg, err := s.Send(host, f)
+	if err != nil {
+		return nil, err
+	}
+
+	// Marshall the result
+	result := &messages.Ack{}
+	return result, ptypes.UnmarshalAny(resultMsg, result)
+}
@@ -16,7 +16,9 @@ import (
 	"github.com/golang/protobuf/ptypes"
 	"github.com/pkg/errors"
 	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/elixxir/comms/capability"
 	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/priority"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/comms/messages"
 	"google.golang.org/grpc/metadata"
@@ -93,6 +95,7 @@ func (s *Comms) getPrecompTestBatchContext(info *pb.RoundInfo) (context.Context,
 	context.CancelFunc) {
 	// Create streaming context so you can close stream later
 	ctx, cancel := connect.StreamingContext()
+	ctx = priority.AppendToOutgoingContext(ctx, priority.Precomputation)
 
 	encodedStr := base64.StdEncoding.EncodeToString([]byte(info.String()))
 
@@ -114,7 +117,7 @@ func (s *Comms) getPrecompTestBatchStream(host *connect.Host,
 		ctx = s.PackAuthenticatedContext(host, ctx)
 
 		// Get the stream client
-		streamClient, err := pb.NewNodeClient(conn.GetGrpcConn()).
+		streamClient, err := pb.NewNodeClient(s.Interceptors.ClientConn(conn.GetGrpcConn())).
 			PrecompTestBatch(ctx)
 		if err != nil {
 			return nil, errors.New(err.Error())
@@ -125,7 +128,7 @@ func (s *Comms) getPrecompTestBatchStream(host *connect.Host,
 	jww.TRACE.Printf("Streaming PrecompTestBatch")
 
 	// Execute the Stream function
-	resultClient, err := s.ProtoComms.Stream(host, f)
+	resultClient, err := s.Stream(host, f)
 	if err != nil {
 		return nil, err
 	}
@@ -143,7 +146,7 @@ func (s *Comms) PrecompTestBatch(stream pb.Node_PrecompTestBatchServer) error {
 		return errors.Errorf("Unable to extract authentication info: %+v", err)
 	}
 
-	authState, err := s.AuthenticatedReceiver(authMsg, stream.Context())
+	authState, err := s.authenticatedReceiver(authMsg, stream.Context())
 	if err != nil {
 		return errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
 	}
@@ -190,13 +193,47 @@ func (s *Comms) UploadUnmixedBatch(server pb.Node_UploadUnmixedBatchServer) erro
 		return errors.Errorf("Unable to extract authentication info: %+v", err)
 	}
 
-	authState, err := s.AuthenticatedReceiver(authMsg, server.Context())
+	authState, err := s.authenticatedReceiver(authMsg, server.Context())
 	if err != nil {
 		return errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
 	}
 
-	// Verify the message authentication
-	return s.handler.UploadUnmixedBatch(server, authState)
+	// Check the gateway has been authorized by permissioning
+	if s.Capabilities != nil {
+		if !authState.IsAuthenticated {
+			return connect.AuthError(authState.Sender.GetId())
+		}
+		err = s.Capabilities.VerifyContext(server.Context(),
+			authState.Sender.GetId(), capability.UploadUnmixedBatch)
+		if err != nil {
+			return errors.Errorf("Unauthorized batch upload: %+v", err)
+		}
+	}
+
+	// Uploads without an idempotency key are always processed. Keys are only
+	// honoured from authenticated senders, scoped to their authenticated ID,
+	// so that other callers cannot claim or replay them.
+	if !authState.IsAuthenticated {
+		return s.handler.UploadUnmixedBatch(server, authState)
+	}
+	key := batchKey(server, authState.Sender.GetId())
+	if key == "" {
+		return s.handler.UploadUnmixedBatch(server, authState)
+	}
+
+	// Acknowledge retries of an upload which was already processed
+	upload, process, err := s.batches.await(server.Context(), key)
+	if err != nil {
+		return err
+	}
+	if !process {
+		s.handler.DuplicateUnmixedBatch(key, authState)
+		return server.SendAndClose(&messages.Ack{})
+	}
+
+	err = s.handler.UploadUnmixedBatch(server, authState)
+	s.batches.finish(key, upload, err)
+	return err
 }
 
 // GetUnmixedBatchStreamHeader gets the header in the metadata from
@@ -209,8 +246,13 @@ func GetUnmixedBatchStreamHeader(stream pb.Node_UploadUnmixedBatchServer) (*pb.B
 		return nil, errors.New("unable to retrieve meta data / header")
 	}
 
+	values := md.Get(pb.UnmixedBatchHeader)
+	if len(values) == 0 {
+		return nil, errors.Errorf(pb.NoStreamingHeaderErr, "UploadUnmixedBatch")
+	}
+
 	// Unmarshall the header into a message
-	marshledBatch, err := base64.StdEncoding.DecodeString(md.Get(pb.UnmixedBatchHeader)[0])
+	marshledBatch, err := base64.StdEncoding.DecodeString(values[0])
 	if err != nil {
 		return nil, err
 	}
@@ -229,7 +271,7 @@ func GetUnmixedBatchStreamHeader(stream pb.Node_UploadUnmixedBatchServer) (*pb.B
 func (s *Comms) DownloadMixedBatch(authMsg *messages.AuthenticatedMessage,
 	stream pb.Node_DownloadMixedBatchServer) error {
 
-	authState, err := s.AuthenticatedReceiver(authMsg, stream.Context())
+	authState, err := s.authenticatedReceiver(authMsg, stream.Context())
 	if err != nil {
 		return errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
 	}
@@ -10,13 +10,19 @@
 package node
 
 import (
+	"context"
+
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/pkg/errors"
 	jww "github.com/spf13/jwalterweatherman"
 	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/priority"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/primitives/netTime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 // Server -> Server error function
@@ -34,7 +40,7 @@ func (s *Comms) SendRoundError(host *connect.Host, message *pb.RoundError) (*mes
 		}
 
 		// Send the message
-		resultMsg, err := pb.NewNodeClient(conn.GetGrpcConn()).
+		resultMsg, err := pb.NewNodeClient(s.Interceptors.ClientConn(conn.GetGrpcConn())).
 			RoundError(ctx, authMsg)
 		if err != nil {
 			return nil, errors.New(err.Error())
@@ -62,13 +68,14 @@ func (s *Comms) SendGetMeasure(host *connect.Host,
 		// Set up the context
 		ctx, cancel := host.GetMessagingContext()
 		defer cancel()
+		ctx = priority.AppendToOutgoingContext(ctx, priority.Metrics)
 		// Format to authenticated message type
 		authMsg, err := s.PackAuthenticatedMessage(message, host, false)
 		if err != nil {
 			return nil, errors.New(err.Error())
 		}
 		// Send the message
-		resultMsg, err := pb.NewNodeClient(conn.GetGrpcConn()).
+		resultMsg, err := pb.NewNodeClient(s.Interceptors.ClientConn(conn.GetGrpcConn())).
 			GetMeasure(ctx, authMsg)
 		if err != nil {
 			return nil, errors.New(err.Error())
@@ -103,7 +110,7 @@ func (s *Comms) SendAskOnline(host *connect.Host) (*messages.Ack, error) {
 		}
 
 		// Send the message
-		resultMsg, err := pb.NewNodeClient(conn.GetGrpcConn()).
+		resultMsg, err := pb.NewNodeClient(s.Interceptors.ClientConn(conn.GetGrpcConn())).
 			AskOnline(ctx, authMsg)
 		if err != nil {
 			return nil, errors.New(err.Error())
@@ -126,20 +133,33 @@ func (s *Comms) SendAskOnline(host *connect.Host) (*messages.Ack, error) {
 // Server -> Server Send Function
 func (s *Comms) SendNewRound(host *connect.Host,
 	message *pb.RoundInfo) (*messages.Ack, error) {
+	pm, err := PrepareMessage(message)
+	if err != nil {
+		return nil, err
+	}
+	return s.SendNewRoundPrepared(host, pm)
+}
+
+// SendNewRoundPrepared sends a RoundInfo prepared by PrepareMessage, so a
+// round can be sent to each team member without re-marshalling it
+func (s *Comms) SendNewRoundPrepared(host *connect.Host,
+	pm *PreparedMessage) (*messages.Ack, error) {
 
 	// Create the Send Function
 	f := func(conn connect.Connection) (*any.Any, error) {
 		// Set up the context
 		ctx, cancel := host.GetMessagingContext()
 		defer cancel()
+		ctx = priority.AppendToOutgoingContext(ctx,
+			priority.Precomputation)
 		// Format to authenticated message type
-		authMsg, err := s.PackAuthenticatedMessage(message, host, false)
+		authMsg, err := s.packPrepared(pm, host)
 		if err != nil {
 			return nil, errors.New(err.Error())
 		}
 
 		// Send the message
-		resultMsg, err := pb.NewNodeClient(conn.GetGrpcConn()).
+		resultMsg, err := pb.NewNodeClient(s.Interceptors.ClientConn(conn.GetGrpcConn())).
 			CreateNewRound(ctx, authMsg)
 		if err != nil {
 			return nil, errors.New(err.Error())
@@ -148,7 +168,7 @@ func (s *Comms) SendNewRound(host *connect.Host,
 	}
 
 	// Execute the Send function
-	jww.TRACE.Printf("Sending New Round message: %+v", message)
+	jww.TRACE.Printf("Sending New Round message: %+v", pm.GetMessage())
 	resultMsg, err := s.Send(host, f)
 	if err != nil {
 		return nil, err
@@ -162,12 +182,31 @@ func (s *Comms) SendNewRound(host *connect.Host,
 // Server -> Server Send Function
 func (s *Comms) SendPostPrecompResult(host *connect.Host,
 	roundID uint64, numSlots uint32) (*messages.Ack, error) {
+	return s.sendPostPrecompResult(host, roundID, numSlots,
+		host.GetMessagingContext)
+}
+
+// SendPostPrecompResultWithContext is SendPostPrecompResult bound by the
+// deadline and cancellation of ctx rather than the send timeout of the host,
+// for rounds with large batches.
+func (s *Comms) SendPostPrecompResultWithContext(ctx context.Context,
+	host *connect.Host, roundID uint64, numSlots uint32) (*messages.Ack, error) {
+	return s.sendPostPrecompResult(host, roundID, numSlots,
+		parentContext(ctx))
+}
+
+// sendPostPrecompResult sends the result with the context returned by
+// newContext.
+func (s *Comms) sendPostPrecompResult(host *connect.Host, roundID uint64,
+	numSlots uint32, newContext contextFunc) (*messages.Ack, error) {
 
 	// Create the Send Function
 	f := func(conn connect.Connection) (*any.Any, error) {
 		// Set up the context
-		ctx, cancel := host.GetMessagingContext()
+		ctx, cancel := newContext()
 		defer cancel()
+		ctx = priority.AppendToOutgoingContext(ctx,
+			priority.Precomputation)
 
 		// Pack the message as an authenticated message
 		batchMsg := &pb.PostPrecompResult{
@@ -180,7 +219,7 @@ func (s *Comms) SendPostPrecompResult(host *connect.Host,
 		}
 
 		// Send the message
-		resultMsg, err := pb.NewNodeClient(conn.GetGrpcConn()).
+		resultMsg, err := pb.NewNodeClient(s.Interceptors.ClientConn(conn.GetGrpcConn())).
 			PostPrecompResult(ctx, authMsg)
 		if err != nil {
 			return nil, errors.New(err.Error())
@@ -209,6 +248,7 @@ func (s *Comms) RoundTripPing(host *connect.Host, rtPing *pb.RoundTripPing) (*me
 		// Set up the context
 		ctx, cancel := host.GetMessagingContext()
 		defer cancel()
+		ctx = priority.AppendToOutgoingContext(ctx, priority.Metrics)
 
 		// Pack the message as an authenticated message
 		authMsg, err := s.PackAuthenticatedMessage(rtPing, host, false)
@@ -217,12 +257,21 @@ func (s *Comms) RoundTripPing(host *connect.Host, rtPing *pb.RoundTripPing) (*me
 		}
 
 		// Send the message
-		resultMsg, err := pb.NewNodeClient(conn.GetGrpcConn()).
+		sent := netTime.Now()
+		ctx = metadata.AppendToOutgoingContext(ctx,
+			pb.RoundTripPingSentHeader, pb.FormatHeaderTime(sent))
+		var header metadata.MD
+		resultMsg, err := pb.NewNodeClient(s.Interceptors.ClientConn(conn.GetGrpcConn())).
 			SendRoundTripPing(ctx,
-				authMsg)
+				authMsg, grpc.Header(&header))
 		if err != nil {
 			return nil, errors.New(err.Error())
 		}
+
+		err = s.recordRoundTripPing(host, rtPing, sent, header)
+		if err != nil {
+			return nil, err
+		}
 		return ptypes.MarshalAny(resultMsg)
 	}
 
@@ -241,20 +290,33 @@ func (s *Comms) RoundTripPing(host *connect.Host, rtPing *pb.RoundTripPing) (*me
 
 // Server -> Server initiating multi-party round DH key generation
 func (s *Comms) SendStartSharePhase(host *connect.Host, ri *pb.RoundInfo) (*messages.Ack, error) {
+	pm, err := PrepareMessage(ri)
+	if err != nil {
+		return nil, err
+	}
+	return s.SendStartSharePhasePrepared(host, pm)
+}
+
+// SendStartSharePhasePrepared sends a RoundInfo prepared by PrepareMessage to
+// start the share phase, so it is marshalled once for the whole team
+func (s *Comms) SendStartSharePhasePrepared(host *connect.Host,
+	pm *PreparedMessage) (*messages.Ack, error) {
 	// Create the Send Function
 	f := func(conn connect.Connection) (*any.Any, error) {
 		// Set up the context
 		ctx, cancel := host.GetMessagingContext()
 		defer cancel()
+		ctx = priority.AppendToOutgoingContext(ctx,
+			priority.Precomputation)
 
 		// Pack the message as an authenticated message
-		authMsg, err := s.PackAuthenticatedMessage(ri, host, false)
+		authMsg, err := s.packPrepared(pm, host)
 		if err != nil {
 			return nil, errors.New(err.Error())
 		}
 
 		// Send the message
-		resultMsg, err := pb.NewNodeClient(conn.GetGrpcConn()).
+		resultMsg, err := pb.NewNodeClient(s.Interceptors.ClientConn(conn.GetGrpcConn())).
 			StartSharePhase(ctx,
 				authMsg)
 		if err != nil {
@@ -265,7 +327,7 @@ func (s *Comms) SendStartSharePhase(host *connect.Host, ri *pb.RoundInfo) (*mess
 
 	// Execute the Send function
 	jww.DEBUG.Printf("Sending Start Share Phase message...")
-	jww.TRACE.Printf("Sending Start Share Phase message: %+v", ri)
+	jww.TRACE.Printf("Sending Start Share Phase message: %+v", pm.GetMessage())
 	resultMsg, err := s.Send(host, f)
 	if err != nil {
 		return nil, err
@@ -284,6 +346,8 @@ func (s *Comms) SendSharePhase(host *connect.Host, sharedPiece *pb.SharePiece) (
 		// Set up the context
 		ctx, cancel := host.GetMessagingContext()
 		defer cancel()
+		ctx = priority.AppendToOutgoingContext(ctx,
+			priority.Precomputation)
 
 		// Pack the message as an authenticated message
 		authMsg, err := s.PackAuthenticatedMessage(sharedPiece, host, false)
@@ -292,7 +356,7 @@ func (s *Comms) SendSharePhase(host *connect.Host, sharedPiece *pb.SharePiece) (
 		}
 
 		// Send the message
-		resultMsg, err := pb.NewNodeClient(conn.GetGrpcConn()).
+		resultMsg, err := pb.NewNodeClient(s.Interceptors.ClientConn(conn.GetGrpcConn())).
 			SharePhaseRound(ctx, authMsg)
 		if err != nil {
 			return nil, errors.New(err.Error())
@@ -321,6 +385,8 @@ func (s *Comms) SendFinalKey(host *connect.Host, sharedPiece *pb.SharePiece) (*m
 		// Set up the context
 		ctx, cancel := host.GetMessagingContext()
 		defer cancel()
+		ctx = priority.AppendToOutgoingContext(ctx,
+			priority.Precomputation)
 
 		// Pack the message as an authenticated message
 		authMsg, err := s.PackAuthenticatedMessage(sharedPiece, host, false)
@@ -329,7 +395,7 @@ func (s *Comms) SendFinalKey(host *connect.Host, sharedPiece *pb.SharePiece) (*m
 		}
 
 		// Send the message
-		resultMsg, err := pb.NewNodeClient(conn.GetGrpcConn()).
+		resultMsg, err := pb.NewNodeClient(s.Interceptors.ClientConn(conn.GetGrpcConn())).
 			ShareFinalKey(ctx, authMsg)
 		if err != nil {
 			return nil, errors.New(err.Error())
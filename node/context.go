@@ -0,0 +1,28 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the contexts of sends with a caller provided deadline
+
+package node
+
+import (
+	"context"
+)
+
+// contextFunc returns the context a send is made with. Sends normally use
+// connect.Host.GetMessagingContext, which times out after the send timeout
+// of the host.
+type contextFunc func() (context.Context, context.CancelFunc)
+
+// parentContext returns a contextFunc deriving the context of each attempt
+// of a send from the caller's context, so that its deadline and cancellation
+// apply in place of the send timeout of the host.
+func parentContext(ctx context.Context) contextFunc {
+	return func() (context.Context, context.CancelFunc) {
+		return context.WithCancel(ctx)
+	}
+}
@@ -12,16 +12,18 @@ package node
 import (
 	"github.com/golang/protobuf/ptypes"
 	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
 	pb "gitlab.com/elixxir/comms/mixmessages"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/primitives/netTime"
 	"golang.org/x/net/context"
 )
 
 // Handle a Broadcasted Ask Online event
 func (s *Comms) AskOnline(ctx context.Context, msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
 	// Verify the message authentication
-	auth, err := s.AuthenticatedReceiver(msg, ctx)
+	auth, err := s.authenticatedReceiver(msg, ctx)
 	if err != nil {
 		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
 	}
@@ -37,21 +39,40 @@ func (s *Comms) AskOnline(ctx context.Context, msg *messages.AuthenticatedMessag
 // Handles validation of reverse-authentication tokens
 func (s *Comms) AuthenticateToken(ctx context.Context,
 	msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
-	return &messages.Ack{}, s.ValidateToken(msg)
+	return &messages.Ack{}, s.AuthMetrics.Validate(msg, s.ProtoComms.GetHost,
+		func(msg *messages.AuthenticatedMessage) error {
+			return s.ChannelBinding.VerifyToken(ctx, msg, s.ValidateToken)
+		})
 }
 
 // Handles reception of reverse-authentication token requests
-func (s *Comms) RequestToken(context.Context, *messages.Ping) (*messages.AssignToken, error) {
+func (s *Comms) RequestToken(ctx context.Context, _ *messages.Ping) (*messages.AssignToken, error) {
 	token, err := s.GenerateToken()
+	if err == nil {
+		err = s.ChannelBinding.Bind(ctx, token)
+	}
+	s.AuthMetrics.RecordIssued(err)
 	return &messages.AssignToken{
 		Token: token,
 	}, err
 }
 
+// authenticatedReceiver wraps AuthenticatedReceiver, additionally checking
+// that the message arrived over the channel the sender's token is bound to
+func (s *Comms) authenticatedReceiver(msg *messages.AuthenticatedMessage,
+	ctx context.Context) (*connect.Auth, error) {
+	auth, err := s.AuthenticatedReceiver(msg, ctx)
+	if err != nil {
+		return auth, err
+	}
+	s.ChannelBinding.Check(ctx, auth)
+	return auth, nil
+}
+
 // Handle a NewRound event
 func (s *Comms) CreateNewRound(ctx context.Context, msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
 	// Verify the message authentication
-	authState, err := s.AuthenticatedReceiver(msg, ctx)
+	authState, err := s.authenticatedReceiver(msg, ctx)
 	if err != nil {
 		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
 	}
@@ -71,7 +92,7 @@ func (s *Comms) CreateNewRound(ctx context.Context, msg *messages.AuthenticatedM
 func (s *Comms) PostPhase(ctx context.Context, msg *messages.AuthenticatedMessage) (*messages.Ack,
 	error) {
 	// Verify the message authentication
-	authState, err := s.AuthenticatedReceiver(msg, ctx)
+	authState, err := s.authenticatedReceiver(msg, ctx)
 	if err != nil {
 		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
 	}
@@ -97,7 +118,7 @@ func (s *Comms) StreamPostPhase(server pb.Node_StreamPostPhaseServer) error {
 		return errors.Errorf("Unable to extract authentication info: %+v", err)
 	}
 
-	authState, err := s.AuthenticatedReceiver(authMsg, server.Context())
+	authState, err := s.authenticatedReceiver(authMsg, server.Context())
 	if err != nil {
 		return errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
 	}
@@ -112,7 +133,7 @@ func (s *Comms) GetRoundBufferInfo(ctx context.Context,
 	*pb.RoundBufferInfo, error) {
 
 	// Verify the message authentication
-	authState, err := s.AuthenticatedReceiver(msg, ctx)
+	authState, err := s.authenticatedReceiver(msg, ctx)
 	if err != nil {
 		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
 	}
@@ -129,7 +150,7 @@ func (s *Comms) RequestClientKey(ctx context.Context,
 	msg *messages.AuthenticatedMessage) (*pb.SignedKeyResponse, error) {
 
 	// Verify the message authentication
-	authState, err := s.AuthenticatedReceiver(msg, ctx)
+	authState, err := s.authenticatedReceiver(msg, ctx)
 	if err != nil {
 		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
 	}
@@ -147,16 +168,38 @@ func (s *Comms) RequestClientKey(ctx context.Context,
 
 	}
 
+	// Attach a receipt the client can use to prove it registered
+	if err == nil && nonce != nil && nonce.Error == "" {
+		nonce.Receipt = s.signRegistrationReceipt(nonce)
+	}
+
 	// Return the NonceMessage
 	return nonce, err
 }
 
+// signRegistrationReceipt signs a receipt over the response. Failure is
+// logged and no receipt is returned rather than failing registration.
+func (s *Comms) signRegistrationReceipt(
+	response *pb.SignedKeyResponse) *pb.RegistrationReceipt {
+	key := s.GetPrivateKey()
+	if key == nil {
+		return nil
+	}
+
+	receipt := pb.NewRegistrationReceipt(response, netTime.Now())
+	if err := receipt.Sign(key); err != nil {
+		jww.WARN.Printf("Failed to sign registration receipt: %+v", err)
+		return nil
+	}
+	return receipt
+}
+
 // PostPrecompResult sends final Message and AD precomputations.
 func (s *Comms) PostPrecompResult(ctx context.Context,
 	msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
 
 	// Verify the message authentication
-	authState, err := s.AuthenticatedReceiver(msg, ctx)
+	authState, err := s.authenticatedReceiver(msg, ctx)
 	if err != nil {
 		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
 	}
@@ -176,7 +219,7 @@ func (s *Comms) PostPrecompResult(ctx context.Context,
 
 func (s *Comms) GetMeasure(ctx context.Context, msg *messages.AuthenticatedMessage) (*pb.RoundMetrics, error) {
 	// Verify the message authentication
-	authState, err := s.AuthenticatedReceiver(msg, ctx)
+	authState, err := s.authenticatedReceiver(msg, ctx)
 	if err != nil {
 		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
 	}
@@ -189,12 +232,21 @@ func (s *Comms) GetMeasure(ctx context.Context, msg *messages.AuthenticatedMessa
 	}
 
 	rm, err := s.handler.GetMeasure(roundInfoMsg, authState)
-	return rm, err
+	if err != nil {
+		return rm, err
+	}
+
+	timings := s.pings.get(roundInfoMsg.GetID())
+	if err = pb.AddRoundTripTimings(rm, timings); err != nil {
+		jww.WARN.Printf("Failed to add round trip pings to metrics for "+
+			"round %d: %+v", roundInfoMsg.GetID(), err)
+	}
+	return rm, nil
 }
 
 // Gateway -> Server unified polling
 func (s *Comms) Poll(ctx context.Context, msg *messages.AuthenticatedMessage) (*pb.ServerPollResponse, error) {
-	authState, err := s.AuthenticatedReceiver(msg, ctx)
+	authState, err := s.authenticatedReceiver(msg, ctx)
 	if err != nil {
 		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
 	}
@@ -209,7 +261,7 @@ func (s *Comms) Poll(ctx context.Context, msg *messages.AuthenticatedMessage) (*
 }
 
 func (s *Comms) RoundError(ctx context.Context, msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
-	authState, err := s.AuthenticatedReceiver(msg, ctx)
+	authState, err := s.authenticatedReceiver(msg, ctx)
 	if err != nil {
 		return nil, errors.Errorf("Unable to handle reception of AuthenticatedMessage: %+v", err)
 	}
@@ -224,10 +276,11 @@ func (s *Comms) RoundError(ctx context.Context, msg *messages.AuthenticatedMessa
 
 func (s *Comms) SendRoundTripPing(ctx context.Context, msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
 	// Verify the message authentication
-	authState, err := s.AuthenticatedReceiver(msg, ctx)
+	authState, err := s.authenticatedReceiver(msg, ctx)
 	if err != nil {
 		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
 	}
+
 	//Marshall the any message to the message type needed
 	roundTripPing := &pb.RoundTripPing{}
 	err = ptypes.UnmarshalAny(msg.Message, roundTripPing)
@@ -235,6 +288,8 @@ func (s *Comms) SendRoundTripPing(ctx context.Context, msg *messages.Authenticat
 		return nil, err
 	}
 
+	s.receiveRoundTripPing(ctx, roundTripPing, authState)
+
 	err = s.handler.SendRoundTripPing(roundTripPing, authState)
 	return &messages.Ack{}, err
 }
@@ -251,7 +306,7 @@ func (s *Comms) GetPermissioningAddress(context.Context, *messages.Ping) (*pb.St
 // Server -> Server initiating multi-party round DH key generation
 func (s *Comms) StartSharePhase(ctx context.Context, msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
 	// Verify the message authentication
-	authState, err := s.AuthenticatedReceiver(msg, ctx)
+	authState, err := s.authenticatedReceiver(msg, ctx)
 	if err != nil {
 		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
 	}
@@ -270,7 +325,7 @@ func (s *Comms) StartSharePhase(ctx context.Context, msg *messages.Authenticated
 // Server -> Server passing state of multi-party round DH key generation
 func (s *Comms) SharePhaseRound(ctx context.Context, msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
 	// Verify the message authentication
-	authState, err := s.AuthenticatedReceiver(msg, ctx)
+	authState, err := s.authenticatedReceiver(msg, ctx)
 	if err != nil {
 		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
 	}
@@ -289,7 +344,7 @@ func (s *Comms) SharePhaseRound(ctx context.Context, msg *messages.Authenticated
 // Server -> Server sending multi-party round DH final key
 func (s *Comms) ShareFinalKey(ctx context.Context, msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
 	// Verify the message authentication
-	authState, err := s.AuthenticatedReceiver(msg, ctx)
+	authState, err := s.authenticatedReceiver(msg, ctx)
 	if err != nil {
 		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
 	}
@@ -304,3 +359,76 @@ func (s *Comms) ShareFinalKey(ctx context.Context, msg *messages.AuthenticatedMe
 	err = s.handler.ShareFinalKey(sharePiece, authState)
 	return &messages.Ack{}, err
 }
+
+// Gateway -> Server reservation of a precomputed round for a batch
+func (s *Comms) ReservePrecomputation(ctx context.Context,
+	msg *messages.AuthenticatedMessage) (*pb.RoundInfo, error) {
+	// Verify the message authentication
+	authState, err := s.authenticatedReceiver(msg, ctx)
+	if err != nil {
+		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
+	}
+
+	// Return an error if the connection is not authenticated
+	if !authState.IsAuthenticated {
+		return nil, connect.AuthError(authState.Sender.GetId())
+	}
+
+	// Marshall the any message to the message type needed
+	request := &pb.BatchInfo{}
+	err = ptypes.UnmarshalAny(msg.Message, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.handler.ReservePrecomputation(request, authState)
+}
+
+// Gateway -> Server confirmation that the batch for a reserved round is
+// being uploaded
+func (s *Comms) ConfirmPrecomputation(ctx context.Context,
+	msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
+	// Verify the message authentication
+	authState, err := s.authenticatedReceiver(msg, ctx)
+	if err != nil {
+		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
+	}
+
+	// Return an error if the connection is not authenticated
+	if !authState.IsAuthenticated {
+		return &messages.Ack{}, connect.AuthError(authState.Sender.GetId())
+	}
+
+	// Marshall the any message to the message type needed
+	round := &pb.RoundInfo{}
+	err = ptypes.UnmarshalAny(msg.Message, round)
+	if err != nil {
+		return nil, err
+	}
+
+	return &messages.Ack{}, s.handler.ConfirmPrecomputation(round, authState)
+}
+
+// Gateway -> Server release of a reserved round which will not be used
+func (s *Comms) ReleasePrecomputation(ctx context.Context,
+	msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
+	// Verify the message authentication
+	authState, err := s.authenticatedReceiver(msg, ctx)
+	if err != nil {
+		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
+	}
+
+	// Return an error if the connection is not authenticated
+	if !authState.IsAuthenticated {
+		return &messages.Ack{}, connect.AuthError(authState.Sender.GetId())
+	}
+
+	// Marshall the any message to the message type needed
+	round := &pb.RoundInfo{}
+	err = ptypes.UnmarshalAny(msg.Message, round)
+	if err != nil {
+		return nil, err
+	}
+
+	return &messages.Ack{}, s.handler.ReleasePrecomputation(round, authState)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/pkg/errors"
 	jww "github.com/spf13/jwalterweatherman"
 	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/priority"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/comms/messages"
 	"google.golang.org/grpc/metadata"
@@ -96,6 +97,7 @@ func (s *Comms) getFinishRealtimeContext(info *pb.RoundInfo) (context.Context,
 
 	// Create streaming context so you can close stream later
 	ctx, cancel := connect.StreamingContext()
+	ctx = priority.AppendToOutgoingContext(ctx, priority.Realtime)
 
 	encodedStr := base64.StdEncoding.EncodeToString([]byte(info.String()))
 
@@ -117,7 +119,7 @@ func (s *Comms) getFinishRealtimeStream(host *connect.Host,
 		ctx = s.PackAuthenticatedContext(host, ctx)
 
 		// Get the stream client
-		streamClient, err := pb.NewNodeClient(conn.GetGrpcConn()).
+		streamClient, err := pb.NewNodeClient(s.Interceptors.ClientConn(conn.GetGrpcConn())).
 			FinishRealtime(ctx)
 		if err != nil {
 			return nil, errors.New(err.Error())
@@ -128,7 +130,7 @@ func (s *Comms) getFinishRealtimeStream(host *connect.Host,
 	jww.TRACE.Printf("Streaming FinishRealtime")
 
 	// Execute the Stream function
-	resultClient, err := s.ProtoComms.Stream(host, f)
+	resultClient, err := s.Stream(host, f)
 	if err != nil {
 		return nil, err
 	}
@@ -148,7 +150,7 @@ func (s *Comms) FinishRealtime(stream pb.Node_FinishRealtimeServer) error {
 		return errors.Errorf("Unable to extract authentication info: %+v", err)
 	}
 
-	authState, err := s.AuthenticatedReceiver(authMsg, stream.Context())
+	authState, err := s.authenticatedReceiver(authMsg, stream.Context())
 	if err != nil {
 		return errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
 	}
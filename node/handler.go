@@ -11,28 +11,104 @@ package node
 
 import (
 	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/elixxir/comms/authMetrics"
+	"gitlab.com/elixxir/comms/buildInfo"
+	"gitlab.com/elixxir/comms/capability"
+	"gitlab.com/elixxir/comms/channelBinding"
+	"gitlab.com/elixxir/comms/chaos"
+	// Registers the compressors the server accepts
+	_ "gitlab.com/elixxir/comms/compression"
+	"gitlab.com/elixxir/comms/drain"
+	"gitlab.com/elixxir/comms/dualStack"
+	"gitlab.com/elixxir/comms/endpointSwitch"
+	"gitlab.com/elixxir/comms/health"
+	"gitlab.com/elixxir/comms/instrumentation"
+	"gitlab.com/elixxir/comms/interceptors"
+	"gitlab.com/elixxir/comms/messageSize"
 	"gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/priority"
+	"gitlab.com/elixxir/comms/streamBudget"
+	"gitlab.com/elixxir/comms/unixSocket"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/comms/interconnect"
 	"gitlab.com/xx_network/comms/messages"
 	"gitlab.com/xx_network/primitives/id"
 	"runtime/debug"
 	"strconv"
+	"time"
 )
 
 // Server object used to implement endpoints and top-level comms functionality
 type Comms struct {
 	*connect.ProtoComms
 	handler Handler
-	*mixmessages.UnimplementedNodeServer
-	*messages.UnimplementedGenericServer
+	// Results of reverse-authentication handshakes with this node
+	AuthMetrics *authMetrics.Tracker
+	// If set, callers of endpoints which require a capability must present
+	// a token issued by permissioning
+	Capabilities *capability.Verifier
+	// If set, reverse-authentication tokens are bound to the TLS session
+	// they are issued over
+	ChannelBinding *channelBinding.Binder
+	// Timings of round trip pings sent and received in recent rounds
+	pings pingTimings
+	// Idempotency keys of recent batch uploads
+	batches batchDedup
+	// Describes what this server is running to remote callers
+	BuildInfo *buildInfo.Server
+	// Liveness and readiness probes of this server
+	Health *health.Server
+	// Interceptors run on the calls received and sent by this server
+	Interceptors *interceptors.Chain
+	// Limits on the size of the messages sent and received by this server
+	MessageSize *messageSize.Limiter
+	// Endpoints disabled at runtime
+	Switches *endpointSwitch.Switches
+	// Schedules requests by the priority their senders give them. It has no
+	// limit until one is set with SetLimit.
+	Priority *priority.Scheduler
+	// Limits the data held by received streams. It has no limit until one is
+	// set with SetLimit.
+	StreamBudget *streamBudget.Budget
+	// Faults injected into calls received by this node, for testing. It has
+	// no rules until some are added.
+	Faults *chaos.Injector
+	mixmessages.UnimplementedNodeServer
+	messages.UnimplementedGenericServer
+	// Callbacks reporting each send
+	sendHooks instrumentation.Hooks
 }
 
+// Comms is checked at compile time to serve its gRPC services
+var (
+	_ mixmessages.NodeServer = (*Comms)(nil)
+	_ messages.GenericServer = (*Comms)(nil)
+)
+
 // Starts a new server on the address:port specified by listeningAddr
 // and a callback interface for server operations
-// with given path to public and private key for TLS connection
+// with given path to public and private key for TLS connection.
+// localServer may list several comma separated addresses, e.g.
+// "0.0.0.0:11420,[::]:11420", to listen on both IPv4 and IPv6.
 func StartNode(id *id.ID, localServer string, interconnectPort int, handler Handler,
 	certPEMblock, keyPEMblock []byte) *Comms {
+	return startNode(id, localServer, interconnectPort, handler,
+		certPEMblock, keyPEMblock, false)
+}
+
+// StartNodeWithWeb starts a node like StartNode, but multiplexes grpc-web
+// with gRPC on the first listening address, so that browser clients can
+// reach it. Extra listening addresses serve gRPC only.
+func StartNodeWithWeb(id *id.ID, localServer string, interconnectPort int,
+	handler Handler, certPEMblock, keyPEMblock []byte) *Comms {
+	return startNode(id, localServer, interconnectPort, handler,
+		certPEMblock, keyPEMblock, true)
+}
+
+// startNode starts a node, serving grpc-web alongside gRPC if web is set.
+func startNode(id *id.ID, localServer string, interconnectPort int,
+	handler Handler, certPEMblock, keyPEMblock []byte, web bool) *Comms {
+	localServer, extraAddresses := dualStack.Split(localServer)
 	pc, err := connect.StartCommServer(id, localServer,
 		certPEMblock, keyPEMblock, nil)
 	if err != nil {
@@ -40,12 +116,29 @@ func StartNode(id *id.ID, localServer string, interconnectPort int, handler Hand
 	}
 
 	mixmessageServer := Comms{
-		ProtoComms: pc,
-		handler:    handler,
+		ProtoComms:  pc,
+		handler:     handler,
+		AuthMetrics: authMetrics.NewTracker(),
 	}
 	// Register GRPC services to the listening address
-	mixmessages.RegisterNodeServer(mixmessageServer.GetServer(), &mixmessageServer)
+	mixmessageServer.Switches = endpointSwitch.NewSwitches()
+	mixmessageServer.Priority = priority.NewScheduler(0, priority.DefaultWeights)
+	mixmessageServer.StreamBudget = streamBudget.NewBudget(0)
+	mixmessageServer.Faults = chaos.NewInjector(0)
+	mixmessageServer.Interceptors = interceptors.New()
+	mixmessageServer.MessageSize = messageSize.NewLimiter(messageSize.Limits{})
+	mixmessageServer.MessageSize.Install(mixmessageServer.Interceptors)
+	mixmessageServer.Switches.Register(mixmessageServer.GetServer(),
+		mixmessageServer.Interceptors.Wrap(mixmessageServer.Faults.Wrap(
+			mixmessageServer.StreamBudget.Wrap(mixmessageServer.Priority.Wrap(
+				&mixmessages.Node_ServiceDesc)))),
+		&mixmessageServer)
+	mixmessageServer.Switches.RegisterAdmin(mixmessageServer.GetServer(), mixmessageServer.authenticatedReceiver)
 	messages.RegisterGenericServer(mixmessageServer.GetServer(), &mixmessageServer)
+	mixmessageServer.BuildInfo = buildInfo.NewServer()
+	mixmessageServer.BuildInfo.Register(mixmessageServer.GetServer())
+	mixmessageServer.Health = health.NewServer(handler)
+	mixmessageServer.Health.Register(mixmessageServer.GetServer())
 
 	// Start up interconnect service
 	if interconnectPort != 0 {
@@ -56,15 +149,51 @@ func StartNode(id *id.ID, localServer string, interconnectPort int, handler Hand
 		jww.WARN.Printf("Port for consensus not set, interconnect not started")
 	}
 
-	pc.Serve()
+	if web {
+		pc.ServeWithWeb()
+	} else {
+		pc.Serve()
+	}
+	if len(extraAddresses) > 0 {
+		if _, err = mixmessageServer.ServeAddresses(extraAddresses...); err != nil {
+			jww.FATAL.Panicf("Unable to start comms server: %+v", err)
+		}
+	}
 	return &mixmessageServer
 }
 
+// ServeAddresses additionally serves the node on the TCP addresses, e.g. an
+// IPv6 address alongside the IPv4 address it was started on.
+func (s *Comms) ServeAddresses(addresses ...string) (*dualStack.Listeners, error) {
+	return dualStack.Serve(s.GetServer(), addresses)
+}
+
+// ServeUnixSocket additionally serves the node on the Unix domain socket at
+// the address, e.g. "unix:///run/xxnetwork/node.sock", so that a gateway on
+// the same machine can reach it without going through TCP.
+func (s *Comms) ServeUnixSocket(address string) (*unixSocket.Listener, error) {
+	return unixSocket.Serve(s.GetServer(), address)
+}
+
+// ShutdownGraceful shuts down the node like Shutdown, but first stops it
+// accepting new RPCs and waits up to the timeout for in-flight RPCs, including
+// active streams, to complete before closing all host connections. Returns
+// true if every in-flight RPC completed in time.
+func (s *Comms) ShutdownGraceful(timeout time.Duration) bool {
+	s.Health.SetDraining()
+	completed := drain.Stop(s.GetServer(), timeout)
+	s.ProtoComms.Shutdown()
+	return completed
+}
+
 type Handler interface {
 	// Server interface for starting New Rounds
 	CreateNewRound(message *mixmessages.RoundInfo, auth *connect.Auth) error
 	// Server interface for sending a new batch
 	UploadUnmixedBatch(server mixmessages.Node_UploadUnmixedBatchServer, auth *connect.Auth) error
+	// Server interface notified when a batch upload is a retry of one
+	// already processed, which is acknowledged without being processed again
+	DuplicateUnmixedBatch(idempotencyKey string, auth *connect.Auth)
 	// Server interface for handling a mixed batch request
 	DownloadMixedBatch(stream mixmessages.Node_DownloadMixedBatchServer,
 		batchInfo *mixmessages.BatchReady, auth *connect.Auth) error
@@ -115,6 +244,17 @@ type Handler interface {
 
 	// Server interface for RequestNonceMessage
 	RequestClientKey(nonceRequest *mixmessages.SignedClientKeyRequest, auth *connect.Auth) (*mixmessages.SignedKeyResponse, error)
+
+	// Gateway -> Server reservation of a precomputed round for a batch of
+	// the given size, returning the reserved round
+	ReservePrecomputation(request *mixmessages.BatchInfo, auth *connect.Auth) (*mixmessages.RoundInfo, error)
+
+	// Gateway -> Server confirmation that the batch for the reserved round
+	// is being uploaded
+	ConfirmPrecomputation(round *mixmessages.RoundInfo, auth *connect.Auth) error
+
+	// Gateway -> Server release of a reserved round which will not be used
+	ReleasePrecomputation(round *mixmessages.RoundInfo, auth *connect.Auth) error
 }
 
 type implementationFunctions struct {
@@ -126,6 +266,8 @@ type implementationFunctions struct {
 	CreateNewRound func(message *mixmessages.RoundInfo, auth *connect.Auth) error
 	// Server interface for sending a new batch
 	UploadUnmixedBatch func(stream mixmessages.Node_UploadUnmixedBatchServer, auth *connect.Auth) error
+	// Server interface notified of retried batch uploads
+	DuplicateUnmixedBatch func(idempotencyKey string, auth *connect.Auth)
 	// Server interface for gateway requesting a new batch
 	DownloadMixedBatch func(stream mixmessages.Node_DownloadMixedBatchServer,
 		batchInfo *mixmessages.BatchReady, auth *connect.Auth) error
@@ -175,6 +317,11 @@ type implementationFunctions struct {
 
 	// Server -> Server sending multi-party round DH key
 	ShareFinalKey func(sharedPiece *mixmessages.SharePiece, auth *connect.Auth) error
+
+	// Gateway -> Server reservation of precomputed rounds
+	ReservePrecomputation func(request *mixmessages.BatchInfo, auth *connect.Auth) (*mixmessages.RoundInfo, error)
+	ConfirmPrecomputation func(round *mixmessages.RoundInfo, auth *connect.Auth) error
+	ReleasePrecomputation func(round *mixmessages.RoundInfo, auth *connect.Auth) error
 }
 
 // Implementation allows users of the client library to set the
@@ -217,6 +364,9 @@ func NewImplementation() *Implementation {
 				warn(um)
 				return nil
 			},
+			DuplicateUnmixedBatch: func(idempotencyKey string, auth *connect.Auth) {
+				warn(um)
+			},
 			DownloadMixedBatch: func(stream mixmessages.Node_DownloadMixedBatchServer,
 				batchInfo *mixmessages.BatchReady, auth *connect.Auth) error {
 				warn(um)
@@ -281,6 +431,18 @@ func NewImplementation() *Implementation {
 				warn(um)
 				return nil
 			},
+			ReservePrecomputation: func(request *mixmessages.BatchInfo, auth *connect.Auth) (*mixmessages.RoundInfo, error) {
+				warn(um)
+				return &mixmessages.RoundInfo{}, nil
+			},
+			ConfirmPrecomputation: func(round *mixmessages.RoundInfo, auth *connect.Auth) error {
+				warn(um)
+				return nil
+			},
+			ReleasePrecomputation: func(round *mixmessages.RoundInfo, auth *connect.Auth) error {
+				warn(um)
+				return nil
+			},
 		},
 	}
 }
@@ -300,6 +462,11 @@ func (s *Implementation) UploadUnmixedBatch(stream mixmessages.Node_UploadUnmixe
 	return s.Functions.UploadUnmixedBatch(stream, auth)
 }
 
+func (s *Implementation) DuplicateUnmixedBatch(idempotencyKey string,
+	auth *connect.Auth) {
+	s.Functions.DuplicateUnmixedBatch(idempotencyKey, auth)
+}
+
 func (s *Implementation) DownloadMixedBatch(stream mixmessages.Node_DownloadMixedBatchServer,
 	batchInfo *mixmessages.BatchReady, auth *connect.Auth) error {
 	return s.Functions.DownloadMixedBatch(stream, batchInfo, auth)
@@ -385,3 +552,18 @@ func (s *Implementation) SharePhaseRound(sharedPiece *mixmessages.SharePiece, au
 func (s *Implementation) ShareFinalKey(sharedPiece *mixmessages.SharePiece, auth *connect.Auth) error {
 	return s.Functions.ShareFinalKey(sharedPiece, auth)
 }
+
+// Gateway -> Server reservation of a precomputed round for a batch
+func (s *Implementation) ReservePrecomputation(request *mixmessages.BatchInfo, auth *connect.Auth) (*mixmessages.RoundInfo, error) {
+	return s.Functions.ReservePrecomputation(request, auth)
+}
+
+// Gateway -> Server confirmation of a reserved round
+func (s *Implementation) ConfirmPrecomputation(round *mixmessages.RoundInfo, auth *connect.Auth) error {
+	return s.Functions.ConfirmPrecomputation(round, auth)
+}
+
+// Gateway -> Server release of a reserved round
+func (s *Implementation) ReleasePrecomputation(round *mixmessages.RoundInfo, auth *connect.Auth) error {
+	return s.Functions.ReleasePrecomputation(round, auth)
+}
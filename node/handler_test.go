@@ -11,6 +11,7 @@ package node
 import (
 	jww "github.com/spf13/jwalterweatherman"
 	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/testutils"
 	"gitlab.com/xx_network/comms/connect"
 	"os"
 	"testing"
@@ -37,3 +38,12 @@ func (m TestInterface) PostPrecompResult(roundID uint64,
 	slots []*pb.Slot) error {
 	return nil
 }
+
+// Tests that every Handler method has an endpoint and every endpoint a
+// Handler method.
+func TestHandler_Endpoints(t *testing.T) {
+	// DuplicateUnmixedBatch is called from UploadUnmixedBatch and GetNDF serves
+	// the gateway over the Generic service.
+	testutils.CheckEndpoints(t, (*Handler)(nil), &pb.Node_ServiceDesc,
+		"DuplicateUnmixedBatch", "GetNDF")
+}
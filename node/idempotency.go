@@ -0,0 +1,119 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the deduplication of retried batch uploads
+
+package node
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// batchKeyTTL is how long the key of a processed batch upload is remembered.
+// Retries arriving after this are processed again.
+const batchKeyTTL = 10 * time.Minute
+
+// batchUpload tracks the processing of one keyed batch upload.
+type batchUpload struct {
+	// Closed once processing finishes
+	done chan struct{}
+	// Set when processing succeeded
+	succeeded bool
+	completed time.Time
+}
+
+// batchDedup remembers the idempotency keys of batch uploads so that retries
+// of an upload which was already processed are not processed again. The zero
+// value is ready to use.
+type batchDedup struct {
+	uploads map[string]*batchUpload
+	mux     sync.Mutex
+}
+
+// batchKey returns the idempotency key in the BatchInfo of the upload, scoped
+// to its sender. It returns an empty string if no key was sent or the
+// BatchInfo cannot be read, in which case the handler reports the error.
+func batchKey(stream pb.Node_UploadUnmixedBatchServer, sender *id.ID) string {
+	info, err := GetUnmixedBatchStreamHeader(stream)
+	if err != nil || info.GetIdempotencyKey() == "" {
+		return ""
+	}
+	return sender.String() + "/" + info.GetIdempotencyKey()
+}
+
+// begin registers the upload with the key. If an upload with the key is
+// already known it is returned instead and ok is false.
+func (bd *batchDedup) begin(key string) (upload *batchUpload, ok bool) {
+	bd.mux.Lock()
+	defer bd.mux.Unlock()
+
+	if bd.uploads == nil {
+		bd.uploads = make(map[string]*batchUpload)
+	}
+
+	// Forget processed uploads which can no longer be retried
+	now := time.Now()
+	for k, u := range bd.uploads {
+		if u.succeeded && now.Sub(u.completed) > batchKeyTTL {
+			delete(bd.uploads, k)
+		}
+	}
+
+	if existing, exists := bd.uploads[key]; exists {
+		return existing, false
+	}
+	upload = &batchUpload{done: make(chan struct{})}
+	bd.uploads[key] = upload
+	return upload, true
+}
+
+// finish records the outcome of processing the upload. The key of a failed
+// upload is forgotten so that a retry is processed.
+func (bd *batchDedup) finish(key string, upload *batchUpload, err error) {
+	bd.mux.Lock()
+	defer bd.mux.Unlock()
+
+	if err == nil {
+		upload.succeeded = true
+		upload.completed = time.Now()
+	} else {
+		delete(bd.uploads, key)
+	}
+	close(upload.done)
+}
+
+// await blocks until the upload with the key has been processed by this
+// caller or a previous one. It returns true if the caller should process the
+// upload, in which case it must call finish once done, and false if a
+// previous upload with the key already succeeded.
+func (bd *batchDedup) await(ctx context.Context, key string) (
+	upload *batchUpload, process bool, err error) {
+	for {
+		upload, process = bd.begin(key)
+		if process {
+			return upload, true, nil
+		}
+
+		select {
+		case <-upload.done:
+		case <-ctx.Done():
+			return nil, false, ctx.Err()
+		}
+
+		bd.mux.Lock()
+		succeeded := upload.succeeded
+		bd.mux.Unlock()
+		if succeeded {
+			return upload, false, nil
+		}
+	}
+}
@@ -18,36 +18,69 @@ import (
 	"github.com/pkg/errors"
 	jww "github.com/spf13/jwalterweatherman"
 	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/priority"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/comms/messages"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
 
+// postPhaseMethod is the full name of the PostPhase RPC.
+const postPhaseMethod = "/mixmessages.Node/PostPhase"
+
 // Server -> Server Send Function
 func (s *Comms) SendPostPhase(host *connect.Host,
 	message *pb.Batch) (*messages.Ack, error) {
+	return s.sendPostPhase(host, message, host.GetMessagingContext)
+}
+
+// SendPostPhaseWithContext is SendPostPhase bound by the deadline and
+// cancellation of ctx rather than the send timeout of the host, for batches
+// too large to send within it.
+func (s *Comms) SendPostPhaseWithContext(ctx context.Context,
+	host *connect.Host, message *pb.Batch) (*messages.Ack, error) {
+	return s.sendPostPhase(host, message, parentContext(ctx))
+}
+
+// sendPostPhase sends the batch with the context returned by newContext.
+func (s *Comms) sendPostPhase(host *connect.Host, message *pb.Batch,
+	newContext contextFunc) (*messages.Ack, error) {
 
 	// Create the Send Function
 	f := func(conn connect.Connection) (*any.Any, error) {
 		// Set up the context
-		ctx, cancel := host.GetMessagingContext()
+		ctx, cancel := newContext()
 		defer cancel()
-		// Format to authenticated message type
-		authMsg, err := s.PackAuthenticatedMessage(message, host, false)
+		ctx = priority.AppendToOutgoingContext(ctx,
+			priority.ForRound(message.GetRound().GetState()))
+		// Format to authenticated message type. The batch is marshalled
+		// straight into the authenticated message rather than into an Any
+		// which is then copied, as batches can be several megabytes.
+		authMsg, err := s.PackAuthenticatedMessage(&messages.Ack{}, host,
+			false)
 		if err != nil {
 			return nil, errors.New(err.Error())
 		}
+		raw, release, err := pb.MarshalAuthenticated(authMsg, message)
+		if err != nil {
+			return nil, err
+		}
 		// Send the message
-		resultMsg, err := pb.NewNodeClient(conn.GetGrpcConn()).
-			PostPhase(ctx, authMsg)
+		resultMsg := &messages.Ack{}
+		err = s.Interceptors.ClientConn(conn.GetGrpcConn()).Invoke(ctx,
+			postPhaseMethod, raw, resultMsg, grpc.ForceCodec(pb.RawCodec))
 		if err != nil {
+			// The transport may still hold the buffer of a failed call, so
+			// it is left to the garbage collector
 			return nil, errors.New(err.Error())
 		}
+		release()
 		return ptypes.MarshalAny(resultMsg)
 	}
 
 	// Execute the Send function
-	jww.TRACE.Printf("Sending Post Phase message: %+v", message)
+	jww.TRACE.Printf("Sending Post Phase message for round %d with %d "+
+		"slots", message.GetRound().GetID(), len(message.GetSlots()))
 	resultMsg, err := s.Send(host, f)
 	if err != nil {
 		return nil, err
@@ -82,6 +115,8 @@ func (s *Comms) getPostPhaseStreamContext(batchInfo *pb.BatchInfo) (
 
 	// Create streaming context so you can close stream later
 	ctx, cancel := connect.StreamingContext()
+	ctx = priority.AppendToOutgoingContext(ctx,
+		priority.ForRound(batchInfo.GetRound().GetState()))
 
 	encodedStr := base64.StdEncoding.EncodeToString([]byte(batchInfo.String()))
 
@@ -104,7 +139,7 @@ func (s *Comms) getPostPhaseStream(host *connect.Host,
 		ctx = s.PackAuthenticatedContext(host, ctx)
 
 		// Get the stream client
-		streamClient, err := pb.NewNodeClient(conn.GetGrpcConn()).
+		streamClient, err := pb.NewNodeClient(s.Interceptors.ClientConn(conn.GetGrpcConn())).
 			StreamPostPhase(ctx)
 		if err != nil {
 			return nil, errors.New(err.Error())
@@ -0,0 +1,144 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains tracking of precomputed rounds reserved for batches
+
+package node
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// reservation is a precomputed round reserved for the batch of a holder.
+type reservation struct {
+	holder  *id.ID
+	expires time.Time
+	// Confirmed reservations do not expire
+	confirmed bool
+}
+
+// PrecompReservations tracks which precomputed rounds are reserved for a
+// batch, so a Handler can hand each precomputation to one batch only. A
+// round is reserved, then confirmed once its batch is being uploaded, or
+// released if it will not be used. Unconfirmed reservations expire.
+type PrecompReservations struct {
+	ttl          time.Duration
+	reservations map[uint64]*reservation
+	// Replaced in tests
+	now func() time.Time
+	mux sync.Mutex
+}
+
+// NewPrecompReservations returns a tracker whose unconfirmed reservations
+// expire after the ttl.
+func NewPrecompReservations(ttl time.Duration) *PrecompReservations {
+	return &PrecompReservations{
+		ttl:          ttl,
+		reservations: make(map[uint64]*reservation),
+		now:          time.Now,
+	}
+}
+
+// Reserve reserves the first of the precomputed rounds which is not already
+// reserved for the holder and returns its ID. Rounds should be passed in the
+// order they are to be used.
+func (pr *PrecompReservations) Reserve(rounds []uint64,
+	holder *id.ID) (uint64, error) {
+	pr.mux.Lock()
+	defer pr.mux.Unlock()
+
+	now := pr.now()
+	for _, roundID := range rounds {
+		if pr.heldUnsafe(roundID, now) {
+			continue
+		}
+		pr.reservations[roundID] = &reservation{
+			holder:  holder.DeepCopy(),
+			expires: now.Add(pr.ttl),
+		}
+		return roundID, nil
+	}
+	return 0, errors.Errorf("None of %d precomputed rounds are free to "+
+		"reserve", len(rounds))
+}
+
+// Confirm confirms the reservation of the round by the holder, after which
+// it no longer expires.
+func (pr *PrecompReservations) Confirm(roundID uint64, holder *id.ID) error {
+	pr.mux.Lock()
+	defer pr.mux.Unlock()
+
+	r, err := pr.getUnsafe(roundID, holder)
+	if err != nil {
+		return err
+	}
+	r.confirmed = true
+	return nil
+}
+
+// Release releases the reservation of the round by the holder, so it can be
+// reserved again.
+func (pr *PrecompReservations) Release(roundID uint64, holder *id.ID) error {
+	pr.mux.Lock()
+	defer pr.mux.Unlock()
+
+	if _, err := pr.getUnsafe(roundID, holder); err != nil {
+		return err
+	}
+	delete(pr.reservations, roundID)
+	return nil
+}
+
+// Done forgets the reservation of the round once its realtime has started
+// and the precomputation has been used.
+func (pr *PrecompReservations) Done(roundID uint64) {
+	pr.mux.Lock()
+	defer pr.mux.Unlock()
+	delete(pr.reservations, roundID)
+}
+
+// IsReserved returns true if the round is reserved for a batch.
+func (pr *PrecompReservations) IsReserved(roundID uint64) bool {
+	pr.mux.Lock()
+	defer pr.mux.Unlock()
+	return pr.heldUnsafe(roundID, pr.now())
+}
+
+// heldUnsafe returns true if the round has an unexpired reservation,
+// forgetting the reservation if it has expired. Must be called under the
+// lock.
+func (pr *PrecompReservations) heldUnsafe(roundID uint64,
+	now time.Time) bool {
+	r, exists := pr.reservations[roundID]
+	if !exists {
+		return false
+	}
+	if !r.confirmed && now.After(r.expires) {
+		delete(pr.reservations, roundID)
+		return false
+	}
+	return true
+}
+
+// getUnsafe returns the unexpired reservation of the round, or an error if
+// it is not held by the holder. Must be called under the lock.
+func (pr *PrecompReservations) getUnsafe(roundID uint64,
+	holder *id.ID) (*reservation, error) {
+	if !pr.heldUnsafe(roundID, pr.now()) {
+		return nil, errors.Errorf("Round %d is not reserved", roundID)
+	}
+	r := pr.reservations[roundID]
+	if !r.holder.Cmp(holder) {
+		return nil, errors.Errorf("Round %d is reserved by %s, not %s",
+			roundID, r.holder, holder)
+	}
+	return r, nil
+}
@@ -0,0 +1,70 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package node
+
+import (
+	"testing"
+	"time"
+
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// Tests that reserved rounds are not handed to a second batch until they are
+// released or expire.
+func TestPrecompReservations_Reserve(t *testing.T) {
+	gw1 := id.NewIdFromString("gateway1", id.Gateway, t)
+	gw2 := id.NewIdFromString("gateway2", id.Gateway, t)
+	now := time.Unix(0, 0)
+	pr := NewPrecompReservations(time.Minute)
+	pr.now = func() time.Time { return now }
+
+	first, err := pr.Reserve([]uint64{1, 2}, gw1)
+	if err != nil || first != 1 {
+		t.Fatalf("Reserved round %d (%v), expected 1.", first, err)
+	}
+	second, err := pr.Reserve([]uint64{1, 2}, gw2)
+	if err != nil || second != 2 {
+		t.Fatalf("Reserved round %d (%v), expected 2.", second, err)
+	}
+	if _, err = pr.Reserve([]uint64{1, 2}, gw2); err == nil {
+		t.Errorf("Reserved a round when none were free.")
+	}
+
+	// Only the holder may confirm or release
+	if err = pr.Release(1, gw2); err == nil {
+		t.Errorf("Released a round reserved by another gateway.")
+	}
+	if err = pr.Confirm(2, gw2); err != nil {
+		t.Errorf("Failed to confirm round 2: %+v", err)
+	}
+
+	// The unconfirmed reservation expires, the confirmed one does not
+	now = now.Add(2 * time.Minute)
+	if pr.IsReserved(1) {
+		t.Errorf("Unconfirmed reservation did not expire.")
+	}
+	if !pr.IsReserved(2) {
+		t.Errorf("Confirmed reservation expired.")
+	}
+	if err = pr.Confirm(1, gw1); err == nil {
+		t.Errorf("Confirmed an expired reservation.")
+	}
+
+	if err = pr.Release(2, gw2); err != nil {
+		t.Errorf("Failed to release round 2: %+v", err)
+	}
+	if pr.IsReserved(2) {
+		t.Errorf("Released round is still reserved.")
+	}
+
+	pr.Reserve([]uint64{3}, gw1)
+	pr.Done(3)
+	if pr.IsReserved(3) {
+		t.Errorf("Used round is still reserved.")
+	}
+}
@@ -0,0 +1,63 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains messages which are marshalled once and sent to many servers
+
+package node
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/pkg/errors"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/messages"
+)
+
+// PreparedMessage holds a message already marshalled into an Any so that it
+// can be broadcast to every member of a team without being re-marshalled for
+// each send. It must not be modified after it is prepared and is safe to
+// share between concurrent sends.
+//
+// Only the message is cached; the authentication wrapper still carries each
+// host's own token, so it is built per send.
+type PreparedMessage struct {
+	msg    proto.Message
+	anyMsg *any.Any
+}
+
+// PrepareMessage marshals the message for use in one or more prepared sends.
+func PrepareMessage(msg proto.Message) (*PreparedMessage, error) {
+	anyMsg, err := ptypes.MarshalAny(msg)
+	if err != nil {
+		return nil, errors.Errorf("Failed to prepare message: %+v", err)
+	}
+
+	return &PreparedMessage{
+		msg:    msg,
+		anyMsg: anyMsg,
+	}, nil
+}
+
+// GetMessage returns the message that was prepared.
+func (pm *PreparedMessage) GetMessage() proto.Message {
+	return pm.msg
+}
+
+// packPrepared wraps the prepared message in an AuthenticatedMessage for the
+// host. The wrapper is built around an empty message, which is cheap to
+// marshal, and the prepared Any is then swapped in.
+func (s *Comms) packPrepared(pm *PreparedMessage,
+	host *connect.Host) (*messages.AuthenticatedMessage, error) {
+	authMsg, err := s.PackAuthenticatedMessage(&messages.Ping{}, host, false)
+	if err != nil {
+		return nil, err
+	}
+
+	authMsg.Message = pm.anyMsg
+	return authMsg, nil
+}
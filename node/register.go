@@ -28,7 +28,7 @@ func (s *Comms) SendNodeRegistration(host *connect.Host,
 		defer cancel()
 
 		// Send the message
-		_, err := pb.NewRegistrationClient(conn.GetGrpcConn()).
+		_, err := pb.NewRegistrationClient(s.Interceptors.ClientConn(conn.GetGrpcConn())).
 			RegisterNode(ctx, message)
 		if err != nil {
 			err = errors.New(err.Error())
@@ -58,7 +58,7 @@ func (s *Comms) SendPoll(host *connect.Host,
 		}
 
 		// Send the message
-		resultMsg, err := pb.NewRegistrationClient(conn.GetGrpcConn()).
+		resultMsg, err := pb.NewRegistrationClient(s.Interceptors.ClientConn(conn.GetGrpcConn())).
 			Poll(ctx, authMsg)
 		if err != nil {
 			return nil, errors.New(err.Error())
@@ -88,7 +88,7 @@ func (s *Comms) SendRegistrationCheck(host *connect.Host,
 		defer cancel()
 
 		// Send the message
-		resultMsg, err := pb.NewRegistrationClient(conn.GetGrpcConn()).
+		resultMsg, err := pb.NewRegistrationClient(s.Interceptors.ClientConn(conn.GetGrpcConn())).
 			CheckRegistration(ctx, message)
 		if err != nil {
 			return nil, errors.New(err.Error())
@@ -119,7 +119,7 @@ func (s *Comms) SendAuthorizerAuth(host *connect.Host,
 		defer cancel()
 
 		// Send the message
-		resultMsg, err := pb.NewAuthorizerClient(conn.GetGrpcConn()).
+		resultMsg, err := pb.NewAuthorizerClient(s.Interceptors.ClientConn(conn.GetGrpcConn())).
 			Authorize(ctx, message)
 		if err != nil {
 			return nil, errors.New(err.Error())
@@ -139,3 +139,32 @@ func (s *Comms) SendAuthorizerAuth(host *connect.Host,
 	result := &messages.Ack{}
 	return result, ptypes.UnmarshalAny(resultMsg, result)
 }
+
+// Server -> Registration export of the structured metrics of a round
+func (s *Comms) SendRoundMetrics(host *connect.Host,
+	report *pb.RoundMetricsReport) error {
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+		// Pack the message for server
+		authMsg, err := s.PackAuthenticatedMessage(report, host, false)
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+
+		// Send the message
+		resultMsg, err := pb.NewRegistrationClient(s.Interceptors.ClientConn(conn.GetGrpcConn())).
+			ReportRoundMetrics(ctx, authMsg)
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	// Execute the Send function
+	jww.TRACE.Printf("Sending round metrics for round %d", report.RoundID)
+	_, err := s.Send(host, f)
+	return err
+}
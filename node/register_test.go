@@ -73,6 +73,44 @@ func TestComms_SendPoll(t *testing.T) {
 	}
 }
 
+// Tests that round metrics from an unauthenticated node are rejected without
+// reaching the handler.
+func TestComms_SendRoundMetrics_Unauthenticated(t *testing.T) {
+	RegAddress := getNextServerAddress()
+	testId := id.NewIdFromString("test", id.Generic, t)
+	server := StartNode(testId, getNextServerAddress(), 0, NewImplementation(),
+		nil, nil)
+	impl := registration.NewImplementation()
+	called := false
+	impl.Functions.ReportRoundMetrics = func(*pb.RoundMetricsReport,
+		*connect.Auth) error {
+		called = true
+		return nil
+	}
+	reg := registration.StartRegistrationServer(testId, RegAddress, impl, nil, nil, nil)
+	defer server.Shutdown()
+	defer reg.Shutdown()
+	manager := connect.NewManagerTesting(t)
+
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(testId, RegAddress, nil, params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+
+	report := &pb.RoundMetricsReport{
+		RoundID: 42,
+		Phases:  []*pb.PhaseTiming{{Phase: "REALTIME"}},
+	}
+	if err = server.SendRoundMetrics(host, report); err == nil {
+		t.Errorf("SendRoundMetrics did not error for an unauthenticated node.")
+	}
+	if called {
+		t.Errorf("Handler called for an unauthenticated node.")
+	}
+}
+
 func TestComms_SendRegistrationCheck(t *testing.T) {
 	RegAddress := getNextServerAddress()
 	testId := id.NewIdFromString("blah", id.Generic, t)
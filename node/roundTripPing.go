@@ -0,0 +1,140 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the recording of round trip ping timings
+
+package node
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/netTime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// maxPingRounds is the number of rounds ping timings are kept for. Timings
+// for older rounds are dropped as new rounds are recorded.
+const maxPingRounds = 256
+
+// maxPingsPerRound is the number of timings kept for a single round. A round
+// has one ping in each direction per pair of nodes in its team, so further
+// timings are dropped.
+const maxPingsPerRound = 64
+
+// pingTimings holds the round trip ping timings of recent rounds. The zero
+// value is ready to use.
+type pingTimings struct {
+	timings map[uint64][]pb.RoundTripTiming
+	// Round IDs in the order they were first recorded
+	order []uint64
+	mux   sync.Mutex
+}
+
+// add records the timing under its round, unless the round already holds
+// maxPingsPerRound timings.
+func (pt *pingTimings) add(timing pb.RoundTripTiming) {
+	pt.mux.Lock()
+	defer pt.mux.Unlock()
+
+	if pt.timings == nil {
+		pt.timings = make(map[uint64][]pb.RoundTripTiming)
+	}
+	if _, exists := pt.timings[timing.RoundID]; !exists {
+		if len(pt.order) == maxPingRounds {
+			delete(pt.timings, pt.order[0])
+			pt.order = pt.order[1:]
+		}
+		pt.order = append(pt.order, timing.RoundID)
+	}
+	if len(pt.timings[timing.RoundID]) >= maxPingsPerRound {
+		jww.DEBUG.Printf("Dropping round trip ping timing for round %d: "+
+			"%d timings already recorded", timing.RoundID, maxPingsPerRound)
+		return
+	}
+	pt.timings[timing.RoundID] = append(pt.timings[timing.RoundID], timing)
+}
+
+// get returns a copy of the timings recorded for the round.
+func (pt *pingTimings) get(roundID uint64) []pb.RoundTripTiming {
+	pt.mux.Lock()
+	defer pt.mux.Unlock()
+	return append([]pb.RoundTripTiming(nil), pt.timings[roundID]...)
+}
+
+// GetRoundTripTimings returns the timings of the round trip pings sent and
+// received by this node for the round. Timings are also added to the
+// RoundMetrics returned by GetMeasure.
+func (s *Comms) GetRoundTripTimings(roundID uint64) []pb.RoundTripTiming {
+	return s.pings.get(roundID)
+}
+
+// receiveRoundTripPing echoes the hash of a received ping's payload and the
+// time it was received to the sender. The timing of the ping is only recorded
+// if the sender is authenticated, so unknown senders cannot add to the
+// round's metrics.
+func (s *Comms) receiveRoundTripPing(ctx context.Context,
+	ping *pb.RoundTripPing, auth *connect.Auth) {
+	received := netTime.Now()
+
+	if auth.IsAuthenticated {
+		timing := pb.RoundTripTiming{
+			RoundID:  ping.GetRound().GetID(),
+			Peer:     auth.Sender.GetId().String(),
+			Received: received,
+		}
+		md, _ := metadata.FromIncomingContext(ctx)
+		sent, err := pb.TimeFromHeader(md, pb.RoundTripPingSentHeader)
+		if err == nil {
+			timing.Sent = sent
+		}
+		s.pings.add(timing)
+	}
+
+	header := metadata.Pairs(
+		pb.RoundTripPingHashHeader,
+		pb.HashRoundTripPingPayload(ping.GetPayload()),
+		pb.RoundTripPingReceivedHeader, pb.FormatHeaderTime(received))
+	if err := grpc.SetHeader(ctx, header); err != nil {
+		jww.WARN.Printf("Failed to echo round trip ping: %+v", err)
+	}
+}
+
+// recordRoundTripPing records the timing of a ping sent at the given time,
+// using the response header from the receiver. It returns an error if the
+// receiver echoed a hash of a different payload. Receivers which do not echo
+// the payload are recorded with PayloadVerified false.
+func (s *Comms) recordRoundTripPing(host *connect.Host,
+	ping *pb.RoundTripPing, sent time.Time, header metadata.MD) error {
+	timing := pb.RoundTripTiming{
+		RoundID:  ping.GetRound().GetID(),
+		Peer:     host.GetId().String(),
+		Sent:     sent,
+		Returned: netTime.Now(),
+	}
+
+	if hash := header.Get(pb.RoundTripPingHashHeader); len(hash) > 0 {
+		if hash[0] != pb.HashRoundTripPingPayload(ping.GetPayload()) {
+			return errors.Errorf("Round trip ping payload for round %d "+
+				"was altered before reaching %s", timing.RoundID, host.GetId())
+		}
+		timing.PayloadVerified = true
+	}
+	received, err := pb.TimeFromHeader(header, pb.RoundTripPingReceivedHeader)
+	if err == nil {
+		timing.Received = received
+	}
+
+	s.pings.add(timing)
+	return nil
+}
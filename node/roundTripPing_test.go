@@ -0,0 +1,100 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package node
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/netTime"
+	"google.golang.org/grpc/metadata"
+)
+
+// Tests that the timings recorded when receiving a ping from an authenticated
+// sender and when its response returns are complete, and that pings from
+// unauthenticated senders are not recorded.
+func TestComms_receiveRoundTripPing(t *testing.T) {
+	payload, err := ptypes.MarshalAny(&messages.Ack{})
+	if err != nil {
+		t.Fatalf("Failed to marshal payload: %+v", err)
+	}
+	ping := &pb.RoundTripPing{Round: &pb.RoundInfo{ID: 1}, Payload: payload}
+	s := &Comms{}
+
+	senderID := id.NewIdFromString("sender", id.Node, t)
+	senderHost, err := connect.NewHost(senderID, "0.0.0.0:0", nil,
+		connect.GetDefaultHostParams())
+	if err != nil {
+		t.Fatalf("Failed to create host: %+v", err)
+	}
+
+	sent := netTime.Now().Add(-time.Millisecond)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		pb.RoundTripPingSentHeader, pb.FormatHeaderTime(sent)))
+
+	// Pings from unauthenticated senders are not recorded
+	s.receiveRoundTripPing(ctx, ping,
+		&connect.Auth{IsAuthenticated: false, Sender: senderHost})
+	if timings := s.GetRoundTripTimings(1); len(timings) != 0 {
+		t.Fatalf("Timings recorded for an unauthenticated ping: %+v",
+			timings)
+	}
+
+	s.receiveRoundTripPing(ctx, ping,
+		&connect.Auth{IsAuthenticated: true, Sender: senderHost})
+
+	hostID := id.NewIdFromString("receiver", id.Node, t)
+	host, err := connect.NewHost(hostID, "0.0.0.0:0", nil,
+		connect.GetDefaultHostParams())
+	if err != nil {
+		t.Fatalf("Failed to create host: %+v", err)
+	}
+	header := metadata.Pairs(
+		pb.RoundTripPingHashHeader, pb.HashRoundTripPingPayload(payload),
+		pb.RoundTripPingReceivedHeader, pb.FormatHeaderTime(netTime.Now()))
+	if err = s.recordRoundTripPing(host, ping, sent, header); err != nil {
+		t.Fatalf("recordRoundTripPing returned an error: %+v", err)
+	}
+
+	timings := s.GetRoundTripTimings(1)
+	if len(timings) != 2 {
+		t.Fatalf("Expected 2 timings, got %d", len(timings))
+	}
+	receiver, sender := timings[0], timings[1]
+	if !receiver.Sent.Equal(time.Unix(0, sent.UnixNano())) ||
+		receiver.Received.IsZero() || receiver.Peer != senderID.String() {
+		t.Errorf("Receiver timing is incomplete: %+v", receiver)
+	}
+	if !sender.PayloadVerified || sender.RoundTrip() <= 0 ||
+		sender.Received.IsZero() {
+		t.Errorf("Sender timing is incomplete: %+v", sender)
+	}
+
+	// A response echoing a different payload is rejected
+	header.Set(pb.RoundTripPingHashHeader, "altered")
+	if err = s.recordRoundTripPing(host, ping, sent, header); err == nil {
+		t.Errorf("recordRoundTripPing accepted an altered payload.")
+	}
+}
+
+// Tests that pingTimings keeps at most maxPingsPerRound timings per round.
+func TestPingTimings_add_Limit(t *testing.T) {
+	var pt pingTimings
+	for i := 0; i < maxPingsPerRound+5; i++ {
+		pt.add(pb.RoundTripTiming{RoundID: 3})
+	}
+	if n := len(pt.get(3)); n != maxPingsPerRound {
+		t.Errorf("Expected %d timings, got %d", maxPingsPerRound, n)
+	}
+}
@@ -8,6 +8,7 @@
 package node
 
 import (
+	"context"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/pkg/errors"
 	pb "gitlab.com/elixxir/comms/mixmessages"
@@ -17,6 +18,7 @@ import (
 	"gitlab.com/xx_network/primitives/id"
 	"io"
 	"testing"
+	"time"
 )
 
 // Smoke test SendAskOnline
@@ -250,6 +252,35 @@ func TestSendPostPhase(t *testing.T) {
 	}
 }
 
+// Tests that SendPostPhaseWithContext sends with a live context and fails
+// once the context is cancelled.
+func TestSendPostPhaseWithContext(t *testing.T) {
+	ServerAddress := getNextServerAddress()
+	testId := id.NewIdFromString("test", id.Node, t)
+	server := StartNode(testId, ServerAddress, 0, NewImplementation(), nil, nil)
+	defer server.Shutdown()
+	manager := connect.NewManagerTesting(t)
+
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(testId, ServerAddress, nil, params)
+	if err != nil {
+		t.Errorf("Unable to call NewHost: %+v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	_, err = server.SendPostPhaseWithContext(ctx, host, &pb.Batch{})
+	cancel()
+	if err != nil {
+		t.Errorf("Phase: Error received: %s", err)
+	}
+
+	_, err = server.SendPostPhaseWithContext(ctx, host, &pb.Batch{})
+	if err == nil {
+		t.Errorf("Phase: sent with a cancelled context.")
+	}
+}
+
 // TestPostPrecompResult Smoke test
 func TestSendPostPrecompResult(t *testing.T) {
 	ServerAddress := getNextServerAddress()
@@ -389,3 +420,31 @@ func TestSendRoundError(t *testing.T) {
 	}
 
 }
+
+// Smoke test SendNewRoundPrepared sending one prepared message twice
+func TestSendNewRoundPrepared(t *testing.T) {
+	ServerAddress := getNextServerAddress()
+	testId := id.NewIdFromString("test", id.Node, t)
+	server := StartNode(testId, ServerAddress, 0, NewImplementation(), nil, nil)
+	defer server.Shutdown()
+	manager := connect.NewManagerTesting(t)
+
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(testId, ServerAddress, nil, params)
+	if err != nil {
+		t.Errorf("Unable to call NewHost: %+v", err)
+	}
+
+	pm, err := PrepareMessage(&pb.RoundInfo{ID: 5})
+	if err != nil {
+		t.Fatalf("PrepareMessage: Error received: %+v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		_, err = server.SendNewRoundPrepared(host, pm)
+		if err != nil {
+			t.Errorf("NewRoundPrepared: Error received: %s", err)
+		}
+	}
+}
@@ -21,7 +21,7 @@ import (
 // Handles validation of reverse-authentication tokens
 func (nb *Comms) AuthenticateToken(_ context.Context,
 	msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
-	err := nb.ValidateToken(msg)
+	err := nb.AuthMetrics.Validate(msg, nb.ProtoComms.GetHost, nb.ValidateToken)
 	if err != nil {
 		jww.ERROR.Printf("Unable to authenticate token: %+v", err)
 	}
@@ -31,6 +31,7 @@ func (nb *Comms) AuthenticateToken(_ context.Context,
 // Handles reception of reverse-authentication token requests
 func (nb *Comms) RequestToken(context.Context, *messages.Ping) (*messages.AssignToken, error) {
 	token, err := nb.GenerateToken()
+	nb.AuthMetrics.RecordIssued(err)
 	return &messages.AssignToken{
 		Token: token,
 	}, err
@@ -11,7 +11,16 @@ package notificationBot
 
 import (
 	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/elixxir/comms/authMetrics"
+	"gitlab.com/elixxir/comms/buildInfo"
+	// Registers the compressors the server accepts
+	_ "gitlab.com/elixxir/comms/compression"
+	"gitlab.com/elixxir/comms/endpointSwitch"
+	"gitlab.com/elixxir/comms/health"
+	"gitlab.com/elixxir/comms/instrumentation"
+	"gitlab.com/elixxir/comms/interceptors"
 	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/validation"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/comms/messages"
 	"gitlab.com/xx_network/primitives/id"
@@ -37,10 +46,31 @@ type Handler interface {
 type Comms struct {
 	*connect.ProtoComms
 	handler Handler
-	*pb.UnimplementedNotificationBotServer
-	*messages.UnimplementedGenericServer
+	// Results of reverse-authentication handshakes with this server
+	AuthMetrics *authMetrics.Tracker
+	// Describes what this server is running to remote callers
+	BuildInfo *buildInfo.Server
+	// Liveness and readiness probes of this server
+	Health *health.Server
+	// Interceptors run on the calls received and sent by this server
+	Interceptors *interceptors.Chain
+	// Validators checking the requests received before they reach the
+	// handler
+	Validators *validation.Validators
+	// Endpoints disabled at runtime
+	Switches *endpointSwitch.Switches
+	pb.UnimplementedNotificationBotServer
+	messages.UnimplementedGenericServer
+	// Callbacks reporting each send
+	sendHooks instrumentation.Hooks
 }
 
+// Comms is checked at compile time to serve its gRPC services
+var (
+	_ pb.NotificationBotServer = (*Comms)(nil)
+	_ messages.GenericServer   = (*Comms)(nil)
+)
+
 // Starts a new server on the address:port specified by localServer
 // and a callback interface for server operations
 // with given path to public and private key for TLS connection
@@ -54,11 +84,23 @@ func StartNotificationBot(id *id.ID, localServer string, handler Handler,
 	}
 
 	notificationBot := Comms{
-		ProtoComms: pc,
-		handler:    handler,
+		ProtoComms:  pc,
+		handler:     handler,
+		AuthMetrics: authMetrics.NewTracker(),
 	}
-	pb.RegisterNotificationBotServer(notificationBot.GetServer(), &notificationBot)
+	notificationBot.Switches = endpointSwitch.NewSwitches()
+	notificationBot.Interceptors = interceptors.New()
+	notificationBot.Validators = newValidators()
+	notificationBot.Interceptors.AddUnaryServerInterceptor(
+		notificationBot.Validators.UnaryServerInterceptor())
+	notificationBot.Switches.Register(notificationBot.GetServer(),
+		notificationBot.Interceptors.Wrap(&pb.NotificationBot_ServiceDesc), &notificationBot)
+	notificationBot.Switches.RegisterAdmin(notificationBot.GetServer(), notificationBot.AuthenticatedReceiver)
 	messages.RegisterGenericServer(notificationBot.GetServer(), &notificationBot)
+	notificationBot.BuildInfo = buildInfo.NewServer()
+	notificationBot.BuildInfo.Register(notificationBot.GetServer())
+	notificationBot.Health = health.NewServer(handler)
+	notificationBot.Health.Register(notificationBot.GetServer())
 
 	pc.ServeWithWeb()
 	return &notificationBot
@@ -10,6 +10,8 @@ package notificationBot
 import (
 	"fmt"
 	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/testutils"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/primitives/id"
 	"os"
@@ -51,3 +53,9 @@ func TestBadCerts(t *testing.T) {
 	_ = StartNotificationBot(testID, Address, NewImplementation(),
 		[]byte("bad cert"), []byte("bad key"))
 }
+
+// Tests that every Handler method has an endpoint and every endpoint a
+// Handler method.
+func TestHandler_Endpoints(t *testing.T) {
+	testutils.CheckEndpoints(t, (*Handler)(nil), &pb.NotificationBot_ServiceDesc)
+}
@@ -30,7 +30,7 @@ func (nb *Comms) PollNdf(host *connect.Host, ndfHash []byte) (*pb.NDF, error) {
 		ndfRequest := &pb.NDFHash{Hash: ndfHash}
 
 		// Send the message
-		resultMsg, err := pb.NewRegistrationClient(conn.GetGrpcConn()).
+		resultMsg, err := pb.NewRegistrationClient(nb.Interceptors.ClientConn(conn.GetGrpcConn())).
 			PollNdf(ctx, ndfRequest)
 		if err != nil {
 			return nil, errors.New(err.Error())
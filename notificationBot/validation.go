@@ -0,0 +1,35 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the validation of the requests received by the notification bot
+
+package notificationBot
+
+import (
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/validation"
+)
+
+// Longest app name and token accepted. Push tokens are far shorter.
+const (
+	maxAppLen   = 256
+	maxTokenLen = 4096
+)
+
+// newValidators returns the validators the requests received by the
+// notification bot are checked with by default.
+func newValidators() *validation.Validators {
+	v := validation.New()
+	v.Register(&pb.RegisterTokenRequest{}, validation.MaxLength("App", maxAppLen))
+	v.Register(&pb.RegisterTokenRequest{},
+		validation.MaxLength("Token", maxTokenLen))
+	v.Register(&pb.UnregisterTokenRequest{},
+		validation.MaxLength("App", maxAppLen))
+	v.Register(&pb.UnregisterTokenRequest{},
+		validation.MaxLength("Token", maxTokenLen))
+	return v
+}
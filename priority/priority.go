@@ -0,0 +1,85 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package priority labels requests with a priority in their metadata and
+// schedules them on servers so that, under overload, realtime-critical
+// requests are processed before background traffic.
+package priority
+
+import (
+	"context"
+	"strconv"
+
+	"gitlab.com/elixxir/primitives/states"
+	"google.golang.org/grpc/metadata"
+)
+
+// Header is the request metadata key the priority is sent under.
+const Header = "priority"
+
+// Level is the priority of a request. Higher levels are more important.
+type Level int
+
+const (
+	// Metrics collection and other background traffic
+	Metrics Level = iota
+	// Requests without a priority
+	Default
+	// Precomputation of rounds
+	Precomputation
+	// Realtime processing of batches
+	Realtime
+
+	numLevels = int(Realtime) + 1
+)
+
+// String returns the name of the level.
+func (l Level) String() string {
+	switch l {
+	case Metrics:
+		return "metrics"
+	case Default:
+		return "default"
+	case Precomputation:
+		return "precomputation"
+	case Realtime:
+		return "realtime"
+	default:
+		return "Level(" + strconv.Itoa(int(l)) + ")"
+	}
+}
+
+// ForRound returns the level of a request taking part in a round in the
+// given state.
+func ForRound(state uint32) Level {
+	if states.Round(state) == states.REALTIME {
+		return Realtime
+	}
+	return Precomputation
+}
+
+// AppendToOutgoingContext adds the level to the request metadata.
+func AppendToOutgoingContext(ctx context.Context,
+	level Level) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, Header,
+		strconv.Itoa(int(level)))
+}
+
+// FromIncomingContext returns the level in the request metadata, or Default
+// if there is none or it is not valid.
+func FromIncomingContext(ctx context.Context) Level {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(Header)
+	if len(values) == 0 {
+		return Default
+	}
+	level, err := strconv.Atoi(values[0])
+	if err != nil || level < 0 || level >= numLevels {
+		return Default
+	}
+	return Level(level)
+}
@@ -0,0 +1,194 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the weighted scheduling of requests by priority
+
+package priority
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc"
+)
+
+// DefaultWeights are the shares of the processing given to each level while
+// requests of several levels are waiting.
+var DefaultWeights = map[Level]int{
+	Metrics:        1,
+	Default:        2,
+	Precomputation: 4,
+	Realtime:       8,
+}
+
+// Scheduler limits the number of requests processed at once. While requests
+// are waiting, each freed slot goes to a waiting request chosen by weighted
+// round robin over the levels, so higher levels are processed first without
+// starving lower ones. Requests are not delayed while the limit is not
+// reached.
+type Scheduler struct {
+	limit   int
+	running int
+	weights [numLevels]int
+	// Smooth weighted round robin state of each level
+	current [numLevels]int
+	queues  [numLevels][]chan struct{}
+	waiting int
+	mux     sync.Mutex
+}
+
+// NewScheduler returns a Scheduler processing at most limit requests at once,
+// sharing slots between levels according to the weights. Levels missing from
+// the weights are given a weight of one. A limit of zero or less disables the
+// limit.
+func NewScheduler(limit int, weights map[Level]int) *Scheduler {
+	s := &Scheduler{limit: limit}
+	for l := range s.weights {
+		s.weights[l] = 1
+		if w, exists := weights[Level(l)]; exists && w > 0 {
+			s.weights[l] = w
+		}
+	}
+	return s
+}
+
+// Acquire waits for a slot for a request of the level. It returns an error
+// if the context is done first. Every successful Acquire must be followed by
+// a Release.
+func (s *Scheduler) Acquire(ctx context.Context, level Level) error {
+	if level < 0 || int(level) >= numLevels {
+		level = Default
+	}
+
+	s.mux.Lock()
+	if (s.limit <= 0 || s.running < s.limit) && s.waiting == 0 {
+		s.running++
+		s.mux.Unlock()
+		return nil
+	}
+	ready := make(chan struct{})
+	s.queues[level] = append(s.queues[level], ready)
+	s.waiting++
+	s.mux.Unlock()
+
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+	}
+
+	s.mux.Lock()
+	queue := s.queues[level]
+	for i, c := range queue {
+		if c == ready {
+			s.queues[level] = append(queue[:i], queue[i+1:]...)
+			s.waiting--
+			s.mux.Unlock()
+			return ctx.Err()
+		}
+	}
+	s.mux.Unlock()
+
+	// The slot was granted as the context finished, so pass it on
+	s.Release()
+	return ctx.Err()
+}
+
+// SetLimit changes the number of requests processed at once. A limit of zero
+// or less disables the limit.
+func (s *Scheduler) SetLimit(limit int) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.limit = limit
+	for s.waiting > 0 && (s.limit <= 0 || s.running < s.limit) {
+		s.running++
+		s.grantNext()
+	}
+}
+
+// Release frees the slot of a request, passing it to the next waiting
+// request if there is one.
+func (s *Scheduler) Release() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	// Slots over a lowered limit are not passed on
+	if s.waiting == 0 || (s.limit > 0 && s.running > s.limit) {
+		s.running--
+		return
+	}
+	s.grantNext()
+}
+
+// grantNext passes a slot to the next waiting request. It must be called with
+// the lock held and at least one request waiting.
+func (s *Scheduler) grantNext() {
+	// Smooth weighted round robin over the levels with waiting requests
+	total, next := 0, -1
+	for l := range s.queues {
+		if len(s.queues[l]) == 0 {
+			continue
+		}
+		s.current[l] += s.weights[l]
+		total += s.weights[l]
+		if next < 0 || s.current[l] > s.current[next] {
+			next = l
+		}
+	}
+	s.current[next] -= total
+
+	ready := s.queues[next][0]
+	s.queues[next] = s.queues[next][1:]
+	s.waiting--
+	close(ready)
+}
+
+// Wrap returns a copy of the service description whose handlers wait for a
+// slot at the priority in the request metadata before being called. A nil
+// Scheduler returns the description unchanged.
+func (s *Scheduler) Wrap(desc *grpc.ServiceDesc) *grpc.ServiceDesc {
+	if s == nil {
+		return desc
+	}
+	wrapped := *desc
+
+	wrapped.Methods = make([]grpc.MethodDesc, len(desc.Methods))
+	for i, md := range desc.Methods {
+		handler := md.Handler
+		wrapped.Methods[i] = grpc.MethodDesc{
+			MethodName: md.MethodName,
+			Handler: func(srv interface{}, ctx context.Context,
+				dec func(interface{}) error,
+				interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				err := s.Acquire(ctx, FromIncomingContext(ctx))
+				if err != nil {
+					return nil, err
+				}
+				defer s.Release()
+				return handler(srv, ctx, dec, interceptor)
+			},
+		}
+	}
+
+	wrapped.Streams = make([]grpc.StreamDesc, len(desc.Streams))
+	for i, sd := range desc.Streams {
+		handler := sd.Handler
+		wrapped.Streams[i] = sd
+		wrapped.Streams[i].Handler = func(srv interface{},
+			stream grpc.ServerStream) error {
+			ctx := stream.Context()
+			if err := s.Acquire(ctx, FromIncomingContext(ctx)); err != nil {
+				return err
+			}
+			defer s.Release()
+			return handler(srv, stream)
+		}
+	}
+
+	return &wrapped
+}
@@ -0,0 +1,104 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package priority
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// waitQueued waits until n requests are waiting on the scheduler.
+func waitQueued(t *testing.T, s *Scheduler, n int) {
+	for i := 0; i < 100; i++ {
+		s.mux.Lock()
+		waiting := s.waiting
+		s.mux.Unlock()
+		if waiting == n {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("Timed out waiting for %d queued requests", n)
+}
+
+// Tests that waiting realtime requests are processed before background
+// requests which arrived earlier.
+func TestScheduler_Priority(t *testing.T) {
+	s := NewScheduler(1, DefaultWeights)
+	if err := s.Acquire(context.Background(), Metrics); err != nil {
+		t.Fatalf("Failed to acquire free slot: %+v", err)
+	}
+
+	order := make(chan Level, 4)
+	levels := []Level{Metrics, Metrics, Realtime, Realtime}
+	for i, level := range levels {
+		go func(level Level) {
+			if err := s.Acquire(context.Background(), level); err != nil {
+				t.Errorf("Failed to acquire slot: %+v", err)
+			}
+			order <- level
+			s.Release()
+		}(level)
+		waitQueued(t, s, i+1)
+	}
+
+	s.Release()
+	var received []Level
+	for range levels {
+		received = append(received, <-order)
+	}
+	if received[0] != Realtime || received[1] != Realtime {
+		t.Errorf("Realtime requests were not processed first: %v", received)
+	}
+}
+
+// Tests that a request whose context finishes while waiting leaves the queue
+// and that raising the limit releases waiting requests.
+func TestScheduler_Acquire_Cancel(t *testing.T) {
+	s := NewScheduler(1, nil)
+	_ = s.Acquire(context.Background(), Default)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errChan := make(chan error)
+	go func() { errChan <- s.Acquire(ctx, Default) }()
+	waitQueued(t, s, 1)
+	cancel()
+	if err := <-errChan; err == nil {
+		t.Errorf("Acquire succeeded after its context was cancelled.")
+	}
+	waitQueued(t, s, 0)
+
+	go func() { errChan <- s.Acquire(context.Background(), Default) }()
+	waitQueued(t, s, 1)
+	s.SetLimit(2)
+	if err := <-errChan; err != nil {
+		t.Errorf("Acquire failed after the limit was raised: %+v", err)
+	}
+}
+
+// Tests that the level survives being sent as metadata and that invalid
+// levels are treated as Default.
+func TestFromIncomingContext(t *testing.T) {
+	ctx := AppendToOutgoingContext(context.Background(), Realtime)
+	md, _ := metadata.FromOutgoingContext(ctx)
+	ctx = metadata.NewIncomingContext(context.Background(), md)
+	if level := FromIncomingContext(ctx); level != Realtime {
+		t.Errorf("Unexpected level %s", level)
+	}
+
+	for _, value := range []string{"9", "-1", "high"} {
+		ctx = metadata.NewIncomingContext(context.Background(),
+			metadata.Pairs(Header, value))
+		if level := FromIncomingContext(ctx); level != Default {
+			t.Errorf("Invalid level %q read as %s", value, level)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains parsing of host addresses which may hold IPv6 literals
+
+package publicAddress
+
+import (
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// SplitAddress splits a host address into its host and port. IPv6 literals
+// must be bracketed, e.g. "[2001:db8::1]:11420", since the port of a bare
+// literal cannot be told apart from its last group. The returned host has no
+// brackets.
+func SplitAddress(address string) (string, int, error) {
+	host, portStr, err := net.SplitHostPort(address)
+	if err != nil {
+		return "", 0, errors.Errorf("failed to parse address \"%s\": %v",
+			address, err)
+	}
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return "", 0, errors.Errorf("invalid port in address \"%s\": %v",
+			address, err)
+	}
+	if strings.Contains(host, ":") && net.ParseIP(host) == nil {
+		return "", 0, errors.Errorf("invalid IPv6 address in \"%s\"",
+			address)
+	}
+	return host, int(port), nil
+}
+
+// JoinAddress joins the host and port, bracketing IPv6 literals. A host which
+// is already bracketed is not bracketed again.
+func JoinAddress(host string, port int) string {
+	host = strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")
+	return net.JoinHostPort(host, strconv.Itoa(port))
+}
+
+// IsIPv6 returns true if the host of the address is an IPv6 literal. The
+// address may be a bare host or have a port.
+func IsIPv6(address string) bool {
+	host := address
+	if h, _, err := net.SplitHostPort(address); err == nil {
+		host = h
+	}
+	host = strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")
+	ip := net.ParseIP(host)
+	return ip != nil && ip.To4() == nil
+}
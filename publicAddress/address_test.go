@@ -0,0 +1,94 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package publicAddress
+
+import (
+	"testing"
+)
+
+// Tests that SplitAddress parses IPv4, IPv6 and named addresses.
+func TestSplitAddress(t *testing.T) {
+	tests := []struct {
+		address string
+		host    string
+		port    int
+	}{
+		{"1.2.3.4:11420", "1.2.3.4", 11420},
+		{"[2001:db8::1]:11420", "2001:db8::1", 11420},
+		{"[::]:22840", "::", 22840},
+		{"gateway.example.com:443", "gateway.example.com", 443},
+	}
+	for _, tt := range tests {
+		host, port, err := SplitAddress(tt.address)
+		if err != nil {
+			t.Errorf("Failed to split %s: %+v", tt.address, err)
+		} else if host != tt.host || port != tt.port {
+			t.Errorf("Unexpected split of %s: %s %d", tt.address, host, port)
+		}
+	}
+}
+
+// Tests that SplitAddress rejects addresses without a valid port or with an
+// unbracketed IPv6 literal.
+func TestSplitAddress_Error(t *testing.T) {
+	for _, address := range []string{"1.2.3.4", "2001:db8::1:11420",
+		"[2001:db8::1]", "1.2.3.4:port", "1.2.3.4:70000", "[2001:zz::1]:443"} {
+		if _, _, err := SplitAddress(address); err == nil {
+			t.Errorf("Split invalid address %s.", address)
+		}
+	}
+}
+
+// Tests that JoinAddress brackets IPv6 literals once.
+func TestJoinAddress(t *testing.T) {
+	tests := map[string]string{
+		"1.2.3.4":       "1.2.3.4:11420",
+		"2001:db8::1":   "[2001:db8::1]:11420",
+		"[2001:db8::1]": "[2001:db8::1]:11420",
+		"localhost":     "localhost:11420",
+	}
+	for host, expected := range tests {
+		if address := JoinAddress(host, 11420); address != expected {
+			t.Errorf("Expected %s, got %s.", expected, address)
+		}
+	}
+}
+
+// Tests that JoinIpPort adds the port to bare and bracketed IPv6 literals.
+func TestJoinIpPort_IPv6(t *testing.T) {
+	for _, ip := range []string{"2001:db8::1", "[2001:db8::1]"} {
+		address, err := JoinIpPort(ip, 11420)
+		if err != nil {
+			t.Errorf("JoinIpPort() returned an error for %s: %+v", ip, err)
+		} else if address != "[2001:db8::1]:11420" {
+			t.Errorf("Unexpected address for %s: %s", ip, address)
+		}
+	}
+
+	address, err := JoinIpPort("[2001:db8::1]:22840", 11420)
+	if err != nil || address != "[2001:db8::1]:22840" {
+		t.Errorf("Unexpected address with a port: %s (%v)", address, err)
+	}
+}
+
+// Tests that IsIPv6 detects IPv6 literals with or without a port.
+func TestIsIPv6(t *testing.T) {
+	tests := map[string]bool{
+		"[2001:db8::1]:443": true,
+		"2001:db8::1":       true,
+		"[::1]":             true,
+		"1.2.3.4:443":       false,
+		"::ffff:1.2.3.4":    false,
+		"localhost:443":     false,
+	}
+	for address, expected := range tests {
+		if IsIPv6(address) != expected {
+			t.Errorf("Expected %t for %s.", expected, address)
+		}
+	}
+}
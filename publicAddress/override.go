@@ -42,12 +42,17 @@ func getIpOverride(overrideIP string, port int, services []Service) (string, err
 }
 
 // JoinIpPort joins the ip and port together. If the ip already has a port, it
-// is returned as is.
+// is returned as is. IPv6 literals may be bare or bracketed.
 func JoinIpPort(ip string, port int) (string, error) {
 	if ip == "" {
 		return ip, nil
 	}
 
+	// A bare IPv6 literal has no port, but would fail to split on its colons
+	if net.ParseIP(strings.TrimSuffix(strings.TrimPrefix(ip, "["), "]")) != nil {
+		return JoinAddress(ip, port), nil
+	}
+
 	_, _, err := net.SplitHostPort(ip)
 	if err != nil {
 		// If it does not have a port, then append the supplied port
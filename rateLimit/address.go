@@ -0,0 +1,103 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package rateLimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultMaxConnections is the number of connections a ConnLimiter holds a
+// bucket for by default.
+const DefaultMaxConnections = 10000
+
+// ConnLimiter limits the rate of the calls received on each connection, for
+// endpoints whose callers are not authenticated and so have no ID to be
+// limited by. Connections are told apart by their remote address. Only the
+// buckets of the most recently used connections are kept, so a caller
+// opening many connections cannot grow it without bound.
+type ConnLimiter struct {
+	rate    Rate
+	max     int
+	buckets map[string]*bucket
+	now     func() time.Time
+	mux     sync.Mutex
+}
+
+// NewConnLimiter returns a ConnLimiter allowing calls on each connection at
+// the rate, which holds the buckets of at most maxConnections connections.
+func NewConnLimiter(rate Rate, maxConnections int) *ConnLimiter {
+	return &ConnLimiter{
+		rate:    rate,
+		max:     maxConnections,
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+// SetRate sets the rate of every connection. Passing the zero Rate removes
+// the limit.
+func (l *ConnLimiter) SetRate(rate Rate) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	l.rate = rate
+	for _, b := range l.buckets {
+		b.rate = rate
+	}
+}
+
+// Allow takes a token from the bucket of the connection the call in the
+// context was received on, returning a RESOURCE_EXHAUSTED error if it is
+// empty. Calls without a peer share a single bucket. A nil ConnLimiter
+// allows every call.
+func (l *ConnLimiter) Allow(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	var addr string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr = p.Addr.String()
+	}
+
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	now := l.now()
+	b, exists := l.buckets[addr]
+	if !exists {
+		if l.max > 0 && len(l.buckets) >= l.max {
+			l.evict()
+		}
+		b = &bucket{rate: l.rate, tokens: float64(burst(l.rate)), last: now}
+		l.buckets[addr] = b
+	}
+
+	if !b.take(now) {
+		return status.Errorf(codes.ResourceExhausted, "Calls from %s are "+
+			"limited to %g per second", addr, b.rate.PerSecond)
+	}
+	return nil
+}
+
+// evict removes the bucket of the least recently used connection.
+func (l *ConnLimiter) evict() {
+	var oldest string
+	var oldestTime time.Time
+	first := true
+	for addr, b := range l.buckets {
+		if first || b.last.Before(oldestTime) {
+			oldest, oldestTime, first = addr, b.last, false
+		}
+	}
+	delete(l.buckets, oldest)
+}
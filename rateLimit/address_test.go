@@ -0,0 +1,78 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package rateLimit
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// newPeerContext returns a context of a call received from the address.
+func newPeerContext(addr string) context.Context {
+	tcpAddr, _ := net.ResolveTCPAddr("tcp", addr)
+	return peer.NewContext(context.Background(), &peer.Peer{Addr: tcpAddr})
+}
+
+// Tests that each connection is limited separately.
+func TestConnLimiter_Allow(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := NewConnLimiter(Rate{PerSecond: 1, Burst: 2}, 0)
+	l.now = func() time.Time { return now }
+	a := newPeerContext("127.0.0.1:1000")
+	b := newPeerContext("127.0.0.1:1001")
+
+	for i := 0; i < 2; i++ {
+		if err := l.Allow(a); err != nil {
+			t.Fatalf("Call %d within the burst rejected: %+v", i, err)
+		}
+	}
+	err := l.Allow(a)
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("Call beyond the burst returned %v", err)
+	}
+	if err = l.Allow(b); err != nil {
+		t.Errorf("Call on another connection rejected: %+v", err)
+	}
+
+	now = now.Add(time.Second)
+	if err = l.Allow(a); err != nil {
+		t.Errorf("Call after refilling rejected: %+v", err)
+	}
+}
+
+// Tests that the least recently used connection is evicted once the limiter
+// holds the maximum number of connections.
+func TestConnLimiter_Allow_Evict(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := NewConnLimiter(Rate{PerSecond: 1, Burst: 1}, 2)
+	l.now = func() time.Time { return now }
+	a := newPeerContext("127.0.0.1:1000")
+
+	if err := l.Allow(a); err != nil {
+		t.Fatalf("First call rejected: %+v", err)
+	}
+	for _, addr := range []string{"127.0.0.1:1001", "127.0.0.1:1002"} {
+		now = now.Add(time.Millisecond)
+		if err := l.Allow(newPeerContext(addr)); err != nil {
+			t.Fatalf("Call from %s rejected: %+v", addr, err)
+		}
+	}
+
+	if len(l.buckets) != 2 {
+		t.Errorf("Limiter holds %d connections, expected 2", len(l.buckets))
+	}
+	if _, exists := l.buckets["127.0.0.1:1000"]; exists {
+		t.Errorf("Least recently used connection was not evicted.")
+	}
+}
@@ -0,0 +1,153 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package rateLimit limits the rate of the sends and streams made to each
+// host, so that a misbehaving caller cannot saturate a node or gateway. Each
+// host has a token bucket, whose rate is set for the host or defaults to the
+// rate set for the type of its ID. A connect.Host takes no limiter, so the
+// limit is applied to the sends of a comms object by its instrumentation
+// hooks. A ConnLimiter instead limits the calls received on each connection
+// by an endpoint whose callers are not authenticated.
+package rateLimit
+
+import (
+	"sync"
+	"time"
+
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Rate is the rate sends are allowed at. A PerSecond of zero or less
+// disables the limit.
+type Rate struct {
+	// Sends allowed per second on average
+	PerSecond float64
+	// Sends allowed at once after the host is idle. Values less than one
+	// are treated as one.
+	Burst int
+}
+
+// bucket is the token bucket of a host.
+type bucket struct {
+	rate   Rate
+	tokens float64
+	last   time.Time
+}
+
+// Limiter holds the token buckets of the hosts sent to.
+type Limiter struct {
+	// Rates of each type of host, and of individual hosts
+	defaults map[id.Type]Rate
+	hosts    map[id.ID]Rate
+	buckets  map[id.ID]*bucket
+	now      func() time.Time
+	mux      sync.Mutex
+}
+
+// NewLimiter returns a Limiter without limits.
+func NewLimiter() *Limiter {
+	return &Limiter{
+		defaults: make(map[id.Type]Rate),
+		hosts:    make(map[id.ID]Rate),
+		buckets:  make(map[id.ID]*bucket),
+		now:      time.Now,
+	}
+}
+
+// SetDefault sets the rate of every host of the type which has no rate of
+// its own. Passing the zero Rate removes the limit.
+func (l *Limiter) SetDefault(hostType id.Type, rate Rate) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	if rate.PerSecond <= 0 {
+		delete(l.defaults, hostType)
+	} else {
+		l.defaults[hostType] = rate
+	}
+	for hid, b := range l.buckets {
+		if _, exists := l.hosts[hid]; !exists && hid.GetType() == hostType {
+			b.rate = rate
+		}
+	}
+}
+
+// SetHostRate sets the rate of the host, overriding the default of its type.
+func (l *Limiter) SetHostRate(hid *id.ID, rate Rate) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	l.hosts[*hid] = rate
+	if b, exists := l.buckets[*hid]; exists {
+		b.rate = rate
+	}
+}
+
+// RemoveHost removes the rate and bucket of the host, which returns to the
+// default of its type.
+func (l *Limiter) RemoveHost(hid *id.ID) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	delete(l.hosts, *hid)
+	delete(l.buckets, *hid)
+}
+
+// Allow takes a token from the bucket of the host, returning a
+// RESOURCE_EXHAUSTED error if it is empty. A nil Limiter allows every send.
+func (l *Limiter) Allow(hid *id.ID) error {
+	if l == nil {
+		return nil
+	}
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	now := l.now()
+	b, exists := l.buckets[*hid]
+	if !exists {
+		rate, exists := l.hosts[*hid]
+		if !exists {
+			rate = l.defaults[hid.GetType()]
+		}
+		b = &bucket{rate: rate, tokens: float64(burst(rate)), last: now}
+		l.buckets[*hid] = b
+	}
+	if !b.take(now) {
+		return status.Errorf(codes.ResourceExhausted, "Sends to %s are "+
+			"limited to %g per second", hid, b.rate.PerSecond)
+	}
+	return nil
+}
+
+// take refills the bucket for the time since it was last used and takes a
+// token from it, returning false if it is empty. A bucket without a rate is
+// never empty.
+func (b *bucket) take(now time.Time) bool {
+	if b.rate.PerSecond <= 0 {
+		return true
+	}
+
+	// Refill the bucket for the time since it was last used
+	b.tokens += now.Sub(b.last).Seconds() * b.rate.PerSecond
+	if max := float64(burst(b.rate)); b.tokens > max {
+		b.tokens = max
+	}
+	b.last = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// burst returns the size of the bucket of the rate.
+func burst(rate Rate) int {
+	if rate.Burst < 1 {
+		return 1
+	}
+	return rate.Burst
+}
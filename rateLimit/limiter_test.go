@@ -0,0 +1,86 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package rateLimit
+
+import (
+	"testing"
+	"time"
+
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Tests that sends are allowed up to the burst, then at the rate, and that
+// rejected sends return RESOURCE_EXHAUSTED.
+func TestLimiter_Allow(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := NewLimiter()
+	l.now = func() time.Time { return now }
+	gw := id.NewIdFromString("gateway", id.Gateway, t)
+	l.SetDefault(id.Gateway, Rate{PerSecond: 2, Burst: 3})
+
+	for i := 0; i < 3; i++ {
+		if err := l.Allow(gw); err != nil {
+			t.Fatalf("Send %d within the burst rejected: %+v", i, err)
+		}
+	}
+	err := l.Allow(gw)
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("Send beyond the burst returned %v", err)
+	}
+
+	// Half a second refills one token at two per second
+	now = now.Add(500 * time.Millisecond)
+	if err = l.Allow(gw); err != nil {
+		t.Errorf("Send after refilling rejected: %+v", err)
+	}
+	if err = l.Allow(gw); err == nil {
+		t.Errorf("Send beyond the rate allowed.")
+	}
+
+	// Hosts of other types are not limited
+	node := id.NewIdFromString("node", id.Node, t)
+	for i := 0; i < 10; i++ {
+		if err = l.Allow(node); err != nil {
+			t.Fatalf("Unlimited send rejected: %+v", err)
+		}
+	}
+}
+
+// Tests that host rates override the default of their type.
+func TestLimiter_SetHostRate(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := NewLimiter()
+	l.now = func() time.Time { return now }
+	gw := id.NewIdFromString("gateway", id.Gateway, t)
+	l.SetDefault(id.Gateway, Rate{PerSecond: 1})
+	l.SetHostRate(gw, Rate{})
+
+	for i := 0; i < 10; i++ {
+		if err := l.Allow(gw); err != nil {
+			t.Fatalf("Send to an unlimited host rejected: %+v", err)
+		}
+	}
+
+	l.RemoveHost(gw)
+	if err := l.Allow(gw); err != nil {
+		t.Fatalf("First send rejected: %+v", err)
+	}
+	if err := l.Allow(gw); err == nil {
+		t.Errorf("Removed host not limited by the default.")
+	}
+}
+
+// Tests that a nil Limiter allows every send.
+func TestLimiter_Nil(t *testing.T) {
+	var l *Limiter
+	if err := l.Allow(id.NewIdFromString("gateway", id.Gateway, t)); err != nil {
+		t.Errorf("Nil limiter rejected a send: %+v", err)
+	}
+}
@@ -10,20 +10,28 @@
 package registration
 
 import (
-	"fmt"
+	"encoding/base64"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/pkg/errors"
 	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/elixxir/comms/capability"
 	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/publicAddress"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/comms/messages"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"strconv"
 )
 
 // Handles validation of reverse-authentication tokens
 func (r *Comms) AuthenticateToken(ctx context.Context,
 	msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
-	err := r.ValidateToken(msg)
+	err := r.AuthMetrics.Validate(msg, r.ProtoComms.GetHost,
+		func(msg *messages.AuthenticatedMessage) error {
+			return r.ChannelBinding.VerifyToken(ctx, msg, r.ValidateToken)
+		})
 	if err != nil {
 		jww.ERROR.Printf("Unable to authenticate token: %+v", err)
 	}
@@ -31,13 +39,29 @@ func (r *Comms) AuthenticateToken(ctx context.Context,
 }
 
 // Handles reception of reverse-authentication token requests
-func (r *Comms) RequestToken(context.Context, *messages.Ping) (*messages.AssignToken, error) {
+func (r *Comms) RequestToken(ctx context.Context, _ *messages.Ping) (*messages.AssignToken, error) {
 	token, err := r.GenerateToken()
+	if err == nil {
+		err = r.ChannelBinding.Bind(ctx, token)
+	}
+	r.AuthMetrics.RecordIssued(err)
 	return &messages.AssignToken{
 		Token: token,
 	}, err
 }
 
+// authenticatedReceiver wraps AuthenticatedReceiver, additionally checking
+// that the message arrived over the channel the sender's token is bound to
+func (r *Comms) authenticatedReceiver(msg *messages.AuthenticatedMessage,
+	ctx context.Context) (*connect.Auth, error) {
+	auth, err := r.AuthenticatedReceiver(msg, ctx)
+	if err != nil {
+		return auth, err
+	}
+	r.ChannelBinding.Check(ctx, auth)
+	return auth, nil
+}
+
 // RegisterUser event handler which registers a user with the platform
 func (r *Comms) RegisterUser(ctx context.Context, msg *pb.ClientRegistration) (
 	*pb.SignedClientRegistrationConfirmations, error) {
@@ -65,10 +89,10 @@ func (r *Comms) RegisterNode(ctx context.Context, msg *pb.NodeRegistration) (
 	}
 
 	port := msg.GetServerPort()
-	address := fmt.Sprintf("%s:%d", ip, port)
+	address := publicAddress.JoinAddress(ip, int(port))
 
-	gwAddress := fmt.Sprintf("%s:%d", msg.GetGatewayAddress(),
-		msg.GetGatewayPort())
+	gwAddress := publicAddress.JoinAddress(msg.GetGatewayAddress(),
+		int(msg.GetGatewayPort()))
 
 	// Pass information for Node registration
 	err = r.handler.RegisterNode(msg.GetSalt(), address,
@@ -80,13 +104,64 @@ func (r *Comms) RegisterNode(ctx context.Context, msg *pb.NodeRegistration) (
 
 // Handles incoming requests for the NDF
 func (r *Comms) PollNdf(ctx context.Context, ndfHash *pb.NDFHash) (*pb.NDF, error) {
-	return r.handler.PollNdf(ndfHash.Hash)
+	response, err := r.handler.PollNdf(ndfHash.Hash)
+	if err != nil || response == nil {
+		return response, err
+	}
+
+	// If the caller holds the same NDF, respond without it
+	incoming, _ := metadata.FromIncomingContext(ctx)
+	md := pb.ETagMetadata(pb.ETag(response), pb.IfNoneMatch(incoming))
+	if err = grpc.SetHeader(ctx, md); err != nil {
+		jww.WARN.Printf("Failed to set NDF tag header: %+v", err)
+	} else if pb.IsNotModified(md) {
+		return &pb.NDF{}, nil
+	}
+	return response, nil
+}
+
+// Handles incoming requests for the NDF, streaming it in chunks. The hash of
+// the marshalled NDF is sent in the trailer so the receiver can check it.
+func (r *Comms) PollNdfStream(ndfHash *pb.NDFHash,
+	stream pb.Registration_PollNdfStreamServer) error {
+	response, err := r.handler.PollNdf(ndfHash.Hash)
+	if err != nil {
+		return err
+	}
+
+	// Split response into streamable chunks
+	chunks, err := pb.SplitResponseIntoChunks(response)
+	if err != nil {
+		return err
+	}
+
+	// Send a header informing client-side of the total number of chunks
+	md := metadata.New(map[string]string{
+		pb.ChunkHeader: strconv.Itoa(len(chunks)),
+	})
+	if err = stream.SendHeader(md); err != nil {
+		return errors.Errorf("Failed to send streaming header: %v", err)
+	}
+
+	// Stream each chunk individually
+	for i, chunk := range chunks {
+		if err = stream.Send(chunk); err != nil {
+			return errors.Errorf("Failed to send chunk (%d/%d) for "+
+				"NDF: %v", i, len(chunks), err)
+		}
+	}
+
+	stream.SetTrailer(metadata.New(map[string]string{
+		pb.ChunkHashTrailer: base64.StdEncoding.EncodeToString(
+			pb.HashChunks(chunks)),
+	}))
+	return nil
 }
 
 // Server -> Permissioning unified polling
 func (r *Comms) Poll(ctx context.Context, msg *messages.AuthenticatedMessage) (*pb.PermissionPollResponse, error) {
 	// Create an auth object
-	authState, err := r.AuthenticatedReceiver(msg, ctx)
+	authState, err := r.authenticatedReceiver(msg, ctx)
 	if err != nil {
 		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
 	}
@@ -108,3 +183,64 @@ func (r *Comms) CheckRegistration(ctx context.Context, msg *pb.RegisteredNodeChe
 	//Return the new ndf
 	return r.handler.CheckRegistration(msg)
 }
+
+// Handles a request for a capability token authorizing the sender to call a
+// method on another host. The returned token is signed by permissioning.
+func (r *Comms) RequestCapability(ctx context.Context,
+	msg *messages.AuthenticatedMessage) (*messages.AssignToken, error) {
+	// Create an auth object
+	authState, err := r.authenticatedReceiver(msg, ctx)
+	if err != nil {
+		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
+	}
+	if !authState.IsAuthenticated {
+		return nil, connect.AuthError(authState.Sender.GetId())
+	}
+
+	// Unmarshall the any message to the message type needed
+	requestMsg := &messages.AssignToken{}
+	err = ptypes.UnmarshalAny(msg.Message, requestMsg)
+	if err != nil {
+		return nil, err
+	}
+	request, err := capability.Unmarshal(requestMsg.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	// The token is only ever issued to the authenticated sender
+	request.Holder = authState.Sender.GetId()
+	ttl, err := r.handler.IssueCapability(request, authState)
+	if err != nil {
+		return nil, err
+	}
+
+	err = request.Sign(ttl, r.GetPrivateKey())
+	if err != nil {
+		return nil, errors.Errorf("Failed to sign capability token: %+v", err)
+	}
+	token, err := request.Marshal()
+	return &messages.AssignToken{Token: token}, err
+}
+
+// Handles the structured metrics of a round exported by a node
+func (r *Comms) ReportRoundMetrics(ctx context.Context,
+	msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
+	// Create an auth object
+	authState, err := r.authenticatedReceiver(msg, ctx)
+	if err != nil {
+		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
+	}
+	if !authState.IsAuthenticated {
+		return nil, connect.AuthError(authState.Sender.GetId())
+	}
+
+	// Unmarshall the any message to the message type needed
+	report := &pb.RoundMetricsReport{}
+	err = ptypes.UnmarshalAny(msg.Message, report)
+	if err != nil {
+		return nil, err
+	}
+
+	return &messages.Ack{}, r.handler.ReportRoundMetrics(report, authState)
+}
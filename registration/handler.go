@@ -10,12 +10,25 @@
 package registration
 
 import (
+	"github.com/pkg/errors"
 	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/elixxir/comms/authMetrics"
+	"gitlab.com/elixxir/comms/buildInfo"
+	"gitlab.com/elixxir/comms/capability"
+	"gitlab.com/elixxir/comms/channelBinding"
+	// Registers the compressors the server accepts
+	_ "gitlab.com/elixxir/comms/compression"
+	"gitlab.com/elixxir/comms/drain"
+	"gitlab.com/elixxir/comms/endpointSwitch"
+	"gitlab.com/elixxir/comms/health"
+	"gitlab.com/elixxir/comms/interceptors"
 	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/validation"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/comms/messages"
 	"gitlab.com/xx_network/primitives/id"
 	"runtime/debug"
+	"time"
 )
 
 // Registration object used to implement
@@ -23,10 +36,32 @@ import (
 type Comms struct {
 	*connect.ProtoComms
 	handler Handler
-	*pb.UnimplementedRegistrationServer
-	*messages.UnimplementedGenericServer
+	// Results of reverse-authentication handshakes with this server
+	AuthMetrics *authMetrics.Tracker
+	// If set, reverse-authentication tokens are bound to the TLS session
+	// they are issued over
+	ChannelBinding *channelBinding.Binder
+	// Describes what this server is running to remote callers
+	BuildInfo *buildInfo.Server
+	// Liveness and readiness probes of this server
+	Health *health.Server
+	// Interceptors run on the calls received and sent by this server
+	Interceptors *interceptors.Chain
+	// Validators checking the requests received before they reach the
+	// handler
+	Validators *validation.Validators
+	// Endpoints disabled at runtime
+	Switches *endpointSwitch.Switches
+	pb.UnimplementedRegistrationServer
+	messages.UnimplementedGenericServer
 }
 
+// Comms is checked at compile time to serve its gRPC services
+var (
+	_ pb.RegistrationServer  = (*Comms)(nil)
+	_ messages.GenericServer = (*Comms)(nil)
+)
+
 // Starts a new server on the address:port specified by localServer
 // and a callback interface for server operations
 // with given path to public and private key for TLS connection
@@ -40,16 +75,39 @@ func StartRegistrationServer(id *id.ID, localServer string, handler Handler,
 	}
 
 	registrationServer := Comms{
-		ProtoComms: pc,
-		handler:    handler,
+		ProtoComms:  pc,
+		handler:     handler,
+		AuthMetrics: authMetrics.NewTracker(),
 	}
-	pb.RegisterRegistrationServer(registrationServer.GetServer(), &registrationServer)
+	registrationServer.Switches = endpointSwitch.NewSwitches()
+	registrationServer.Interceptors = interceptors.New()
+	registrationServer.Validators = newValidators()
+	registrationServer.Interceptors.AddUnaryServerInterceptor(
+		registrationServer.Validators.UnaryServerInterceptor())
+	registrationServer.Switches.Register(registrationServer.GetServer(),
+		registrationServer.Interceptors.Wrap(&pb.Registration_ServiceDesc), &registrationServer)
+	registrationServer.Switches.RegisterAdmin(registrationServer.GetServer(), registrationServer.authenticatedReceiver)
 	messages.RegisterGenericServer(registrationServer.GetServer(), &registrationServer)
+	registrationServer.BuildInfo = buildInfo.NewServer()
+	registrationServer.BuildInfo.Register(registrationServer.GetServer())
+	registrationServer.Health = health.NewServer(handler)
+	registrationServer.Health.Register(registrationServer.GetServer())
 
 	pc.Serve()
 	return &registrationServer
 }
 
+// ShutdownGraceful shuts down the registration server like Shutdown, but first stops it
+// accepting new RPCs and waits up to the timeout for in-flight RPCs, including
+// active streams, to complete before closing all host connections. Returns
+// true if every in-flight RPC completed in time.
+func (r *Comms) ShutdownGraceful(timeout time.Duration) bool {
+	r.Health.SetDraining()
+	completed := drain.Stop(r.GetServer(), timeout)
+	r.ProtoComms.Shutdown()
+	return completed
+}
+
 type Handler interface {
 	RegisterUser(msg *pb.ClientRegistration) (confirmation *pb.SignedClientRegistrationConfirmations, err error)
 	RegisterNode(salt []byte, serverAddr, serverTlsCert, gatewayAddr,
@@ -58,6 +116,9 @@ type Handler interface {
 	Poll(msg *pb.PermissioningPoll, auth *connect.Auth) (*pb.
 		PermissionPollResponse, error)
 	CheckRegistration(msg *pb.RegisteredNodeCheck) (*pb.RegisteredNodeConfirmation, error)
+	IssueCapability(request *capability.Token, auth *connect.Auth) (
+		time.Duration, error)
+	ReportRoundMetrics(report *pb.RoundMetricsReport, auth *connect.Auth) error
 }
 
 type implementationFunctions struct {
@@ -67,6 +128,12 @@ type implementationFunctions struct {
 	PollNdf           func(ndfHash []byte) (*pb.NDF, error)
 	Poll              func(msg *pb.PermissioningPoll, auth *connect.Auth) (*pb.PermissionPollResponse, error)
 	CheckRegistration func(msg *pb.RegisteredNodeCheck) (*pb.RegisteredNodeConfirmation, error)
+	// IssueCapability decides whether the sender may hold the requested
+	// token and returns how long it is valid for
+	IssueCapability func(request *capability.Token, auth *connect.Auth) (
+		time.Duration, error)
+	// ReportRoundMetrics receives the metrics a node recorded for a round
+	ReportRoundMetrics func(report *pb.RoundMetricsReport, auth *connect.Auth) error
 }
 
 // Implementation allows users of the client library to set the
@@ -109,6 +176,16 @@ func NewImplementation() *Implementation {
 				warn(um)
 				return &pb.RegisteredNodeConfirmation{}, nil
 			},
+			IssueCapability: func(request *capability.Token,
+				auth *connect.Auth) (time.Duration, error) {
+				warn(um)
+				return 0, errors.New(um)
+			},
+			ReportRoundMetrics: func(report *pb.RoundMetricsReport,
+				auth *connect.Auth) error {
+				warn(um)
+				return nil
+			},
 		},
 	}
 }
@@ -136,3 +213,13 @@ func (s *Implementation) CheckRegistration(msg *pb.RegisteredNodeCheck) (*pb.
 	RegisteredNodeConfirmation, error) {
 	return s.Functions.CheckRegistration(msg)
 }
+
+func (s *Implementation) IssueCapability(request *capability.Token,
+	auth *connect.Auth) (time.Duration, error) {
+	return s.Functions.IssueCapability(request, auth)
+}
+
+func (s *Implementation) ReportRoundMetrics(report *pb.RoundMetricsReport,
+	auth *connect.Auth) error {
+	return s.Functions.ReportRoundMetrics(report, auth)
+}
@@ -9,6 +9,8 @@ package registration
 
 import (
 	"fmt"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/testutils"
 	"gitlab.com/xx_network/primitives/id"
 	"sync"
 	"testing"
@@ -37,3 +39,13 @@ func TestBadCerts(t *testing.T) {
 
 	_ = StartRegistrationServer(testId, RegAddress, NewImplementation(), []byte("bad cert"), []byte("bad key"), nil)
 }
+
+// Tests that every Handler method has an endpoint and every endpoint a
+// Handler method.
+func TestHandler_Endpoints(t *testing.T) {
+	// PollNdfStream is served by PollNdf and RequestCapability by
+	// IssueCapability. RegisterUser is not part of the service.
+	testutils.CheckEndpoints(t, (*Handler)(nil), &pb.Registration_ServiceDesc,
+		"RegisterUser", "IssueCapability",
+		"PollNdfStream", "RequestCapability")
+}
@@ -0,0 +1,28 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the validation of the requests received by permissioning
+
+package registration
+
+import (
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/validation"
+)
+
+// maxRegistrationCodeLen is the longest registration code accepted.
+const maxRegistrationCodeLen = 256
+
+// newValidators returns the validators the requests received by
+// permissioning are checked with by default.
+func newValidators() *validation.Validators {
+	v := validation.New()
+	v.Register(&pb.RegisteredNodeCheck{}, validation.ID("ID"))
+	v.Register(&pb.NodeRegistration{},
+		validation.MaxLength("RegistrationCode", maxRegistrationCodeLen))
+	return v
+}
@@ -17,7 +17,7 @@ func (rc *Comms) Login(host *connect.Host, msg *pb.RsAuthenticationRequest) (*pb
 		ctx, cancel := host.GetMessagingContext()
 		defer cancel()
 		// Send the message
-		resultMsg, err := pb.NewRemoteSyncClient(conn.GetGrpcConn()).
+		resultMsg, err := pb.NewRemoteSyncClient(rc.Interceptors.ClientConn(conn.GetGrpcConn())).
 			Login(ctx, msg)
 		if err != nil {
 			return nil, errors.New(err.Error())
@@ -44,7 +44,7 @@ func (rc *Comms) Read(host *connect.Host, msg *pb.RsReadRequest) (*pb.RsReadResp
 		ctx, cancel := host.GetMessagingContext()
 		defer cancel()
 		// Send the message
-		resultMsg, err := pb.NewRemoteSyncClient(conn.GetGrpcConn()).
+		resultMsg, err := pb.NewRemoteSyncClient(rc.Interceptors.ClientConn(conn.GetGrpcConn())).
 			Read(ctx, msg)
 		if err != nil {
 			return nil, errors.New(err.Error())
@@ -71,7 +71,7 @@ func (rc *Comms) Write(host *connect.Host, msg *pb.RsWriteRequest) (*messages.Ac
 		ctx, cancel := host.GetMessagingContext()
 		defer cancel()
 		// Send the message
-		resultMsg, err := pb.NewRemoteSyncClient(conn.GetGrpcConn()).
+		resultMsg, err := pb.NewRemoteSyncClient(rc.Interceptors.ClientConn(conn.GetGrpcConn())).
 			Write(ctx, msg)
 		if err != nil {
 			return nil, errors.New(err.Error())
@@ -98,7 +98,7 @@ func (rc *Comms) GetLastModified(host *connect.Host, msg *pb.RsReadRequest) (*pb
 		ctx, cancel := host.GetMessagingContext()
 		defer cancel()
 		// Send the message
-		resultMsg, err := pb.NewRemoteSyncClient(conn.GetGrpcConn()).
+		resultMsg, err := pb.NewRemoteSyncClient(rc.Interceptors.ClientConn(conn.GetGrpcConn())).
 			GetLastModified(ctx, msg)
 		if err != nil {
 			return nil, errors.New(err.Error())
@@ -125,7 +125,7 @@ func (rc *Comms) GetLastWrite(host *connect.Host, msg *pb.RsLastWriteRequest) (*
 		ctx, cancel := host.GetMessagingContext()
 		defer cancel()
 		// Send the message
-		resultMsg, err := pb.NewRemoteSyncClient(conn.GetGrpcConn()).
+		resultMsg, err := pb.NewRemoteSyncClient(rc.Interceptors.ClientConn(conn.GetGrpcConn())).
 			GetLastWrite(ctx, msg)
 		if err != nil {
 			return nil, errors.New(err.Error())
@@ -152,7 +152,7 @@ func (rc *Comms) ReadDir(host *connect.Host, msg *pb.RsReadRequest) (*pb.RsReadD
 		ctx, cancel := host.GetMessagingContext()
 		defer cancel()
 		// Send the message
-		resultMsg, err := pb.NewRemoteSyncClient(conn.GetGrpcConn()).
+		resultMsg, err := pb.NewRemoteSyncClient(rc.Interceptors.ClientConn(conn.GetGrpcConn())).
 			ReadDir(ctx, msg)
 		if err != nil {
 			return nil, errors.New(err.Error())
@@ -2,6 +2,8 @@ package client
 
 import (
 	"github.com/pkg/errors"
+	"gitlab.com/elixxir/comms/instrumentation"
+	"gitlab.com/elixxir/comms/interceptors"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/primitives/id"
 )
@@ -9,6 +11,10 @@ import (
 // Comms is an object used for top-level remote sync client calls.
 type Comms struct {
 	*connect.ProtoComms
+	// Callbacks reporting each send
+	sendHooks instrumentation.Hooks
+	// Interceptors run on the calls sent by this client
+	Interceptors *interceptors.Chain
 }
 
 // NewClientComms returns a Comms object with given attributes.
@@ -17,5 +23,5 @@ func NewClientComms(id *id.ID, pubKeyPem, privKeyPem, salt []byte) (*Comms, erro
 	if err != nil {
 		return nil, errors.Errorf("Unable to create Client comms: %+v", err)
 	}
-	return &Comms{pc}, nil
+	return &Comms{ProtoComms: pc, Interceptors: interceptors.New()}, nil
 }
@@ -0,0 +1,59 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains reporting of sends to user-registered callbacks
+
+package client
+
+import (
+	"github.com/golang/protobuf/ptypes/any"
+	"gitlab.com/elixxir/comms/bandwidth"
+	"gitlab.com/elixxir/comms/chaos"
+	"gitlab.com/elixxir/comms/circuitBreaker"
+	"gitlab.com/elixxir/comms/instrumentation"
+	"gitlab.com/elixxir/comms/rateLimit"
+	"gitlab.com/xx_network/comms/connect"
+)
+
+// SetSendCallbacks registers callbacks which are called at the start and end
+// of every send made by these comms. Passing the zero Callbacks disables them.
+func (rc *Comms) SetSendCallbacks(callbacks instrumentation.Callbacks) {
+	rc.sendHooks.Set(callbacks)
+}
+
+// Send overrides connect.ProtoComms.Send to report each send to the
+// registered callbacks.
+func (rc *Comms) Send(host *connect.Host, f func(conn connect.Connection) (
+	*any.Any, error)) (*any.Any, error) {
+	return rc.sendHooks.Send(rc.ProtoComms, host, f)
+}
+
+// SetFaults sets the injector whose faults are injected into the sends made
+// by these comms, for testing. Passing nil disables fault injection.
+func (rc *Comms) SetFaults(faults *chaos.Injector) {
+	rc.sendHooks.SetFaults(faults)
+}
+
+// SetRateLimiter sets the limiter of the rate of the sends made by these comms
+// to each host. Passing nil removes the limits.
+func (rc *Comms) SetRateLimiter(limiter *rateLimit.Limiter) {
+	rc.sendHooks.SetRateLimiter(limiter)
+}
+
+// SetBandwidthMeter sets the meter accounting for the bytes sent to and
+// received from each host by these comms and enforcing their quotas. The
+// meter is installed on the Interceptors. Passing nil stops enforcing quotas.
+func (rc *Comms) SetBandwidthMeter(meter *bandwidth.Meter) {
+	meter.Install(rc.Interceptors)
+	rc.sendHooks.SetBandwidthMeter(meter)
+}
+
+// SetCircuitBreakers sets the breakers which fail the sends made by these
+// comms fast to hosts which keep failing. Passing nil disables them.
+func (rc *Comms) SetCircuitBreakers(breakers *circuitBreaker.Breakers) {
+	rc.sendHooks.SetCircuitBreakers(breakers)
+}
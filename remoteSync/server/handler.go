@@ -11,6 +11,12 @@ package server
 
 import (
 	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/elixxir/comms/buildInfo"
+	// Registers the compressors the server accepts
+	_ "gitlab.com/elixxir/comms/compression"
+	"gitlab.com/elixxir/comms/endpointSwitch"
+	"gitlab.com/elixxir/comms/health"
+	"gitlab.com/elixxir/comms/interceptors"
 	pb "gitlab.com/elixxir/comms/mixmessages"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/comms/messages"
@@ -23,10 +29,24 @@ import (
 type Comms struct {
 	*connect.ProtoComms
 	handler Handler
-	*pb.UnimplementedRemoteSyncServer
-	*messages.UnimplementedGenericServer
+	// Describes what this server is running to remote callers
+	BuildInfo *buildInfo.Server
+	// Liveness and readiness probes of this server
+	Health *health.Server
+	// Interceptors run on the calls received and sent by this server
+	Interceptors *interceptors.Chain
+	// Endpoints disabled at runtime
+	Switches *endpointSwitch.Switches
+	pb.UnimplementedRemoteSyncServer
+	messages.UnimplementedGenericServer
 }
 
+// Comms is checked at compile time to serve its gRPC services
+var (
+	_ pb.RemoteSyncServer    = (*Comms)(nil)
+	_ messages.GenericServer = (*Comms)(nil)
+)
+
 // Handler describes the endpoint callbacks for remote sync.
 type Handler interface {
 	Login(*pb.RsAuthenticationRequest) (*pb.RsAuthenticationResponse, error)
@@ -56,8 +76,16 @@ func StartRemoteSync(id *id.ID, localServer string, handler Handler,
 
 	// Register the high-level comms endpoint functionality
 	grpcServer := rsServer.GetServer()
-	pb.RegisterRemoteSyncServer(grpcServer, &rsServer)
+	rsServer.Switches = endpointSwitch.NewSwitches()
+	rsServer.Interceptors = interceptors.New()
+	rsServer.Switches.Register(grpcServer,
+		rsServer.Interceptors.Wrap(&pb.RemoteSync_ServiceDesc), &rsServer)
+	rsServer.Switches.RegisterAdmin(grpcServer, rsServer.AuthenticatedReceiver)
 	messages.RegisterGenericServer(grpcServer, &rsServer)
+	rsServer.BuildInfo = buildInfo.NewServer()
+	rsServer.BuildInfo.Register(grpcServer)
+	rsServer.Health = health.NewServer(handler)
+	rsServer.Health.Register(grpcServer)
 
 	pc.ServeWithWeb()
 	return &rsServer
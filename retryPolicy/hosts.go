@@ -0,0 +1,196 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package retryPolicy
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// HostParams returns a copy of the host parameters under which the host
+// makes a single attempt each time it connects, leaving retries to Connect.
+func HostParams(params connect.HostParams) connect.HostParams {
+	params.MaxRetries = 1
+	return params
+}
+
+// Hosts holds the retry policy of each host, falling back to a default for
+// hosts without one.
+type Hosts struct {
+	defaultPolicy Policy
+	policies      map[id.ID]Policy
+	// Cancels each connection in progress, by host
+	dials    map[id.ID]map[uint64]context.CancelFunc
+	nextDial uint64
+	// Replaced in tests to avoid waiting
+	sleep func(ctx context.Context, d time.Duration) error
+	mux   sync.RWMutex
+}
+
+// NewHosts returns Hosts which use the policy for hosts without their own.
+// If the policy is nil, Default is used.
+func NewHosts(defaultPolicy Policy) *Hosts {
+	if defaultPolicy == nil {
+		defaultPolicy = Default()
+	}
+	return &Hosts{
+		defaultPolicy: defaultPolicy,
+		policies:      make(map[id.ID]Policy),
+		dials:         make(map[id.ID]map[uint64]context.CancelFunc),
+		sleep:         sleep,
+	}
+}
+
+// SetDefault changes the policy of hosts without their own.
+func (h *Hosts) SetDefault(policy Policy) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	h.defaultPolicy = policy
+}
+
+// Set sets the policy of the host. A nil policy reverts the host to the
+// default.
+func (h *Hosts) Set(hid *id.ID, policy Policy) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	if policy == nil {
+		delete(h.policies, *hid)
+		return
+	}
+	h.policies[*hid] = policy
+}
+
+// Get returns the policy of the host.
+func (h *Hosts) Get(hid *id.ID) Policy {
+	h.mux.RLock()
+	defer h.mux.RUnlock()
+	if policy, exists := h.policies[*hid]; exists {
+		return policy
+	}
+	return h.defaultPolicy
+}
+
+// Connect connects to the host, retrying as its policy decides. The host
+// should have been created with HostParams, otherwise each attempt is itself
+// retried by connect.Host. It can be cancelled by Disconnect.
+func (h *Hosts) Connect(host *connect.Host) error {
+	return h.ConnectWithContext(context.Background(), host)
+}
+
+// ConnectWithContext is Connect, but stops once the context is done. An
+// attempt in progress is abandoned rather than waited for; if it succeeds
+// later, the host is disconnected again.
+func (h *Hosts) ConnectWithContext(ctx context.Context,
+	host *connect.Host) error {
+	ctx, done := h.startDial(ctx, host.GetId())
+	defer done()
+
+	policy := h.Get(host.GetId())
+
+	var err error
+	for attempt := uint32(0); attempt < policy.Attempts(); attempt++ {
+		if err = h.attempt(ctx, host); err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		delay := policy.Backoff(attempt)
+		jww.DEBUG.Printf("Connection attempt %d of %d to %s failed, "+
+			"retrying in %s: %+v", attempt+1, policy.Attempts(),
+			host.GetId(), delay, err)
+		if attempt+1 < policy.Attempts() {
+			if h.sleep(ctx, delay) != nil {
+				break
+			}
+		}
+	}
+
+	if ctx.Err() != nil {
+		return errors.WithMessagef(ctx.Err(), "Stopped connecting to %s",
+			host.GetId())
+	}
+	return errors.Errorf("Failed to connect to %s after %d attempts: %+v",
+		host.GetId(), policy.Attempts(), err)
+}
+
+// Disconnect cancels every connection to the host in progress and then
+// disconnects it.
+func (h *Hosts) Disconnect(host *connect.Host) {
+	h.mux.Lock()
+	for _, cancel := range h.dials[*host.GetId()] {
+		cancel()
+	}
+	h.mux.Unlock()
+	host.Disconnect()
+}
+
+// startDial returns a context for connecting to the host which is cancelled
+// by Disconnect, and a function to call once done connecting.
+func (h *Hosts) startDial(ctx context.Context, hid *id.ID) (
+	context.Context, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	h.nextDial++
+	dialID := h.nextDial
+	if h.dials[*hid] == nil {
+		h.dials[*hid] = make(map[uint64]context.CancelFunc)
+	}
+	h.dials[*hid][dialID] = cancel
+
+	return ctx, func() {
+		cancel()
+		h.mux.Lock()
+		defer h.mux.Unlock()
+		delete(h.dials[*hid], dialID)
+		if len(h.dials[*hid]) == 0 {
+			delete(h.dials, *hid)
+		}
+	}
+}
+
+// attempt makes a single connection attempt, returning early if the context
+// is done first.
+func (h *Hosts) attempt(ctx context.Context, host *connect.Host) error {
+	result := make(chan error, 1)
+	go func() {
+		result <- host.Connect()
+	}()
+
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		go func() {
+			if <-result == nil {
+				host.Disconnect()
+			}
+		}()
+		return ctx.Err()
+	}
+}
+
+// sleep waits for the duration or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
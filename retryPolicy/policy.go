@@ -0,0 +1,107 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package retryPolicy controls how connections to hosts are retried. The
+// connection logic of connect.Host retries on a fixed schedule; hosts
+// created with HostParams make a single attempt each time they connect, so
+// that a Policy decides how many attempts are made and how long is waited
+// between them.
+package retryPolicy
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// Policy decides how connection attempts are retried.
+type Policy interface {
+	// Attempts returns the maximum number of connection attempts
+	Attempts() uint32
+	// Backoff returns the time waited after the given failed attempt,
+	// counting from zero, before the next attempt
+	Backoff(attempt uint32) time.Duration
+}
+
+// Constant waits the same delay between every attempt.
+type Constant struct {
+	MaxAttempts uint32
+	Delay       time.Duration
+}
+
+// Attempts returns the maximum number of connection attempts.
+func (c Constant) Attempts() uint32 {
+	return c.MaxAttempts
+}
+
+// Backoff returns the delay.
+func (c Constant) Backoff(uint32) time.Duration {
+	return c.Delay
+}
+
+// Exponential multiplies the delay after each attempt, up to a maximum.
+type Exponential struct {
+	MaxAttempts uint32
+	// Delay after the first attempt
+	Initial time.Duration
+	// Factor the delay grows by after each attempt. Values below one are
+	// treated as one.
+	Multiplier float64
+	// Longest delay waited. If zero, the delay is not capped.
+	Max time.Duration
+}
+
+// Attempts returns the maximum number of connection attempts.
+func (e Exponential) Attempts() uint32 {
+	return e.MaxAttempts
+}
+
+// Backoff returns Initial*Multiplier^attempt, capped at Max.
+func (e Exponential) Backoff(attempt uint32) time.Duration {
+	multiplier := math.Max(e.Multiplier, 1)
+	delay := float64(e.Initial) * math.Pow(multiplier, float64(attempt))
+	if e.Max > 0 && delay > float64(e.Max) {
+		return e.Max
+	}
+	if delay > math.MaxInt64 {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(delay)
+}
+
+// Jittered randomizes the delays of another policy so that many hosts
+// losing their connection at once do not retry in lockstep.
+type Jittered struct {
+	Policy
+	// Fraction of each delay which is randomized. A fraction of 0.2 gives
+	// delays between 80% and 120% of those of the policy. Values are
+	// clamped to [0, 1].
+	Fraction float64
+}
+
+// Backoff returns the delay of the policy, randomized by the fraction.
+func (j Jittered) Backoff(attempt uint32) time.Duration {
+	delay := float64(j.Policy.Backoff(attempt))
+	fraction := math.Min(math.Max(j.Fraction, 0), 1)
+	jitter := (rand.Float64()*2 - 1) * fraction * delay
+	return time.Duration(delay + jitter)
+}
+
+// Default returns the policy used for hosts without one: 100 attempts, as
+// connect.Host makes by default, with exponential backoff from 100ms up to
+// 15s and 20% jitter.
+func Default() Policy {
+	return Jittered{
+		Policy: Exponential{
+			MaxAttempts: 100,
+			Initial:     100 * time.Millisecond,
+			Multiplier:  2,
+			Max:         15 * time.Second,
+		},
+		Fraction: 0.2,
+	}
+}
@@ -0,0 +1,171 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package retryPolicy
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+func TestMain(m *testing.M) {
+	connect.TestingOnlyDisableTLS = true
+	os.Exit(m.Run())
+}
+
+// Tests that Exponential grows its delay and caps it at the maximum.
+func TestExponential_Backoff(t *testing.T) {
+	e := Exponential{
+		MaxAttempts: 10,
+		Initial:     time.Second,
+		Multiplier:  2,
+		Max:         5 * time.Second,
+	}
+
+	expected := []time.Duration{time.Second, 2 * time.Second,
+		4 * time.Second, 5 * time.Second, 5 * time.Second}
+	for attempt, delay := range expected {
+		if got := e.Backoff(uint32(attempt)); got != delay {
+			t.Errorf("Unexpected delay after attempt %d."+
+				"\nexpected: %s\nreceived: %s", attempt, delay, got)
+		}
+	}
+
+	// A multiplier below one does not shrink the delay
+	e.Multiplier = 0.5
+	if got := e.Backoff(3); got != time.Second {
+		t.Errorf("Delay shrank to %s.", got)
+	}
+}
+
+// Tests that Jittered keeps delays within the fraction of the policy's.
+func TestJittered_Backoff(t *testing.T) {
+	j := Jittered{
+		Policy:   Constant{MaxAttempts: 3, Delay: time.Second},
+		Fraction: 0.2,
+	}
+	if j.Attempts() != 3 {
+		t.Errorf("Unexpected attempts %d.", j.Attempts())
+	}
+
+	for i := 0; i < 100; i++ {
+		delay := j.Backoff(0)
+		if delay < 800*time.Millisecond || delay > 1200*time.Millisecond {
+			t.Fatalf("Delay %s outside of the jitter.", delay)
+		}
+	}
+}
+
+// Tests that hosts use their own policy or the default.
+func TestHosts_Get(t *testing.T) {
+	defaultPolicy := Constant{MaxAttempts: 1}
+	h := NewHosts(defaultPolicy)
+
+	hid := id.NewIdFromString("node", id.Node, t)
+	other := id.NewIdFromString("other", id.Node, t)
+	policy := Constant{MaxAttempts: 7}
+	h.Set(hid, policy)
+
+	if h.Get(hid) != policy {
+		t.Errorf("Host does not use its own policy.")
+	}
+	if h.Get(other) != defaultPolicy {
+		t.Errorf("Host without a policy does not use the default.")
+	}
+
+	h.Set(hid, nil)
+	if h.Get(hid) != defaultPolicy {
+		t.Errorf("Host does not revert to the default.")
+	}
+}
+
+// Tests that Connect makes the attempts of the policy with its backoff.
+func TestHosts_Connect(t *testing.T) {
+	h := NewHosts(Constant{MaxAttempts: 2, Delay: time.Hour})
+	var slept []time.Duration
+	h.sleep = func(_ context.Context, d time.Duration) error {
+		slept = append(slept, d)
+		return nil
+	}
+
+	hid := id.NewIdFromString("node", id.Node, t)
+	params := HostParams(connect.GetDefaultHostParams())
+	params.AuthEnabled = false
+	host, err := connect.NewHost(hid, "0.0.0.0:1", nil, params)
+	if err != nil {
+		t.Fatalf("Failed to create host: %+v", err)
+	}
+
+	if err = h.Connect(host); err == nil {
+		t.Fatalf("Connected to an unused address.")
+	}
+	if len(slept) != 1 || slept[0] != time.Hour {
+		t.Errorf("Unexpected backoff between attempts: %v", slept)
+	}
+}
+
+// Tests that ConnectWithContext stops retrying once its context is done.
+func TestHosts_ConnectWithContext(t *testing.T) {
+	h := NewHosts(Constant{MaxAttempts: 100, Delay: time.Hour})
+
+	hid := id.NewIdFromString("node", id.Node, t)
+	params := HostParams(connect.GetDefaultHostParams())
+	params.AuthEnabled = false
+	host, err := connect.NewHost(hid, "0.0.0.0:1", nil, params)
+	if err != nil {
+		t.Fatalf("Failed to create host: %+v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(),
+		100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err = h.ConnectWithContext(ctx, host)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected the deadline to be exceeded, got: %+v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Connecting took %s after the context was done", elapsed)
+	}
+}
+
+// Tests that Disconnect cancels a connection in progress.
+func TestHosts_Disconnect(t *testing.T) {
+	h := NewHosts(Constant{MaxAttempts: 100, Delay: time.Hour})
+
+	hid := id.NewIdFromString("node", id.Node, t)
+	params := HostParams(connect.GetDefaultHostParams())
+	params.AuthEnabled = false
+	host, err := connect.NewHost(hid, "0.0.0.0:1", nil, params)
+	if err != nil {
+		t.Fatalf("Failed to create host: %+v", err)
+	}
+
+	result := make(chan error, 1)
+	go func() { result <- h.Connect(host) }()
+	time.Sleep(50 * time.Millisecond)
+	h.Disconnect(host)
+
+	select {
+	case err = <-result:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected the connection to be cancelled, got: %+v",
+				err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Disconnect did not cancel the connection")
+	}
+	if connected, _ := host.Connected(); connected {
+		t.Error("Host is connected after Disconnect")
+	}
+}
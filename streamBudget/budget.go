@@ -0,0 +1,123 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package streamBudget limits the memory held by streams received by a comms
+// server. New streams are rejected with RESOURCE_EXHAUSTED while the data
+// received by open streams exceeds the budget, so that a server receiving
+// many batches at once does not run out of memory.
+package streamBudget
+
+import (
+	"sync/atomic"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Budget accounts for the bytes received by open streams. Bytes received by
+// a stream are counted until its handler returns, as handlers typically keep
+// the data of a stream until it ends.
+type Budget struct {
+	limit    int64
+	inFlight int64
+	rejected uint64
+}
+
+// NewBudget returns a Budget admitting new streams while fewer than limit
+// bytes are held by open streams. A limit of zero or less disables the limit.
+func NewBudget(limit int64) *Budget {
+	return &Budget{limit: limit}
+}
+
+// SetLimit changes the limit. Open streams are not affected.
+func (b *Budget) SetLimit(limit int64) {
+	atomic.StoreInt64(&b.limit, limit)
+}
+
+// InFlight returns the number of bytes held by open streams.
+func (b *Budget) InFlight() int64 {
+	return atomic.LoadInt64(&b.inFlight)
+}
+
+// Rejected returns the number of streams rejected for exceeding the budget.
+func (b *Budget) Rejected() uint64 {
+	return atomic.LoadUint64(&b.rejected)
+}
+
+// admit returns a RESOURCE_EXHAUSTED error if the budget is exceeded.
+func (b *Budget) admit(method string) error {
+	limit := atomic.LoadInt64(&b.limit)
+	inFlight := atomic.LoadInt64(&b.inFlight)
+	if limit > 0 && inFlight >= limit {
+		atomic.AddUint64(&b.rejected, 1)
+		return status.Errorf(codes.ResourceExhausted, "Rejecting stream "+
+			"%s: %d bytes are held by open streams, exceeding the budget "+
+			"of %d", method, inFlight, limit)
+	}
+	return nil
+}
+
+// Wrap returns a copy of the service description whose stream handlers are
+// rejected while the budget is exceeded and count the bytes they receive
+// against it. Unary handlers are unchanged. A nil Budget returns the
+// description unchanged.
+func (b *Budget) Wrap(desc *grpc.ServiceDesc) *grpc.ServiceDesc {
+	if b == nil {
+		return desc
+	}
+	wrapped := *desc
+
+	wrapped.Streams = make([]grpc.StreamDesc, len(desc.Streams))
+	for i, sd := range desc.Streams {
+		method := "/" + desc.ServiceName + "/" + sd.StreamName
+		handler := sd.Handler
+		wrapped.Streams[i] = sd
+		if !sd.ClientStreams {
+			continue
+		}
+		wrapped.Streams[i].Handler = func(srv interface{},
+			stream grpc.ServerStream) error {
+			if err := b.admit(method); err != nil {
+				return err
+			}
+			counted := &countingStream{ServerStream: stream, budget: b}
+			defer counted.release()
+			return handler(srv, counted)
+		}
+	}
+
+	return &wrapped
+}
+
+// countingStream counts the bytes of each message received against the
+// budget.
+type countingStream struct {
+	grpc.ServerStream
+	budget *Budget
+	bytes  int64
+}
+
+// RecvMsg receives a message, counting its size.
+func (cs *countingStream) RecvMsg(m interface{}) error {
+	err := cs.ServerStream.RecvMsg(m)
+	if err != nil {
+		return err
+	}
+	if msg, ok := m.(proto.Message); ok {
+		size := int64(proto.Size(msg))
+		cs.bytes += size
+		atomic.AddInt64(&cs.budget.inFlight, size)
+	}
+	return nil
+}
+
+// release returns the bytes received by the stream to the budget.
+func (cs *countingStream) release() {
+	atomic.AddInt64(&cs.budget.inFlight, -cs.bytes)
+}
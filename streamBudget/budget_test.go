@@ -0,0 +1,84 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package streamBudget
+
+import (
+	"testing"
+
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mockStream is a ServerStream receiving slots with a fixed payload.
+type mockStream struct {
+	grpc.ServerStream
+	payload []byte
+}
+
+func (ms *mockStream) RecvMsg(m interface{}) error {
+	m.(*pb.Slot).PayloadA = ms.payload
+	return nil
+}
+
+// Tests that new streams are rejected while open streams hold more than the
+// budget and are admitted again once those streams end.
+func TestBudget_Wrap(t *testing.T) {
+	b := NewBudget(100)
+
+	release := make(chan struct{})
+	received := make(chan struct{})
+	desc := &grpc.ServiceDesc{
+		ServiceName: "test.Service",
+		Streams: []grpc.StreamDesc{{
+			StreamName: "Upload",
+			Handler: func(_ interface{}, stream grpc.ServerStream) error {
+				if err := stream.RecvMsg(&pb.Slot{}); err != nil {
+					return err
+				}
+				received <- struct{}{}
+				<-release
+				return nil
+			},
+			ClientStreams: true,
+		}},
+	}
+	handler := b.Wrap(desc).Streams[0].Handler
+
+	errChan := make(chan error)
+	go func() {
+		errChan <- handler(nil, &mockStream{payload: make([]byte, 200)})
+	}()
+	<-received
+	if b.InFlight() <= 100 {
+		t.Errorf("Received bytes were not counted: %d", b.InFlight())
+	}
+
+	err := handler(nil, &mockStream{})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Stream over budget was not rejected: %+v", err)
+	}
+	if b.Rejected() != 1 {
+		t.Errorf("Unexpected rejection count %d", b.Rejected())
+	}
+
+	close(release)
+	if err = <-errChan; err != nil {
+		t.Errorf("Admitted stream failed: %+v", err)
+	}
+	if b.InFlight() != 0 {
+		t.Errorf("Bytes were not released: %d", b.InFlight())
+	}
+
+	go func() { errChan <- handler(nil, &mockStream{}) }()
+	<-received
+	if err = <-errChan; err != nil {
+		t.Errorf("Stream was rejected after the budget freed: %+v", err)
+	}
+}
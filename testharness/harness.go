@@ -0,0 +1,282 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package testharness starts a small network of real comms objects within a
+// test: a permissioning server, nodes, a gateway for each node and clients,
+// each holding hosts for the others it talks to. Their handlers are the
+// Implementation of each package, whose functions tests replace to script
+// the behaviour of the network, so that protocol changes can be checked end
+// to end within this repository.
+//
+// The servers listen on loopback TCP ports rather than in memory, since
+// connect.Host dials its address itself and cannot be given a dialer.
+package testharness
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/pkg/errors"
+	"gitlab.com/elixxir/comms/client"
+	"gitlab.com/elixxir/comms/gateway"
+	"gitlab.com/elixxir/comms/node"
+	"gitlab.com/elixxir/comms/registration"
+	"gitlab.com/elixxir/comms/testkeys"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/gossip"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+// Config describes the network to start.
+type Config struct {
+	// Number of nodes, each with a gateway
+	Nodes int
+	// Number of clients
+	Clients int
+	// Parameters of the hosts each member adds for the others
+	HostParams connect.HostParams
+}
+
+// DefaultConfig returns the configuration of a network with three nodes and
+// one client whose hosts do not authenticate.
+func DefaultConfig() Config {
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	return Config{
+		Nodes:      3,
+		Clients:    1,
+		HostParams: params,
+	}
+}
+
+// Permissioning is the permissioning server of the network.
+type Permissioning struct {
+	ID      *id.ID
+	Address string
+	Comms   *registration.Comms
+	Handler *registration.Implementation
+}
+
+// Node is a node of the network.
+type Node struct {
+	ID      *id.ID
+	Address string
+	Comms   *node.Comms
+	Handler *node.Implementation
+}
+
+// Gateway is the gateway of a node of the network. Its ID is the ID of its
+// node with the gateway type.
+type Gateway struct {
+	ID      *id.ID
+	Address string
+	Comms   *gateway.Comms
+	Handler *gateway.Implementation
+}
+
+// Network is a running network. Members are indexed in the order they were
+// started; Gateways[i] is the gateway of Nodes[i].
+type Network struct {
+	Permissioning *Permissioning
+	Nodes         []*Node
+	Gateways      []*Gateway
+	Clients       []*client.Comms
+}
+
+// New starts a network and stops it when the test finishes. It fails the test
+// if any member cannot be started.
+func New(t testing.TB, config Config) *Network {
+	n, err := Start(config)
+	if err != nil {
+		t.Fatalf("Failed to start test network: %+v", err)
+	}
+	t.Cleanup(n.Shutdown)
+	return n
+}
+
+// Start starts a network. The caller must call Shutdown once done with it.
+func Start(config Config) (*Network, error) {
+	n := &Network{}
+
+	address, err := freeAddress()
+	if err != nil {
+		return nil, err
+	}
+	p := &Permissioning{
+		ID:      id.Permissioning.DeepCopy(),
+		Address: address,
+		Handler: registration.NewImplementation(),
+	}
+	p.Comms = registration.StartRegistrationServer(p.ID, p.Address,
+		p.Handler, testkeys.GetNodeCert(), testkeys.GetNodeKey(), nil)
+	n.Permissioning = p
+
+	for i := 0; i < config.Nodes; i++ {
+		nid := newID(fmt.Sprintf("node%d", i), id.Node)
+
+		if address, err = freeAddress(); err != nil {
+			n.Shutdown()
+			return nil, err
+		}
+		nd := &Node{ID: nid, Address: address,
+			Handler: node.NewImplementation()}
+		nd.Comms = node.StartNode(nd.ID, nd.Address, 0, nd.Handler,
+			testkeys.GetNodeCert(), testkeys.GetNodeKey())
+		n.Nodes = append(n.Nodes, nd)
+
+		if address, err = freeAddress(); err != nil {
+			n.Shutdown()
+			return nil, err
+		}
+		gwID := nid.DeepCopy()
+		gwID.SetType(id.Gateway)
+		gw := &Gateway{ID: gwID, Address: address,
+			Handler: gateway.NewImplementation()}
+		gw.Comms = gateway.StartGateway(gw.ID, gw.Address, gw.Handler,
+			testkeys.GetGatewayCert(), testkeys.GetGatewayKey(),
+			gossip.DefaultManagerFlags())
+		n.Gateways = append(n.Gateways, gw)
+	}
+
+	for i := 0; i < config.Clients; i++ {
+		cid := newID(fmt.Sprintf("client%d", i), id.User)
+		c, err := client.NewClientComms(cid, nil, testkeys.GetGatewayKey(),
+			nil)
+		if err != nil {
+			n.Shutdown()
+			return nil, errors.Errorf("Failed to create client %d: %+v", i,
+				err)
+		}
+		n.Clients = append(n.Clients, c)
+	}
+
+	if err = n.connect(config.HostParams); err != nil {
+		n.Shutdown()
+		return nil, err
+	}
+	return n, nil
+}
+
+// connect adds to each member hosts for those it talks to: permissioning
+// talks to every node and gateway, nodes to permissioning, each other and
+// their gateway, gateways to permissioning, their node and each other, and
+// clients to permissioning and every gateway.
+func (n *Network) connect(params connect.HostParams) error {
+	p := n.Permissioning
+	nodeCert, gatewayCert := testkeys.GetNodeCert(), testkeys.GetGatewayCert()
+
+	add := func(m *connect.Manager, hid *id.ID, address string,
+		cert []byte) error {
+		if _, err := m.AddHost(hid, address, cert, params); err != nil {
+			return errors.Errorf("Failed to add host %s: %+v", hid, err)
+		}
+		return nil
+	}
+
+	for i, nd := range n.Nodes {
+		gw := n.Gateways[i]
+		if err := add(p.Comms.Manager, nd.ID, nd.Address, nodeCert); err != nil {
+			return err
+		}
+		if err := add(p.Comms.Manager, gw.ID, gw.Address, gatewayCert); err != nil {
+			return err
+		}
+
+		for _, m := range []*connect.Manager{nd.Comms.Manager,
+			gw.Comms.ProtoComms.Manager} {
+			if err := add(m, &id.Permissioning, p.Address, nodeCert); err != nil {
+				return err
+			}
+		}
+		if err := add(nd.Comms.Manager, gw.ID, gw.Address, gatewayCert); err != nil {
+			return err
+		}
+		if err := add(gw.Comms.ProtoComms.Manager, nd.ID, nd.Address, nodeCert); err != nil {
+			return err
+		}
+
+		for j, other := range n.Nodes {
+			if j == i {
+				continue
+			}
+			if err := add(nd.Comms.Manager, other.ID, other.Address, nodeCert); err != nil {
+				return err
+			}
+			otherGw := n.Gateways[j]
+			if err := add(gw.Comms.ProtoComms.Manager, otherGw.ID,
+				otherGw.Address, gatewayCert); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, c := range n.Clients {
+		if err := add(c.Manager, &id.Permissioning, p.Address, nodeCert); err != nil {
+			return err
+		}
+		for _, gw := range n.Gateways {
+			if err := add(c.Manager, gw.ID, gw.Address, gatewayCert); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Shutdown stops every server of the network.
+func (n *Network) Shutdown() {
+	for _, gw := range n.Gateways {
+		gw.Comms.Shutdown()
+	}
+	for _, nd := range n.Nodes {
+		nd.Comms.Shutdown()
+	}
+	if n.Permissioning != nil {
+		n.Permissioning.Comms.Shutdown()
+	}
+}
+
+// GatewayHost returns the host the client holds for the gateway of the i'th
+// node.
+func (n *Network) GatewayHost(c *client.Comms, i int) (*connect.Host, error) {
+	host, exists := c.GetHost(n.Gateways[i].ID)
+	if !exists {
+		return nil, errors.Errorf("Client has no host for gateway %d", i)
+	}
+	return host, nil
+}
+
+// NodeHost returns the host the i'th node holds for the j'th node.
+func (n *Network) NodeHost(i, j int) (*connect.Host, error) {
+	host, exists := n.Nodes[i].Comms.GetHost(n.Nodes[j].ID)
+	if !exists {
+		return nil, errors.Errorf("Node %d has no host for node %d", i, j)
+	}
+	return host, nil
+}
+
+// newID returns the ID of a member of the network with the name.
+func newID(name string, idType id.Type) *id.ID {
+	nid := &id.ID{}
+	copy(nid[:id.ArrIDLen-1], name)
+	nid.SetType(idType)
+	return nid
+}
+
+// freeAddress returns a loopback address with a port which is free.
+func freeAddress() (string, error) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", errors.Errorf("Failed to find a free port: %+v", err)
+	}
+	address := lis.Addr().String()
+	if err = lis.Close(); err != nil {
+		return "", err
+	}
+	return address, nil
+}
@@ -0,0 +1,57 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package testharness
+
+import (
+	"testing"
+	"time"
+
+	pb "gitlab.com/elixxir/comms/mixmessages"
+)
+
+// Tests that a client reaches the scripted handler of a gateway and nodes
+// reach each other.
+func TestNew(t *testing.T) {
+	n := New(t, DefaultConfig())
+	if len(n.Nodes) != 3 || len(n.Gateways) != 3 || len(n.Clients) != 1 {
+		t.Fatalf("Unexpected network: %d nodes, %d gateways, %d clients",
+			len(n.Nodes), len(n.Gateways), len(n.Clients))
+	}
+
+	received := make(chan uint64, 1)
+	n.Gateways[1].Handler.Functions.PutMessage = func(
+		msg *pb.GatewaySlot, _ string) (*pb.GatewaySlotResponse, error) {
+		received <- msg.GetRoundID()
+		return &pb.GatewaySlotResponse{Accepted: true,
+			RoundID: msg.GetRoundID()}, nil
+	}
+
+	host, err := n.GatewayHost(n.Clients[0], 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := n.Clients[0].SendPutMessage(host, &pb.GatewaySlot{
+		Message: &pb.Slot{PayloadA: []byte("payload")},
+		RoundID: 42,
+		Target:  n.Gateways[1].ID.Marshal(),
+	}, 5*time.Second)
+	if err != nil {
+		t.Fatalf("Failed to put message: %+v", err)
+	}
+	if !resp.GetAccepted() || <-received != 42 {
+		t.Errorf("Unexpected response: %+v", resp)
+	}
+
+	host, err = n.NodeHost(0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = n.Nodes[0].Comms.SendAskOnline(host); err != nil {
+		t.Errorf("Node 0 failed to reach node 2: %+v", err)
+	}
+}
@@ -0,0 +1,73 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains a check that a server's Handler interface and the gRPC service it
+// serves have not drifted apart
+
+package testutils
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"google.golang.org/grpc"
+)
+
+// CheckEndpoints fails the test for each method of the handler interface
+// without a matching endpoint in the service, and each endpoint of the
+// service without a matching handler method. Comms objects embed the
+// generated Unimplemented server, so an RPC added to the proto compiles
+// without an endpoint; this check catches it. Handler methods and endpoints
+// which intentionally have no counterpart are listed as exceptions. The
+// handler is a nil pointer to the interface, e.g. (*node.Handler)(nil).
+func CheckEndpoints(t testing.TB, handler interface{},
+	desc *grpc.ServiceDesc, exceptions ...string) {
+	t.Helper()
+
+	handlerType := reflect.TypeOf(handler)
+	if handlerType == nil || handlerType.Kind() != reflect.Ptr ||
+		handlerType.Elem().Kind() != reflect.Interface {
+		t.Fatalf("Handler must be a nil pointer to an interface, got %T",
+			handler)
+	}
+	handlerType = handlerType.Elem()
+
+	except := make(map[string]bool, len(exceptions))
+	for _, name := range exceptions {
+		except[name] = true
+	}
+
+	endpoints := make(map[string]bool)
+	for _, m := range desc.Methods {
+		endpoints[m.MethodName] = true
+	}
+	for _, s := range desc.Streams {
+		endpoints[s.StreamName] = true
+	}
+
+	for i := 0; i < handlerType.NumMethod(); i++ {
+		name := handlerType.Method(i).Name
+		if !endpoints[name] && !except[name] {
+			t.Errorf("Handler method %s has no endpoint in service %s",
+				name, desc.ServiceName)
+		}
+	}
+
+	names := make([]string, 0, len(endpoints))
+	for name := range endpoints {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, exists := handlerType.MethodByName(name); !exists &&
+			!except[name] {
+			t.Errorf("Endpoint %s of service %s has no handler method",
+				name, desc.ServiceName)
+		}
+	}
+}
@@ -0,0 +1,373 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains generators for random-but-valid comms messages and mutation
+// helpers which break them, for use in property and fuzz testing
+
+package testutils
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/primitives/states"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/ndf"
+)
+
+// Bounds used when generating random messages. They are kept small so that
+// generated messages are cheap to sign and compare in tests.
+const (
+	maxRandomTopologySize = 5
+	maxRandomBatchSize    = 32
+	maxRandomPayloadSize  = 256
+	maxRandomNdfNodes     = 8
+)
+
+// testingOnly panics if i is not a testing object.
+func testingOnly(i interface{}, fn string) {
+	switch i.(type) {
+	case *testing.T, *testing.M, *testing.B:
+		break
+	default:
+		jww.FATAL.Panicf("%s is restricted to testing only. Got %T", fn, i)
+	}
+}
+
+// RandomRoundInfo generates an unsigned RoundInfo with random but internally
+// consistent contents. The state is picked at random and a timestamp is set
+// for every state up to and including it, in ascending order.
+func RandomRoundInfo(rng *rand.Rand, i interface{}) *pb.RoundInfo {
+	testingOnly(i, "RandomRoundInfo")
+
+	state := states.Round(rng.Intn(int(states.NUM_STATES)))
+	return randomRoundInfo(rng, state, randomTime(rng), i)
+}
+
+// RandomQueuedRoundInfo generates an unsigned RoundInfo in the QUEUED state
+// whose realtime is scheduled to start at startTime. This is the shape of
+// round accepted by dataStructures.WaitingRounds.
+func RandomQueuedRoundInfo(rng *rand.Rand, startTime time.Time,
+	i interface{}) *pb.RoundInfo {
+	testingOnly(i, "RandomQueuedRoundInfo")
+
+	ri := randomRoundInfo(rng, states.QUEUED, startTime, i)
+	ri.Timestamps[states.QUEUED] = uint64(startTime.UnixNano())
+	return ri
+}
+
+// RandomRoundInfos generates num unsigned RoundInfos with unique, ascending
+// round IDs and update IDs.
+func RandomRoundInfos(rng *rand.Rand, num int, i interface{}) []*pb.RoundInfo {
+	testingOnly(i, "RandomRoundInfos")
+
+	rounds := make([]*pb.RoundInfo, num)
+	firstID := uint64(rng.Int63n(1 << 32))
+	for j := range rounds {
+		rounds[j] = RandomRoundInfo(rng, i)
+		rounds[j].ID = firstID + uint64(j)
+		rounds[j].UpdateID = firstID + uint64(j)
+	}
+
+	return rounds
+}
+
+// randomRoundInfo builds a RoundInfo in the given state. Timestamps for every
+// state up to the given one are set in order, with the last one falling at
+// lastTimestamp.
+func randomRoundInfo(rng *rand.Rand, state states.Round,
+	lastTimestamp time.Time, i interface{}) *pb.RoundInfo {
+	topologySize := 1 + rng.Intn(maxRandomTopologySize)
+	topology := make([][]byte, topologySize)
+	for j := range topology {
+		topology[j] = id.NewRandomTestID(rng, id.Node, i).Marshal()
+	}
+
+	timestamps := make([]uint64, states.NUM_STATES)
+	ts := lastTimestamp
+	for s := int(state); s >= 0; s-- {
+		timestamps[s] = uint64(ts.UnixNano())
+		ts = ts.Add(-time.Duration(1+rng.Intn(1000)) * time.Millisecond)
+	}
+
+	return &pb.RoundInfo{
+		ID:                         uint64(rng.Int63()),
+		UpdateID:                   uint64(rng.Int63()),
+		State:                      uint32(state),
+		BatchSize:                  uint32(1 + rng.Intn(maxRandomBatchSize)),
+		Topology:                   topology,
+		Timestamps:                 timestamps,
+		ResourceQueueTimeoutMillis: uint32(1 + rng.Intn(60000)),
+		AddressSpaceSize:           uint32(8 + rng.Intn(25)),
+	}
+}
+
+// randomTime returns a random time within a day of the Unix epoch plus ten
+// years, so that generated timestamps are stable across runs with the same
+// seed.
+func randomTime(rng *rand.Rand) time.Time {
+	base := time.Unix(10*365*24*60*60, 0)
+	return base.Add(time.Duration(rng.Int63n(int64(24 * time.Hour))))
+}
+
+// RandomBatch generates a Batch for a random round whose slots are fully
+// populated and correctly indexed.
+func RandomBatch(rng *rand.Rand, i interface{}) *pb.Batch {
+	testingOnly(i, "RandomBatch")
+
+	ri := RandomRoundInfo(rng, i)
+	slots := make([]*pb.Slot, ri.BatchSize)
+	for j := range slots {
+		slots[j] = RandomSlot(rng, uint32(j), len(ri.Topology), i)
+	}
+
+	return &pb.Batch{
+		Round:     ri,
+		FromPhase: int32(rng.Intn(8)),
+		Slots:     slots,
+	}
+}
+
+// RandomSlot generates a client slot at the given index with random payloads
+// and one KMAC per node.
+func RandomSlot(rng *rand.Rand, index uint32, numNodes int,
+	i interface{}) *pb.Slot {
+	testingOnly(i, "RandomSlot")
+
+	kmacs := make([][]byte, numNodes)
+	for j := range kmacs {
+		kmacs[j] = randomBytes(rng, 32)
+	}
+
+	return &pb.Slot{
+		Index:    index,
+		SenderID: id.NewRandomTestID(rng, id.User, i).Marshal(),
+		PayloadA: randomBytes(rng, 1+rng.Intn(maxRandomPayloadSize)),
+		PayloadB: randomBytes(rng, 1+rng.Intn(maxRandomPayloadSize)),
+		Salt:     randomBytes(rng, 32),
+		KMACs:    kmacs,
+	}
+}
+
+// RandomNdf generates a NetworkDefinition with a random number of nodes, each
+// with a paired gateway. Certificates and groups are copied from the example
+// NDF so that the result is accepted by the NDF parsing code.
+func RandomNdf(rng *rand.Rand, i interface{}) *ndf.NetworkDefinition {
+	testingOnly(i, "RandomNdf")
+
+	def := NDF.DeepCopy()
+	def.Timestamp = randomTime(rng)
+
+	numNodes := 1 + rng.Intn(maxRandomNdfNodes)
+	nodeCert := def.Nodes[0].TlsCertificate
+	gwCert := def.Gateways[0].TlsCertificate
+	def.Nodes = make([]ndf.Node, numNodes)
+	def.Gateways = make([]ndf.Gateway, numNodes)
+	for j := 0; j < numNodes; j++ {
+		nid := id.NewRandomTestID(rng, id.Node, i)
+		gwID := nid.DeepCopy()
+		gwID.SetType(id.Gateway)
+
+		def.Nodes[j] = ndf.Node{
+			ID:             nid.Marshal(),
+			Address:        randomAddress(rng),
+			TlsCertificate: nodeCert,
+		}
+		def.Gateways[j] = ndf.Gateway{
+			ID:             gwID.Marshal(),
+			Address:        randomAddress(rng),
+			TlsCertificate: gwCert,
+		}
+	}
+
+	return def
+}
+
+// RoundInfoMutation breaks a single invariant of a valid RoundInfo in place.
+type RoundInfoMutation func(ri *pb.RoundInfo, rng *rand.Rand)
+
+// RoundInfoMutations lists every supported way of invalidating a RoundInfo,
+// keyed by a description of the broken invariant.
+var RoundInfoMutations = map[string]RoundInfoMutation{
+	"invalid state": func(ri *pb.RoundInfo, rng *rand.Rand) {
+		ri.State = uint32(states.NUM_STATES) + uint32(rng.Intn(100))
+	},
+	"truncated timestamps": func(ri *pb.RoundInfo, rng *rand.Rand) {
+		ri.Timestamps = ri.Timestamps[:rng.Intn(len(ri.Timestamps))]
+	},
+	"out of order timestamps": func(ri *pb.RoundInfo, rng *rand.Rand) {
+		if ri.State == 0 {
+			ri.State = 1
+			ri.Timestamps[1] = ri.Timestamps[0]
+		}
+		ri.Timestamps[0] = ri.Timestamps[ri.State] + uint64(1+rng.Intn(1000))
+	},
+	"empty topology": func(ri *pb.RoundInfo, rng *rand.Rand) {
+		ri.Topology = nil
+	},
+	"duplicate topology node": func(ri *pb.RoundInfo, rng *rand.Rand) {
+		ri.Topology = append(ri.Topology, ri.Topology[rng.Intn(len(ri.Topology))])
+	},
+	"malformed topology node": func(ri *pb.RoundInfo, rng *rand.Rand) {
+		j := rng.Intn(len(ri.Topology))
+		ri.Topology[j] = ri.Topology[j][:rng.Intn(id.ArrIDLen)]
+	},
+	"zero batch size": func(ri *pb.RoundInfo, rng *rand.Rand) {
+		ri.BatchSize = 0
+	},
+	"corrupted signature": func(ri *pb.RoundInfo, rng *rand.Rand) {
+		sig := ri.GetSig()
+		if len(sig.Signature) == 0 {
+			sig.Signature = randomBytes(rng, 256)
+			return
+		}
+		sig.Signature[rng.Intn(len(sig.Signature))] ^= 0xFF
+	},
+}
+
+// MutateRoundInfo returns a copy of ri with a single, randomly chosen
+// invariant broken, along with the description of the mutation applied.
+// The original RoundInfo is not modified.
+func MutateRoundInfo(ri *pb.RoundInfo, rng *rand.Rand,
+	i interface{}) (*pb.RoundInfo, string) {
+	testingOnly(i, "MutateRoundInfo")
+
+	names := make([]string, 0, len(RoundInfoMutations))
+	for name := range RoundInfoMutations {
+		names = append(names, name)
+	}
+
+	name := pickMutation(rng, names)
+	mutated := proto.Clone(ri).(*pb.RoundInfo)
+	RoundInfoMutations[name](mutated, rng)
+	return mutated, name
+}
+
+// BatchMutation breaks a single invariant of a valid Batch in place.
+type BatchMutation func(b *pb.Batch, rng *rand.Rand)
+
+// BatchMutations lists every supported way of invalidating a Batch, keyed by
+// a description of the broken invariant.
+var BatchMutations = map[string]BatchMutation{
+	"nil round": func(b *pb.Batch, rng *rand.Rand) {
+		b.Round = nil
+	},
+	"too many slots": func(b *pb.Batch, rng *rand.Rand) {
+		numNodes := len(b.Round.Topology)
+		for j := 0; j <= rng.Intn(maxRandomBatchSize); j++ {
+			index := uint32(len(b.Slots))
+			b.Slots = append(b.Slots, &pb.Slot{Index: index,
+				KMACs: make([][]byte, numNodes)})
+		}
+		b.Round.BatchSize = uint32(len(b.Slots)) - 1
+	},
+	"duplicate slot index": func(b *pb.Batch, rng *rand.Rand) {
+		j := rng.Intn(len(b.Slots))
+		b.Slots = append(b.Slots, proto.Clone(b.Slots[j]).(*pb.Slot))
+	},
+	"slot index out of range": func(b *pb.Batch, rng *rand.Rand) {
+		b.Slots[rng.Intn(len(b.Slots))].Index = b.Round.BatchSize +
+			uint32(rng.Intn(100))
+	},
+	"empty payload": func(b *pb.Batch, rng *rand.Rand) {
+		b.Slots[rng.Intn(len(b.Slots))].PayloadA = nil
+	},
+	"missing KMAC": func(b *pb.Batch, rng *rand.Rand) {
+		s := b.Slots[rng.Intn(len(b.Slots))]
+		s.KMACs = s.KMACs[:len(s.KMACs)-1]
+	},
+}
+
+// MutateBatch returns a copy of b with a single, randomly chosen invariant
+// broken, along with the description of the mutation applied. The original
+// Batch is not modified.
+func MutateBatch(b *pb.Batch, rng *rand.Rand, i interface{}) (*pb.Batch, string) {
+	testingOnly(i, "MutateBatch")
+
+	names := make([]string, 0, len(BatchMutations))
+	for name := range BatchMutations {
+		names = append(names, name)
+	}
+
+	name := pickMutation(rng, names)
+	mutated := proto.Clone(b).(*pb.Batch)
+	BatchMutations[name](mutated, rng)
+	return mutated, name
+}
+
+// NdfMutation breaks a single invariant of a valid NetworkDefinition in place.
+type NdfMutation func(def *ndf.NetworkDefinition, rng *rand.Rand)
+
+// NdfMutations lists every supported way of invalidating a NetworkDefinition,
+// keyed by a description of the broken invariant.
+var NdfMutations = map[string]NdfMutation{
+	"node and gateway count mismatch": func(def *ndf.NetworkDefinition, rng *rand.Rand) {
+		def.Gateways = def.Gateways[:len(def.Gateways)-1]
+	},
+	"duplicate node ID": func(def *ndf.NetworkDefinition, rng *rand.Rand) {
+		dup := def.Nodes[rng.Intn(len(def.Nodes))]
+		def.Nodes = append(def.Nodes, dup)
+		def.Gateways = append(def.Gateways, def.Gateways[0])
+	},
+	"malformed node ID": func(def *ndf.NetworkDefinition, rng *rand.Rand) {
+		n := &def.Nodes[rng.Intn(len(def.Nodes))]
+		n.ID = n.ID[:rng.Intn(id.ArrIDLen)]
+	},
+	"empty gateway address": func(def *ndf.NetworkDefinition, rng *rand.Rand) {
+		def.Gateways[rng.Intn(len(def.Gateways))].Address = ""
+	},
+	"empty node certificate": func(def *ndf.NetworkDefinition, rng *rand.Rand) {
+		def.Nodes[rng.Intn(len(def.Nodes))].TlsCertificate = ""
+	},
+	"missing cmix group": func(def *ndf.NetworkDefinition, rng *rand.Rand) {
+		def.CMIX = ndf.Group{}
+	},
+}
+
+// MutateNdf returns a copy of def with a single, randomly chosen invariant
+// broken, along with the description of the mutation applied. The original
+// NetworkDefinition is not modified.
+func MutateNdf(def *ndf.NetworkDefinition, rng *rand.Rand,
+	i interface{}) (*ndf.NetworkDefinition, string) {
+	testingOnly(i, "MutateNdf")
+
+	names := make([]string, 0, len(NdfMutations))
+	for name := range NdfMutations {
+		names = append(names, name)
+	}
+
+	name := pickMutation(rng, names)
+	mutated := def.DeepCopy()
+	NdfMutations[name](mutated, rng)
+	return mutated, name
+}
+
+// pickMutation deterministically selects one of the names for a given rng
+// state. The names are sorted first since map iteration order is random.
+func pickMutation(rng *rand.Rand, names []string) string {
+	sort.Strings(names)
+	return names[rng.Intn(len(names))]
+}
+
+// randomBytes returns n bytes read from rng.
+func randomBytes(rng *rand.Rand, n int) []byte {
+	b := make([]byte, n)
+	rng.Read(b)
+	return b
+}
+
+// randomAddress returns a random IPv4 address and port.
+func randomAddress(rng *rand.Rand) string {
+	return fmt.Sprintf("%d.%d.%d.%d:%d", 1+rng.Intn(254), rng.Intn(256),
+		rng.Intn(256), 1+rng.Intn(254), 1024+rng.Intn(64511))
+}
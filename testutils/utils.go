@@ -140,6 +140,29 @@ func SignRoundInfoEddsa(ri *pb.RoundInfo, key *ec.PrivateKey, i interface{}) err
 
 }
 
+// SignRoundInfoDual signs a round info message with both the testing RSA key
+// and the passed elliptic key, as done by permissioning while clients migrate
+// from RSA to Ed25519 verification.
+func SignRoundInfoDual(ri *pb.RoundInfo, key *ec.PrivateKey, i interface{}) error {
+	switch i.(type) {
+	case *testing.T:
+		break
+	case *testing.M:
+		break
+	case *testing.B:
+		break
+	default:
+		jww.FATAL.Panicf("SignRoundInfoDual is restricted to testing only. Got %T", i)
+	}
+
+	err := SignRoundInfoRsa(ri, i)
+	if err != nil {
+		return err
+	}
+
+	return SignRoundInfoEddsa(ri, key, i)
+}
+
 // NewContextTesting constructs a context.Context object on
 // the local Unix default domain (UDP) port
 func NewContextTesting(i interface{}) (context.Context, context.CancelFunc) {
@@ -22,7 +22,7 @@ import (
 // Handles validation of reverse-authentication tokens
 func (u *Comms) AuthenticateToken(ctx context.Context,
 	msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
-	err := u.ValidateToken(msg)
+	err := u.AuthMetrics.Validate(msg, u.ProtoComms.GetHost, u.ValidateToken)
 	if err != nil {
 		jww.ERROR.Printf("Unable to authenticate token: %+v", err)
 	}
@@ -32,6 +32,7 @@ func (u *Comms) AuthenticateToken(ctx context.Context,
 // Handles reception of reverse-authentication token requests
 func (u *Comms) RequestToken(context.Context, *messages.Ping) (*messages.AssignToken, error) {
 	token, err := u.GenerateToken()
+	u.AuthMetrics.RecordIssued(err)
 	return &messages.AssignToken{
 		Token: token,
 	}, err
@@ -12,7 +12,17 @@ package udb
 import (
 	//	"github.com/pkg/errors"
 	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/elixxir/comms/authMetrics"
+	"gitlab.com/elixxir/comms/buildInfo"
+	// Registers the compressors the server accepts
+	_ "gitlab.com/elixxir/comms/compression"
+	"gitlab.com/elixxir/comms/endpointSwitch"
+	"gitlab.com/elixxir/comms/health"
+	"gitlab.com/elixxir/comms/instrumentation"
+	"gitlab.com/elixxir/comms/interceptors"
+	"gitlab.com/elixxir/comms/messageSize"
 	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/validation"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/comms/messages"
 	//	"gitlab.com/xx_network/comms/messages"
@@ -27,10 +37,33 @@ type Comms struct {
 	*connect.ProtoComms
 	handler Handler // an object that implements the interface below, which
 	// has all the functions called by endpoint.go
-	*pb.UnimplementedUDBServer
-	*messages.UnimplementedGenericServer
+	// Results of reverse-authentication handshakes with this server
+	AuthMetrics *authMetrics.Tracker
+	// Describes what this server is running to remote callers
+	BuildInfo *buildInfo.Server
+	// Liveness and readiness probes of this server
+	Health *health.Server
+	// Interceptors run on the calls received and sent by this server
+	Interceptors *interceptors.Chain
+	// Limits on the size of the messages sent and received by this server
+	MessageSize *messageSize.Limiter
+	// Validators checking the requests received before they reach the
+	// handler
+	Validators *validation.Validators
+	// Endpoints disabled at runtime
+	Switches *endpointSwitch.Switches
+	pb.UnimplementedUDBServer
+	messages.UnimplementedGenericServer
+	// Callbacks reporting each send
+	sendHooks instrumentation.Hooks
 }
 
+// Comms is checked at compile time to serve its gRPC services
+var (
+	_ pb.UDBServer           = (*Comms)(nil)
+	_ messages.GenericServer = (*Comms)(nil)
+)
+
 // StartServer starts a new server on the address:port specified by localServer
 // and a callback interface for server operations
 // with given path to public and private key for TLS connection
@@ -43,11 +76,25 @@ func StartServer(id *id.ID, localServer string, handler Handler,
 	}
 
 	udbServer := Comms{
-		ProtoComms: pc,
-		handler:    handler,
+		ProtoComms:  pc,
+		handler:     handler,
+		AuthMetrics: authMetrics.NewTracker(),
 	}
-	pb.RegisterUDBServer(udbServer.GetServer(), &udbServer)
+	udbServer.Switches = endpointSwitch.NewSwitches()
+	udbServer.Interceptors = interceptors.New()
+	udbServer.MessageSize = messageSize.NewLimiter(messageSize.Limits{})
+	udbServer.MessageSize.Install(udbServer.Interceptors)
+	udbServer.Validators = newValidators()
+	udbServer.Interceptors.AddUnaryServerInterceptor(
+		udbServer.Validators.UnaryServerInterceptor())
+	udbServer.Switches.Register(udbServer.GetServer(),
+		udbServer.Interceptors.Wrap(&pb.UDB_ServiceDesc), &udbServer)
+	udbServer.Switches.RegisterAdmin(udbServer.GetServer(), udbServer.AuthenticatedReceiver)
 	messages.RegisterGenericServer(udbServer.GetServer(), &udbServer)
+	udbServer.BuildInfo = buildInfo.NewServer()
+	udbServer.BuildInfo.Register(udbServer.GetServer())
+	udbServer.Health = health.NewServer(handler)
+	udbServer.Health.Register(udbServer.GetServer())
 
 	pc.ServeWithWeb()
 	return &udbServer
@@ -28,7 +28,7 @@ func (u *Comms) RequestNdf(host *connect.Host) (*pb.NDF, error) {
 		defer cancel()
 
 		// Send the message
-		resultMsg, err := pb.NewRegistrationClient(conn.GetGrpcConn()).
+		resultMsg, err := pb.NewRegistrationClient(u.Interceptors.ClientConn(conn.GetGrpcConn())).
 			PollNdf(ctx, &pb.NDFHash{Hash: make([]byte, 0)})
 		if err != nil {
 			return nil, errors.New(err.Error())
@@ -9,6 +9,8 @@ package udb
 
 import (
 	"fmt"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/testutils"
 	"sync"
 	"testing"
 )
@@ -92,3 +94,9 @@ func TestTwo(t *testing.T) {
 		}
 	*/
 }
+
+// Tests that every Handler method has an endpoint and every endpoint a
+// Handler method.
+func TestHandler_Endpoints(t *testing.T) {
+	testutils.CheckEndpoints(t, (*Handler)(nil), &pb.UDB_ServiceDesc)
+}
@@ -0,0 +1,25 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the validation of the requests received by user discovery
+
+package udb
+
+import (
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/validation"
+)
+
+// newValidators returns the validators the requests received by user
+// discovery are checked with by default.
+func newValidators() *validation.Validators {
+	v := validation.New()
+	v.Register(&pb.UDBUserRegistration{}, validation.ID("UID"))
+	v.Register(&pb.FactRemovalRequest{}, validation.ID("UID"))
+	v.Register(&pb.UsernameValidationRequest{}, validation.ID("UserId"))
+	return v
+}
@@ -0,0 +1,118 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package unixSocket serves comms over Unix domain sockets, so that a node
+// and gateway on the same machine can skip the TCP stack. Hosts already dial
+// "unix:///path/to/socket" addresses, as gRPC resolves the unix scheme
+// itself, but connect.StartCommServer only listens on TCP. Serve instead
+// adds a Unix socket listener to the gRPC server of a comms object, which
+// keeps its TCP listener. Connections over the socket use the same TLS
+// credentials as those over TCP.
+package unixSocket
+
+import (
+	"net"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	"google.golang.org/grpc"
+)
+
+// Scheme is the prefix of Unix domain socket addresses.
+const Scheme = "unix:"
+
+// IsAddress returns true if the address is a Unix domain socket address.
+func IsAddress(address string) bool {
+	return strings.HasPrefix(address, Scheme)
+}
+
+// Path returns the path of the socket of a Unix domain socket address, in
+// either of the forms gRPC accepts: "unix:path" or "unix:///absolute/path".
+func Path(address string) (string, error) {
+	if !IsAddress(address) {
+		return "", errors.Errorf("%q is not a Unix socket address", address)
+	}
+	path := strings.TrimPrefix(address, Scheme)
+	if strings.HasPrefix(path, "//") {
+		path = strings.TrimPrefix(path, "//")
+		if !strings.HasPrefix(path, "/") {
+			return "", errors.Errorf("Unix socket address %q must have an "+
+				"absolute path", address)
+		}
+	}
+	if path == "" {
+		return "", errors.Errorf("Unix socket address %q has no path",
+			address)
+	}
+	return path, nil
+}
+
+// Listener is a Unix domain socket served by a gRPC server.
+type Listener struct {
+	lis  net.Listener
+	path string
+	once sync.Once
+}
+
+// Serve listens on the Unix domain socket at the address and serves the
+// gRPC server on it until the listener or server is closed. A stale socket
+// left at the path is replaced; any other file is not.
+func Serve(server *grpc.Server, address string) (*Listener, error) {
+	path, err := Path(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if info, err := os.Lstat(path); err == nil {
+		if info.Mode()&os.ModeSocket == 0 {
+			return nil, errors.Errorf("Cannot listen on %s: file exists and "+
+				"is not a socket", path)
+		}
+		if err = os.Remove(path); err != nil {
+			return nil, errors.Errorf("Failed to remove stale socket %s: %+v",
+				path, err)
+		}
+	}
+
+	lis, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, errors.Errorf("Failed to listen on %s: %+v", path, err)
+	}
+
+	go func() {
+		// This blocks for the lifetime of the listener
+		if err := server.Serve(lis); err != nil &&
+			err != grpc.ErrServerStopped {
+			jww.WARN.Printf("Stopped serving on %s: %+v", path, err)
+		}
+		jww.INFO.Printf("Shutting down Unix socket listener %s", path)
+	}()
+
+	return &Listener{lis: lis, path: path}, nil
+}
+
+// Addr returns the address of the socket.
+func (l *Listener) Addr() net.Addr {
+	return l.lis.Addr()
+}
+
+// Close stops listening on the socket and removes it. Connections already
+// made are closed with the server.
+func (l *Listener) Close() error {
+	var err error
+	l.once.Do(func() {
+		err = l.lis.Close()
+		if rmErr := os.Remove(l.path); rmErr != nil && !os.IsNotExist(rmErr) &&
+			err == nil {
+			err = rmErr
+		}
+	})
+	return err
+}
@@ -0,0 +1,92 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package unixSocket
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Tests the paths parsed from addresses.
+func TestPath(t *testing.T) {
+	tests := map[string]string{
+		"unix:///tmp/node.sock": "/tmp/node.sock",
+		"unix:node.sock":        "node.sock",
+	}
+	for address, expected := range tests {
+		path, err := Path(address)
+		if err != nil || path != expected {
+			t.Errorf("Path(%q) = %q, %v; expected %q", address, path, err,
+				expected)
+		}
+	}
+
+	for _, address := range []string{"0.0.0.0:5970", "unix:", "unix://tmp"} {
+		if _, err := Path(address); err == nil {
+			t.Errorf("Parsed invalid address %q.", address)
+		}
+	}
+}
+
+// Tests that a server served on a socket is reached by dialing its address.
+func TestServe(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "server.sock")
+	address := "unix://" + path
+
+	server := grpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+	defer server.Stop()
+
+	l, err := Serve(server, address)
+	if err != nil {
+		t.Fatalf("Failed to serve: %+v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, address, grpc.WithInsecure(),
+		grpc.WithBlock())
+	if err != nil {
+		t.Fatalf("Failed to dial %s: %+v", address, err)
+	}
+	defer conn.Close()
+
+	resp, err := grpc_health_v1.NewHealthClient(conn).Check(ctx,
+		&grpc_health_v1.HealthCheckRequest{})
+	if err != nil {
+		t.Fatalf("Failed to call server: %+v", err)
+	}
+	if resp.Status != grpc_health_v1.HealthCheckResponse_SERVING {
+		t.Errorf("Unexpected status %s.", resp.Status)
+	}
+
+	if err = l.Close(); err != nil {
+		t.Errorf("Failed to close: %+v", err)
+	}
+	if _, err = os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("Socket not removed: %v", err)
+	}
+}
+
+// Tests that a file other than a socket is not replaced.
+func TestServe_NotSocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(path, []byte("data"), 0600); err != nil {
+		t.Fatalf("Failed to write file: %+v", err)
+	}
+	if _, err := Serve(grpc.NewServer(), "unix://"+path); err == nil {
+		t.Errorf("Replaced a file which is not a socket.")
+	}
+}
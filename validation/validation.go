@@ -0,0 +1,227 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package validation checks the requests received by a server before they
+// reach its handler. Validators are registered per message type and run by
+// a server interceptor; a request failing any of them is rejected with an
+// INVALID_ARGUMENT error whose details list each offending field as an
+// errdetails.BadRequest. Requests wrapped in an AuthenticatedMessage are
+// validated by the type of the message they wrap.
+package validation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// Violation describes a field of a request which is not valid. An empty
+// Field refers to the request as a whole.
+type Violation struct {
+	Field       string
+	Description string
+}
+
+// Validator returns the violations of the message, or none if it is valid.
+type Validator func(msg proto.Message) []Violation
+
+// Validators holds the validators of each message type.
+type Validators struct {
+	validators map[protoreflect.FullName][]Validator
+	mux        sync.RWMutex
+}
+
+// New returns Validators without any validators.
+func New() *Validators {
+	return &Validators{
+		validators: make(map[protoreflect.FullName][]Validator),
+	}
+}
+
+// Register adds a validator run on every request of the type of msg.
+func (v *Validators) Register(msg proto.Message, validator Validator) {
+	name := msg.ProtoReflect().Descriptor().FullName()
+	v.mux.Lock()
+	v.validators[name] = append(v.validators[name], validator)
+	v.mux.Unlock()
+}
+
+// get returns the validators of the type.
+func (v *Validators) get(name protoreflect.FullName) []Validator {
+	v.mux.RLock()
+	defer v.mux.RUnlock()
+	return v.validators[name]
+}
+
+// Validate runs the validators of the type of the message, returning an
+// INVALID_ARGUMENT error listing every violation. A nil Validators accepts
+// every message.
+func (v *Validators) Validate(msg proto.Message) error {
+	if v == nil || msg == nil {
+		return nil
+	}
+
+	if authMsg, ok := msg.(*messages.AuthenticatedMessage); ok {
+		return v.validateAuthenticated(authMsg)
+	}
+
+	var violations []Violation
+	for _, validator := range v.get(msg.ProtoReflect().Descriptor().FullName()) {
+		violations = append(violations, validator(msg)...)
+	}
+	return toError(msg, violations)
+}
+
+// validateAuthenticated validates the message wrapped by the authenticated
+// message, if validators are registered for its type.
+func (v *Validators) validateAuthenticated(
+	authMsg *messages.AuthenticatedMessage) error {
+	if authMsg.Message == nil {
+		return nil
+	}
+	name := authMsg.Message.MessageName()
+	if len(v.get(name)) == 0 {
+		return nil
+	}
+	inner, err := anypb.UnmarshalNew(authMsg.Message, proto.UnmarshalOptions{})
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "Failed to unmarshal "+
+			"authenticated %s: %v", name, err)
+	}
+	return v.Validate(inner)
+}
+
+// toError returns the INVALID_ARGUMENT error of the violations, or nil if
+// there are none.
+func toError(msg proto.Message, violations []Violation) error {
+	if len(violations) == 0 {
+		return nil
+	}
+
+	descriptions := make([]string, len(violations))
+	badRequest := &errdetails.BadRequest{}
+	for j, violation := range violations {
+		descriptions[j] = violation.Field + ": " + violation.Description
+		badRequest.FieldViolations = append(badRequest.FieldViolations,
+			&errdetails.BadRequest_FieldViolation{
+				Field:       violation.Field,
+				Description: violation.Description,
+			})
+	}
+
+	s := status.Newf(codes.InvalidArgument, "Invalid %s: %s",
+		msg.ProtoReflect().Descriptor().Name(),
+		strings.Join(descriptions, "; "))
+	if withDetails, err := s.WithDetails(badRequest); err == nil {
+		s = withDetails
+	}
+	return s.Err()
+}
+
+// UnaryServerInterceptor returns an interceptor validating each request
+// before it reaches the handler.
+func (v *Validators) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{},
+		info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (
+		interface{}, error) {
+		if msg, ok := req.(proto.Message); ok {
+			if err := v.Validate(msg); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+/* ------------------------------ Validators ------------------------------ */
+
+// field returns the descriptor of the named field of the message, panicking
+// if it has none, since that is an error in registering the validator.
+func field(msg proto.Message, name string) protoreflect.FieldDescriptor {
+	fd := msg.ProtoReflect().Descriptor().Fields().ByName(
+		protoreflect.Name(name))
+	if fd == nil {
+		panic(fmt.Sprintf("%s has no field %s",
+			msg.ProtoReflect().Descriptor().FullName(), name))
+	}
+	return fd
+}
+
+// Required returns a validator requiring the named fields to be set. Scalar
+// fields are set if they are not zero.
+func Required(names ...string) Validator {
+	return func(msg proto.Message) []Violation {
+		var violations []Violation
+		for _, name := range names {
+			if !msg.ProtoReflect().Has(field(msg, name)) {
+				violations = append(violations,
+					Violation{Field: name, Description: "is required"})
+			}
+		}
+		return violations
+	}
+}
+
+// MaxLength returns a validator limiting the length of the named bytes,
+// string or repeated field.
+func MaxLength(name string, max int) Validator {
+	return func(msg proto.Message) []Violation {
+		fd := field(msg, name)
+		value := msg.ProtoReflect().Get(fd)
+		var length int
+		switch {
+		case fd.IsList():
+			length = value.List().Len()
+		case fd.Kind() == protoreflect.BytesKind:
+			length = len(value.Bytes())
+		default:
+			length = len(value.String())
+		}
+		if length > max {
+			return []Violation{{Field: name, Description: fmt.Sprintf(
+				"has length %d, longer than %d", length, max)}}
+		}
+		return nil
+	}
+}
+
+// MaxSize returns a validator limiting the marshalled size of the message.
+func MaxSize(max int) Validator {
+	return func(msg proto.Message) []Violation {
+		if size := proto.Size(msg); size > max {
+			return []Violation{{Description: fmt.Sprintf(
+				"is %d bytes, larger than %d", size, max)}}
+		}
+		return nil
+	}
+}
+
+// ID returns a validator requiring the named bytes field, if set, to be a
+// marshalled ID.
+func ID(name string) Validator {
+	return func(msg proto.Message) []Violation {
+		value := msg.ProtoReflect().Get(field(msg, name)).Bytes()
+		if len(value) == 0 {
+			return nil
+		}
+		if _, err := id.Unmarshal(value); err != nil {
+			return []Violation{{Field: name, Description: err.Error()}}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,115 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package validation
+
+import (
+	"context"
+	"testing"
+
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// Tests that every violation is listed in the details of the error.
+func TestValidators_Validate(t *testing.T) {
+	v := New()
+	v.Register(&pb.GatewaySlot{}, ID("Target"))
+	v.Register(&pb.GatewaySlot{}, Required("Message", "RoundID"))
+
+	valid := &pb.GatewaySlot{
+		Message: &pb.Slot{},
+		RoundID: 5,
+		Target:  id.NewIdFromString("gateway", id.Gateway, t).Marshal(),
+	}
+	if err := v.Validate(valid); err != nil {
+		t.Errorf("Valid message rejected: %+v", err)
+	}
+
+	err := v.Validate(&pb.GatewaySlot{Target: []byte{1, 2, 3}})
+	s, _ := status.FromError(err)
+	if s.Code() != codes.InvalidArgument {
+		t.Fatalf("Expected %s, got %v", codes.InvalidArgument, err)
+	}
+	if len(s.Details()) != 1 {
+		t.Fatalf("Expected 1 detail, got %d.", len(s.Details()))
+	}
+	badRequest, ok := s.Details()[0].(*errdetails.BadRequest)
+	if !ok {
+		t.Fatalf("Unexpected detail %T.", s.Details()[0])
+	}
+	fields := make(map[string]bool)
+	for _, violation := range badRequest.FieldViolations {
+		fields[violation.Field] = true
+	}
+	for _, f := range []string{"Target", "Message", "RoundID"} {
+		if !fields[f] {
+			t.Errorf("No violation of %s in %v.", f, badRequest)
+		}
+	}
+
+	// Messages without validators are accepted
+	if err = v.Validate(&pb.GatewaySlots{Target: []byte{1}}); err != nil {
+		t.Errorf("Message without validators rejected: %+v", err)
+	}
+}
+
+// Tests that the message wrapped in an authenticated message is validated.
+func TestValidators_Validate_Authenticated(t *testing.T) {
+	v := New()
+	v.Register(&pb.GatewaySlots{}, MaxLength("Messages", 1))
+
+	wrapped, err := anypb.New(&pb.GatewaySlots{
+		Messages: []*pb.GatewaySlot{{}, {}}})
+	if err != nil {
+		t.Fatalf("Failed to wrap message: %+v", err)
+	}
+	err = v.Validate(&messages.AuthenticatedMessage{Message: wrapped})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected %s, got %v", codes.InvalidArgument, err)
+	}
+
+	if err = v.Validate(&messages.AuthenticatedMessage{}); err != nil {
+		t.Errorf("Empty authenticated message rejected: %+v", err)
+	}
+}
+
+// Tests that the interceptor stops invalid requests before the handler.
+func TestValidators_UnaryServerInterceptor(t *testing.T) {
+	v := New()
+	v.Register(&pb.GatewaySlot{}, MaxSize(10))
+	interceptor := v.UnaryServerInterceptor()
+
+	called := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return nil, nil
+	}
+	_, err := interceptor(context.Background(),
+		&pb.GatewaySlot{MAC: make([]byte, 20)}, &grpc.UnaryServerInfo{},
+		handler)
+	if status.Code(err) != codes.InvalidArgument || called {
+		t.Errorf("Invalid request reached the handler: %v", err)
+	}
+
+	_, err = interceptor(context.Background(), &pb.GatewaySlot{},
+		&grpc.UnaryServerInfo{}, handler)
+	if err != nil || !called {
+		t.Errorf("Valid request did not reach the handler: %v", err)
+	}
+
+	var nilValidators *Validators
+	if err = nilValidators.Validate(&pb.GatewaySlot{MAC: make([]byte, 20)}); err != nil {
+		t.Errorf("Nil validators rejected a message: %+v", err)
+	}
+}
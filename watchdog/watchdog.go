@@ -0,0 +1,179 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package watchdog keeps the connections of hosts alive in the background. A
+// connect.Host only finds its connection dead at the next send, which then
+// pays for reconnecting and re-authenticating inline. A Watchdog checks its
+// hosts on an interval and reconnects any whose connection has failed, so
+// sends find a live connection.
+package watchdog
+
+import (
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/any"
+	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc/connectivity"
+)
+
+// Watchdog reconnects the hosts it watches when their connection fails.
+// Connections are detected as failed once gRPC moves them to
+// TransientFailure or Shutdown; keepalive pings, configured by the
+// KaClientOpts of the host, make this happen on idle connections too.
+type Watchdog struct {
+	comms    *connect.ProtoComms
+	interval time.Duration
+	hosts    map[id.ID]*connect.Host
+	// Number of times each host was reconnected
+	reconnects map[id.ID]uint64
+	// Called after a host is reconnected, if set
+	onReconnect func(host *connect.Host)
+	stop        chan struct{}
+	mux         sync.Mutex
+}
+
+// New returns a Watchdog which checks its hosts on the interval, reconnecting
+// them through the comms. The ProtoComms embedded in a Comms object is taken,
+// rather than the Comms, so that checks do not run its send hooks and are not
+// counted, rate limited or failed by its circuit breakers as sends. It is not
+// running until Start is called.
+func New(comms *connect.ProtoComms, interval time.Duration) *Watchdog {
+	return &Watchdog{
+		comms:      comms,
+		interval:   interval,
+		hosts:      make(map[id.ID]*connect.Host),
+		reconnects: make(map[id.ID]uint64),
+	}
+}
+
+// OnReconnect sets a callback called after a host is reconnected. It is
+// called on the watchdog goroutine and so must not block.
+func (w *Watchdog) OnReconnect(callback func(host *connect.Host)) {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	w.onReconnect = callback
+}
+
+// Watch adds the host to those kept connected. A host which has not yet
+// connected is connected at the next check.
+func (w *Watchdog) Watch(host *connect.Host) {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	w.hosts[*host.GetId()] = host
+}
+
+// Unwatch stops keeping the host connected. Its connection is left as is.
+func (w *Watchdog) Unwatch(hid *id.ID) {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	delete(w.hosts, *hid)
+	delete(w.reconnects, *hid)
+}
+
+// Reconnects returns the number of times the host was reconnected.
+func (w *Watchdog) Reconnects(hid *id.ID) uint64 {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	return w.reconnects[*hid]
+}
+
+// Start starts checking the hosts in the background. Calling Start on a
+// running Watchdog does nothing.
+func (w *Watchdog) Start() {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	if w.stop != nil {
+		return
+	}
+	w.stop = make(chan struct{})
+	go w.run(w.stop)
+}
+
+// Stop stops checking the hosts.
+func (w *Watchdog) Stop() {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	if w.stop != nil {
+		close(w.stop)
+		w.stop = nil
+	}
+}
+
+// run checks the hosts on the interval until stopped.
+func (w *Watchdog) run(stop chan struct{}) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			w.CheckAll()
+		}
+	}
+}
+
+// CheckAll checks every watched host, reconnecting any whose connection has
+// failed.
+func (w *Watchdog) CheckAll() {
+	w.mux.Lock()
+	hosts := make([]*connect.Host, 0, len(w.hosts))
+	for _, host := range w.hosts {
+		hosts = append(hosts, host)
+	}
+	w.mux.Unlock()
+
+	for _, host := range hosts {
+		w.check(host)
+	}
+}
+
+// check reconnects the host if its connection has failed. Sending over the
+// host does this, as a send reconnects a host whose connection is not alive
+// and runs the authentication handshake if the host requires it; the send
+// itself makes no call to the remote. Idle gRPC connections are asked to
+// connect so that keepalive pings run on them; web connections have no gRPC
+// connection.
+func (w *Watchdog) check(host *connect.Host) {
+	_, count := host.Connected()
+
+	_, err := w.comms.Send(host, func(conn connect.Connection) (
+		*any.Any, error) {
+		if conn.IsWeb() {
+			return nil, nil
+		}
+		if gc := conn.GetGrpcConn(); gc != nil &&
+			gc.GetState() == connectivity.Idle {
+			gc.Connect()
+		}
+		return nil, nil
+	})
+	if err != nil {
+		jww.WARN.Printf("Watchdog failed to reconnect to %s: %+v",
+			host.GetId(), err)
+		return
+	}
+
+	if _, newCount := host.Connected(); newCount == count {
+		return
+	}
+
+	w.mux.Lock()
+	if _, watched := w.hosts[*host.GetId()]; watched {
+		w.reconnects[*host.GetId()]++
+	}
+	onReconnect := w.onReconnect
+	w.mux.Unlock()
+
+	jww.INFO.Printf("Watchdog reconnected to %s", host.GetId())
+	if onReconnect != nil {
+		onReconnect(host)
+	}
+}
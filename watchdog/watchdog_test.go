@@ -0,0 +1,88 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package watchdog
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"gitlab.com/elixxir/comms/gateway"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/gossip"
+	"gitlab.com/xx_network/primitives/id"
+)
+
+func TestMain(m *testing.M) {
+	connect.TestingOnlyDisableTLS = true
+	os.Exit(m.Run())
+}
+
+// Tests that the watchdog connects a watched host and reconnects it after its
+// connection is closed, but leaves a live connection alone.
+func TestWatchdog_CheckAll(t *testing.T) {
+	remoteAddress := "0.0.0.0:5960"
+	remoteID := id.NewIdFromString("remote", id.Gateway, t)
+	remote := gateway.StartGateway(remoteID, remoteAddress,
+		gateway.NewImplementation(), nil, nil, gossip.DefaultManagerFlags())
+	defer remote.Shutdown()
+
+	localID := id.NewIdFromString("local", id.Gateway, t)
+	local := gateway.StartGateway(localID, "0.0.0.0:5961",
+		gateway.NewImplementation(), nil, nil, gossip.DefaultManagerFlags())
+	defer local.Shutdown()
+
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := local.AddHost(remoteID, remoteAddress, nil, params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+
+	w := New(local.ProtoComms, time.Hour)
+	reconnected := 0
+	w.OnReconnect(func(*connect.Host) { reconnected++ })
+	w.Watch(host)
+
+	w.CheckAll()
+	if connected, _ := host.Connected(); !connected {
+		t.Fatalf("Watched host was not connected.")
+	}
+
+	host.Disconnect()
+	w.CheckAll()
+	if connected, _ := host.Connected(); !connected {
+		t.Fatalf("Watched host was not reconnected.")
+	}
+
+	w.CheckAll()
+	if n := w.Reconnects(remoteID); n != 2 {
+		t.Errorf("Host reconnected %d times, expected 2.", n)
+	}
+	if reconnected != 2 {
+		t.Errorf("Callback called %d times, expected 2.", reconnected)
+	}
+
+	w.Unwatch(remoteID)
+	host.Disconnect()
+	w.CheckAll()
+	if connected, _ := host.Connected(); connected {
+		t.Errorf("Unwatched host was reconnected.")
+	}
+}
+
+// Tests that a started watchdog can be stopped and started again.
+func TestWatchdog_StartStop(t *testing.T) {
+	w := New(nil, time.Millisecond)
+	w.Start()
+	w.Start()
+	w.Stop()
+	w.Stop()
+	w.Start()
+	w.Stop()
+}